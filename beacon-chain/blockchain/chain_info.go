@@ -7,6 +7,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"go.opencensus.io/trace"
 
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -49,6 +50,11 @@ type HeadFetcher interface {
 	ProtoArrayStore() *protoarray.Store
 }
 
+// ReorgFetcher retrieves the reorgs of the chain recently seen by the node.
+type ReorgFetcher interface {
+	RecentReorgs() []*statefeed.ReorgData
+}
+
 // ForkFetcher retrieves the current fork information of the Ethereum beacon chain.
 type ForkFetcher interface {
 	CurrentFork() *pb.Fork
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -107,7 +108,7 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	}
 
 	// Get the new head state from cached state or DB.
-	newHeadState, err := s.stateGen.StateByRoot(ctx, headRoot)
+	newHeadState, err := s.stateGen.StateByRoot(stategen.WithCaller(ctx, "head_update"), headRoot)
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve head state in DB")
 	}
@@ -116,21 +117,27 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	}
 
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
-	headSlot := s.HeadSlot()
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
-		log.WithFields(logrus.Fields{
-			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", headSlot),
-		}).Debug("Chain reorg occurred")
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
-			},
-		})
-
-		reorgCount.Inc()
+	oldHeadRoot := bytesutil.ToBytes32(r)
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
+		if reorg := s.reorgData(ctx, oldHeadRoot, headRoot, newHeadBlock); reorg != nil {
+			log.WithFields(logrus.Fields{
+				"newSlot":        fmt.Sprintf("%d", reorg.NewSlot),
+				"oldSlot":        fmt.Sprintf("%d", reorg.OldSlot),
+				"depth":          reorg.Depth,
+				"commonAncestor": fmt.Sprintf("%#x", bytesutil.Trunc(reorg.CommonAncestorRoot[:])),
+			}).Debug("Chain reorg occurred")
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.Reorg,
+				Data: reorg,
+			})
+			s.saveRecentReorg(reorg)
+
+			reorgCount.Inc()
+			if reorg.CommonAncestorRoot != params.BeaconConfig().ZeroHash {
+				reorgDepth.Observe(float64(reorg.Depth))
+				reorgDistance.Observe(float64(reorg.Distance))
+			}
+		}
 	}
 
 	// Cache the new head info.
@@ -144,6 +151,50 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	return nil
 }
 
+// This returns the reorg data of a head change from the old head to the new head, or nil if the
+// new head descends from the old head. The common ancestor of both heads is looked up in fork
+// choice. If fork choice no longer knows either head, the head change is treated as a reorg
+// of unknown depth.
+func (s *Service) reorgData(
+	ctx context.Context,
+	oldHeadRoot, newHeadRoot [32]byte,
+	newHeadBlock *ethpb.SignedBeaconBlock,
+) *statefeed.ReorgData {
+	ctx, span := trace.StartSpan(ctx, "blockChain.reorgData")
+	defer span.End()
+
+	reorg := &statefeed.ReorgData{
+		NewSlot:          newHeadBlock.Block.Slot,
+		OldSlot:          s.HeadSlot(),
+		OldHeadRoot:      oldHeadRoot,
+		NewHeadRoot:      newHeadRoot,
+		NewHeadStateRoot: newHeadBlock.Block.StateRoot,
+	}
+	s.headLock.RLock()
+	if s.head != nil && s.head.block != nil && s.head.block.Block != nil {
+		reorg.OldHeadStateRoot = s.head.block.Block.StateRoot
+	}
+	s.headLock.RUnlock()
+
+	ancestorRoot, ancestorSlot, err := s.forkChoiceStore.CommonAncestorRoot(ctx, oldHeadRoot, newHeadRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not find common ancestor of old and new head")
+		return reorg
+	}
+	// The new head descends from the old head, there is no reorg.
+	if ancestorRoot == oldHeadRoot {
+		return nil
+	}
+	reorg.CommonAncestorRoot = ancestorRoot
+	if reorg.OldSlot > ancestorSlot {
+		reorg.Depth = reorg.OldSlot - ancestorSlot
+	}
+	if reorg.NewSlot > ancestorSlot {
+		reorg.Distance = reorg.NewSlot - ancestorSlot
+	}
+	return reorg
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -255,7 +306,9 @@ func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot
 		if err != nil {
 			return err
 		}
-	} else {
+	}
+	// The genesis state is not available when the node started from a finalized checkpoint.
+	if justifiedState == nil {
 		justifiedState, err = s.stateGen.StateByRoot(ctx, justifiedRoot)
 		if err != nil {
 			return err
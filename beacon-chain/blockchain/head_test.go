@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -92,6 +95,92 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_Reorg_CommonAncestor(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// a <- b (old head)
+	// ^
+	// c <- new head
+	oldRoot := [32]byte{'b'}
+	oldHeadBlock := testutil.NewBeaconBlock()
+	oldHeadBlock.Block.Slot = 3
+	oldHeadBlock.Block.StateRoot = bytesutil.PadTo([]byte("old state"), 32)
+	service.head = &head{slot: 3, root: oldRoot, block: oldHeadBlock, state: testutil.NewBeaconState()}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = 4
+	newHeadSignedBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'c'}, 32)
+	newHeadSignedBlock.Block.StateRoot = bytesutil.PadTo([]byte("new state"), 32)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(4))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 4, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'a'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, oldRoot, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 2, [32]byte{'c'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 4, newRoot, [32]byte{'c'}, [32]byte{}, 0, 0))
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	want := &statefeed.ReorgData{
+		NewSlot:            4,
+		OldSlot:            3,
+		Depth:              2,
+		Distance:           3,
+		CommonAncestorRoot: [32]byte{'a'},
+		OldHeadRoot:        oldRoot,
+		NewHeadRoot:        newRoot,
+		OldHeadStateRoot:   oldHeadBlock.Block.StateRoot,
+		NewHeadStateRoot:   newHeadSignedBlock.Block.StateRoot,
+	}
+	ev := <-stateChannel
+	assert.Equal(t, feed.EventType(statefeed.Reorg), ev.Type)
+	assert.DeepEqual(t, want, ev.Data)
+	assert.DeepEqual(t, []*statefeed.ReorgData{want}, service.RecentReorgs())
+	require.LogsContain(t, hook, "Chain reorg occurred")
+}
+
+func TestSaveHead_Descendant_NoReorg(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// The new head skips a block which was never the head: a <- b <- new head.
+	oldRoot := [32]byte{'a'}
+	service.head = &head{slot: 1, root: oldRoot, block: testutil.NewBeaconBlock(), state: testutil.NewBeaconState()}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = 3
+	newHeadSignedBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'b'}, 32)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(3))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, oldRoot, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 2, [32]byte{'b'}, oldRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, newRoot, [32]byte{'b'}, [32]byte{}, 0, 0))
+
+	require.NoError(t, service.saveHead(ctx, newRoot))
+	assert.Equal(t, uint64(3), service.HeadSlot(), "Head did not change")
+	assert.Equal(t, 0, len(service.RecentReorgs()))
+	require.LogsDoNotContain(t, hook, "Chain reorg occurred")
+}
+
 func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	service := setupBeaconChain(t, beaconDB)
@@ -98,6 +98,20 @@ var (
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_depth_slots",
+			Help:    "The number of slots between the common ancestor and the old head of a reorg",
+			Buckets: []float64{1, 2, 3, 4, 6, 8, 16, 32, 64},
+		},
+	)
+	reorgDistance = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_distance_slots",
+			Help:    "The number of slots between the common ancestor and the new head of a reorg",
+			Buckets: []float64{1, 2, 3, 4, 6, 8, 16, 32, 64},
+		},
+	)
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
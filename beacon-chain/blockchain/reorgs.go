@@ -0,0 +1,32 @@
+package blockchain
+
+import (
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// maxRecentReorgs is the number of reorgs kept in memory for the debug RPC.
+const maxRecentReorgs = 64
+
+// RecentReorgs returns the reorgs recently seen by the node, the most recent first.
+func (s *Service) RecentReorgs() []*statefeed.ReorgData {
+	s.recentReorgsLock.RLock()
+	defer s.recentReorgsLock.RUnlock()
+
+	reorgs := make([]*statefeed.ReorgData, len(s.recentReorgs))
+	for i, r := range s.recentReorgs {
+		reorgs[len(reorgs)-1-i] = r
+	}
+	return reorgs
+}
+
+// This saves a reorg to the recent reorgs, evicting the oldest reorg once more than
+// maxRecentReorgs are kept.
+func (s *Service) saveRecentReorg(reorg *statefeed.ReorgData) {
+	s.recentReorgsLock.Lock()
+	defer s.recentReorgsLock.Unlock()
+
+	s.recentReorgs = append(s.recentReorgs, reorg)
+	if len(s.recentReorgs) > maxRecentReorgs {
+		s.recentReorgs = s.recentReorgs[len(s.recentReorgs)-maxRecentReorgs:]
+	}
+}
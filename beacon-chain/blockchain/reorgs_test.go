@@ -0,0 +1,33 @@
+package blockchain
+
+import (
+	"testing"
+
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRecentReorgs_MostRecentFirst(t *testing.T) {
+	s := &Service{}
+	assert.Equal(t, 0, len(s.RecentReorgs()))
+
+	for i := uint64(1); i <= 3; i++ {
+		s.saveRecentReorg(&statefeed.ReorgData{NewSlot: i})
+	}
+	reorgs := s.RecentReorgs()
+	require.Equal(t, 3, len(reorgs))
+	assert.Equal(t, uint64(3), reorgs[0].NewSlot)
+	assert.Equal(t, uint64(1), reorgs[2].NewSlot)
+}
+
+func TestRecentReorgs_EvictsOldest(t *testing.T) {
+	s := &Service{}
+	for i := uint64(0); i < maxRecentReorgs+10; i++ {
+		s.saveRecentReorg(&statefeed.ReorgData{NewSlot: i})
+	}
+	reorgs := s.RecentReorgs()
+	require.Equal(t, maxRecentReorgs, len(reorgs))
+	assert.Equal(t, uint64(maxRecentReorgs+9), reorgs[0].NewSlot)
+	assert.Equal(t, uint64(10), reorgs[maxRecentReorgs-1].NewSlot)
+}
@@ -78,6 +78,8 @@ type Service struct {
 	wsEpoch               uint64
 	wsRoot                []byte
 	wsVerified            bool
+	recentReorgs          []*statefeed.ReorgData
+	recentReorgsLock      sync.RWMutex
 }
 
 // Config options for the service.
@@ -170,16 +172,19 @@ func (s *Service) Start() {
 			log.Fatalf("Could not set up chain info: %v", err)
 		}
 
-		// We start a counter to genesis, if needed.
+		// We start a counter to genesis, if needed. A node started from a checkpoint
+		// has no genesis state and is past genesis by definition.
 		gState, err := s.beaconDB.GenesisState(s.ctx)
 		if err != nil {
 			log.Fatalf("Could not retrieve genesis state: %v", err)
 		}
-		gRoot, err := gState.HashTreeRoot(s.ctx)
-		if err != nil {
-			log.Fatalf("Could not hash tree root genesis state: %v", err)
+		if gState != nil {
+			gRoot, err := gState.HashTreeRoot(s.ctx)
+			if err != nil {
+				log.Fatalf("Could not hash tree root genesis state: %v", err)
+			}
+			go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 		}
-		go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 
 		justifiedCheckpoint, err := s.beaconDB.JustifiedCheckpoint(s.ctx)
 		if err != nil {
@@ -426,14 +431,24 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	if genesisBlock != nil {
+		genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else {
+		// A node started from a finalized checkpoint does not have the genesis block,
+		// the checkpoint block is the oldest root the node can build on.
+		originRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get origin block root from db")
+		}
+		if originRoot == params.BeaconConfig().ZeroHash {
+			return errors.New("no genesis block in db")
+		}
+		s.genesisRoot = originRoot
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -287,6 +287,32 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	assert.Equal(t, genesisRoot, c.genesisRoot, "Genesis block root incorrect")
 }
 
+func TestChainService_InitializeChainInfo_FromOrigin(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	ctx := context.Background()
+
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 4
+	originState := testutil.NewBeaconState()
+	require.NoError(t, originState.SetSlot(originSlot))
+	stateRoot, err := originState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	originBlock := testutil.NewBeaconBlock()
+	originBlock.Block.Slot = originSlot
+	originBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+	originBlock.Block.StateRoot = stateRoot[:]
+	originRoot, err := originBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOrigin(ctx, originState, originBlock))
+
+	c := &Service{beaconDB: beaconDB, stateGen: stategen.New(beaconDB)}
+	require.NoError(t, c.initializeChainInfo(ctx))
+	assert.Equal(t, originSlot, c.HeadSlot(), "Head slot incorrect")
+	r, err := c.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, originRoot[:], r, "Head root incorrect")
+	assert.Equal(t, originRoot, c.genesisRoot, "Origin block root should be used in place of genesis")
+}
+
 func TestChainService_InitializeChainInfo_SetHeadAtGenesis(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -48,6 +48,7 @@ type ChainService struct {
 	ForkChoiceStore             *protoarray.Store
 	VerifyBlkDescendantErr      error
 	Slot                        *uint64 // Pointer because 0 is a useful value, so checking against it can be incorrect.
+	Reorgs                      []*statefeed.ReorgData
 }
 
 // StateNotifier mocks the same method in the chain service.
@@ -380,3 +381,8 @@ func (s *ChainService) VerifyFinalizedConsistency(_ context.Context, r []byte) e
 	}
 	return nil
 }
+
+// RecentReorgs mocks the same method in the chain service.
+func (s *ChainService) RecentReorgs() []*statefeed.ReorgData {
+	return s.Reorgs
+}
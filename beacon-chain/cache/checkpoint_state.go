@@ -13,9 +13,9 @@ import (
 
 var (
 	// maxCheckpointStateSize defines the max number of entries check point to state cache can contain.
-	// Choosing 10 to account for multiple forks, this allows 5 forks per epoch boundary with 2 epochs
-	// window to accept attestation based on latest spec.
-	maxCheckpointStateSize = 10
+	// The memory held by the cached states is bounded by the state cache manager, this is an upper
+	// bound on the number of checkpoints tracked during long periods of non-finality.
+	maxCheckpointStateSize = 256
 
 	// Metrics.
 	checkpointStateMiss = promauto.NewCounter(prometheus.CounterOpts{
@@ -28,21 +28,35 @@ var (
 	})
 )
 
+const (
+	// checkpointStateCacheName identifies the checkpoint state cache in the state cache manager.
+	checkpointStateCacheName = "checkpoint"
+	// checkpointStateCost is the relative cost of regenerating a checkpoint state, which requires
+	// processing the slots up to the checkpoint epoch.
+	checkpointStateCost = 2
+)
+
 // CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint.
 type CheckpointStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache   *lru.Cache
+	lock    sync.RWMutex
+	manager *StateCacheHandle
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
 func NewCheckpointStateCache() *CheckpointStateCache {
-	cache, err := lru.New(maxCheckpointStateSize)
+	c := &CheckpointStateCache{
+		manager: StateCaches.Handle(checkpointStateCacheName),
+	}
+	cache, err := lru.NewWithEvict(maxCheckpointStateSize, func(key, _ interface{}) {
+		h := key.([32]byte)
+		c.manager.Remove(string(h[:]))
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &CheckpointStateCache{
-		cache: cache,
-	}
+	c.cache = cache
+	return c
 }
 
 // StateByCheckpoint fetches state by checkpoint. Returns true with a
@@ -59,23 +73,32 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (*stateTr
 
 	if exists && item != nil {
 		checkpointStateHit.Inc()
+		c.manager.Hit(string(h[:]))
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
 		return item.(*stateTrie.BeaconState), nil
 	}
 
 	checkpointStateMiss.Inc()
+	c.manager.Miss()
 	return nil, nil
 }
 
 // AddCheckpointState adds CheckpointState object to the cache. This method also trims the least
-// recently added CheckpointState object if the cache size has ready the max cache size limit.
+// recently added CheckpointState object if the cache size has ready the max cache size limit, and
+// registers the state with the state cache manager, which may evict it to stay within its budget.
 func (c *CheckpointStateCache) AddCheckpointState(cp *ethpb.Checkpoint, s *stateTrie.BeaconState) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
 	h, err := hashutil.HashProto(cp)
 	if err != nil {
 		return err
 	}
+	c.lock.Lock()
 	c.cache.Add(h, s)
+	evicted := c.manager.Add(string(h[:]), s, checkpointStateCost, func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.cache.Remove(h)
+	})
+	c.lock.Unlock()
+	RunEvictions(evicted)
 	return nil
 }
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"math"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -54,6 +55,8 @@ func TestCheckpointStateCache_StateByCheckpoint(t *testing.T) {
 
 func TestCheckpointStateCache_MaxSize(t *testing.T) {
 	c := NewCheckpointStateCache()
+	// States of the caches of other tests may fill the budget of the shared manager.
+	c.manager = NewStateCacheManager(math.MaxUint64).Handle(checkpointStateCacheName)
 	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{
 		Slot: 0,
 	})
@@ -0,0 +1,262 @@
+package cache
+
+import (
+	"container/heap"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// DefaultStateCacheBudget is the default memory budget of the state caches, in bytes.
+	DefaultStateCacheBudget = 2 << 30
+	// validatorMemorySize is the estimated memory held by a validator of a state: the validator
+	// object, its balance and its leaves in the validators and balances tries.
+	validatorMemorySize = 320
+	// stateCacheSizeUnit scales the size of states when computing their eviction priority.
+	stateCacheSizeUnit = 1 << 20
+)
+
+var (
+	stateCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_hits_total",
+		Help: "The number of state requests served by a state cache, by cache.",
+	}, []string{"cache"})
+	stateCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_misses_total",
+		Help: "The number of state requests that were not present in a state cache, by cache.",
+	}, []string{"cache"})
+	stateCacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_evictions_total",
+		Help: "The number of states evicted from a state cache to stay within the memory budget, by cache.",
+	}, []string{"cache"})
+	stateCacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "state_cache_entries",
+		Help: "The number of states held by a state cache, by cache.",
+	}, []string{"cache"})
+	stateCacheUsedBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "state_cache_used_bytes",
+		Help: "The estimated memory held by the states of all the state caches.",
+	})
+	stateCacheBudgetBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "state_cache_budget_bytes",
+		Help: "The memory budget of the state caches.",
+	})
+)
+
+// StateCaches is the manager shared by the state caches of the beacon node.
+var StateCaches = NewStateCacheManager(DefaultStateCacheBudget)
+
+// StateCacheManager bounds the estimated memory held by the states of several state caches. Each
+// cache registers the states it holds along with the cost of regenerating them, and the manager
+// evicts states across all the caches once the budget is exceeded.
+//
+// States are evicted with the GreedyDual-Size policy: a state is given the priority
+// clock + cost / size when it is added or hit, and the state with the lowest priority is evicted
+// first, advancing the clock to its priority. Large states which are cheap to regenerate are
+// evicted first, and states which are not hit age as the clock advances.
+type StateCacheManager struct {
+	budget  uint64
+	used    uint64
+	clock   float64
+	entries map[stateCacheKey]*stateCacheEntry
+	queue   stateCacheQueue
+	lock    sync.Mutex
+}
+
+// stateCacheKey identifies a state by the cache instance holding it, so that the states of
+// several instances of a cache do not collide.
+type stateCacheKey struct {
+	cache *StateCacheHandle
+	key   string
+}
+
+type stateCacheEntry struct {
+	key      stateCacheKey
+	size     uint64
+	cost     float64
+	priority float64
+	index    int
+	evict    func()
+}
+
+// StateCacheHandle registers the states of a cache instance with a state cache manager. The name
+// of the cache labels the metrics of the manager, and is shared by the instances of a cache.
+type StateCacheHandle struct {
+	manager *StateCacheManager
+	name    string
+}
+
+// NewStateCacheManager creates a state cache manager with a budget in bytes.
+func NewStateCacheManager(budget uint64) *StateCacheManager {
+	stateCacheBudgetBytes.Set(float64(budget))
+	return &StateCacheManager{
+		budget:  budget,
+		entries: make(map[stateCacheKey]*stateCacheEntry),
+	}
+}
+
+// Handle returns a new handle for an instance of the named cache.
+func (m *StateCacheManager) Handle(name string) *StateCacheHandle {
+	return &StateCacheHandle{manager: m, name: name}
+}
+
+// SetBudget changes the budget of the manager, evicting states if they no longer fit.
+func (m *StateCacheManager) SetBudget(budget uint64) {
+	m.lock.Lock()
+	m.budget = budget
+	evicted := m.evictOverBudget()
+	m.lock.Unlock()
+	stateCacheBudgetBytes.Set(float64(budget))
+	RunEvictions(evicted)
+}
+
+// Used returns the estimated memory held by the states of the caches.
+func (m *StateCacheManager) Used() uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.used
+}
+
+// Add registers a state added to the cache under the key. The cost is the relative cost of
+// regenerating the state once evicted. The evict function must remove the state from the cache.
+// The evict functions of the states which no longer fit in the budget are returned rather than
+// called, so that the cache can register the state while holding its own lock, and must be
+// passed to RunEvictions once that lock is released.
+func (h *StateCacheHandle) Add(key string, st *stateTrie.BeaconState, cost float64, evict func()) []func() {
+	m := h.manager
+	size := EstimateStateSize(st)
+	k := stateCacheKey{cache: h, key: key}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if e, ok := m.entries[k]; ok {
+		m.used -= e.size
+		e.size = size
+		e.cost = cost
+		e.evict = evict
+		e.priority = m.priority(e)
+		heap.Fix(&m.queue, e.index)
+	} else {
+		e := &stateCacheEntry{key: k, size: size, cost: cost, evict: evict}
+		e.priority = m.priority(e)
+		heap.Push(&m.queue, e)
+		m.entries[k] = e
+		stateCacheEntries.WithLabelValues(h.name).Inc()
+	}
+	m.used += size
+	return m.evictOverBudget()
+}
+
+// Hit records a hit on the state of the cache under the key, which raises its priority.
+func (h *StateCacheHandle) Hit(key string) {
+	m := h.manager
+	stateCacheHits.WithLabelValues(h.name).Inc()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if e, ok := m.entries[stateCacheKey{cache: h, key: key}]; ok {
+		e.priority = m.priority(e)
+		heap.Fix(&m.queue, e.index)
+	}
+}
+
+// Miss records a miss on the cache.
+func (h *StateCacheHandle) Miss() {
+	stateCacheMisses.WithLabelValues(h.name).Inc()
+}
+
+// Remove unregisters a state removed from the cache by the cache itself.
+func (h *StateCacheHandle) Remove(key string) {
+	m := h.manager
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if e, ok := m.entries[stateCacheKey{cache: h, key: key}]; ok {
+		m.remove(e)
+	}
+}
+
+func (m *StateCacheManager) priority(e *stateCacheEntry) float64 {
+	size := float64(e.size) / stateCacheSizeUnit
+	if size == 0 {
+		size = 1.0 / stateCacheSizeUnit
+	}
+	return m.clock + e.cost/size
+}
+
+func (m *StateCacheManager) remove(e *stateCacheEntry) {
+	heap.Remove(&m.queue, e.index)
+	delete(m.entries, e.key)
+	m.used -= e.size
+	stateCacheEntries.WithLabelValues(e.key.cache.name).Dec()
+	stateCacheUsedBytes.Set(float64(m.used))
+}
+
+// evictOverBudget unregisters the states with the lowest priority until the states fit in the
+// budget, and returns their evict functions. The lock must be held.
+func (m *StateCacheManager) evictOverBudget() []func() {
+	var evicted []func()
+	for m.used > m.budget && m.queue.Len() > 0 {
+		e := m.queue[0]
+		m.clock = e.priority
+		m.remove(e)
+		stateCacheEvictions.WithLabelValues(e.key.cache.name).Inc()
+		if e.evict != nil {
+			evicted = append(evicted, e.evict)
+		}
+	}
+	stateCacheUsedBytes.Set(float64(m.used))
+	return evicted
+}
+
+// RunEvictions calls the evict functions returned by the manager, which must be done without the
+// lock of any cache held.
+func RunEvictions(evicted []func()) {
+	for _, evict := range evicted {
+		evict()
+	}
+}
+
+// EstimateStateSize returns an estimate of the memory held by the state, made of its fixed size
+// vectors and of the validators. Copies of a state share most of their memory until they are
+// modified, each copy is counted in full so the estimate is an upper bound.
+func EstimateStateSize(st *stateTrie.BeaconState) uint64 {
+	if st == nil || st.InnerStateUnsafe() == nil {
+		return 0
+	}
+	cfg := params.BeaconConfig()
+	// Block roots, state roots and randao mixes are 32 bytes each, slashings are 8 bytes each.
+	// Their merkle tries roughly double their size.
+	vectors := 2 * (2*cfg.SlotsPerHistoricalRoot*32 + cfg.EpochsPerHistoricalVector*32 + cfg.EpochsPerSlashingsVector*8)
+	return vectors + uint64(st.NumValidators())*validatorMemorySize
+}
+
+// stateCacheQueue is a min-heap of the state cache entries by priority.
+type stateCacheQueue []*stateCacheEntry
+
+func (q stateCacheQueue) Len() int { return len(q) }
+
+func (q stateCacheQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+
+func (q stateCacheQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *stateCacheQueue) Push(x interface{}) {
+	e := x.(*stateCacheEntry)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *stateCacheQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return e
+}
@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateCacheManager_EvictsByCost(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{})
+	require.NoError(t, err)
+	size := EstimateStateSize(st)
+	m := NewStateCacheManager(2 * size)
+
+	var evicted []string
+	evict := func(key string) func() {
+		return func() {
+			evicted = append(evicted, key)
+		}
+	}
+	a, b := m.Handle("a"), m.Handle("b")
+	RunEvictions(a.Add("1", st, 1, evict("1")))
+	RunEvictions(b.Add("2", st, 4, evict("2")))
+	assert.Equal(t, 2*size, m.Used())
+	assert.Equal(t, 0, len(evicted))
+
+	RunEvictions(a.Add("3", st, 2, evict("3")))
+	assert.DeepEqual(t, []string{"1"}, evicted)
+	assert.Equal(t, 2*size, m.Used())
+
+	b.Remove("2")
+	assert.Equal(t, size, m.Used())
+	m.SetBudget(0)
+	assert.DeepEqual(t, []string{"1", "3"}, evicted)
+	assert.Equal(t, uint64(0), m.Used())
+}
+
+func TestStateCacheManager_HitDelaysEviction(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{})
+	require.NoError(t, err)
+	m := NewStateCacheManager(2 * EstimateStateSize(st))
+
+	var evicted []string
+	evict := func(key string) func() {
+		return func() {
+			evicted = append(evicted, key)
+		}
+	}
+	a := m.Handle("a")
+	RunEvictions(a.Add("1", st, 2, evict("1")))
+	RunEvictions(a.Add("2", st, 2, evict("2")))
+	// The cheapest state is evicted right away, and ages the states which are not hit.
+	RunEvictions(a.Add("3", st, 1, evict("3")))
+	assert.DeepEqual(t, []string{"3"}, evicted)
+
+	a.Hit("1")
+	RunEvictions(a.Add("4", st, 2, evict("4")))
+	assert.DeepEqual(t, []string{"3", "2"}, evicted)
+}
+
+func TestCheckpointStateCache_EvictedByManager(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{})
+	require.NoError(t, err)
+	c := NewCheckpointStateCache()
+	m := NewStateCacheManager(EstimateStateSize(st))
+	c.manager = m.Handle(checkpointStateCacheName)
+
+	require.NoError(t, c.AddCheckpointState(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'A'}, 32)}, st))
+	require.NoError(t, c.AddCheckpointState(&ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'B'}, 32)}, st))
+	assert.Equal(t, 1, c.cache.Len())
+	assert.Equal(t, EstimateStateSize(st), m.Used())
+}
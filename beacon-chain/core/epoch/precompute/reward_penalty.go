@@ -69,35 +69,55 @@ func AttestationsDelta(state *stateTrie.BeaconState, pBal *Balance, vp []*Valida
 }
 
 func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uint64) (uint64, uint64) {
+	r := attestationRewards(pBal, v, prevEpoch, finalizedEpoch)
+	return r.SourceReward + r.InclusionDelayReward + r.TargetReward + r.HeadReward,
+		r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty
+}
+
+// AttestationsRewards computes and returns the itemized rewards and penalties of individual validators based on the
+// voting records. The sum of the items of a validator is its attestation delta.
+func AttestationsRewards(state *stateTrie.BeaconState, pBal *Balance, vp []*Validator) []*AttestationRewards {
+	prevEpoch := helpers.PrevEpoch(state)
+	finalizedEpoch := state.FinalizedCheckpointEpoch()
+
+	rewards := make([]*AttestationRewards, len(vp))
+	for i, v := range vp {
+		r := attestationRewards(pBal, v, prevEpoch, finalizedEpoch)
+		rewards[i] = &r
+	}
+	return rewards
+}
+
+func attestationRewards(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uint64) AttestationRewards {
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible || pBal.ActiveCurrentEpoch == 0 {
-		return 0, 0
+		return AttestationRewards{}
 	}
 
 	baseRewardsPerEpoch := params.BeaconConfig().BaseRewardsPerEpoch
 	effectiveBalanceIncrement := params.BeaconConfig().EffectiveBalanceIncrement
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(pBal.ActiveCurrentEpoch) / baseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
+	r := AttestationRewards{}
 	currentEpochBalance := pBal.ActiveCurrentEpoch / effectiveBalanceIncrement
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAttesterReward := br - proposerReward
-		r += maxAttesterReward / v.InclusionDistance
+		r.InclusionDelayReward = maxAttesterReward / v.InclusionDistance
 
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			r.SourceReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			r.SourceReward = rewardNumerator / currentEpochBalance
 
 		}
 	} else {
-		p += br
+		r.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
@@ -105,13 +125,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			r.TargetReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochTargetAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			r.TargetReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		r.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
@@ -119,13 +139,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			r.HeadReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochHeadAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			r.HeadReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		r.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
@@ -134,15 +154,15 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 	if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 		// If validator is performing optimally, this cancels all rewards for a neutral balance.
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
-		p += baseRewardsPerEpoch*br - proposerReward
+		r.InactivityPenalty = baseRewardsPerEpoch*br - proposerReward
 		// Apply an additional penalty to validators that did not vote on the correct target or has been slashed.
 		// Equivalent to the following condition from the spec:
 		// `index not in get_unslashed_attesting_indices(state, matching_target_attestations)`
 		if !v.IsPrevEpochTargetAttester || v.IsSlashed {
-			p += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
+			r.InactivityPenalty += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return r
 }
 
 // ProposersDelta computes and returns the rewards and penalties differences for individual validators based on the
@@ -254,6 +254,66 @@ func TestProcessRewardsAndPenaltiesPrecompute_SlashedInactivePenalty(t *testing.
 	}
 }
 
+func TestAttestationsRewards_SumsToDelta(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	base := buildState(e+3, validatorCount)
+	// The epoch is processed at slot 10 * SlotsPerEpoch, so the attestations vote for epoch 9.
+	prevEpoch := uint64(9)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Slot:            prevEpoch * e,
+				BeaconBlockRoot: make([]byte, 32),
+				Target:          &ethpb.Checkpoint{Epoch: prevEpoch, Root: make([]byte, 32)},
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			AggregationBits: bitfield.Bitlist{0x00, 0x00, 0x00, 0x00, 0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  1,
+		}
+	}
+	base.PreviousEpochAttestations = atts
+
+	beaconState, err := state.InitializeFromProto(base)
+	require.NoError(t, err)
+	// Process the epoch in an inactivity leak.
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch*10))
+
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
+	require.NoError(t, err)
+	items := AttestationsRewards(beaconState, bp, vp)
+	require.Equal(t, len(vp), len(items))
+
+	for i, r := range items {
+		assert.Equal(t, rewards[i], r.SourceReward+r.TargetReward+r.HeadReward+r.InclusionDelayReward,
+			"Unexpected rewards for validator %d", i)
+		assert.Equal(t, penalties[i], r.SourcePenalty+r.TargetPenalty+r.HeadPenalty+r.InactivityPenalty,
+			"Unexpected penalties for validator %d", i)
+	}
+
+	attesters := 0
+	for i, v := range vp {
+		baseReward, err := epoch.BaseReward(beaconState, uint64(i))
+		require.NoError(t, err)
+		if v.IsPrevEpochAttester && !v.IsSlashed {
+			// Optimal participation receives the full base reward in an inactivity leak.
+			assert.Equal(t, baseReward, items[i].SourceReward)
+			assert.Equal(t, true, items[i].InclusionDelayReward > 0)
+			attesters++
+			continue
+		}
+		assert.Equal(t, uint64(0), items[i].SourceReward+items[i].InclusionDelayReward)
+		assert.Equal(t, baseReward, items[i].SourcePenalty)
+		assert.Equal(t, true, items[i].InactivityPenalty > 0)
+	}
+	assert.Equal(t, true, attesters > 0, "No attester in the state")
+}
+
 func buildState(slot, validatorCount uint64) *pb.BeaconState {
 	validators := make([]*ethpb.Validator, validatorCount)
 	for i := 0; i < len(validators); i++ {
@@ -58,3 +58,24 @@ type Balance struct {
 	// correctly for head block during prev epoch.
 	PrevEpochHeadAttested uint64
 }
+
+// AttestationRewards stores the itemized rewards and penalties of a validator for its attestation
+// of the previous epoch, as applied during the epoch transition.
+type AttestationRewards struct {
+	// SourceReward is the reward for attesting to the correct source.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not attesting to the correct source.
+	SourcePenalty uint64
+	// TargetReward is the reward for attesting to the correct target.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not attesting to the correct target.
+	TargetPenalty uint64
+	// HeadReward is the reward for attesting to the correct head.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not attesting to the correct head.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for the inclusion distance of the attestation.
+	InclusionDelayReward uint64
+	// InactivityPenalty is the penalty applied while the chain is in an inactivity leak.
+	InactivityPenalty uint64
+}
@@ -18,8 +18,8 @@ const (
 	Initialized
 	// Synced is sent when the beacon node has completed syncing and is ready to participate in the network.
 	Synced
-	// Reorg is an event sent when the new head state's slot after a block
-	// transition is lower than its previous head state slot value.
+	// Reorg is an event sent when the new head after a block transition does not
+	// descend from the previous head.
 	Reorg
 )
 
@@ -61,4 +61,20 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// Depth is the number of slots from the common ancestor to the old head, zero if the
+	// common ancestor is unknown.
+	Depth uint64
+	// Distance is the number of slots from the common ancestor to the new head, zero if the
+	// common ancestor is unknown.
+	Distance uint64
+	// CommonAncestorRoot is the root of the latest block shared by the old and new heads.
+	CommonAncestorRoot [32]byte
+	// OldHeadRoot is the block root of the head before the reorg.
+	OldHeadRoot [32]byte
+	// NewHeadRoot is the block root of the head after the reorg.
+	NewHeadRoot [32]byte
+	// OldHeadStateRoot is the state root of the head before the reorg.
+	OldHeadStateRoot []byte
+	// NewHeadStateRoot is the state root of the head after the reorg.
+	NewHeadStateRoot []byte
 }
@@ -27,5 +27,79 @@ var DatabaseCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "convert",
+			Description: `converts the database to another storage backend, the database must not be in use`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.ConvertSourceBackendFlag,
+				cmd.ConvertTargetBackendFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := convert(cliCtx); err != nil {
+					log.Fatalf("Could not convert database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "inspect",
+			Description: `lists the key count and size of the database buckets, along with the head and checkpoints`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := inspect(cliCtx); err != nil {
+					log.Fatalf("Could not inspect database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "check",
+			Description: `checks the consistency of the database indices, the node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := checkIndices(cliCtx); err != nil {
+					log.Fatalf("Database check failed: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "repair",
+			Description: `rebuilds the database indices from the stored blocks and states, the node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := repairIndices(cliCtx); err != nil {
+					log.Fatalf("Could not repair database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "rollback",
+			Description: `rolls the head of the database back to a finalized block and deletes the blocks and states ` +
+				`after it, the node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.RollbackRootFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := rollback(cliCtx); err != nil {
+					log.Fatalf("Could not roll back database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
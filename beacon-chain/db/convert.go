@@ -0,0 +1,22 @@
+package db
+
+import (
+	"path"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+func convert(cliCtx *cli.Context) error {
+	dbPath := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	sourceBackend := cliCtx.String(cmd.ConvertSourceBackendFlag.Name)
+	targetBackend := cliCtx.String(cmd.ConvertTargetBackendFlag.Name)
+	if err := kv.ConvertDatabase(cliCtx.Context, dbPath, sourceBackend, targetBackend); err != nil {
+		return err
+	}
+
+	log.WithField("backend", targetBackend).Info("Conversion completed successfully, " +
+		"the converted database is used by starting the beacon node with the db-backend flag")
+	return nil
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestConvert(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	dbPath := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	boltDB, err := kv.NewKVStore(ctx, dbPath, &kv.Config{Backend: kv.BoltBackend})
+	require.NoError(t, err)
+	head := testutil.NewBeaconBlock()
+	head.Block.Slot = 5000
+	require.NoError(t, boltDB.SaveBlock(ctx, head))
+	root, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(5000))
+	require.NoError(t, boltDB.SaveState(ctx, st, root))
+	require.NoError(t, boltDB.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, boltDB.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(cmd.ConvertSourceBackendFlag.Name, kv.BoltBackend, "")
+	set.String(cmd.ConvertTargetBackendFlag.Name, kv.BadgerBackend, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	cliCtx.Context = ctx
+
+	require.NoError(t, convert(cliCtx))
+	assert.LogsContain(t, logHook, "Conversion completed successfully")
+
+	badgerDB, err := kv.NewKVStore(ctx, dbPath, &kv.Config{Backend: kv.BadgerBackend})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, badgerDB.Close())
+	}()
+	headBlock, err := badgerDB.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5000), headBlock.Block.Slot, "Converted database has incorrect data")
+	hasBlocks, blocks, err := badgerDB.BlocksBySlot(ctx, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, true, hasBlocks)
+	assert.Equal(t, 1, len(blocks), "Converted database has incorrect indices")
+	savedState, err := badgerDB.State(ctx, root)
+	require.NoError(t, err)
+	require.NotNil(t, savedState)
+	assert.Equal(t, uint64(5000), savedState.Slot())
+
+	// Converting again fails as the target database already exists.
+	assert.ErrorContains(t, "already exists", convert(cliCtx))
+}
@@ -0,0 +1,54 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// cursor tracks the next slot to export to a sink. It is persisted once the objects of the
+// previous slots are delivered, so that the export resumes from there after a restart.
+type cursor struct {
+	path     string
+	saved    bool
+	NextSlot uint64 `json:"next_slot,string"`
+}
+
+// loadCursor reads the cursor of the sink from the directory, if it was saved before.
+func loadCursor(dir, sinkName string) (*cursor, error) {
+	c := &cursor{path: filepath.Join(dir, sinkName+".cursor")}
+	if !fileutil.FileExists(c.path) {
+		return c, nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(c.path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(enc, c); err != nil {
+		return nil, errors.Wrapf(err, "could not decode cursor %s", c.path)
+	}
+	c.saved = true
+	return c, nil
+}
+
+// save persists the next slot of the cursor. The cursor is written to a temporary file first, so
+// that a crash never leaves a truncated cursor behind.
+func (c *cursor) save(nextSlot uint64) error {
+	c.NextSlot = nextSlot
+	enc, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not write cursor")
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return err
+	}
+	c.saved = true
+	return nil
+}
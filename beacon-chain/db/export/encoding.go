@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+)
+
+// Format is the serialization format of the exported objects.
+type Format string
+
+const (
+	// JSONFormat serializes each object as a JSON document on its own line (NDJSON).
+	JSONFormat Format = "ndjson"
+	// SSZFormat serializes each object as a length prefixed binary record holding the SSZ encoding
+	// of the object.
+	SSZFormat Format = "ssz"
+)
+
+// sszRecordHeaderSize is the size of the header of an SSZ record: the object type identifier, the
+// slot and the block root.
+const sszRecordHeaderSize = 1 + 8 + 32
+
+var marshaler = &jsonpb.Marshaler{}
+
+// jsonRecord is the JSON encoding of an object.
+type jsonRecord struct {
+	Type      ObjectType      `json:"type"`
+	Slot      uint64          `json:"slot,string"`
+	BlockRoot string          `json:"block_root"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// encodeJSON returns the JSON encoding of the object, without trailing newline.
+func encodeJSON(obj *Object) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := marshaler.Marshal(buf, obj.Message); err != nil {
+		return nil, errors.Wrapf(err, "could not marshal %s object", obj.Type)
+	}
+	return json.Marshal(&jsonRecord{
+		Type:      obj.Type,
+		Slot:      obj.Slot,
+		BlockRoot: fmt.Sprintf("%#x", obj.BlockRoot),
+		Data:      buf.Bytes(),
+	})
+}
+
+// encodeSSZ returns the SSZ record of the object. A record is made of the little endian uint32
+// size of the rest of the record, the uint8 identifier of the object type (its index in
+// ObjectTypes), the little endian uint64 slot, the 32 bytes block root and the SSZ encoding of
+// the object.
+func encodeSSZ(obj *Object) ([]byte, error) {
+	typeID := -1
+	for i, t := range ObjectTypes {
+		if t == obj.Type {
+			typeID = i
+			break
+		}
+	}
+	if typeID < 0 {
+		return nil, errors.Errorf("unknown object type %q", obj.Type)
+	}
+	data, err := obj.Message.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal %s object", obj.Type)
+	}
+	record := make([]byte, 4+sszRecordHeaderSize, 4+sszRecordHeaderSize+len(data))
+	binary.LittleEndian.PutUint32(record[:4], uint32(sszRecordHeaderSize+len(data)))
+	record[4] = byte(typeID)
+	binary.LittleEndian.PutUint64(record[5:13], obj.Slot)
+	copy(record[13:45], obj.BlockRoot[:])
+	return append(record, data...), nil
+}
+
+// encodeObjects encodes the objects in the format, one record after the other.
+func encodeObjects(objs []*Object, format Format) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for _, obj := range objs {
+		switch format {
+		case JSONFormat:
+			enc, err := encodeJSON(obj)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(enc)
+			buf.WriteByte('\n')
+		case SSZFormat:
+			enc, err := encodeSSZ(obj)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(enc)
+		default:
+			return nil, errors.Errorf("unknown export format %q", format)
+		}
+	}
+	return buf.Bytes(), nil
+}
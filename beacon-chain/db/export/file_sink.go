@@ -0,0 +1,103 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes the objects to local files in a directory. A new file is started once the
+// current one reaches the maximum size, files are named after the slot of their first object.
+type FileSink struct {
+	dir     string
+	format  Format
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+// NewFileSink creates a file sink writing to the directory in the format, rotating files after
+// maxSize bytes.
+func NewFileSink(dir string, format Format, maxSize int64) (*FileSink, error) {
+	if format != JSONFormat && format != SSZFormat {
+		return nil, errors.Errorf("unknown export format %q, expected %s or %s", format, JSONFormat, SSZFormat)
+	}
+	if maxSize <= 0 {
+		return nil, errors.New("maximum export file size must be positive")
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, errors.Wrap(err, "could not create export directory")
+	}
+	return &FileSink{dir: dir, format: format, maxSize: maxSize}, nil
+}
+
+// Name of the file sink.
+func (f *FileSink) Name() string {
+	return "file"
+}
+
+// Write appends the objects to the current file and syncs it to disk.
+func (f *FileSink) Write(_ context.Context, objs []*Object) error {
+	for _, obj := range objs {
+		enc, err := encodeObjects([]*Object{obj}, f.format)
+		if err != nil {
+			return err
+		}
+		if f.file == nil || f.size >= f.maxSize {
+			if err := f.rotate(obj.Slot); err != nil {
+				return err
+			}
+		}
+		n, err := f.file.Write(enc)
+		f.size += int64(n)
+		if err != nil {
+			return errors.Wrapf(err, "could not write to %s", f.file.Name())
+		}
+	}
+	if f.file == nil {
+		return nil
+	}
+	return f.file.Sync()
+}
+
+// Close the current file.
+func (f *FileSink) Close() error {
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// rotate closes the current file and opens the file of the slot. Objects are appended if the file
+// already exists, which happens when objects are exported again.
+func (f *FileSink) rotate(slot uint64) error {
+	if f.file != nil {
+		if err := f.file.Sync(); err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	name := filepath.Join(f.dir, fmt.Sprintf("export-%012d.%s", slot, f.format))
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not open export file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
@@ -0,0 +1,80 @@
+// +build kafka_enabled
+
+package export
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+// kafkaFlushTimeoutMs is the time given to the producer to deliver its pending messages on close.
+const kafkaFlushTimeoutMs = 10000
+
+var _ Sink = (*KafkaSink)(nil)
+
+// KafkaSink publishes the objects as JSON to one Kafka topic per object type, named after the type
+// with a beacon_ prefix. Messages are keyed by block root.
+type KafkaSink struct {
+	p *kafka.Producer
+}
+
+// NewKafkaSink creates a Kafka sink connecting to the comma separated list of bootstrap servers.
+func NewKafkaSink(bootstrapServers string) (Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": bootstrapServers})
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSink{p: p}, nil
+}
+
+// Name of the Kafka sink.
+func (k *KafkaSink) Name() string {
+	return "kafka"
+}
+
+// Write publishes the objects and waits for the broker to acknowledge all of them.
+func (k *KafkaSink) Write(ctx context.Context, objs []*Object) error {
+	deliveries := make(chan kafka.Event, len(objs))
+	for _, obj := range objs {
+		enc, err := encodeJSON(obj)
+		if err != nil {
+			return err
+		}
+		topic := "beacon_" + string(obj.Type)
+		key := obj.BlockRoot
+		if err := k.p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            key[:],
+			Value:          enc,
+		}, deliveries); err != nil {
+			return errors.Wrap(err, "could not produce kafka message")
+		}
+	}
+	for range objs {
+		select {
+		case e := <-deliveries:
+			m, ok := e.(*kafka.Message)
+			if !ok {
+				return errors.Errorf("unexpected kafka delivery event %v", e)
+			}
+			if m.TopicPartition.Error != nil {
+				return errors.Wrap(m.TopicPartition.Error, "could not deliver kafka message")
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close flushes the pending messages and closes the producer.
+func (k *KafkaSink) Close() error {
+	if remaining := k.p.Flush(kafkaFlushTimeoutMs); remaining > 0 {
+		log.WithField("messages", remaining).Warn("Kafka messages were not delivered before closing")
+	}
+	k.p.Close()
+	return nil
+}
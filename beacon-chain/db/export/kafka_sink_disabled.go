@@ -0,0 +1,11 @@
+// +build !kafka_enabled
+
+package export
+
+import "errors"
+
+// NewKafkaSink returns an error, the Kafka sink requires the cgo librdkafka library and is only
+// available in builds with the kafka_enabled tag.
+func NewKafkaSink(_ string) (Sink, error) {
+	return nil, errors.New("kafka export is not supported by this build, rebuild with the kafka_enabled tag")
+}
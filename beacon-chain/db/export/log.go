@@ -0,0 +1,5 @@
+package export
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "export")
@@ -0,0 +1,21 @@
+package export
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportedObjectsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_export_objects_total",
+		Help: "The number of objects delivered to an export sink, by sink and object type.",
+	}, []string{"sink", "type"})
+	exportFailuresCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_export_failures_total",
+		Help: "The number of failed deliveries to an export sink.",
+	}, []string{"sink"})
+	exportCursorSlot = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_export_cursor_slot",
+		Help: "The next slot to be exported to an export sink.",
+	}, []string{"sink"})
+)
@@ -0,0 +1,106 @@
+package export
+
+import (
+	fssz "github.com/ferranbt/fastssz"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// ObjectType is a kind of object exported from the database.
+type ObjectType string
+
+const (
+	// BlockType is the type of signed beacon blocks.
+	BlockType ObjectType = "blocks"
+	// AttestationType is the type of the attestations included in blocks.
+	AttestationType ObjectType = "attestations"
+	// DepositType is the type of the deposits included in blocks.
+	DepositType ObjectType = "deposits"
+	// VoluntaryExitType is the type of the signed voluntary exits included in blocks.
+	VoluntaryExitType ObjectType = "voluntary_exits"
+	// ProposerSlashingType is the type of the proposer slashings included in blocks.
+	ProposerSlashingType ObjectType = "proposer_slashings"
+	// AttesterSlashingType is the type of the attester slashings included in blocks.
+	AttesterSlashingType ObjectType = "attester_slashings"
+)
+
+// ObjectTypes lists the types of objects which can be exported. The position of a type in the
+// list is its identifier in the SSZ file format, new types must be appended.
+var ObjectTypes = []ObjectType{
+	BlockType,
+	AttestationType,
+	DepositType,
+	VoluntaryExitType,
+	ProposerSlashingType,
+	AttesterSlashingType,
+}
+
+// Message is a protobuf message which can be serialized with SSZ.
+type Message interface {
+	proto.Message
+	fssz.Marshaler
+}
+
+// Object is an exported object, along with the block it was saved with.
+type Object struct {
+	Type      ObjectType
+	Slot      uint64
+	BlockRoot [32]byte
+	Message   Message
+}
+
+// ParseObjectTypes returns the object types of the names, or an error if a name is not one of the
+// ObjectTypes.
+func ParseObjectTypes(names []string) ([]ObjectType, error) {
+	types := make([]ObjectType, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, t := range ObjectTypes {
+			if string(t) == name {
+				types = append(types, t)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("unknown object type %q, expected one of %v", name, ObjectTypes)
+		}
+	}
+	return types, nil
+}
+
+// objectsFromBlock returns the block and the operations it includes, keeping only the selected
+// types.
+func objectsFromBlock(blk *ethpb.SignedBeaconBlock, root [32]byte, types map[ObjectType]bool) []*Object {
+	if blk == nil || blk.Block == nil {
+		return nil
+	}
+	var objs []*Object
+	add := func(t ObjectType, msg Message) {
+		if types[t] {
+			objs = append(objs, &Object{Type: t, Slot: blk.Block.Slot, BlockRoot: root, Message: msg})
+		}
+	}
+	add(BlockType, blk)
+	body := blk.Block.Body
+	if body == nil {
+		return objs
+	}
+	for _, att := range body.Attestations {
+		add(AttestationType, att)
+	}
+	for _, deposit := range body.Deposits {
+		add(DepositType, deposit)
+	}
+	for _, exit := range body.VoluntaryExits {
+		add(VoluntaryExitType, exit)
+	}
+	for _, slashing := range body.ProposerSlashings {
+		add(ProposerSlashingType, slashing)
+	}
+	for _, slashing := range body.AttesterSlashings {
+		add(AttesterSlashingType, slashing)
+	}
+	return objs
+}
@@ -0,0 +1,280 @@
+// Package export streams the blocks saved in the database, and the operations they include, to
+// pluggable sinks such as rotating local files, HTTP webhooks or Kafka. Each sink has a cursor
+// persisted on disk which only advances once the objects are delivered, so that a sink catches up
+// after a restart or a downtime of its remote end, and objects are delivered at least once. Only
+// the finalized blocks of the canonical chain are exported, so that sinks never receive blocks
+// which are later reorganized away.
+package export
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// exportBatchSlots is the number of slots whose objects are written to a sink at once.
+const exportBatchSlots = 32
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the export service.
+type Config struct {
+	BeaconDB      db.ReadOnlyDatabase
+	StateNotifier statefeed.Notifier
+	Sinks         []Sink
+	Types         []ObjectType
+	// CursorDir is the directory the cursors of the sinks are persisted to.
+	CursorDir string
+	// Replay resets the cursors of all the sinks to ReplaySlot, so that the objects are exported
+	// again from that slot. Sinks without a cursor start from the finalized slot otherwise.
+	Replay     bool
+	ReplaySlot uint64
+}
+
+// Service exports the objects of the database to the sinks, up to the finalized slot.
+type Service struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cfg     *Config
+	types   map[ObjectType]bool
+	cursors map[string]*cursor
+	trigger chan struct{}
+	done    chan struct{}
+	lock    sync.RWMutex
+	err     error
+}
+
+// New initializes the export service and loads the cursors of the sinks.
+func New(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.Sinks) == 0 {
+		return nil, errors.New("no export sink configured")
+	}
+	if err := fileutil.MkdirAll(cfg.CursorDir); err != nil {
+		return nil, errors.Wrap(err, "could not create cursor directory")
+	}
+	types := make(map[ObjectType]bool, len(cfg.Types))
+	for _, t := range cfg.Types {
+		types[t] = true
+	}
+	cursors := make(map[string]*cursor, len(cfg.Sinks))
+	for _, sink := range cfg.Sinks {
+		if _, ok := cursors[sink.Name()]; ok {
+			return nil, errors.Errorf("duplicate export sink %s", sink.Name())
+		}
+		c, err := loadCursor(cfg.CursorDir, sink.Name())
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Replay {
+			if err := c.save(cfg.ReplaySlot); err != nil {
+				return nil, err
+			}
+		}
+		cursors[sink.Name()] = c
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     cfg,
+		types:   types,
+		cursors: cursors,
+		trigger: make(chan struct{}, 1),
+	}, nil
+}
+
+// Start the export service.
+func (s *Service) Start() {
+	for _, sink := range s.cfg.Sinks {
+		log.WithFields(logrus.Fields{
+			"sink":     sink.Name(),
+			"nextSlot": s.cursors[sink.Name()].NextSlot,
+		}).Info("Exporting database objects")
+	}
+	s.done = make(chan struct{})
+	s.schedule()
+	go s.run()
+	go s.subscribeToBlocks()
+}
+
+// Stop the export service, waiting for the export in progress to be interrupted before closing
+// the sinks.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.done != nil {
+		<-s.done
+	}
+	var err error
+	for _, sink := range s.cfg.Sinks {
+		if closeErr := sink.Close(); closeErr != nil {
+			log.WithError(closeErr).WithField("sink", sink.Name()).Error("Could not close export sink")
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Status of the export service, which is the last delivery error of any sink.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// schedule an export without blocking. A pending export picks up the latest finalized slot once
+// it starts.
+func (s *Service) schedule() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// run executes the scheduled exports one at a time. Failed deliveries are retried every slot.
+func (s *Service) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.trigger:
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+		err := s.export(s.ctx)
+		if s.ctx.Err() != nil {
+			return
+		}
+		s.lock.Lock()
+		s.err = err
+		s.lock.Unlock()
+	}
+}
+
+// subscribeToBlocks schedules an export when a block is processed.
+func (s *Service) subscribeToBlocks() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.BlockProcessed {
+				s.schedule()
+			}
+		case <-stateSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// export delivers the objects up to the finalized slot to each sink. A failing sink does not hold
+// back the other sinks.
+func (s *Service) export(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "export.export")
+	defer span.End()
+
+	cp, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	fRoot := bytesutil.ToBytes32(cp.Root)
+	fBlock, err := s.cfg.BeaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized block")
+	}
+	if fBlock == nil || fBlock.Block == nil {
+		// Nothing is finalized yet.
+		return nil
+	}
+	for _, sink := range s.cfg.Sinks {
+		if sinkErr := s.exportTo(ctx, sink, fBlock.Block.Slot, fRoot); sinkErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			exportFailuresCount.WithLabelValues(sink.Name()).Inc()
+			log.WithError(sinkErr).WithField("sink", sink.Name()).Error("Could not export database objects")
+			err = errors.Wrapf(sinkErr, "could not export to %s sink", sink.Name())
+		}
+	}
+	return err
+}
+
+// exportTo delivers the objects from the cursor of the sink up to the slot of the finalized block,
+// advancing the cursor after each delivered batch.
+func (s *Service) exportTo(ctx context.Context, sink Sink, finalizedSlot uint64, finalizedRoot [32]byte) error {
+	c := s.cursors[sink.Name()]
+	if !c.saved {
+		if err := c.save(finalizedSlot); err != nil {
+			return err
+		}
+	}
+	for c.NextSlot <= finalizedSlot {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		endSlot := c.NextSlot + exportBatchSlots - 1
+		if endSlot > finalizedSlot {
+			endSlot = finalizedSlot
+		}
+		objs, err := s.objects(ctx, c.NextSlot, endSlot, finalizedSlot, finalizedRoot)
+		if err != nil {
+			return err
+		}
+		if len(objs) > 0 {
+			if err := sink.Write(ctx, objs); err != nil {
+				return err
+			}
+		}
+		for _, obj := range objs {
+			exportedObjectsCount.WithLabelValues(sink.Name(), string(obj.Type)).Inc()
+		}
+		if err := c.save(endSlot + 1); err != nil {
+			return err
+		}
+		exportCursorSlot.WithLabelValues(sink.Name()).Set(float64(c.NextSlot))
+	}
+	return nil
+}
+
+// objects returns the objects of the selected types saved with the canonical blocks of the slots,
+// in slot order. Blocks of the finalized slot other than the finalized block are not canonical,
+// even though the finalized block roots index holds all the blocks of the finalized epoch.
+func (s *Service) objects(ctx context.Context, startSlot, endSlot, finalizedSlot uint64, finalizedRoot [32]byte) ([]*Object, error) {
+	var objs []*Object
+	for slot := startSlot; slot <= endSlot; slot++ {
+		_, blks, err := s.cfg.BeaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve blocks of slot %d", slot)
+		}
+		for _, blk := range blks {
+			root, err := blk.Block.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			canonical := root == finalizedRoot
+			if slot != finalizedSlot {
+				canonical = s.cfg.BeaconDB.IsFinalizedBlock(ctx, root)
+			}
+			if !canonical {
+				continue
+			}
+			objs = append(objs, objectsFromBlock(blk, root, s.types)...)
+		}
+	}
+	return objs, nil
+}
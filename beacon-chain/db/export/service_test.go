@@ -0,0 +1,198 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type memorySink struct {
+	objs []*Object
+	err  error
+}
+
+func (m *memorySink) Name() string {
+	return "memory"
+}
+
+func (m *memorySink) Write(_ context.Context, objs []*Object) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.objs = append(m.objs, objs...)
+	return nil
+}
+
+func (m *memorySink) Close() error {
+	return nil
+}
+
+// setupService saves a chain of blocks at every slot up to the finalized slot, finalized at the
+// first epoch starting at or after it, and the extra blocks. The roots of the chain are returned.
+func setupService(t *testing.T, cfg *Config, finalizedSlot uint64, extra ...*ethpb.SignedBeaconBlock) (*Service, [][32]byte) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	roots := make([][32]byte, 0, finalizedSlot+1)
+	var parentRoot [32]byte
+	for slot := uint64(0); slot <= finalizedSlot; slot++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		if slot == 3 {
+			b.Block.Body.Attestations = []*ethpb.Attestation{testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(8)})}
+		}
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, r)
+		parentRoot = r
+	}
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, roots[0]))
+	for _, b := range extra {
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	}
+	require.NoError(t, beaconDB.SaveState(ctx, testutil.NewBeaconState(), parentRoot))
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	epoch := (finalizedSlot + slotsPerEpoch - 1) / slotsPerEpoch
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: epoch, Root: parentRoot[:]}))
+	cfg.BeaconDB = beaconDB
+	cfg.CursorDir = filepath.Join(t.TempDir(), "cursor")
+	s, err := New(ctx, cfg)
+	require.NoError(t, err)
+	return s, roots
+}
+
+func TestService_Export_Replay(t *testing.T) {
+	sink := &memorySink{}
+	s, _ := setupService(t, &Config{
+		Sinks:  []Sink{sink},
+		Types:  []ObjectType{BlockType, AttestationType},
+		Replay: true,
+	}, 40)
+
+	require.NoError(t, s.export(context.Background()))
+	require.Equal(t, 42, len(sink.objs))
+	assert.Equal(t, BlockType, sink.objs[0].Type)
+	assert.Equal(t, uint64(0), sink.objs[0].Slot)
+	assert.Equal(t, AttestationType, sink.objs[4].Type)
+	assert.Equal(t, uint64(3), sink.objs[4].Slot)
+	assert.Equal(t, sink.objs[3].BlockRoot, sink.objs[4].BlockRoot)
+	assert.Equal(t, uint64(40), sink.objs[41].Slot)
+
+	c, err := loadCursor(s.cfg.CursorDir, sink.Name())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(41), c.NextSlot)
+}
+
+func TestService_Export_TypeSelection(t *testing.T) {
+	sink := &memorySink{}
+	s, _ := setupService(t, &Config{
+		Sinks:  []Sink{sink},
+		Types:  []ObjectType{AttestationType},
+		Replay: true,
+	}, 5)
+
+	require.NoError(t, s.export(context.Background()))
+	require.Equal(t, 1, len(sink.objs))
+	assert.Equal(t, AttestationType, sink.objs[0].Type)
+}
+
+func TestService_Export_StartsAtFinalized(t *testing.T) {
+	sink := &memorySink{}
+	s, _ := setupService(t, &Config{
+		Sinks: []Sink{sink},
+		Types: []ObjectType{BlockType},
+	}, 5)
+
+	require.NoError(t, s.export(context.Background()))
+	require.Equal(t, 1, len(sink.objs))
+	assert.Equal(t, uint64(5), sink.objs[0].Slot)
+}
+
+func TestService_Export_NothingFinalized(t *testing.T) {
+	sink := &memorySink{}
+	s, err := New(context.Background(), &Config{
+		BeaconDB:  testDB.SetupDB(t),
+		Sinks:     []Sink{sink},
+		Types:     []ObjectType{BlockType},
+		CursorDir: filepath.Join(t.TempDir(), "cursor"),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.export(context.Background()))
+	assert.Equal(t, 0, len(sink.objs))
+	assert.Equal(t, false, s.cursors[sink.Name()].saved)
+}
+
+func TestService_Export_CanonicalOnly(t *testing.T) {
+	finalizedSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	// An orphaned block, a block competing with the finalized block, and a block which is not
+	// finalized yet.
+	orphaned := testutil.NewBeaconBlock()
+	orphaned.Block.Slot = 3
+	orphaned.Block.Body.Graffiti = bytesutil.PadTo([]byte("orphaned"), 32)
+	competing := testutil.NewBeaconBlock()
+	competing.Block.Slot = finalizedSlot
+	competing.Block.Body.Graffiti = bytesutil.PadTo([]byte("competing"), 32)
+	unfinalized := testutil.NewBeaconBlock()
+	unfinalized.Block.Slot = finalizedSlot + 1
+
+	sink := &memorySink{}
+	s, roots := setupService(t, &Config{
+		Sinks:  []Sink{sink},
+		Types:  []ObjectType{BlockType},
+		Replay: true,
+	}, finalizedSlot, orphaned, competing, unfinalized)
+	require.Equal(t, true, s.cfg.BeaconDB.IsFinalizedBlock(context.Background(), mustRoot(t, competing)), "Competing block should be in the finalized epoch")
+
+	require.NoError(t, s.export(context.Background()))
+	require.Equal(t, len(roots), len(sink.objs))
+	for i, obj := range sink.objs {
+		assert.Equal(t, uint64(i), obj.Slot)
+		assert.Equal(t, roots[i], obj.BlockRoot, "Unexpected block at slot %d", obj.Slot)
+	}
+	assert.Equal(t, finalizedSlot+1, s.cursors[sink.Name()].NextSlot)
+}
+
+func mustRoot(t *testing.T, b *ethpb.SignedBeaconBlock) [32]byte {
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return r
+}
+
+func TestService_Export_RetriesFailedDelivery(t *testing.T) {
+	sink := &memorySink{err: errors.New("unavailable")}
+	s, _ := setupService(t, &Config{
+		Sinks:      []Sink{sink},
+		Types:      []ObjectType{BlockType},
+		Replay:     true,
+		ReplaySlot: 2,
+	}, 5)
+
+	assert.ErrorContains(t, "unavailable", s.export(context.Background()))
+	assert.Equal(t, uint64(2), s.cursors[sink.Name()].NextSlot)
+
+	sink.err = nil
+	require.NoError(t, s.export(context.Background()))
+	require.Equal(t, 4, len(sink.objs))
+	assert.Equal(t, uint64(2), sink.objs[0].Slot)
+	assert.Equal(t, uint64(6), s.cursors[sink.Name()].NextSlot)
+}
+
+func TestParseObjectTypes(t *testing.T) {
+	types, err := ParseObjectTypes([]string{"blocks", "voluntary_exits"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []ObjectType{BlockType, VoluntaryExitType}, types)
+	_, err = ParseObjectTypes([]string{"states"})
+	assert.ErrorContains(t, "unknown object type", err)
+}
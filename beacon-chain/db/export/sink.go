@@ -0,0 +1,15 @@
+package export
+
+import "context"
+
+// Sink is a destination of the exported objects.
+type Sink interface {
+	// Name of the sink, which identifies its cursor.
+	Name() string
+	// Write delivers the objects, in order. The objects are considered delivered once Write
+	// returns without error, the sink must have flushed them to durable storage or to the remote
+	// end by then. Objects may be written more than once after a failure or a restart.
+	Write(ctx context.Context, objs []*Object) error
+	// Close releases the resources of the sink.
+	Close() error
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testObjects(t *testing.T, slots ...uint64) []*Object {
+	objs := make([]*Object, len(slots))
+	for i, slot := range slots {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		objs[i] = &Object{Type: BlockType, Slot: slot, BlockRoot: root, Message: b}
+	}
+	return objs
+}
+
+func TestFileSink_JSON(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "export")
+	sink, err := NewFileSink(dir, JSONFormat, 1<<20)
+	require.NoError(t, err)
+	objs := testObjects(t, 10, 11)
+	require.NoError(t, sink.Write(context.Background(), objs))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(filepath.Join(dir, "export-000000000010.ndjson"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	var records []*jsonRecord
+	for scanner.Scan() {
+		record := &jsonRecord{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, BlockType, records[1].Type)
+	assert.Equal(t, uint64(11), records[1].Slot)
+	assert.Equal(t, true, len(records[1].Data) > 0)
+}
+
+func TestFileSink_SSZRotation(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "export")
+	objs := testObjects(t, 10, 11, 12)
+	first, err := encodeSSZ(objs[0])
+	require.NoError(t, err)
+	// Each file holds two records at most.
+	sink, err := NewFileSink(dir, SSZFormat, int64(2*len(first)))
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), objs))
+	require.NoError(t, sink.Close())
+
+	enc, err := ioutil.ReadFile(filepath.Join(dir, "export-000000000010.ssz"))
+	require.NoError(t, err)
+	assert.Equal(t, 2*len(first), len(enc))
+	size := binary.LittleEndian.Uint32(enc[:4])
+	assert.Equal(t, uint32(len(first)-4), size)
+	assert.Equal(t, byte(0), enc[4], "Unexpected object type")
+	assert.Equal(t, uint64(10), binary.LittleEndian.Uint64(enc[5:13]))
+	decoded := &ethpb.SignedBeaconBlock{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc[4+sszRecordHeaderSize:len(first)]))
+	assert.Equal(t, uint64(10), decoded.Block.Slot)
+
+	enc, err = ioutil.ReadFile(filepath.Join(dir, "export-000000000012.ssz"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), binary.LittleEndian.Uint64(enc[5:13]))
+}
+
+func TestWebhookSink(t *testing.T) {
+	var lines int
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		scanner := bufio.NewScanner(r.Body)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			lines++
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(srv.URL)
+	require.NoError(t, sink.Write(context.Background(), testObjects(t, 1, 2, 3)))
+	assert.Equal(t, 3, lines)
+
+	status = http.StatusServiceUnavailable
+	assert.ErrorContains(t, "503", sink.Write(context.Background(), testObjects(t, 4)))
+	require.NoError(t, sink.Close())
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// webhookTimeout is the timeout of a webhook request.
+const webhookTimeout = 30 * time.Second
+
+var _ Sink = (*WebhookSink)(nil)
+
+// WebhookSink posts the objects to an HTTP endpoint as NDJSON, one request per batch of objects.
+// The batch is delivered once the endpoint replies with a 2xx status code.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a webhook sink posting to the URL.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Name of the webhook sink.
+func (w *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Write posts the objects to the endpoint.
+func (w *WebhookSink) Write(ctx context.Context, objs []*Object) error {
+	body, err := encodeObjects(objs, JSONFormat)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not post to webhook")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close webhook response body")
+		}
+	}()
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		return errors.Wrap(err, "could not read webhook response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook replied with status %s", resp.Status)
+	}
+	return nil
+}
+
+// Close the webhook sink.
+func (w *WebhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
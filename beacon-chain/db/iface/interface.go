@@ -26,6 +26,8 @@ type ReadOnlyDatabase interface {
 	BlockRootsBySlot(ctx context.Context, slot uint64) (bool, [][32]byte, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
+	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*eth.SignedBeaconBlock, error)
@@ -55,6 +57,13 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Validator performance index operations.
+	ValidatorPerformance(ctx context.Context, epoch uint64) (*db.ValidatorPerformance, error)
+	ValidatorPerformances(ctx context.Context, startEpoch, endEpoch uint64) ([]*db.ValidatorPerformance, error)
+	ValidatorParticipations(ctx context.Context, startEpoch, endEpoch uint64) ([]*db.ValidatorParticipation, error)
+	NextValidatorPerformanceEpoch(ctx context.Context) (uint64, error)
+	// Attestation tracker operations.
+	AttestationRecords(ctx context.Context, startSlot, endSlot uint64) ([]*db.AttestationRecord, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -65,9 +74,12 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error
+	SaveArchivedState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethereum_beacon_p2p_v1.StateSummary) error
@@ -84,11 +96,21 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Validator performance index operations.
+	SaveValidatorPerformance(ctx context.Context, perf *db.ValidatorPerformance, participation *db.ValidatorParticipation) error
+	// Attestation tracker operations.
+	SaveAttestationRecords(ctx context.Context, records []*db.AttestationRecord) error
+	DeleteAttestationRecordsBefore(ctx context.Context, slot uint64) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint uint64) error
+
+	// Pruning operations.
+	PruneBlocks(ctx context.Context, beforeSlot uint64) (int, error)
+	PruneStates(ctx context.Context, beforeSlot uint64) (int, error)
+	PruneAttestations(ctx context.Context, beforeEpoch uint64) (int, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -98,6 +120,7 @@ type HeadAccessDatabase interface {
 	// Block related methods.
 	HeadBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveOrigin(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock) error
 }
 
 // Database interface with full access.
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/urfave/cli/v2"
+)
+
+// maxPrintedRoots is the number of roots printed for each kind of index inconsistency.
+const maxPrintedRoots = 10
+
+const rollbackYesNoPrompt = "Rolling back deletes all the blocks and states after the given block from the database. " +
+	"Are you sure that you want to continue? [y/n]"
+
+// openDatabase opens the beacon node database of the data directory with the storage backend it
+// was created with. Commands which only read the database open it read-only so that they
+// never modify it.
+func openDatabase(cliCtx *cli.Context, readOnly bool) (*kv.Store, error) {
+	dbPath := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	backend := kv.DetectBackend(dbPath)
+	if backend == kv.BoltBackend && !fileutil.FileExists(path.Join(dbPath, kv.DatabaseFileName)) {
+		return nil, fmt.Errorf("no database found in %s", dbPath)
+	}
+	return kv.NewKVStore(cliCtx.Context, dbPath, &kv.Config{Backend: backend, ReadOnly: readOnly})
+}
+
+func closeDatabase(store *kv.Store) {
+	if err := store.Close(); err != nil {
+		log.WithError(err).Error("Could not close database")
+	}
+}
+
+func inspect(cliCtx *cli.Context) error {
+	store, err := openDatabase(cliCtx, true /* readOnly */)
+	if err != nil {
+		return err
+	}
+	defer closeDatabase(store)
+	ctx := cliCtx.Context
+
+	stats, err := store.BucketStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read bucket stats")
+	}
+	fmt.Printf("%-40s %12s %16s\n", "BUCKET", "KEYS", "SIZE (BYTES)")
+	for _, st := range stats {
+		fmt.Printf("%-40s %12d %16d\n", st.Name, st.Keys, st.Size)
+	}
+	fmt.Println()
+
+	head, err := store.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read head block")
+	}
+	if head != nil && head.Block != nil {
+		headRoot, err := head.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Head:      slot %d, root %#x\n", head.Block.Slot, headRoot)
+	} else {
+		fmt.Println("Head:      none")
+	}
+	justified, err := store.JustifiedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read justified checkpoint")
+	}
+	fmt.Printf("Justified: epoch %d, root %#x\n", justified.Epoch, justified.Root)
+	finalized, err := store.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read finalized checkpoint")
+	}
+	fmt.Printf("Finalized: epoch %d, root %#x\n", finalized.Epoch, finalized.Root)
+	return nil
+}
+
+func checkIndices(cliCtx *cli.Context) error {
+	store, err := openDatabase(cliCtx, true /* readOnly */)
+	if err != nil {
+		return err
+	}
+	defer closeDatabase(store)
+
+	report, err := store.CheckIndices(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not check indices")
+	}
+	printIndexReport(report)
+	if report.Count() > 0 {
+		return fmt.Errorf("found %d index inconsistencies, the indices can be rebuilt with the db repair command",
+			report.Count())
+	}
+	log.Info("No index inconsistency found")
+	return nil
+}
+
+func repairIndices(cliCtx *cli.Context) error {
+	store, err := openDatabase(cliCtx, false /* readOnly */)
+	if err != nil {
+		return err
+	}
+	defer closeDatabase(store)
+
+	if err := store.RepairIndices(cliCtx.Context); err != nil {
+		return err
+	}
+	report, err := store.CheckIndices(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not check indices")
+	}
+	if report.Count() > 0 {
+		printIndexReport(report)
+		return fmt.Errorf("%d index inconsistencies remain after repair", report.Count())
+	}
+	log.Info("Repair completed successfully")
+	return nil
+}
+
+func rollback(cliCtx *cli.Context) error {
+	rootString := strings.TrimPrefix(cliCtx.String(cmd.RollbackRootFlag.Name), "0x")
+	root, err := hex.DecodeString(rootString)
+	if err != nil || len(root) != 32 {
+		return fmt.Errorf("%s is not a valid hex encoded block root", cliCtx.String(cmd.RollbackRootFlag.Name))
+	}
+
+	resp, err := promptutil.ValidatePrompt(os.Stdin, rollbackYesNoPrompt, promptutil.ValidateYesOrNo)
+	if err != nil {
+		return errors.Wrap(err, "could not validate choice")
+	}
+	if strings.EqualFold(resp, "n") {
+		log.Info("Rollback aborted")
+		return nil
+	}
+
+	store, err := openDatabase(cliCtx, false /* readOnly */)
+	if err != nil {
+		return err
+	}
+	defer closeDatabase(store)
+	return store.RollbackHead(cliCtx.Context, bytesutil.ToBytes32(root))
+}
+
+func printIndexReport(report *kv.IndexReport) {
+	for _, issue := range []struct {
+		name  string
+		roots [][32]byte
+	}{
+		{"Blocks missing from the block slot index", report.BlocksNotInSlotIndex},
+		{"Block slot index entries without block", report.DanglingBlockSlotIndices},
+		{"States missing from the state slot index", report.StatesNotInSlotIndex},
+		{"State slot index entries without state", report.DanglingStateSlotIndices},
+		{"States without state summary", report.StatesWithoutSummary},
+		{"State summaries with a wrong slot", report.MismatchingSummaries},
+		{"Breaks in the finalized block roots chain", report.FinalizedChainBreaks},
+	} {
+		fmt.Printf("%s: %d\n", issue.name, len(issue.roots))
+		for i, r := range issue.roots {
+			if i == maxPrintedRoots {
+				fmt.Printf("  ... and %d more\n", len(issue.roots)-maxPrintedRoots)
+				break
+			}
+			fmt.Printf("  %#x\n", r)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCheckIndices(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	store, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{Backend: kv.BadgerBackend})
+	require.NoError(t, err)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	require.NoError(t, store.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, store.SaveState(ctx, st, root))
+	require.NoError(t, store.SaveStateSummary(ctx, &pb.StateSummary{Slot: 10, Root: root[:]}))
+	require.NoError(t, store.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, store.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	cliCtx.Context = ctx
+
+	require.NoError(t, checkIndices(cliCtx))
+	assert.LogsContain(t, logHook, "No index inconsistency found")
+	require.NoError(t, repairIndices(cliCtx))
+	assert.LogsContain(t, logHook, "Repair completed successfully")
+}
+
+func TestCheckIndices_NoDatabase(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, t.TempDir(), "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	cliCtx.Context = context.Background()
+
+	assert.ErrorContains(t, "no database found", checkIndices(cliCtx))
+}
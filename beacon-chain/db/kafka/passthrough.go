@@ -136,11 +136,41 @@ func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte)
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
+// OriginBlockRoot -- passthrough.
+func (e Exporter) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginBlockRoot(ctx)
+}
+
+// SaveOriginBlockRoot -- passthrough.
+func (e Exporter) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveOriginBlockRoot(ctx, blockRoot)
+}
+
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
+// SaveBackfillBlockRoot -- passthrough.
+func (e Exporter) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveBackfillBlockRoot(ctx, blockRoot)
+}
+
+// SaveOrigin -- passthrough.
+func (e Exporter) SaveOrigin(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock) error {
+	return e.db.SaveOrigin(ctx, state, block)
+}
+
 // SaveState -- passthrough.
 func (e Exporter) SaveState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
 	return e.db.SaveState(ctx, st, blockRoot)
 }
 
+// SaveArchivedState -- passthrough.
+func (e Exporter) SaveArchivedState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
+	return e.db.SaveArchivedState(ctx, st, blockRoot)
+}
+
 // SaveStateSummary -- passthrough.
 func (e Exporter) SaveStateSummary(ctx context.Context, summary *pb.StateSummary) error {
 	return e.db.SaveStateSummary(ctx, summary)
@@ -226,6 +256,48 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// ValidatorPerformance -- passthrough
+func (e Exporter) ValidatorPerformance(ctx context.Context, epoch uint64) (*db.ValidatorPerformance, error) {
+	return e.db.ValidatorPerformance(ctx, epoch)
+}
+
+// ValidatorPerformances -- passthrough
+func (e Exporter) ValidatorPerformances(ctx context.Context, startEpoch, endEpoch uint64) ([]*db.ValidatorPerformance, error) {
+	return e.db.ValidatorPerformances(ctx, startEpoch, endEpoch)
+}
+
+// ValidatorParticipations -- passthrough
+func (e Exporter) ValidatorParticipations(ctx context.Context, startEpoch, endEpoch uint64) ([]*db.ValidatorParticipation, error) {
+	return e.db.ValidatorParticipations(ctx, startEpoch, endEpoch)
+}
+
+// NextValidatorPerformanceEpoch -- passthrough
+func (e Exporter) NextValidatorPerformanceEpoch(ctx context.Context) (uint64, error) {
+	return e.db.NextValidatorPerformanceEpoch(ctx)
+}
+
+// SaveValidatorPerformance -- passthrough
+func (e Exporter) SaveValidatorPerformance(
+	ctx context.Context, perf *db.ValidatorPerformance, participation *db.ValidatorParticipation,
+) error {
+	return e.db.SaveValidatorPerformance(ctx, perf, participation)
+}
+
+// AttestationRecords -- passthrough
+func (e Exporter) AttestationRecords(ctx context.Context, startSlot, endSlot uint64) ([]*db.AttestationRecord, error) {
+	return e.db.AttestationRecords(ctx, startSlot, endSlot)
+}
+
+// SaveAttestationRecords -- passthrough
+func (e Exporter) SaveAttestationRecords(ctx context.Context, records []*db.AttestationRecord) error {
+	return e.db.SaveAttestationRecords(ctx, records)
+}
+
+// DeleteAttestationRecordsBefore -- passthrough
+func (e Exporter) DeleteAttestationRecordsBefore(ctx context.Context, slot uint64) error {
+	return e.db.DeleteAttestationRecordsBefore(ctx, slot)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index uint64) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
@@ -265,3 +337,18 @@ func (e Exporter) RunMigrations(ctx context.Context) error {
 func (e Exporter) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint uint64) error {
 	return e.db.RunMigrations(ctx)
 }
+
+// PruneBlocks -- passthrough.
+func (e Exporter) PruneBlocks(ctx context.Context, beforeSlot uint64) (int, error) {
+	return e.db.PruneBlocks(ctx, beforeSlot)
+}
+
+// PruneStates -- passthrough.
+func (e Exporter) PruneStates(ctx context.Context, beforeSlot uint64) (int, error) {
+	return e.db.PruneStates(ctx, beforeSlot)
+}
+
+// PruneAttestations -- passthrough.
+func (e Exporter) PruneAttestations(ctx context.Context, beforeEpoch uint64) (int, error) {
+	return e.db.PruneAttestations(ctx, beforeEpoch)
+}
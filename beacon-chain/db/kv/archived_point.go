@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -13,7 +12,7 @@ func (s *Store) LastArchivedSlot(ctx context.Context) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastArchivedSlot")
 	defer span.End()
 	var index uint64
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		b, _ := bkt.Cursor().Last()
 		index = bytesutil.BytesToUint64BigEndian(b)
@@ -29,7 +28,7 @@ func (s *Store) LastArchivedRoot(ctx context.Context) [32]byte {
 	defer span.End()
 
 	var blockRoot []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		_, blockRoot = bkt.Cursor().Last()
 		return nil
@@ -47,7 +46,7 @@ func (s *Store) ArchivedPointRoot(ctx context.Context, slot uint64) [32]byte {
 	defer span.End()
 
 	var blockRoot []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx kvTx) error {
 		bucket := tx.Bucket(stateSlotIndicesBucket)
 		blockRoot = bucket.Get(bytesutil.Uint64ToBytesBigEndian(slot))
 		return nil
@@ -63,7 +62,7 @@ func (s *Store) HasArchivedPoint(ctx context.Context, slot uint64) bool {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasArchivedPoint")
 	defer span.End()
 	var exists bool
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx kvTx) error {
 		iBucket := tx.Bucket(stateSlotIndicesBucket)
 		exists = iBucket.Get(bytesutil.Uint64ToBytesBigEndian(slot)) != nil
 		return nil
@@ -0,0 +1,94 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveAttestationRecords saves the attestation records of the attestation tracker, replacing the
+// records previously saved for the same validators and slots.
+func (s *Store) SaveAttestationRecords(ctx context.Context, records []*dbpb.AttestationRecord) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttestationRecords")
+	defer span.End()
+
+	encs := make([][]byte, len(records))
+	for i, r := range records {
+		enc, err := encode(ctx, r)
+		if err != nil {
+			return err
+		}
+		encs[i] = enc
+	}
+	err := s.db.Update(func(tx kvTx) error {
+		bkt := tx.Bucket(attestationRecordsBucket)
+		for i, r := range records {
+			if err := bkt.Put(attestationRecordKey(r.Slot, r.ValidatorIndex), encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// AttestationRecords retrieves the attestation records of the slots between the start and the end
+// slots, inclusive, in ascending slot and validator index order.
+func (s *Store) AttestationRecords(ctx context.Context, startSlot, endSlot uint64) ([]*dbpb.AttestationRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationRecords")
+	defer span.End()
+
+	if endSlot < startSlot {
+		return nil, fmt.Errorf("end slot %d < start slot %d", endSlot, startSlot)
+	}
+	min := bytesutil.Uint64ToBytesBigEndian(startSlot)
+	max := bytesutil.Uint64ToBytesBigEndian(endSlot + 1)
+	records := make([]*dbpb.AttestationRecord, 0)
+	err := s.db.View(func(tx kvTx) error {
+		c := tx.Bucket(attestationRecordsBucket).Cursor()
+		for k, enc := c.Seek(min); k != nil && (endSlot == ^uint64(0) || bytes.Compare(k, max) < 0); k, enc = c.Next() {
+			r := &dbpb.AttestationRecord{}
+			if err := decode(ctx, enc, r); err != nil {
+				return err
+			}
+			records = append(records, r)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// DeleteAttestationRecordsBefore deletes the attestation records of the slots before the slot.
+func (s *Store) DeleteAttestationRecordsBefore(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteAttestationRecordsBefore")
+	defer span.End()
+
+	max := bytesutil.Uint64ToBytesBigEndian(slot)
+	err := s.db.Update(func(tx kvTx) error {
+		bkt := tx.Bucket(attestationRecordsBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, max) < 0; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// attestationRecordKey orders the attestation records by slot then validator index.
+func attestationRecordKey(slot, validatorIndex uint64) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(slot), bytesutil.Uint64ToBytesBigEndian(validatorIndex)...)
+}
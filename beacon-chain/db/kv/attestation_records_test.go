@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_AttestationRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	records := []*dbpb.AttestationRecord{
+		{ValidatorIndex: 7, Slot: 10, FirstSeen: 1000},
+		{ValidatorIndex: 3, Slot: 10, Included: true, InclusionSlot: 11, InclusionDistance: 1, CorrectHead: true},
+		{ValidatorIndex: 3, Slot: 42},
+		{ValidatorIndex: 1, Slot: 300},
+	}
+	require.NoError(t, db.SaveAttestationRecords(ctx, records))
+	// Saving a record again replaces it.
+	updated := &dbpb.AttestationRecord{ValidatorIndex: 7, Slot: 10, FirstSeen: 1000, Included: true, InclusionSlot: 12, InclusionDistance: 2}
+	require.NoError(t, db.SaveAttestationRecords(ctx, []*dbpb.AttestationRecord{updated}))
+
+	got, err := db.AttestationRecords(ctx, 10, 42)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*dbpb.AttestationRecord{records[1], updated, records[2]}, got)
+
+	require.NoError(t, db.DeleteAttestationRecordsBefore(ctx, 42))
+	got, err = db.AttestationRecords(ctx, 0, ^uint64(0))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*dbpb.AttestationRecord{records[2], records[3]}, got)
+
+	_, err = db.AttestationRecords(ctx, 2, 1)
+	assert.ErrorContains(t, "end slot 1 < start slot 2", err)
+}
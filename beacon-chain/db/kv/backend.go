@@ -0,0 +1,203 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+const (
+	// BoltBackend is the name of the bbolt storage backend, which is the default backend.
+	BoltBackend = "bolt"
+	// BadgerBackend is the name of the badger storage backend, an LSM tree based key-value
+	// store which allows concurrent write transactions.
+	BadgerBackend = "badger"
+
+	// copyBatchSize is the maximum size of the key-values copied in a single transaction
+	// when copying a database from a backend to another.
+	copyBatchSize = 16 * 1024 * 1024
+	// deleteBatchSize is the maximum number of keys deleted in a single transaction when
+	// deleting a bucket, which stays below the transaction size limit of badger.
+	deleteBatchSize = 10000
+)
+
+// kvBackend is an embedded key-value store organizing its data in buckets, on which the
+// beacon node database is built. The semantics are the ones of bbolt: values read in a
+// transaction are only valid for the life of the transaction, and read-write transactions
+// are atomic.
+type kvBackend interface {
+	View(fn func(tx kvTx) error) error
+	Update(fn func(tx kvTx) error) error
+	Close() error
+}
+
+// kvTx is a transaction of a kvBackend.
+type kvTx interface {
+	// Bucket returns the bucket with the name, or nil if it does not exist.
+	Bucket(name []byte) kvBucket
+	CreateBucketIfNotExists(name []byte) (kvBucket, error)
+	DeleteBucket(name []byte) error
+	// ForEach calls the function for each bucket, in the byte order of their names.
+	ForEach(fn func(name []byte, b kvBucket) error) error
+}
+
+// kvBucket is a collection of key-values sorted by key.
+type kvBucket interface {
+	// Get returns the value of the key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	// ForEach calls the function for each key-value of the bucket, in the byte order of the keys.
+	ForEach(fn func(k, v []byte) error) error
+	Cursor() kvCursor
+}
+
+// kvCursor iterates over the key-values of a bucket in the byte order of the keys. A nil key
+// is returned once the cursor moves past the last key-value.
+type kvCursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Seek(seek []byte) (key, value []byte)
+}
+
+// openBackend opens the database of the storage backend stored in the directory. Read-write
+// transactions fail on a database opened read-only.
+func openBackend(dirPath, backend string, readOnly bool) (kvBackend, error) {
+	switch backend {
+	case BoltBackend, "":
+		return openBoltBackend(path.Join(dirPath, DatabaseFileName), readOnly)
+	case BadgerBackend:
+		return openBadgerBackend(path.Join(dirPath, BadgerDirName), readOnly)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q, expected %q or %q", backend, BoltBackend, BadgerBackend)
+	}
+}
+
+// backendExists returns true if a database of the storage backend is stored in the directory.
+func backendExists(dirPath, backend string) bool {
+	switch backend {
+	case BoltBackend, "":
+		return fileutil.FileExists(path.Join(dirPath, DatabaseFileName))
+	case BadgerBackend:
+		hasDir, err := fileutil.HasDir(path.Join(dirPath, BadgerDirName))
+		return err == nil && hasDir
+	default:
+		return false
+	}
+}
+
+// DetectBackend returns the storage backend of the database stored in the directory. The bolt
+// backend is returned if there is no badger database in the directory.
+func DetectBackend(dirPath string) string {
+	if !backendExists(dirPath, BoltBackend) && backendExists(dirPath, BadgerBackend) {
+		return BadgerBackend
+	}
+	return BoltBackend
+}
+
+// removeBackend deletes the database of the storage backend stored in the directory.
+func removeBackend(dirPath, backend string) error {
+	switch backend {
+	case BoltBackend, "":
+		return os.Remove(path.Join(dirPath, DatabaseFileName))
+	case BadgerBackend:
+		return os.RemoveAll(path.Join(dirPath, BadgerDirName))
+	default:
+		return fmt.Errorf("unknown database backend %q", backend)
+	}
+}
+
+// copyBackend copies all the buckets of the source backend into the destination backend. The
+// key-values are written in batches, so the copy is not atomic.
+func copyBackend(ctx context.Context, src, dst kvBackend) error {
+	return src.View(func(tx kvTx) error {
+		return tx.ForEach(func(name []byte, b kvBucket) error {
+			log.WithField("bucket", string(name)).Debug("Copying bucket")
+			if err := dst.Update(func(tx kvTx) error {
+				_, err := tx.CreateBucketIfNotExists(name)
+				return err
+			}); err != nil {
+				return errors.Wrapf(err, "could not create bucket %s", name)
+			}
+
+			var keys, values [][]byte
+			size := 0
+			flush := func() error {
+				if len(keys) == 0 {
+					return nil
+				}
+				err := dst.Update(func(tx kvTx) error {
+					bkt := tx.Bucket(name)
+					for i, k := range keys {
+						if err := bkt.Put(k, values[i]); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				keys, values, size = nil, nil, 0
+				return err
+			}
+			if err := b.ForEach(func(k, v []byte) error {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+				values = append(values, bytesutil.SafeCopyBytes(v))
+				size += len(k) + len(v)
+				if size >= copyBatchSize {
+					return flush()
+				}
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "could not copy bucket %s", name)
+			}
+			return flush()
+		})
+	})
+}
+
+// deleteBucket deletes the bucket and all of its key-values, if it exists. The keys are deleted
+// in batches before the bucket itself, so that deleting a large bucket does not exceed the
+// transaction size limit of badger. The deletion is not atomic.
+func deleteBucket(ctx context.Context, db kvBackend, name []byte) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		deleted := false
+		if err := db.Update(func(tx kvTx) error {
+			bkt := tx.Bucket(name)
+			if bkt == nil {
+				deleted = true
+				return nil
+			}
+			var keys [][]byte
+			c := bkt.Cursor()
+			for k, _ := c.First(); k != nil && len(keys) < deleteBatchSize; k, _ = c.Next() {
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+			}
+			if len(keys) < deleteBatchSize {
+				deleted = true
+				return tx.DeleteBucket(name)
+			}
+			for _, k := range keys {
+				if err := bkt.Delete(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "could not delete bucket %s", name)
+		}
+		if deleted {
+			return nil
+		}
+	}
+}
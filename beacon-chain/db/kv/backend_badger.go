@@ -0,0 +1,474 @@
+package kv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// BadgerDirName is the name of the directory of the beacon node database when using
+	// the badger backend.
+	BadgerDirName = "beaconchain.badger"
+
+	// badgerUpdateRetries is the number of times a read-write transaction is retried when
+	// it conflicts with a concurrent transaction.
+	badgerUpdateRetries = 10
+	// badgerGCInterval is the interval between two garbage collections of the value log.
+	badgerGCInterval = 10 * time.Minute
+	// badgerGCDiscardRatio is the ratio of discardable data above which a value log file
+	// is rewritten.
+	badgerGCDiscardRatio = 0.5
+)
+
+var (
+	// Buckets are emulated by prefixing keys. The names of the existing buckets are stored
+	// under the bucket marker prefix, and key-values under their length prefixed bucket name,
+	// which never starts with the bucket marker prefix as bucket names can not be empty.
+	badgerBucketMarkerPrefix = []byte{0}
+
+	errBadgerEmptyBucketName = errors.New("bucket name required")
+	errBadgerNameTooLong     = errors.New("bucket name too long")
+)
+
+// badgerBackend is the kvBackend of a badger database. As opposed to bbolt, read-write
+// transactions run concurrently, and are retried when they conflict with each other.
+type badgerBackend struct {
+	db        *badger.DB
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	// buckets caches the names of the existing buckets.
+	buckets     map[string]bool
+	bucketsLock sync.RWMutex
+}
+
+type badgerTx struct {
+	backend *badgerBackend
+	txn     *badger.Txn
+	update  bool
+	// iterators are the open iterators of a read-only transaction.
+	iterators []*badger.Iterator
+	// active is the cursor owning the single iterator a read-write transaction can have
+	// open at a time.
+	active *badgerCursor
+	// Buckets created or deleted in the transaction.
+	created map[string]bool
+	deleted map[string]bool
+}
+
+type badgerBucket struct {
+	tx     *badgerTx
+	prefix []byte
+}
+
+type badgerCursor struct {
+	bucket  *badgerBucket
+	it      *badger.Iterator
+	reverse bool
+	// key is the full key of the current key-value, used to reposition the cursor when its
+	// iterator was closed for another cursor of a read-write transaction.
+	key []byte
+}
+
+func openBadgerBackend(dirPath string, readOnly bool) (*badgerBackend, error) {
+	opts := badger.DefaultOptions(dirPath).WithLogger(log).WithReadOnly(readOnly)
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open badger database")
+	}
+	b := &badgerBackend{
+		db:      db,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		buckets: make(map[string]bool),
+	}
+	if err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: badgerBucketMarkerPrefix})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			b.buckets[string(it.Item().Key()[len(badgerBucketMarkerPrefix):])] = true
+		}
+		return nil
+	}); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close badger database")
+		}
+		return nil, err
+	}
+	if readOnly {
+		// The value log of a read-only database is never rewritten.
+		close(b.done)
+	} else {
+		go b.runValueLogGC()
+	}
+	return b, nil
+}
+
+// View executes the function in a read-only transaction.
+func (b *badgerBackend) View(fn func(tx kvTx) error) error {
+	t := b.newTx(false)
+	defer t.discard()
+	return fn(t)
+}
+
+// Update executes the function in a read-write transaction, which is retried when it
+// conflicts with a concurrent transaction.
+func (b *badgerBackend) Update(fn func(tx kvTx) error) error {
+	var err error
+	for i := 0; i < badgerUpdateRetries; i++ {
+		err = b.update(fn)
+		if !errors.Is(err, badger.ErrConflict) {
+			return err
+		}
+	}
+	return errors.Wrap(err, "could not commit transaction")
+}
+
+func (b *badgerBackend) update(fn func(tx kvTx) error) error {
+	t := b.newTx(true)
+	defer t.discard()
+	if err := fn(t); err != nil {
+		return err
+	}
+	t.closeIterators()
+	if err := t.txn.Commit(); err != nil {
+		return err
+	}
+
+	b.bucketsLock.Lock()
+	defer b.bucketsLock.Unlock()
+	for name := range t.deleted {
+		delete(b.buckets, name)
+	}
+	for name := range t.created {
+		b.buckets[name] = true
+	}
+	return nil
+}
+
+// Close the badger database.
+func (b *badgerBackend) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		close(b.stop)
+		<-b.done
+		err = b.db.Close()
+	})
+	return err
+}
+
+// runValueLogGC periodically reclaims the space of the value log, which is not done by
+// badger itself.
+func (b *badgerBackend) runValueLogGC() {
+	defer close(b.done)
+	ticker := time.NewTicker(badgerGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// Each successful run rewrites a single value log file.
+			for b.db.RunValueLogGC(badgerGCDiscardRatio) == nil {
+			}
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+func (b *badgerBackend) newTx(update bool) *badgerTx {
+	return &badgerTx{
+		backend: b,
+		txn:     b.db.NewTransaction(update),
+		update:  update,
+		created: make(map[string]bool),
+		deleted: make(map[string]bool),
+	}
+}
+
+// closeIterators closes the iterators of the cursors of the transaction, which badger
+// requires before the transaction ends.
+func (t *badgerTx) closeIterators() {
+	for _, it := range t.iterators {
+		it.Close()
+	}
+	t.iterators = nil
+	t.releaseIterator()
+}
+
+// releaseIterator closes the iterator of the active cursor of a read-write transaction, as
+// badger only allows one iterator at a time in such transactions. The cursor reopens an
+// iterator when it is used again.
+func (t *badgerTx) releaseIterator() {
+	if t.active != nil && t.active.it != nil {
+		t.active.it.Close()
+		t.active.it = nil
+	}
+	t.active = nil
+}
+
+func (t *badgerTx) discard() {
+	t.closeIterators()
+	t.txn.Discard()
+}
+
+// newIterator opens an iterator over the keys with the prefix. The iterator of a read-only
+// transaction is closed with the transaction, while the one of a read-write transaction is
+// owned by the cursor, and closed when another iterator is opened.
+func (t *badgerTx) newIterator(prefix []byte, reverse bool, owner *badgerCursor) *badger.Iterator {
+	if t.update {
+		t.releaseIterator()
+	}
+	// Values are only read when needed, as buckets such as the state bucket hold large values.
+	it := t.txn.NewIterator(badger.IteratorOptions{Prefix: prefix, Reverse: reverse})
+	if t.update {
+		t.active = owner
+	} else {
+		t.iterators = append(t.iterators, it)
+	}
+	return it
+}
+
+func (t *badgerTx) bucketExists(name []byte) bool {
+	if t.created[string(name)] {
+		return true
+	}
+	if t.deleted[string(name)] {
+		return false
+	}
+	t.backend.bucketsLock.RLock()
+	defer t.backend.bucketsLock.RUnlock()
+	return t.backend.buckets[string(name)]
+}
+
+// Bucket returns the bucket with the name, or nil if it does not exist.
+func (t *badgerTx) Bucket(name []byte) kvBucket {
+	if !t.bucketExists(name) {
+		return nil
+	}
+	return t.bucket(name)
+}
+
+func (t *badgerTx) bucket(name []byte) *badgerBucket {
+	prefix := make([]byte, 0, len(name)+1)
+	prefix = append(prefix, byte(len(name)))
+	prefix = append(prefix, name...)
+	return &badgerBucket{tx: t, prefix: prefix}
+}
+
+// CreateBucketIfNotExists creates the bucket if it does not exist yet.
+func (t *badgerTx) CreateBucketIfNotExists(name []byte) (kvBucket, error) {
+	if len(name) == 0 {
+		return nil, errBadgerEmptyBucketName
+	}
+	if len(name) > 255 {
+		return nil, errBadgerNameTooLong
+	}
+	if !t.bucketExists(name) {
+		if err := t.txn.Set(bucketMarkerKey(name), []byte{}); err != nil {
+			return nil, err
+		}
+		delete(t.deleted, string(name))
+		t.created[string(name)] = true
+	}
+	return t.bucket(name), nil
+}
+
+// DeleteBucket deletes the bucket and all of its key-values.
+func (t *badgerTx) DeleteBucket(name []byte) error {
+	if !t.bucketExists(name) {
+		return errors.New("bucket not found")
+	}
+	bkt := t.bucket(name)
+	c := &badgerCursor{bucket: bkt}
+	it := c.iterator(false)
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+	t.releaseIterator()
+	for _, k := range keys {
+		if err := t.txn.Delete(k); err != nil {
+			return err
+		}
+	}
+	if err := t.txn.Delete(bucketMarkerKey(name)); err != nil {
+		return err
+	}
+	delete(t.created, string(name))
+	t.deleted[string(name)] = true
+	return nil
+}
+
+// ForEach calls the function for each bucket.
+func (t *badgerTx) ForEach(fn func(name []byte, b kvBucket) error) error {
+	t.backend.bucketsLock.RLock()
+	names := make([]string, 0, len(t.backend.buckets)+len(t.created))
+	for name := range t.backend.buckets {
+		if !t.deleted[name] {
+			names = append(names, name)
+		}
+	}
+	t.backend.bucketsLock.RUnlock()
+	for name := range t.created {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if i > 0 && name == names[i-1] {
+			continue
+		}
+		if err := fn([]byte(name), t.bucket([]byte(name))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bucketMarkerKey(name []byte) []byte {
+	k := make([]byte, 0, len(badgerBucketMarkerPrefix)+len(name))
+	k = append(k, badgerBucketMarkerPrefix...)
+	return append(k, name...)
+}
+
+func (b *badgerBucket) key(key []byte) []byte {
+	k := make([]byte, 0, len(b.prefix)+len(key))
+	k = append(k, b.prefix...)
+	return append(k, key...)
+}
+
+// Get returns the value of the key, or nil if the key does not exist.
+func (b *badgerBucket) Get(key []byte) []byte {
+	item, err := b.tx.txn.Get(b.key(key))
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			log.WithError(err).Error("Could not read key from badger database")
+		}
+		return nil
+	}
+	return itemValue(item)
+}
+
+// Put sets the value of the key.
+func (b *badgerBucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	return b.tx.txn.Set(b.key(key), value)
+}
+
+// Delete removes the key from the bucket.
+func (b *badgerBucket) Delete(key []byte) error {
+	return b.tx.txn.Delete(b.key(key))
+}
+
+// ForEach calls the function for each key-value of the bucket.
+func (b *badgerBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Cursor returns a cursor over the key-values of the bucket.
+func (b *badgerBucket) Cursor() kvCursor {
+	return &badgerCursor{bucket: b}
+}
+
+// First moves the cursor to the first key-value of the bucket.
+func (c *badgerCursor) First() ([]byte, []byte) {
+	c.iterator(false).Rewind()
+	return c.current()
+}
+
+// Last moves the cursor to the last key-value of the bucket.
+func (c *badgerCursor) Last() ([]byte, []byte) {
+	it := c.iterator(true)
+	// Seek to the smallest key greater than all the keys of the bucket, which does not
+	// belong to the bucket itself.
+	end := prefixEnd(c.bucket.prefix)
+	it.Seek(end)
+	if it.Valid() && bytes.Equal(it.Item().Key(), end) {
+		it.Next()
+	}
+	return c.current()
+}
+
+// Next moves the cursor to the next key-value of the bucket. As cursors can only move
+// forward, no key-value follows the last one.
+func (c *badgerCursor) Next() ([]byte, []byte) {
+	if c.key == nil || c.reverse {
+		return nil, nil
+	}
+	if c.it == nil {
+		// The iterator was closed for another cursor, the cursor is moved back to its key.
+		it := c.iterator(false)
+		it.Seek(c.key)
+		if !it.Valid() || !bytes.Equal(it.Item().Key(), c.key) {
+			return c.current()
+		}
+	}
+	c.it.Next()
+	return c.current()
+}
+
+// Seek moves the cursor to the key, or to the next key if it does not exist.
+func (c *badgerCursor) Seek(seek []byte) ([]byte, []byte) {
+	c.iterator(false).Seek(c.bucket.key(seek))
+	return c.current()
+}
+
+// iterator returns an iterator going in the direction, reusing the current iterator of the
+// cursor if it goes in the same direction.
+func (c *badgerCursor) iterator(reverse bool) *badger.Iterator {
+	if c.it == nil || c.reverse != reverse {
+		c.it = c.bucket.tx.newIterator(c.bucket.prefix, reverse, c)
+		c.reverse = reverse
+	}
+	return c.it
+}
+
+func (c *badgerCursor) current() ([]byte, []byte) {
+	if !c.it.ValidForPrefix(c.bucket.prefix) {
+		c.key = nil
+		return nil, nil
+	}
+	item := c.it.Item()
+	c.key = item.KeyCopy(nil)
+	return c.key[len(c.bucket.prefix):], itemValue(item)
+}
+
+// itemValue returns a copy of the value of the item, which is non-nil for an empty value.
+func itemValue(item *badger.Item) []byte {
+	v, err := item.ValueCopy(nil)
+	if err != nil {
+		log.WithError(err).Error("Could not read value from badger database")
+		return nil
+	}
+	if v == nil {
+		return []byte{}
+	}
+	return v
+}
+
+// prefixEnd returns the smallest key greater than all the keys starting with the prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// Bucket prefixes start with the length of the bucket name, so they never only
+	// contain 0xff bytes.
+	return end
+}
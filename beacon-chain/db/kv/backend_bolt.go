@@ -0,0 +1,92 @@
+package kv
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltAllocSize = 8 * 1024 * 1024
+
+// boltBackend is the kvBackend of a bbolt database.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+func openBoltBackend(datafile string, readOnly bool) (*boltBackend, error) {
+	boltDB, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, InitialMmapSize: 10e6, ReadOnly: readOnly},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	boltDB.AllocSize = boltAllocSize
+	return &boltBackend{db: boltDB}, nil
+}
+
+// View executes the function in a read-only transaction.
+func (b *boltBackend) View(fn func(tx kvTx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Update executes the function in a read-write transaction.
+func (b *boltBackend) Update(fn func(tx kvTx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Close the bbolt database.
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+// Bucket returns the bucket with the name, or nil if it does not exist.
+func (t *boltTx) Bucket(name []byte) kvBucket {
+	bkt := t.tx.Bucket(name)
+	if bkt == nil {
+		return nil
+	}
+	return &boltBucket{bkt}
+}
+
+// CreateBucketIfNotExists creates the bucket if it does not exist yet.
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (kvBucket, error) {
+	bkt, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{bkt}, nil
+}
+
+// DeleteBucket deletes the bucket and all of its key-values.
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return t.tx.DeleteBucket(name)
+}
+
+// ForEach calls the function for each bucket.
+func (t *boltTx) ForEach(fn func(name []byte, b kvBucket) error) error {
+	return t.tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+		return fn(name, &boltBucket{bkt})
+	})
+}
+
+// Cursor returns a cursor over the key-values of the bucket.
+func (b *boltBucket) Cursor() kvCursor {
+	return b.Bucket.Cursor()
+}
@@ -0,0 +1,283 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var backends = []string{BoltBackend, BadgerBackend}
+
+func setupBackend(t *testing.T, backend string) kvBackend {
+	b, err := openBackend(t.TempDir(), backend, false /* readOnly */)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, b.Close())
+	})
+	return b
+}
+
+func TestBackend_Buckets(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				assert.Equal(t, true, tx.Bucket([]byte("b")) == nil, "Bucket should not exist")
+				bkt, err := tx.CreateBucketIfNotExists([]byte("b"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("key"), []byte("value")))
+				_, err = tx.CreateBucketIfNotExists([]byte("a"))
+				return err
+			}))
+			require.NoError(t, b.View(func(tx kvTx) error {
+				bkt := tx.Bucket([]byte("b"))
+				require.NotNil(t, bkt)
+				assert.DeepEqual(t, []byte("value"), bkt.Get([]byte("key")))
+				assert.Equal(t, true, bkt.Get([]byte("missing")) == nil, "Missing value should be nil")
+				assert.Equal(t, true, tx.Bucket([]byte("a")).Get([]byte("key")) == nil, "Buckets should not share keys")
+
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, _ kvBucket) error {
+					names = append(names, string(name))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "b"}, names)
+				return nil
+			}))
+
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				require.NoError(t, tx.Bucket([]byte("b")).Delete([]byte("key")))
+				return tx.DeleteBucket([]byte("a"))
+			}))
+			require.NoError(t, b.View(func(tx kvTx) error {
+				assert.Equal(t, true, tx.Bucket([]byte("a")) == nil, "Bucket should be deleted")
+				assert.Equal(t, true, tx.Bucket([]byte("b")).Get([]byte("key")) == nil, "Key should be deleted")
+				return nil
+			}))
+		})
+	}
+}
+
+func TestBackend_UpdateRollback(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			wantedErr := errors.New("rollback")
+			err := b.Update(func(tx kvTx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("b"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("key"), []byte("value")))
+				return wantedErr
+			})
+			assert.ErrorContains(t, wantedErr.Error(), err)
+			require.NoError(t, b.View(func(tx kvTx) error {
+				assert.Equal(t, true, tx.Bucket([]byte("b")) == nil, "Bucket should not be created")
+				return nil
+			}))
+		})
+	}
+}
+
+func TestBackend_Cursor(t *testing.T) {
+	keys := [][]byte{{0x01}, {0x02, 0x01}, {0x02, 0xff}, {0xff, 0xff}}
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				// Neighbouring buckets must not be visible from the cursor.
+				for _, name := range []string{"a", "b", "bb", "c"} {
+					bkt, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					if name == "b" {
+						for i, k := range keys {
+							require.NoError(t, bkt.Put(k, []byte{byte(i)}))
+						}
+					} else {
+						require.NoError(t, bkt.Put([]byte{0x00}, []byte(name)))
+					}
+				}
+				return nil
+			}))
+
+			require.NoError(t, b.View(func(tx kvTx) error {
+				bkt := tx.Bucket([]byte("b"))
+				var got [][]byte
+				c := bkt.Cursor()
+				for k, v := c.First(); k != nil; k, v = c.Next() {
+					assert.Equal(t, byte(len(got)), v[0])
+					got = append(got, k)
+				}
+				assert.DeepEqual(t, keys, got)
+
+				k, v := bkt.Cursor().Last()
+				assert.DeepEqual(t, keys[3], k)
+				assert.DeepEqual(t, []byte{3}, v)
+
+				c = bkt.Cursor()
+				k, _ = c.Seek([]byte{0x02})
+				assert.DeepEqual(t, keys[1], k)
+				k, _ = c.Next()
+				assert.DeepEqual(t, keys[2], k)
+				k, _ = c.Seek([]byte{0xff, 0xff, 0x01})
+				assert.Equal(t, true, k == nil, "Seeking past the last key should return nil")
+
+				var count int
+				require.NoError(t, bkt.ForEach(func(k, v []byte) error {
+					count++
+					return nil
+				}))
+				assert.Equal(t, len(keys), count)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestBackend_EmptyBucketCursor(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				_, err := tx.CreateBucketIfNotExists([]byte("a"))
+				return err
+			}))
+			require.NoError(t, b.View(func(tx kvTx) error {
+				k, _ := tx.Bucket([]byte("a")).Cursor().First()
+				assert.Equal(t, true, k == nil)
+				k, _ = tx.Bucket([]byte("a")).Cursor().Last()
+				assert.Equal(t, true, k == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestBackend_NestedCursorsInUpdate(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				for _, name := range []string{"a", "b", "c"} {
+					bkt, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					for i := byte(0); i < 3; i++ {
+						require.NoError(t, bkt.Put([]byte{i}, []byte{i}))
+					}
+				}
+				return nil
+			}))
+
+			// Badger only allows one open iterator in a read-write transaction.
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				var pairs [][]byte
+				require.NoError(t, tx.Bucket([]byte("a")).ForEach(func(ka, _ []byte) error {
+					return tx.Bucket([]byte("b")).ForEach(func(kb, _ []byte) error {
+						pairs = append(pairs, []byte{ka[0], kb[0]})
+						return nil
+					})
+				}))
+				assert.Equal(t, 9, len(pairs))
+				require.NoError(t, tx.DeleteBucket([]byte("b")))
+				require.NoError(t, tx.DeleteBucket([]byte("c")))
+
+				// A cursor whose iterator was closed moves past its deleted key.
+				c := tx.Bucket([]byte("a")).Cursor()
+				k, _ := c.First()
+				assert.DeepEqual(t, []byte{0}, k)
+				require.NoError(t, tx.Bucket([]byte("a")).Delete([]byte{0}))
+				k, _ = tx.Bucket([]byte("a")).Cursor().Last()
+				assert.DeepEqual(t, []byte{2}, k)
+				k, _ = c.Next()
+				assert.DeepEqual(t, []byte{1}, k)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDeleteBucket(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			b := setupBackend(t, backend)
+			require.NoError(t, b.Update(func(tx kvTx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				for i := 0; i < 2*deleteBatchSize+1; i++ {
+					require.NoError(t, bkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), []byte{1}))
+				}
+				return nil
+			}))
+
+			require.NoError(t, deleteBucket(context.Background(), b, []byte("a")))
+			require.NoError(t, b.View(func(tx kvTx) error {
+				assert.Equal(t, true, tx.Bucket([]byte("a")) == nil, "Bucket should be deleted")
+				return nil
+			}))
+			// Deleting a missing bucket is a no-op.
+			require.NoError(t, deleteBucket(context.Background(), b, []byte("a")))
+		})
+	}
+}
+
+func TestCopyBackend(t *testing.T) {
+	src := setupBackend(t, BoltBackend)
+	dst := setupBackend(t, BadgerBackend)
+	require.NoError(t, src.Update(func(tx kvTx) error {
+		bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, bkt.Put([]byte{byte(i)}, []byte{byte(i), byte(i)}))
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte("empty"))
+		return err
+	}))
+
+	require.NoError(t, copyBackend(context.Background(), src, dst))
+	require.NoError(t, dst.View(func(tx kvTx) error {
+		require.NotNil(t, tx.Bucket([]byte("empty")))
+		bkt := tx.Bucket([]byte("a"))
+		require.NotNil(t, bkt)
+		for i := 0; i < 100; i++ {
+			assert.DeepEqual(t, []byte{byte(i), byte(i)}, bkt.Get([]byte{byte(i)}))
+		}
+		return nil
+	}))
+}
+
+func TestStore_BadgerBackend(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{Backend: BadgerBackend})
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	require.NoError(t, db.RunMigrations(ctx))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 20
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(20))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), head.Block.Slot)
+	blocks, err := db.HighestSlotBlocksBelow(ctx, 21)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, uint64(20), blocks[0].Block.Slot)
+}
+
+func TestNewKVStore_UnknownBackend(t *testing.T) {
+	_, err := NewKVStore(context.Background(), t.TempDir(), &Config{Backend: "leveldb"})
+	assert.ErrorContains(t, "unknown database backend", err)
+}
@@ -7,8 +7,6 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -44,11 +42,8 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
 	log.WithField("backup", backupPath).Info("Writing backup database.")
 
-	copyDB, err := bolt.Open(
-		backupPath,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
-	)
+	// Backups are always bolt databases, which can be restored by copying the file.
+	copyDB, err := openBoltBackend(backupPath, false /* readOnly */)
 	if err != nil {
 		return err
 	}
@@ -58,16 +53,5 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 		}
 	}()
 
-	return s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			log.Debugf("Copying bucket %s\n", name)
-			return copyDB.Update(func(tx2 *bolt.Tx) error {
-				b2, err := tx2.CreateBucketIfNotExists(name)
-				if err != nil {
-					return err
-				}
-				return b.ForEach(b2.Put)
-			})
-		})
-	})
+	return copyBackend(ctx, s.db, copyDB)
 }
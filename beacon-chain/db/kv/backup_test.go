@@ -12,7 +12,7 @@ import (
 )
 
 func TestStore_Backup(t *testing.T) {
-	db, err := NewKVStore(context.Background(), t.TempDir())
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{})
 	require.NoError(t, err, "Failed to instantiate DB")
 	ctx := context.Background()
 
@@ -40,7 +40,7 @@ func TestStore_Backup(t *testing.T) {
 	// our NewKVStore function expects when opening a database.
 	require.NoError(t, os.Rename(oldFilePath, newFilePath))
 
-	backedDB, err := NewKVStore(ctx, backupsPath)
+	backedDB, err := NewKVStore(ctx, backupsPath, &Config{})
 	require.NoError(t, err, "Failed to instantiate DB")
 	t.Cleanup(func() {
 		require.NoError(t, backedDB.Close(), "Failed to close database")
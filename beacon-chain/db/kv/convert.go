@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ConvertDatabase copies the database of the source storage backend stored in the directory into
+// a new database of the target storage backend, in the same directory. The database must not be
+// in use while it is converted.
+func ConvertDatabase(ctx context.Context, dirPath, sourceBackend, targetBackend string) (err error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ConvertDatabase")
+	defer span.End()
+
+	if sourceBackend == targetBackend {
+		return errors.New("source and target backends are the same")
+	}
+	if !backendExists(dirPath, sourceBackend) {
+		return fmt.Errorf("no %s database found in %s", sourceBackend, dirPath)
+	}
+	if backendExists(dirPath, targetBackend) {
+		return fmt.Errorf("a %s database already exists in %s", targetBackend, dirPath)
+	}
+
+	src, err := openBackend(dirPath, sourceBackend, false /* readOnly */)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", sourceBackend)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close source database")
+		}
+	}()
+	dst, err := openBackend(dirPath, targetBackend, false /* readOnly */)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", targetBackend)
+	}
+	defer func() {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close target database")
+		}
+		// Do not leave a partially converted database behind.
+		if err != nil {
+			if removeErr := removeBackend(dirPath, targetBackend); removeErr != nil {
+				log.WithError(removeErr).Error("Could not remove partially converted database")
+			}
+		}
+	}()
+
+	log.WithFields(logrus.Fields{
+		"source": sourceBackend,
+		"target": targetBackend,
+	}).Info("Converting database")
+	return copyBackend(ctx, src, dst)
+}
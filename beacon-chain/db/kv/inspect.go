@@ -0,0 +1,248 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// BucketStats holds the number of keys of a DB bucket and the total size of its key-values.
+type BucketStats struct {
+	Name string
+	Keys int
+	Size int
+}
+
+// IndexReport lists the inconsistencies between the DB indices and the data they index. Each
+// field holds the block roots of the affected entries.
+type IndexReport struct {
+	// BlocksNotInSlotIndex are blocks which are not indexed under their slot.
+	BlocksNotInSlotIndex [][32]byte
+	// DanglingBlockSlotIndices are block slot index entries without a block at that slot.
+	DanglingBlockSlotIndices [][32]byte
+	// StatesNotInSlotIndex are states and state diffs which are not indexed under their slot.
+	StatesNotInSlotIndex [][32]byte
+	// DanglingStateSlotIndices are state slot index entries without a state at that slot.
+	DanglingStateSlotIndices [][32]byte
+	// StatesWithoutSummary are states and state diffs without a state summary.
+	StatesWithoutSummary [][32]byte
+	// MismatchingSummaries are state summaries whose slot differs from the slot of the state.
+	MismatchingSummaries [][32]byte
+	// FinalizedChainBreaks are the finalized block roots index entries whose parent is stored but
+	// not linked to them in the index.
+	FinalizedChainBreaks [][32]byte
+}
+
+// Count returns the total number of inconsistencies in the report.
+func (r *IndexReport) Count() int {
+	return len(r.BlocksNotInSlotIndex) + len(r.DanglingBlockSlotIndices) + len(r.StatesNotInSlotIndex) +
+		len(r.DanglingStateSlotIndices) + len(r.StatesWithoutSummary) + len(r.MismatchingSummaries) +
+		len(r.FinalizedChainBreaks)
+}
+
+// BucketStats returns the number of keys and the size of the key-values of each DB bucket.
+func (s *Store) BucketStats(ctx context.Context) ([]*BucketStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+
+	var stats []*BucketStats
+	err := s.db.View(func(tx kvTx) error {
+		return tx.ForEach(func(name []byte, b kvBucket) error {
+			st := &BucketStats{Name: string(name)}
+			if err := b.ForEach(func(k, v []byte) error {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				st.Keys++
+				st.Size += len(k) + len(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, st)
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// CheckIndices verifies the block slot index against the stored blocks, the state slot index and
+// the state summaries against the stored states, and the continuity of the finalized block roots
+// index. The whole DB is read, so this is meant to be run on a stopped node.
+func (s *Store) CheckIndices(ctx context.Context) (*IndexReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CheckIndices")
+	defer span.End()
+
+	report := &IndexReport{}
+	err := s.db.View(func(tx kvTx) error {
+		blockSlots, err := blockSlotsByRoot(ctx, tx)
+		if err != nil {
+			return err
+		}
+		report.BlocksNotInSlotIndex, report.DanglingBlockSlotIndices = compareSlotIndex(
+			tx.Bucket(blockSlotIndicesBucket), blockSlots,
+		)
+
+		stateSlots, err := stateSlotsByRoot(ctx, tx)
+		if err != nil {
+			return err
+		}
+		report.StatesNotInSlotIndex, report.DanglingStateSlotIndices = compareSlotIndex(
+			tx.Bucket(stateSlotIndicesBucket), stateSlots,
+		)
+		summaries := tx.Bucket(stateSummaryBucket)
+		for root, slot := range stateSlots {
+			enc := summaries.Get(root[:])
+			if enc == nil {
+				report.StatesWithoutSummary = append(report.StatesWithoutSummary, root)
+				continue
+			}
+			summary := &pb.StateSummary{}
+			if err := decode(ctx, enc, summary); err != nil {
+				return err
+			}
+			if summary.Slot != slot {
+				report.MismatchingSummaries = append(report.MismatchingSummaries, root)
+			}
+		}
+
+		report.FinalizedChainBreaks, err = finalizedChainBreaks(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// blockSlotsByRoot returns the slot of each stored block.
+func blockSlotsByRoot(ctx context.Context, tx kvTx) (map[[32]byte]uint64, error) {
+	slots := make(map[[32]byte]uint64)
+	err := tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+		// The blocks bucket also holds the head, genesis, origin and backfill roots.
+		if len(k) != 32 {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		block := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, v, block); err != nil {
+			return errors.Wrapf(err, "could not decode block %#x", k)
+		}
+		if block.Block == nil {
+			return errors.Errorf("block %#x is empty", k)
+		}
+		slots[bytesutil.ToBytes32(k)] = block.Block.Slot
+		return nil
+	})
+	return slots, err
+}
+
+// stateSlotsByRoot returns the slot of each stored state and state diff.
+func stateSlotsByRoot(ctx context.Context, tx kvTx) (map[[32]byte]uint64, error) {
+	slots := make(map[[32]byte]uint64)
+	if err := tx.Bucket(stateBucket).ForEach(func(k, v []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		st, err := createState(ctx, v)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode state %#x", k)
+		}
+		slots[bytesutil.ToBytes32(k)] = st.Slot
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	err := tx.Bucket(stateDiffBucket).ForEach(func(k, v []byte) error {
+		diff := &dbpb.StateDiff{}
+		if err := decode(ctx, v, diff); err != nil {
+			return errors.Wrapf(err, "could not decode state diff %#x", k)
+		}
+		slots[bytesutil.ToBytes32(k)] = diff.Slot
+		return nil
+	})
+	return slots, err
+}
+
+// compareSlotIndex returns the roots which are missing from the slot index bucket, and the roots
+// of the slot index bucket which are stored under another slot than the one of the given roots.
+func compareSlotIndex(bkt kvBucket, slots map[[32]byte]uint64) (missing, dangling [][32]byte) {
+	indexed := make(map[[32]byte]bool)
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		slot := bytesutil.BytesToUint64BigEndian(k)
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := bytesutil.ToBytes32(v[i : i+32])
+			if stored, ok := slots[root]; !ok || stored != slot {
+				dangling = append(dangling, root)
+				continue
+			}
+			indexed[root] = true
+		}
+	}
+	for root := range slots {
+		if !indexed[root] {
+			missing = append(missing, root)
+		}
+	}
+	return missing, dangling
+}
+
+// finalizedChainBreaks walks the finalized block roots index from the finalized checkpoint down
+// to the genesis or origin block, and returns the roots whose parent link is broken. The walk
+// stops at the first block whose parent is not stored, which is expected on pruned DBs.
+func finalizedChainBreaks(ctx context.Context, tx kvTx) ([][32]byte, error) {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return nil, nil
+	}
+	checkpoint := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, checkpoint); err != nil {
+		return nil, err
+	}
+	blocks := tx.Bucket(blocksBucket)
+	genesisRoot := blocks.Get(genesisBlockRootKey)
+	originRoot := blocks.Get(originBlockRootKey)
+	bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+
+	var breaks [][32]byte
+	root := checkpoint.Root
+	for !bytes.Equal(root, genesisRoot) && !bytes.Equal(root, originRoot) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		enc := bkt.Get(root)
+		if enc == nil || bytes.Equal(enc, containerFinalizedButNotCanonical) {
+			return append(breaks, bytesutil.ToBytes32(root)), nil
+		}
+		container := &dbpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, enc, container); err != nil {
+			return nil, err
+		}
+		parent := container.ParentRoot
+		if bytes.Equal(parent, genesisRoot) || blocks.Get(parent) == nil {
+			return breaks, nil
+		}
+		parentEnc := bkt.Get(parent)
+		if parentEnc == nil || bytes.Equal(parentEnc, containerFinalizedButNotCanonical) {
+			return append(breaks, bytesutil.ToBytes32(root)), nil
+		}
+		parentContainer := &dbpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, parentEnc, parentContainer); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(parentContainer.ChildRoot, root) {
+			breaks = append(breaks, bytesutil.ToBytes32(root))
+		}
+		root = parent
+	}
+	return breaks, nil
+}
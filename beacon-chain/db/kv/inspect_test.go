@@ -0,0 +1,160 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// setupChain saves a chain of blocks from slot 0 to count-1 along with their state summaries,
+// the states of the given slots, and finalizes the last block.
+func setupChain(t *testing.T, db *Store, count int, stateSlots ...uint64) [][32]byte {
+	ctx := context.Background()
+	roots := make([][32]byte, count)
+	for i := range roots {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = uint64(i)
+		if i > 0 {
+			b.Block.ParentRoot = roots[i-1][:]
+		}
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+		require.NoError(t, db.SaveBlock(ctx, b))
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: uint64(i), Root: r[:]}))
+	}
+	require.NoError(t, db.saveCachedStateSummariesDB(ctx))
+	for _, slot := range stateSlots {
+		st := testutil.NewBeaconState()
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, roots[slot]))
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, roots[count-1]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[count-1][:]}))
+	return roots
+}
+
+func TestStore_BucketStats(t *testing.T) {
+	db := setupDB(t)
+	setupChain(t, db, 3)
+
+	stats, err := db.BucketStats(context.Background())
+	require.NoError(t, err)
+	found := false
+	for _, st := range stats {
+		if st.Name == string(blocksBucket) {
+			found = true
+			// The blocks bucket also holds the genesis and head roots.
+			assert.Equal(t, 5, st.Keys)
+			assert.Equal(t, true, st.Size > 0)
+		}
+	}
+	assert.Equal(t, true, found, "Blocks bucket not found")
+}
+
+func TestStore_CheckIndices_Consistent(t *testing.T) {
+	db := setupDB(t)
+	setupChain(t, db, 10, 3, 8)
+
+	report, err := db.CheckIndices(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count())
+}
+
+func TestStore_RepairIndices(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	roots := setupChain(t, db, 10, 3, 8)
+
+	danglingRoot := [32]byte{'a'}
+	require.NoError(t, db.db.Update(func(tx kvTx) error {
+		if err := deleteValueForIndices(ctx, createBlockIndicesFromBlock(ctx, &ethpb.BeaconBlock{Slot: 2}), roots[2][:], tx); err != nil {
+			return err
+		}
+		if err := updateValueForIndices(ctx, createBlockIndicesFromBlock(ctx, &ethpb.BeaconBlock{Slot: 7}), danglingRoot[:], tx); err != nil {
+			return err
+		}
+		if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, 8), roots[8][:], tx); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateSummaryBucket).Delete(roots[3][:]); err != nil {
+			return err
+		}
+		return tx.Bucket(finalizedBlockRootsIndexBucket).Delete(roots[5][:])
+	}))
+
+	report, err := db.CheckIndices(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{roots[2]}, report.BlocksNotInSlotIndex)
+	assert.DeepEqual(t, [][32]byte{danglingRoot}, report.DanglingBlockSlotIndices)
+	assert.DeepEqual(t, [][32]byte{roots[8]}, report.StatesNotInSlotIndex)
+	assert.DeepEqual(t, [][32]byte{roots[3]}, report.StatesWithoutSummary)
+	assert.DeepEqual(t, [][32]byte{roots[6]}, report.FinalizedChainBreaks)
+
+	require.NoError(t, db.RepairIndices(ctx))
+	report, err = db.CheckIndices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count())
+
+	hasBlocks, _, err := db.BlocksBySlot(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, hasBlocks, "Block slot index was not rebuilt")
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[5]), "Finalized index was not rebuilt")
+	child, err := db.FinalizedChildBlock(ctx, roots[5])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), child.Block.Slot)
+}
+
+func TestStore_RollbackHead(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	roots := setupChain(t, db, 10, 3, 8)
+
+	require.NoError(t, db.RollbackHead(ctx, roots[5]))
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), head.Block.Slot)
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[5], bytesutil.ToBytes32(finalized.Root))
+	assert.Equal(t, uint64(1), finalized.Epoch, "Checkpoint epoch should start after the block")
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+
+	for i := 0; i <= 5; i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "Block %d should be kept", i)
+	}
+	for i := 6; i < len(roots); i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]), "Block %d should be deleted", i)
+		assert.Equal(t, false, db.HasStateSummary(ctx, roots[i]), "State summary %d should be deleted", i)
+	}
+	assert.Equal(t, true, db.HasState(ctx, roots[3]))
+	assert.Equal(t, false, db.HasState(ctx, roots[8]))
+
+	report, err := db.CheckIndices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count())
+}
+
+func TestStore_RollbackHead_NotFinalized(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	setupChain(t, db, 3)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 100
+	require.NoError(t, db.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "is not finalized", db.RollbackHead(ctx, r))
+	assert.ErrorContains(t, "not found in db", db.RollbackHead(ctx, [32]byte{'a'}))
+}
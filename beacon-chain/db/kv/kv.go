@@ -1,12 +1,10 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, backed by bolt-db or badger.
 package kv
 
 import (
 	"context"
 	"os"
-	"path"
-	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/pkg/errors"
@@ -14,7 +12,7 @@ import (
 	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -27,10 +25,8 @@ const (
 	NumOfVotes = 1 << 20
 	// BeaconNodeDbDirName is the name of the directory containing the beacon node database.
 	BeaconNodeDbDirName = "beaconchaindata"
-	// DatabaseFileName is the name of the beacon node database.
+	// DatabaseFileName is the name of the beacon node database file when using the bolt backend.
 	DatabaseFileName = "beaconchain.db"
-
-	boltAllocSize = 8 * 1024 * 1024
 )
 
 // BlockCacheSize specifies 1000 slots worth of blocks cached, which
@@ -48,39 +44,67 @@ var blockedBuckets = [][]byte{
 	finalizedBlockRootsIndexBucket,
 }
 
+// Config for the kv store.
+type Config struct {
+	// Backend is the name of the storage backend of the store, bolt being used when empty.
+	Backend string
+	// ReadOnly opens an existing database without writing to it, for inspection. The
+	// buckets are not created, and writes to the store fail.
+	ReadOnly bool
+	// StateDiffSnapshotInterval is the number of archived states between two full state
+	// snapshots, the archived states in between being stored as diffs. Archived states are
+	// all stored in full when it is 0.
+	StateDiffSnapshotInterval uint64
+}
+
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for eth2.
+// using an embedded key-value store, BoltDB by default, as the
+// underlying persistent kv-store for eth2.
 type Store struct {
-	db                  *bolt.DB
-	databasePath        string
-	blockCache          *ristretto.Cache
-	validatorIndexCache *ristretto.Cache
-	stateSummaryCache   *stateSummaryCache
-	ctx                 context.Context
+	db                        kvBackend
+	backend                   string
+	readOnly                  bool
+	collector                 prometheus.Collector
+	databasePath              string
+	blockCache                *ristretto.Cache
+	validatorIndexCache       *ristretto.Cache
+	stateSummaryCache         *stateSummaryCache
+	stateDiffSnapshotInterval uint64
+	ctx                       context.Context
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
-func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
+func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	backend := config.Backend
+	if backend == "" {
+		backend = BoltBackend
+	}
 	hasDir, err := fileutil.HasDir(dirPath)
 	if err != nil {
 		return nil, err
 	}
-	if !hasDir {
+	if !hasDir && !config.ReadOnly {
 		if err := fileutil.MkdirAll(dirPath); err != nil {
 			return nil, err
 		}
 	}
-	datafile := path.Join(dirPath, DatabaseFileName)
-	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
-	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+	for _, other := range []string{BoltBackend, BadgerBackend} {
+		if other != backend && backendExists(dirPath, other) && !backendExists(dirPath, backend) {
+			log.WithFields(logrus.Fields{
+				"backend":         backend,
+				"existingBackend": other,
+			}).Warn("Found a database of another storage backend, which can be converted with the db convert command")
 		}
+	}
+	db, err := openBackend(dirPath, backend, config.ReadOnly)
+	if err != nil {
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -100,20 +124,29 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 	}
 
 	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
-		stateSummaryCache:   newStateSummaryCache(),
-		ctx:                 ctx,
+		db:                        db,
+		backend:                   backend,
+		readOnly:                  config.ReadOnly,
+		databasePath:              dirPath,
+		blockCache:                blockCache,
+		validatorIndexCache:       validatorCache,
+		stateSummaryCache:         newStateSummaryCache(),
+		stateDiffSnapshotInterval: config.StateDiffSnapshotInterval,
+		ctx:                       ctx,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	// A read-only store is used by the db commands, which need neither the buckets of a
+	// newer schema nor the database metrics.
+	if config.ReadOnly {
+		return kv, nil
+	}
+	if err := kv.db.Update(func(tx kvTx) error {
 		return createBuckets(
 			tx,
 			attestationsBucket,
 			blocksBucket,
 			stateBucket,
+			stateDiffBucket,
 			proposerSlashingsBucket,
 			attesterSlashingsBucket,
 			voluntaryExitsBucket,
@@ -121,6 +154,9 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			checkpointBucket,
 			powchainBucket,
 			stateSummaryBucket,
+			validatorPerformanceBucket,
+			validatorParticipationBucket,
+			attestationRecordsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -131,6 +167,7 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			stateSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			stateDiffBaseRootIndicesBucket,
 			// New State Management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
@@ -140,7 +177,10 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 		return nil, err
 	}
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	if boltDB, ok := db.(*boltBackend); ok {
+		kv.collector = createBoltCollector(boltDB.db)
+		err = prometheus.Register(kv.collector)
+	}
 
 	return kv, err
 }
@@ -150,20 +190,26 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	if s.collector != nil {
+		prometheus.Unregister(s.collector)
+	}
+	if err := removeBackend(s.databasePath, s.backend); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	if s.collector != nil {
+		prometheus.Unregister(s.collector)
+	}
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
-	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
-		return err
+	if !s.readOnly {
+		if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
+			return err
+		}
 	}
 
 	return s.db.Close()
@@ -174,7 +220,7 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx kvTx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -2,17 +2,65 @@ package kv
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
+// testBackend is the storage backend of the stores instantiated by setupDB.
+var testBackend = BoltBackend
+
+// TestMain runs the tests of the package against each storage backend.
+func TestMain(m *testing.M) {
+	for _, backend := range []string{BoltBackend, BadgerBackend} {
+		testBackend = backend
+		if code := m.Run(); code != 0 {
+			os.Exit(code)
+		}
+	}
+	os.Exit(0)
+}
+
 // setupDB instantiates and returns a Store instance.
 func setupDB(t testing.TB) *Store {
-	db, err := NewKVStore(context.Background(), t.TempDir())
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{Backend: testBackend})
 	require.NoError(t, err, "Failed to instantiate DB")
 	t.Cleanup(func() {
 		require.NoError(t, db.Close(), "Failed to close database")
 	})
 	return db
 }
+
+func TestNewKVStore_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{Backend: testBackend})
+	require.NoError(t, err)
+	put := func(value []byte) error {
+		return db.db.Update(func(tx kvTx) error {
+			return tx.Bucket(chainMetadataBucket).Put([]byte("key"), value)
+		})
+	}
+	require.NoError(t, put([]byte("value")))
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, &Config{Backend: testBackend, ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	require.NoError(t, db.db.View(func(tx kvTx) error {
+		require.DeepEqual(t, []byte("value"), tx.Bucket(chainMetadataBucket).Get([]byte("key")))
+		return nil
+	}))
+	require.NotNil(t, put([]byte("other")), "Wrote to a read-only store")
+}
+
+func TestNewKVStore_ReadOnlyMissingDatabase(t *testing.T) {
+	dir := t.TempDir() + "/missing"
+	_, err := NewKVStore(context.Background(), dir, &Config{Backend: testBackend, ReadOnly: true})
+	require.NotNil(t, err)
+	_, err = os.Stat(dir)
+	require.Equal(t, true, os.IsNotExist(err), "Created the directory of a read-only store")
+}
@@ -1,14 +1,19 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(*bolt.Tx) error
+// migrationBatchSize is the number of entries migrated in a single write transaction, so
+// that migrating a large bucket does not exceed the transaction size limit of badger.
+const migrationBatchSize = 1024
+
+type migration func(context.Context, kvBackend) error
 
 var migrations = []migration{
 	migrateArchivedIndex,
@@ -22,9 +27,73 @@ func (s *Store) RunMigrations(ctx context.Context) error {
 			return ctx.Err()
 		}
 
-		if err := s.db.Update(m); err != nil {
+		if err := m(ctx, s.db); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// isMigrationCompleted returns true if the migration of the key was completed.
+func isMigrationCompleted(db kvBackend, key []byte) (bool, error) {
+	completed := false
+	err := db.View(func(tx kvTx) error {
+		completed = bytes.Equal(tx.Bucket(migrationsBucket).Get(key), migrationCompleted)
+		return nil
+	})
+	return completed, err
+}
+
+// markMigrationCompleted records the migration of the key as completed.
+func markMigrationCompleted(db kvBackend, key []byte) error {
+	return db.Update(func(tx kvTx) error {
+		return tx.Bucket(migrationsBucket).Put(key, migrationCompleted)
+	})
+}
+
+// migrateInBatches calls the function for each key-value of the bucket from the start key,
+// using one write transaction per batch of key-values. The key-values are read before the
+// function is called, so the function can modify the bucket.
+func migrateInBatches(ctx context.Context, db kvBackend, bucket, start []byte, fn func(tx kvTx, k, v []byte) error) error {
+	var last []byte
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var keys, values [][]byte
+		if err := db.View(func(tx kvTx) error {
+			bkt := tx.Bucket(bucket)
+			if bkt == nil {
+				return nil
+			}
+			c := bkt.Cursor()
+			var k, v []byte
+			if last == nil {
+				k, v = c.Seek(start)
+			} else if k, v = c.Seek(last); k != nil && bytes.Equal(k, last) {
+				k, v = c.Next()
+			}
+			for ; k != nil && len(keys) < migrationBatchSize; k, v = c.Next() {
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+				values = append(values, bytesutil.SafeCopyBytes(v))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := db.Update(func(tx kvTx) error {
+			for i, k := range keys {
+				if err := fn(tx, k, values[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		last = keys[len(keys)-1]
+	}
+}
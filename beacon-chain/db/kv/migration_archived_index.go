@@ -6,56 +6,42 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationArchivedIndex0Key = []byte("archive_index_0")
 
-func migrateArchivedIndex(tx *bolt.Tx) error {
-	mb := tx.Bucket(migrationsBucket)
-	if b := mb.Get(migrationArchivedIndex0Key); bytes.Equal(b, migrationCompleted) {
-		return nil // Migration already completed.
+func migrateArchivedIndex(ctx context.Context, db kvBackend) error {
+	if completed, err := isMigrationCompleted(db, migrationArchivedIndex0Key); err != nil || completed {
+		return err // Migration already completed.
 	}
 
-	bkt := tx.Bucket(archivedRootBucket)
-	if bkt == nil {
-		return nil
-	}
-	// Remove "last archived index" key before iterating over all keys.
-	if err := bkt.Delete(lastArchivedIndexKey); err != nil {
-		return err
-	}
-
-	var highest uint64
-	c := bkt.Cursor()
-	for k, v := c.First(); k != nil; k, v = c.Next() {
+	if err := migrateInBatches(ctx, db, archivedRootBucket, nil, func(tx kvTx, k, v []byte) error {
+		// Skip the "last archived index" key, which is deleted with the bucket.
+		if bytes.Equal(k, lastArchivedIndexKey) {
+			return nil
+		}
 		// Look up actual slot from block
 		b := tx.Bucket(blocksBucket).Get(v)
 		// Skip this key if there is no block for whatever reason.
 		if b == nil {
-			continue
+			return nil
 		}
 		blk := &ethpb.SignedBeaconBlock{}
-		if err := decode(context.TODO(), b, blk); err != nil {
+		if err := decode(ctx, b, blk); err != nil {
 			return err
 		}
-		if err := tx.Bucket(stateSlotIndicesBucket).Put(bytesutil.Uint64ToBytesBigEndian(blk.Block.Slot), v); err != nil {
-			return err
-		}
-		if blk.Block.Slot > highest {
-			highest = blk.Block.Slot
-		}
+		return tx.Bucket(stateSlotIndicesBucket).Put(bytesutil.Uint64ToBytesBigEndian(blk.Block.Slot), v)
+	}); err != nil {
+		return err
 	}
 
 	// Delete deprecated buckets.
 	for _, bkt := range [][]byte{slotsHasObjectBucket, archivedRootBucket} {
-		if tx.Bucket(bkt) != nil {
-			if err := tx.DeleteBucket(bkt); err != nil {
-				return err
-			}
+		if err := deleteBucket(ctx, db, bkt); err != nil {
+			return err
 		}
 	}
 
 	// Mark migration complete.
-	return mb.Put(migrationArchivedIndex0Key, migrationCompleted)
+	return markMigrationCompleted(db, migrationArchivedIndex0Key)
 }
@@ -7,19 +7,18 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
-	"go.etcd.io/bbolt"
 )
 
 func Test_migrateArchivedIndex(t *testing.T) {
 	tests := []struct {
 		name  string
-		setup func(t *testing.T, db *bbolt.DB)
-		eval  func(t *testing.T, db *bbolt.DB)
+		setup func(t *testing.T, db kvBackend)
+		eval  func(t *testing.T, db kvBackend)
 	}{
 		{
 			name: "only runs once",
-			setup: func(t *testing.T, db *bbolt.DB) {
-				err := db.Update(func(tx *bbolt.Tx) error {
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
 					_, err := tx.CreateBucketIfNotExists(archivedRootBucket)
 					assert.NoError(t, err)
 					if err := tx.Bucket(archivedRootBucket).Put(bytesutil.Uint64ToBytesLittleEndian(2048), []byte("foo")); err != nil {
@@ -29,8 +28,8 @@ func Test_migrateArchivedIndex(t *testing.T) {
 				})
 				assert.NoError(t, err)
 			},
-			eval: func(t *testing.T, db *bbolt.DB) {
-				err := db.View(func(tx *bbolt.Tx) error {
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
 					v := tx.Bucket(archivedRootBucket).Get(bytesutil.Uint64ToBytesLittleEndian(2048))
 					assert.DeepEqual(t, []byte("foo"), v, "Did not receive correct data for key 2048")
 					return nil
@@ -40,8 +39,8 @@ func Test_migrateArchivedIndex(t *testing.T) {
 		},
 		{
 			name: "migrates and deletes entries",
-			setup: func(t *testing.T, db *bbolt.DB) {
-				err := db.Update(func(tx *bbolt.Tx) error {
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
 					_, err := tx.CreateBucketIfNotExists(archivedRootBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(slotsHasObjectBucket)
@@ -59,8 +58,8 @@ func Test_migrateArchivedIndex(t *testing.T) {
 				})
 				assert.NoError(t, err)
 			},
-			eval: func(t *testing.T, db *bbolt.DB) {
-				err := db.View(func(tx *bbolt.Tx) error {
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
 					k := uint64(2048)
 					v := tx.Bucket(stateSlotIndicesBucket).Get(bytesutil.Uint64ToBytesBigEndian(k))
 					assert.DeepEqual(t, []byte("foo"), v, "Did not receive correct data for key %d", k)
@@ -71,8 +70,8 @@ func Test_migrateArchivedIndex(t *testing.T) {
 		},
 		{
 			name: "deletes old buckets",
-			setup: func(t *testing.T, db *bbolt.DB) {
-				err := db.Update(func(tx *bbolt.Tx) error {
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
 					_, err := tx.CreateBucketIfNotExists(archivedRootBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(slotsHasObjectBucket)
@@ -81,10 +80,10 @@ func Test_migrateArchivedIndex(t *testing.T) {
 				})
 				assert.NoError(t, err)
 			},
-			eval: func(t *testing.T, db *bbolt.DB) {
-				err := db.View(func(tx *bbolt.Tx) error {
-					assert.Equal(t, (*bbolt.Bucket)(nil), tx.Bucket(slotsHasObjectBucket), "Expected %v to be deleted", savedStateSlotsKey)
-					assert.Equal(t, (*bbolt.Bucket)(nil), tx.Bucket(archivedRootBucket), "Expected %v to be deleted", savedStateSlotsKey)
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
+					assert.Equal(t, true, tx.Bucket(slotsHasObjectBucket) == nil, "Expected %v to be deleted", savedStateSlotsKey)
+					assert.Equal(t, true, tx.Bucket(archivedRootBucket) == nil, "Expected %v to be deleted", savedStateSlotsKey)
 					return nil
 				})
 				assert.NoError(t, err)
@@ -95,7 +94,7 @@ func Test_migrateArchivedIndex(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			db := setupDB(t).db
 			tt.setup(t, db)
-			assert.NoError(t, db.Update(migrateArchivedIndex), "migrateArchivedIndex(tx) error")
+			assert.NoError(t, migrateArchivedIndex(context.Background(), db), "migrateArchivedIndex error")
 			tt.eval(t, db)
 		})
 	}
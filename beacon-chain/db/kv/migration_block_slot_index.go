@@ -1,29 +1,30 @@
 package kv
 
 import (
-	"bytes"
+	"context"
 	"strconv"
 
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationBlockSlotIndex0Key = []byte("block_slot_index_0")
 
-func migrateBlockSlotIndex(tx *bolt.Tx) error {
-	mb := tx.Bucket(migrationsBucket)
-	if b := mb.Get(migrationBlockSlotIndex0Key); bytes.Equal(b, migrationCompleted) {
-		return nil // Migration already completed.
-	}
+// blockSlotIndexStringStart is the smallest string index. Big endian indices of slots below
+// 0x30 << 56 sort before it, so an interrupted migration resumes with the string indices.
+var blockSlotIndexStringStart = []byte("0")
 
-	bkt := tx.Bucket(blockSlotIndicesBucket)
+func migrateBlockSlotIndex(ctx context.Context, db kvBackend) error {
+	if completed, err := isMigrationCompleted(db, migrationBlockSlotIndex0Key); err != nil || completed {
+		return err // Migration already completed.
+	}
 
 	// Convert indices from strings to big endian integers.
-	if err := bkt.ForEach(func(k, v []byte) error {
+	if err := migrateInBatches(ctx, db, blockSlotIndicesBucket, blockSlotIndexStringStart, func(tx kvTx, k, v []byte) error {
 		key, err := strconv.ParseUint(string(k), 10, 64)
 		if err != nil {
 			return err
 		}
+		bkt := tx.Bucket(blockSlotIndicesBucket)
 		if err := bkt.Delete(k); err != nil {
 			return err
 		}
@@ -32,5 +33,5 @@ func migrateBlockSlotIndex(tx *bolt.Tx) error {
 		return err
 	}
 
-	return mb.Put(migrationBlockSlotIndex0Key, migrationCompleted)
+	return markMigrationCompleted(db, migrationBlockSlotIndex0Key)
 }
@@ -1,23 +1,24 @@
 package kv
 
 import (
+	"context"
+	"strconv"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
-	"go.etcd.io/bbolt"
 )
 
 func Test_migrateBlockSlotIndex(t *testing.T) {
 	tests := []struct {
 		name  string
-		setup func(t *testing.T, db *bbolt.DB)
-		eval  func(t *testing.T, db *bbolt.DB)
+		setup func(t *testing.T, db kvBackend)
+		eval  func(t *testing.T, db kvBackend)
 	}{
 		{
 			name: "only runs once",
-			setup: func(t *testing.T, db *bbolt.DB) {
-				err := db.Update(func(tx *bbolt.Tx) error {
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
 					if err := tx.Bucket(blockSlotIndicesBucket).Put([]byte("2048"), []byte("foo")); err != nil {
 						return err
 					}
@@ -25,8 +26,8 @@ func Test_migrateBlockSlotIndex(t *testing.T) {
 				})
 				assert.NoError(t, err)
 			},
-			eval: func(t *testing.T, db *bbolt.DB) {
-				err := db.View(func(tx *bbolt.Tx) error {
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
 					v := tx.Bucket(blockSlotIndicesBucket).Get([]byte("2048"))
 					assert.DeepEqual(t, []byte("foo"), v, "Did not receive correct data for key 2048")
 					return nil
@@ -36,14 +37,14 @@ func Test_migrateBlockSlotIndex(t *testing.T) {
 		},
 		{
 			name: "migrates and deletes entries",
-			setup: func(t *testing.T, db *bbolt.DB) {
-				err := db.Update(func(tx *bbolt.Tx) error {
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
 					return tx.Bucket(blockSlotIndicesBucket).Put([]byte("2048"), []byte("foo"))
 				})
 				assert.NoError(t, err)
 			},
-			eval: func(t *testing.T, db *bbolt.DB) {
-				err := db.View(func(tx *bbolt.Tx) error {
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
 					k := uint64(2048)
 					v := tx.Bucket(blockSlotIndicesBucket).Get(bytesutil.Uint64ToBytesBigEndian(k))
 					assert.DeepEqual(t, []byte("foo"), v, "Did not receive correct data for key %d", k)
@@ -52,12 +53,39 @@ func Test_migrateBlockSlotIndex(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "migrates in batches",
+			setup: func(t *testing.T, db kvBackend) {
+				err := db.Update(func(tx kvTx) error {
+					for i := 0; i <= migrationBatchSize; i++ {
+						if err := tx.Bucket(blockSlotIndicesBucket).Put([]byte(strconv.Itoa(i)), []byte("foo")); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				assert.NoError(t, err)
+			},
+			eval: func(t *testing.T, db kvBackend) {
+				err := db.View(func(tx kvTx) error {
+					count := 0
+					assert.NoError(t, tx.Bucket(blockSlotIndicesBucket).ForEach(func(k, v []byte) error {
+						assert.Equal(t, uint64(count), bytesutil.BytesToUint64BigEndian(k))
+						count++
+						return nil
+					}))
+					assert.Equal(t, migrationBatchSize+1, count)
+					return nil
+				})
+				assert.NoError(t, err)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			db := setupDB(t).db
 			tt.setup(t, db)
-			assert.NoError(t, db.Update(migrateBlockSlotIndex), "migrateBlockSlotIndex(tx) error")
+			assert.NoError(t, migrateBlockSlotIndex(context.Background(), db), "migrateBlockSlotIndex error")
 			tt.eval(t, db)
 		})
 	}
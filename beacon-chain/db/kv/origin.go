@@ -0,0 +1,119 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// OriginBlockRoot returns the block root of the block the node started syncing from. This is
+// the genesis block root for nodes which synced from genesis, or the checkpoint block root for
+// nodes which were initialized from a finalized checkpoint. A zero hash is returned if the origin
+// has not been set.
+func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginBlockRoot")
+	defer span.End()
+	var root []byte
+	err := s.db.View(func(tx kvTx) error {
+		bkt := tx.Bucket(blocksBucket)
+		root = bkt.Get(originBlockRootKey)
+		return nil
+	})
+	return bytesutil.ToBytes32(root), err
+}
+
+// SaveOriginBlockRoot to the db.
+func (s *Store) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx kvTx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Put(originBlockRootKey, blockRoot[:])
+	})
+}
+
+// BackfillBlockRoot returns the root of the lowest block which has been backfilled below the
+// origin block. A zero hash is returned if backfilling has not stored any block yet.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+	var root []byte
+	err := s.db.View(func(tx kvTx) error {
+		bkt := tx.Bucket(blocksBucket)
+		root = bkt.Get(backfillBlockRootKey)
+		return nil
+	})
+	return bytesutil.ToBytes32(root), err
+}
+
+// SaveBackfillBlockRoot to the db.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx kvTx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
+// SaveOrigin seeds an empty database with a finalized beacon state and the block which
+// produced it, so a node can start syncing from that checkpoint instead of genesis. The
+// block is recorded as the origin, head, justified and finalized block of the chain.
+func (s *Store) SaveOrigin(ctx context.Context, st *state.BeaconState, signed *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	if st == nil || signed == nil || signed.Block == nil {
+		return errors.New("nil origin state or block")
+	}
+	if st.Slot() != signed.Block.Slot {
+		return errors.Errorf("origin state slot %d does not match block slot %d", st.Slot(), signed.Block.Slot)
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin state")
+	}
+	if stateRoot != bytesutil.ToBytes32(signed.Block.StateRoot) {
+		return errors.Errorf("origin state root %#x does not match block state root %#x", stateRoot, signed.Block.StateRoot)
+	}
+	blockRoot, err := signed.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin block")
+	}
+
+	if err := s.SaveBlock(ctx, signed); err != nil {
+		return errors.Wrap(err, "could not save origin block")
+	}
+	if err := s.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: signed.Block.Slot,
+		Root: blockRoot[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save origin state summary")
+	}
+	if err := s.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin state")
+	}
+	if err := s.SaveOriginBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	checkpoint := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(st.Slot()),
+		Root:  blockRoot[:],
+	}
+	if err := s.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	return nil
+}
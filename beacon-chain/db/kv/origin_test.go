@@ -0,0 +1,95 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_OriginBlockRoot_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	root, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().ZeroHash, root)
+
+	want := bytesutil.ToBytes32([]byte{'A'})
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, want))
+	root, err = db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func TestStore_BackfillBlockRoot_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().ZeroHash, root)
+
+	want := bytesutil.ToBytes32([]byte{'B'})
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, want))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*10))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = st.Slot()
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'P'}, 32)
+	blk.Block.StateRoot = stateRoot[:]
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, db.SaveOrigin(ctx, st, blk))
+
+	origin, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blkRoot, origin)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blkRoot, headRoot)
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), finalized.Epoch)
+	assert.DeepEqual(t, blkRoot[:], finalized.Root)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+	assert.Equal(t, true, db.HasState(ctx, blkRoot))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blkRoot))
+}
+
+func TestStore_SaveOrigin_MismatchedStateRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = st.Slot()
+	blk.Block.StateRoot = bytesutil.PadTo([]byte{'S'}, 32)
+
+	err := db.SaveOrigin(ctx, st, blk)
+	assert.ErrorContains(t, "does not match block state root", err)
+	origin, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().ZeroHash, origin)
+}
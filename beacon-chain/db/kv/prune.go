@@ -0,0 +1,284 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the number of entries deleted in a single write transaction, so that pruning
+// does not hold the database write lock long enough to stall block processing.
+const pruneBatchSize = 256
+
+// indexedRoot is a root stored in a slot or epoch index bucket, along with the index key.
+type indexedRoot struct {
+	index uint64
+	root  [32]byte
+}
+
+// PruneBlocks deletes the blocks with a slot lower than the given slot, along with their DB
+// indices and their state summaries. The genesis, origin, backfill, head and checkpoint blocks
+// are never pruned. It returns the number of deleted blocks.
+func (s *Store) PruneBlocks(ctx context.Context, beforeSlot uint64) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBlocks")
+	defer span.End()
+
+	entries, err := s.prunableEntries(ctx, blockSlotIndicesBucket, 0, beforeSlot)
+	if err != nil {
+		return 0, err
+	}
+	return s.pruneInBatches(ctx, entries, func(tx kvTx, e indexedRoot) (bool, error) {
+		return s.pruneBlock(ctx, tx, e.root)
+	})
+}
+
+// PruneStates deletes the states and state diffs with a slot lower than the given slot, along with
+// their DB indices. States which are the base of a remaining state diff are kept, so that the
+// diff can still be applied. The genesis, origin, backfill, head and checkpoint states are never
+// pruned. It returns the number of deleted states.
+func (s *Store) PruneStates(ctx context.Context, beforeSlot uint64) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneStates")
+	defer span.End()
+
+	entries, err := s.prunableEntries(ctx, stateSlotIndicesBucket, 0, beforeSlot)
+	if err != nil {
+		return 0, err
+	}
+	return s.pruneStateEntries(ctx, entries)
+}
+
+// pruneStateEntries deletes the states of the entries, sorted in ascending slot order. Diffs are
+// always saved after their base state, going from the highest slot down deletes the children of
+// a base state before the base state itself is considered.
+func (s *Store) pruneStateEntries(ctx context.Context, entries []indexedRoot) (int, error) {
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return s.pruneInBatches(ctx, entries, func(tx kvTx, e indexedRoot) (bool, error) {
+		return s.pruneState(ctx, tx, e.index, e.root)
+	})
+}
+
+// PruneAttestations deletes the attestations with a target epoch lower than the given epoch,
+// along with their DB indices. It returns the number of deleted attestations.
+func (s *Store) PruneAttestations(ctx context.Context, beforeEpoch uint64) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneAttestations")
+	defer span.End()
+
+	var entries []indexedRoot
+	err := s.db.View(func(tx kvTx) error {
+		entries = indexedEntries(tx, attestationTargetEpochIndicesBucket, 0, beforeEpoch, nil)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return s.pruneInBatches(ctx, entries, func(tx kvTx, e indexedRoot) (bool, error) {
+		return pruneAttestation(ctx, tx, e.root)
+	})
+}
+
+// prunableEntries returns the roots of the slot index bucket stored under a slot in the range
+// [startSlot, endSlot), in ascending slot order, leaving out the roots which must never be pruned.
+func (s *Store) prunableEntries(ctx context.Context, indexBucket []byte, startSlot, endSlot uint64) ([]indexedRoot, error) {
+	var entries []indexedRoot
+	err := s.db.View(func(tx kvTx) error {
+		protected, err := protectedRoots(ctx, tx)
+		if err != nil {
+			return err
+		}
+		entries = indexedEntries(tx, indexBucket, startSlot, endSlot, protected)
+		return nil
+	})
+	return entries, err
+}
+
+// indexedEntries returns the roots of the index bucket stored under an index in the range
+// [start, end), in ascending index order, leaving out the excluded roots.
+func indexedEntries(tx kvTx, indexBucket []byte, start, end uint64, excluded map[[32]byte]bool) []indexedRoot {
+	var entries []indexedRoot
+	c := tx.Bucket(indexBucket).Cursor()
+	for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(start)); k != nil; k, v = c.Next() {
+		index := bytesutil.BytesToUint64BigEndian(k)
+		if index >= end {
+			break
+		}
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := bytesutil.ToBytes32(v[i : i+32])
+			if excluded[root] {
+				continue
+			}
+			entries = append(entries, indexedRoot{index: index, root: root})
+		}
+	}
+	return entries
+}
+
+// pruneInBatches calls the prune function for each entry, using one write transaction per batch
+// of entries. It returns the number of entries for which the prune function deleted data.
+func (s *Store) pruneInBatches(
+	ctx context.Context, entries []indexedRoot, prune func(tx kvTx, e indexedRoot) (bool, error),
+) (int, error) {
+	pruned := 0
+	for len(entries) > 0 {
+		if ctx.Err() != nil {
+			return pruned, ctx.Err()
+		}
+		batch := entries
+		if len(batch) > pruneBatchSize {
+			batch = batch[:pruneBatchSize]
+		}
+		entries = entries[len(batch):]
+
+		count := 0
+		if err := s.db.Update(func(tx kvTx) error {
+			count = 0
+			for _, e := range batch {
+				deleted, err := prune(tx, e)
+				if err != nil {
+					return err
+				}
+				if deleted {
+					count++
+				}
+			}
+			return nil
+		}); err != nil {
+			return pruned, err
+		}
+		pruned += count
+	}
+	return pruned, nil
+}
+
+// pruneBlock deletes the block of the root and its indices. The state summary is deleted as well,
+// unless the state of the block is still stored.
+func (s *Store) pruneBlock(ctx context.Context, tx kvTx, blockRoot [32]byte) (bool, error) {
+	bkt := tx.Bucket(blocksBucket)
+	enc := bkt.Get(blockRoot[:])
+	if enc == nil {
+		return false, nil
+	}
+	block := &ethpb.SignedBeaconBlock{}
+	if err := decode(ctx, enc, block); err != nil {
+		return false, err
+	}
+	indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return false, errors.Wrap(err, "could not delete root for DB indices")
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(blockRoot[:]); err != nil {
+		return false, err
+	}
+	s.blockCache.Del(string(blockRoot[:]))
+	if err := bkt.Delete(blockRoot[:]); err != nil {
+		return false, err
+	}
+	if tx.Bucket(stateBucket).Get(blockRoot[:]) == nil && tx.Bucket(stateDiffBucket).Get(blockRoot[:]) == nil {
+		if err := s.deleteStateSummary(tx, blockRoot); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// pruneState deletes the state or state diff of the root and its indices, unless it is the base
+// of a state diff. The state summary is deleted as well, unless the block is still stored.
+func (s *Store) pruneState(ctx context.Context, tx kvTx, slot uint64, blockRoot [32]byte) (bool, error) {
+	if tx.Bucket(stateDiffBaseRootIndicesBucket).Get(blockRoot[:]) != nil {
+		return false, nil
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return false, errors.Wrap(err, "could not delete root for DB indices")
+	}
+	if err := deleteStateDiff(ctx, tx, blockRoot[:]); err != nil {
+		return false, err
+	}
+	if err := tx.Bucket(stateBucket).Delete(blockRoot[:]); err != nil {
+		return false, err
+	}
+	if tx.Bucket(blocksBucket).Get(blockRoot[:]) == nil {
+		if err := s.deleteStateSummary(tx, blockRoot); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// pruneAttestation deletes the attestation of the root and its indices.
+func pruneAttestation(ctx context.Context, tx kvTx, attRoot [32]byte) (bool, error) {
+	bkt := tx.Bucket(attestationsBucket)
+	enc := bkt.Get(attRoot[:])
+	if enc == nil {
+		return false, nil
+	}
+	att := &ethpb.Attestation{}
+	if err := decode(ctx, enc, att); err != nil {
+		log.WithError(err).WithField("root", fmt.Sprintf("%#x", attRoot)).Warn("Could not decode attestation, not pruning it")
+		return false, nil
+	}
+	indicesByBucket := createAttestationIndicesFromData(att.Data)
+	if err := deleteValueForIndices(ctx, indicesByBucket, attRoot[:], tx); err != nil {
+		return false, errors.Wrap(err, "could not delete root for DB indices")
+	}
+	return true, bkt.Delete(attRoot[:])
+}
+
+func (s *Store) deleteStateSummary(tx kvTx, blockRoot [32]byte) error {
+	s.stateSummaryCache.delete(blockRoot)
+	return tx.Bucket(stateSummaryBucket).Delete(blockRoot[:])
+}
+
+// protectedRoots returns the block roots whose blocks and states must never be pruned.
+func protectedRoots(ctx context.Context, tx kvTx) (map[[32]byte]bool, error) {
+	protected := make(map[[32]byte]bool)
+	bkt := tx.Bucket(blocksBucket)
+	for _, key := range [][]byte{genesisBlockRootKey, originBlockRootKey, backfillBlockRootKey, headBlockRootKey} {
+		if root := bkt.Get(key); root != nil {
+			protected[bytesutil.ToBytes32(root)] = true
+		}
+	}
+	bkt = tx.Bucket(checkpointBucket)
+	for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+		enc := bkt.Get(key)
+		if enc == nil {
+			continue
+		}
+		checkpoint := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, checkpoint); err != nil {
+			return nil, err
+		}
+		protected[bytesutil.ToBytes32(checkpoint.Root)] = true
+	}
+	return protected, nil
+}
+
+// createAttestationIndicesFromData returns the attestation index buckets along with the key of
+// the attestation in each of them.
+func createAttestationIndicesFromData(data *ethpb.AttestationData) map[string][]byte {
+	indicesByBucket := make(map[string][]byte)
+	if data == nil {
+		return indicesByBucket
+	}
+	if len(data.BeaconBlockRoot) > 0 {
+		indicesByBucket[string(attestationHeadBlockRootBucket)] = data.BeaconBlockRoot
+	}
+	if data.Source != nil {
+		if len(data.Source.Root) > 0 {
+			indicesByBucket[string(attestationSourceRootIndicesBucket)] = data.Source.Root
+		}
+		indicesByBucket[string(attestationSourceEpochIndicesBucket)] = bytesutil.Uint64ToBytesBigEndian(data.Source.Epoch)
+	}
+	if data.Target != nil {
+		if len(data.Target.Root) > 0 {
+			indicesByBucket[string(attestationTargetRootIndicesBucket)] = data.Target.Root
+		}
+		indicesByBucket[string(attestationTargetEpochIndicesBucket)] = bytesutil.Uint64ToBytesBigEndian(data.Target.Epoch)
+	}
+	return indicesByBucket
+}
@@ -0,0 +1,183 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestStore_PruneBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	roots := make([][32]byte, 10)
+	blocks := make([]*ethpb.SignedBeaconBlock, len(roots))
+	for i := range blocks {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = uint64(i)
+		if i > 0 {
+			b.Block.ParentRoot = roots[i-1][:]
+		}
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blocks[i] = b
+		roots[i] = r
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: uint64(i), Root: r[:]}))
+	}
+	require.NoError(t, db.SaveBlocks(ctx, blocks))
+	require.NoError(t, db.saveCachedStateSummariesDB(ctx))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: roots[8][:]}))
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(3))
+	require.NoError(t, db.SaveState(ctx, st, roots[3]))
+
+	pruned, err := db.PruneBlocks(ctx, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 5, pruned, "Expected all blocks below slot 6 but genesis to be pruned")
+
+	assert.Equal(t, true, db.HasBlock(ctx, roots[0]), "Genesis block should not be pruned")
+	for i := 1; i < 6; i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]), "Block %d should be pruned", i)
+	}
+	for i := 6; i < len(roots); i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "Block %d should not be pruned", i)
+	}
+	assert.Equal(t, true, db.HasStateSummary(ctx, roots[3]), "State summary of stored state should be kept")
+	assert.Equal(t, false, db.HasStateSummary(ctx, roots[4]), "State summary should be pruned")
+
+	got, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(9))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{roots[0], roots[6], roots[7], roots[8], roots[9]}, got)
+	got, err = db.BlockRoots(ctx, filters.NewFilter().SetParentRoot(roots[2][:]))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got), "Parent root index should be pruned")
+
+	pruned, err = db.PruneBlocks(ctx, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned, "Pruning again should be a no-op")
+}
+
+func TestStore_PruneStates_KeepsDiffBases(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffSnapshotInterval = 3
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	states := make([]*state.BeaconState, 5)
+	roots := make([][32]byte, len(states))
+	for i := range states {
+		st = nextArchivedState(t, st, uint64(i+1)*64)
+		states[i] = st
+		roots[i] = [32]byte{byte(i + 1)}
+		require.NoError(t, db.SaveArchivedState(ctx, st, roots[i]))
+	}
+
+	// The fourth state is the snapshot the fifth state is diffed against, it must be kept.
+	pruned, err := db.PruneStates(ctx, 5*64)
+	require.NoError(t, err)
+	assert.Equal(t, 3, pruned)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, false, db.HasState(ctx, roots[i]), "State %d should be pruned", i)
+	}
+	assert.Equal(t, true, db.HasState(ctx, roots[3]), "Base state of diff should not be pruned")
+	got, err := db.State(ctx, roots[4])
+	require.NoError(t, err)
+	assertStatesEqual(t, states[4], got)
+
+	highest, err := db.HighestSlotStatesBelow(ctx, 4*64+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(highest))
+	assert.Equal(t, uint64(4*64), highest[0].Slot())
+}
+
+func TestStore_PruneStates_ProtectedRoots(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	genesisRoot := [32]byte{'a'}
+	justifiedRoot := [32]byte{'b'}
+	otherRoot := [32]byte{'c'}
+	for i, r := range [][32]byte{genesisRoot, justifiedRoot, otherRoot} {
+		st := testutil.NewBeaconState()
+		require.NoError(t, st.SetSlot(uint64(i)))
+		require.NoError(t, db.SaveState(ctx, st, r))
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, &ethpb.Checkpoint{Root: justifiedRoot[:]}))
+
+	pruned, err := db.PruneStates(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+	assert.Equal(t, true, db.HasState(ctx, justifiedRoot))
+	assert.Equal(t, false, db.HasState(ctx, otherRoot))
+}
+
+func TestStore_PruneAttestations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	atts := make([]*ethpb.Attestation, 4)
+	attRoots := make([][32]byte, len(atts))
+	for i := range atts {
+		atts[i] = testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data: &ethpb.AttestationData{
+				Slot:   uint64(i) * 32,
+				Target: &ethpb.Checkpoint{Epoch: uint64(i)},
+			},
+		})
+		r, err := atts[i].HashTreeRoot()
+		require.NoError(t, err)
+		attRoots[i] = r
+		enc, err := encode(ctx, atts[i])
+		require.NoError(t, err)
+		require.NoError(t, db.db.Update(func(tx kvTx) error {
+			if err := tx.Bucket(attestationsBucket).Put(r[:], enc); err != nil {
+				return err
+			}
+			return updateValueForIndices(ctx, createAttestationIndicesFromData(atts[i].Data), r[:], tx)
+		}))
+	}
+
+	pruned, err := db.PruneAttestations(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	require.NoError(t, db.db.View(func(tx kvTx) error {
+		for i, r := range attRoots {
+			assert.Equal(t, i >= 2, tx.Bucket(attestationsBucket).Get(r[:]) != nil, "Unexpected attestation %d", i)
+		}
+		assert.Equal(t, true, tx.Bucket(attestationTargetEpochIndicesBucket).Get(make([]byte, 8)) == nil, "Index should be pruned")
+		return nil
+	}))
+}
+
+func TestStore_PruneAttestations_UndecodableAttestation(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db := setupDB(t)
+
+	root := [32]byte{'a'}
+	data := &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 1}}
+	require.NoError(t, db.db.Update(func(tx kvTx) error {
+		if err := tx.Bucket(attestationsBucket).Put(root[:], []byte("foo")); err != nil {
+			return err
+		}
+		return updateValueForIndices(ctx, createAttestationIndicesFromData(data), root[:], tx)
+	}))
+
+	pruned, err := db.PruneAttestations(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+	assert.LogsContain(t, hook, "Could not decode attestation")
+}
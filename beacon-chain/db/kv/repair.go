@@ -0,0 +1,361 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// keyValue is a key-value to be written to a DB bucket.
+type keyValue struct {
+	key   []byte
+	value []byte
+}
+
+// RepairIndices rebuilds the block slot and parent root indices, the state slot index and the
+// finalized block roots index from the stored blocks and states, and saves the missing or
+// mismatching state summaries of the stored states. Indices are rebuilt in batches, an
+// interrupted repair leaves them incomplete and must be run again. The node must not be running.
+func (s *Store) RepairIndices(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RepairIndices")
+	defer span.End()
+
+	var blockSlots, stateSlots map[[32]byte]uint64
+	var parentRoots map[[32]byte][]byte
+	var summaries []keyValue
+	if err := s.db.View(func(tx kvTx) error {
+		var err error
+		if blockSlots, err = blockSlotsByRoot(ctx, tx); err != nil {
+			return err
+		}
+		if parentRoots, err = blockParentRoots(ctx, tx); err != nil {
+			return err
+		}
+		if stateSlots, err = stateSlotsByRoot(ctx, tx); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(stateSummaryBucket)
+		for root, slot := range stateSlots {
+			if enc := bkt.Get(root[:]); enc != nil {
+				summary := &pb.StateSummary{}
+				if err := decode(ctx, enc, summary); err != nil {
+					return err
+				}
+				if summary.Slot == slot {
+					continue
+				}
+			}
+			root := root
+			enc, err := encode(ctx, &pb.StateSummary{Slot: slot, Root: root[:]})
+			if err != nil {
+				return err
+			}
+			summaries = append(summaries, keyValue{key: root[:], value: enc})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := s.rebuildIndex(ctx, [][]byte{blockSlotIndicesBucket, blockParentRootIndicesBucket}, blockSlots,
+		func(root [32]byte, slot uint64) map[string][]byte {
+			return createBlockIndicesFromBlock(ctx, &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoots[root]})
+		},
+	); err != nil {
+		return errors.Wrap(err, "could not rebuild block indices")
+	}
+	if err := s.rebuildIndex(ctx, [][]byte{stateSlotIndicesBucket}, stateSlots,
+		func(_ [32]byte, slot uint64) map[string][]byte {
+			return createStateIndicesFromStateSlot(ctx, slot)
+		},
+	); err != nil {
+		return errors.Wrap(err, "could not rebuild state indices")
+	}
+	if err := s.putInBatches(ctx, stateSummaryBucket, summaries); err != nil {
+		return errors.Wrap(err, "could not save state summaries")
+	}
+	if err := s.rebuildFinalizedBlockRoots(ctx); err != nil {
+		return errors.Wrap(err, "could not rebuild finalized block roots index")
+	}
+	log.WithField("summaries", len(summaries)).Info("Rebuilt DB indices")
+	return nil
+}
+
+// RollbackHead sets the head, justified and finalized checkpoints to the finalized block of the
+// root, then deletes the blocks and states of higher slots so that they are synced again. The
+// state of the block must be stored, or be replayable from its state summary. The node must not
+// be running.
+func (s *Store) RollbackHead(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RollbackHead")
+	defer span.End()
+
+	block, err := s.Block(ctx, blockRoot)
+	if err != nil {
+		return err
+	}
+	if block == nil || block.Block == nil {
+		return fmt.Errorf("block %#x not found in db", blockRoot)
+	}
+	if !s.IsFinalizedBlock(ctx, blockRoot) {
+		return fmt.Errorf("block %#x is not finalized", blockRoot)
+	}
+	if !s.HasState(ctx, blockRoot) && !s.HasStateSummary(ctx, blockRoot) {
+		return fmt.Errorf("no state or state summary stored for block %#x", blockRoot)
+	}
+
+	// The checkpoint epoch is the first epoch starting at or after the slot of the block.
+	slot := block.Block.Slot
+	epoch := helpers.SlotToEpoch(slot)
+	if !helpers.IsEpochStart(slot) {
+		epoch++
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: epoch, Root: blockRoot[:]}
+	enc, err := encode(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Update(func(tx kvTx) error {
+		if err := tx.Bucket(blocksBucket).Put(headBlockRootKey, blockRoot[:]); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(checkpointBucket)
+		if err := bkt.Put(justifiedCheckpointKey, enc); err != nil {
+			return err
+		}
+		if err := bkt.Put(finalizedCheckpointKey, enc); err != nil {
+			return err
+		}
+		bkt = tx.Bucket(finalizedBlockRootsIndexBucket)
+		if err := bkt.Put(previousFinalizedCheckpointKey, enc); err != nil {
+			return err
+		}
+		// The child of the block is deleted below.
+		containerEnc := bkt.Get(blockRoot[:])
+		if containerEnc == nil || bytes.Equal(containerEnc, containerFinalizedButNotCanonical) {
+			return nil
+		}
+		container := &dbpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, containerEnc, container); err != nil {
+			return err
+		}
+		container.ChildRoot = nil
+		containerEnc, err = encode(ctx, container)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(blockRoot[:], containerEnc)
+	}); err != nil {
+		return err
+	}
+
+	entries, err := s.prunableEntries(ctx, blockSlotIndicesBucket, slot+1, math.MaxUint64)
+	if err != nil {
+		return err
+	}
+	blocks, err := s.pruneInBatches(ctx, entries, func(tx kvTx, e indexedRoot) (bool, error) {
+		return s.pruneBlock(ctx, tx, e.root)
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not delete blocks")
+	}
+	entries, err = s.prunableEntries(ctx, stateSlotIndicesBucket, slot+1, math.MaxUint64)
+	if err != nil {
+		return err
+	}
+	states, err := s.pruneStateEntries(ctx, entries)
+	if err != nil {
+		return errors.Wrap(err, "could not delete states")
+	}
+	log.WithField("slot", slot).WithField("blocks", blocks).WithField("states", states).Info("Rolled back head")
+	return nil
+}
+
+// blockParentRoots returns the parent root of each stored block.
+func blockParentRoots(ctx context.Context, tx kvTx) (map[[32]byte][]byte, error) {
+	parents := make(map[[32]byte][]byte)
+	err := tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+		if len(k) != 32 {
+			return nil
+		}
+		block := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, v, block); err != nil {
+			return errors.Wrapf(err, "could not decode block %#x", k)
+		}
+		if block.Block != nil {
+			parents[bytesutil.ToBytes32(k)] = block.Block.ParentRoot
+		}
+		return nil
+	})
+	return parents, err
+}
+
+// rebuildIndex empties the index buckets, then indexes the roots using the indices function.
+func (s *Store) rebuildIndex(
+	ctx context.Context,
+	buckets [][]byte,
+	slots map[[32]byte]uint64,
+	indices func(root [32]byte, slot uint64) map[string][]byte,
+) error {
+	if err := s.recreateBuckets(ctx, buckets...); err != nil {
+		return err
+	}
+	entries := make([]indexedRoot, 0, len(slots))
+	for root, slot := range slots {
+		entries = append(entries, indexedRoot{index: slot, root: root})
+	}
+	_, err := s.pruneInBatches(ctx, entries, func(tx kvTx, e indexedRoot) (bool, error) {
+		return true, updateValueForIndices(ctx, indices(e.root, e.index), e.root[:], tx)
+	})
+	return err
+}
+
+// recreateBuckets empties the buckets by deleting and creating them again.
+func (s *Store) recreateBuckets(ctx context.Context, buckets ...[]byte) error {
+	for _, b := range buckets {
+		if err := deleteBucket(ctx, s.db, b); err != nil {
+			return err
+		}
+	}
+	return s.db.Update(func(tx kvTx) error {
+		for _, b := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// putInBatches writes the key-values to the bucket, using one write transaction per batch.
+func (s *Store) putInBatches(ctx context.Context, bucket []byte, kvs []keyValue) error {
+	for len(kvs) > 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batch := kvs
+		if len(batch) > pruneBatchSize {
+			batch = batch[:pruneBatchSize]
+		}
+		kvs = kvs[len(batch):]
+		if err := s.db.Update(func(tx kvTx) error {
+			bkt := tx.Bucket(bucket)
+			for _, kv := range batch {
+				if err := bkt.Put(kv.key, kv.value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildFinalizedBlockRoots rebuilds the finalized block roots index by walking the ancestry
+// chain of the finalized checkpoint down to the genesis or origin block, or down to the lowest
+// stored block on pruned DBs. See updateFinalizedBlockRoots for the layout of the index.
+func (s *Store) rebuildFinalizedBlockRoots(ctx context.Context) error {
+	checkpoint, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	var containers []keyValue
+	var epochRoots [][]byte
+	if bytes.Equal(checkpoint.Root, params.BeaconConfig().ZeroHash[:]) {
+		// Nothing is finalized yet, the index is left empty.
+		return s.recreateBuckets(ctx, finalizedBlockRootsIndexBucket)
+	}
+	if err := s.db.View(func(tx kvTx) error {
+		blocks := tx.Bucket(blocksBucket)
+		genesisRoot := blocks.Get(genesisBlockRootKey)
+		originRoot := blocks.Get(originBlockRootKey)
+		root := checkpoint.Root
+		var childRoot []byte
+		for !bytes.Equal(root, genesisRoot) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			enc := blocks.Get(root)
+			if enc == nil {
+				if childRoot == nil {
+					return fmt.Errorf("finalized block %#x not found in db", root)
+				}
+				break
+			}
+			block := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, block); err != nil {
+				return err
+			}
+			containerEnc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: block.Block.ParentRoot,
+				ChildRoot:  childRoot,
+			})
+			if err != nil {
+				return err
+			}
+			containers = append(containers, keyValue{key: bytesutil.SafeCopyBytes(root), value: containerEnc})
+			if originRoot != nil && bytes.Equal(root, originRoot) {
+				break
+			}
+			childRoot = bytesutil.SafeCopyBytes(root)
+			root = block.Block.ParentRoot
+		}
+
+		// Blocks from the finalized epoch are considered final, see updateFinalizedBlockRoots.
+		startSlot, err := helpers.StartSlot(checkpoint.Epoch)
+		if err != nil {
+			return err
+		}
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startSlot)); k != nil; k, v = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) >= startSlot+params.BeaconConfig().SlotsPerEpoch {
+				break
+			}
+			for i := 0; i+32 <= len(v); i += 32 {
+				epochRoots = append(epochRoots, bytesutil.SafeCopyBytes(v[i:i+32]))
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	checkpointEnc, err := encode(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := s.recreateBuckets(ctx, finalizedBlockRootsIndexBucket); err != nil {
+		return err
+	}
+	if err := s.db.Update(func(tx kvTx) error {
+		return tx.Bucket(finalizedBlockRootsIndexBucket).Put(previousFinalizedCheckpointKey, checkpointEnc)
+	}); err != nil {
+		return err
+	}
+	if err := s.putInBatches(ctx, finalizedBlockRootsIndexBucket, containers); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx kvTx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for _, root := range epochRoots {
+			if bytes.Equal(root, checkpoint.Root) || bkt.Get(root) != nil {
+				continue
+			}
+			if err := bkt.Put(root, containerFinalizedButNotCanonical); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
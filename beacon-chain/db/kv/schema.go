@@ -10,6 +10,7 @@ var (
 	attestationsBucket      = []byte("attestations")
 	blocksBucket            = []byte("blocks")
 	stateBucket             = []byte("state")
+	stateDiffBucket         = []byte("state-diffs")
 	stateSummaryBucket      = []byte("state-summary")
 	proposerSlashingsBucket = []byte("proposer-slashings")
 	attesterSlashingsBucket = []byte("attester-slashings")
@@ -18,6 +19,13 @@ var (
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
 
+	// Validator performance index buckets, keyed by epoch.
+	validatorPerformanceBucket   = []byte("validator-performance")
+	validatorParticipationBucket = []byte("validator-participation")
+
+	// Attestation tracker bucket, keyed by attestation slot and validator index.
+	attestationRecordsBucket = []byte("attestation-records")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -33,14 +41,19 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	stateDiffBaseRootIndicesBucket      = []byte("state-diff-base-root-indices")
 
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
+	originBlockRootKey        = []byte("origin-root")
+	backfillBlockRootKey      = []byte("backfill-root")
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	lastArchivedStateKey      = []byte("last-archived-state")
+	nextPerformanceEpochKey   = []byte("next-performance-epoch")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -8,9 +8,9 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -26,7 +26,8 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconSta
 	}
 
 	if len(enc) == 0 {
-		return nil, nil
+		// The state may be an archived state stored as a diff.
+		return s.stateFromDiffs(ctx, blockRoot)
 	}
 
 	st, err = createState(ctx, enc)
@@ -41,7 +42,7 @@ func (s *Store) GenesisState(ctx context.Context) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.GenesisState")
 	defer span.End()
 	var st *pb.BeaconState
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		// Retrieve genesis block's signing root from blocks bucket,
 		// to look up what the genesis state is.
 		bucket := tx.Bucket(blocksBucket)
@@ -90,7 +91,7 @@ func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blo
 		}
 	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx kvTx) error {
 		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
@@ -113,7 +114,7 @@ func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	if err != nil {
 		panic(err)
 	}
-	return len(enc) > 0
+	return len(enc) > 0 || s.hasStateDiff(ctx, blockRoot)
 }
 
 // DeleteState by block root.
@@ -121,9 +122,10 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteState")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx kvTx) error {
 		bkt := tx.Bucket(blocksBucket)
 		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
+		originBlockRoot := bkt.Get(originBlockRootKey)
 
 		bkt = tx.Bucket(checkpointBucket)
 		enc := bkt.Get(finalizedCheckpointKey)
@@ -137,10 +139,15 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 		blockBkt := tx.Bucket(blocksBucket)
 		headBlkRoot := blockBkt.Get(headBlockRootKey)
 		bkt = tx.Bucket(stateBucket)
-		// Safe guard against deleting genesis, finalized, head state.
-		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
+		// Safe guard against deleting genesis, origin, finalized, head state.
+		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) ||
+			bytes.Equal(blockRoot[:], originBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
 			return errors.New("cannot delete genesis, finalized, or head state")
 		}
+		// Safe guard against deleting the base state of state diffs.
+		if tx.Bucket(stateDiffBaseRootIndicesBucket).Get(blockRoot[:]) != nil {
+			return errors.New("cannot delete base state of state diffs")
+		}
 
 		slot, err := slotByBlockRoot(ctx, tx, blockRoot[:])
 		if err != nil {
@@ -150,6 +157,9 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := deleteStateDiff(ctx, tx, blockRoot[:]); err != nil {
+			return err
+		}
 
 		return bkt.Delete(blockRoot[:])
 	})
@@ -183,7 +193,7 @@ func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, err
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateBytes")
 	defer span.End()
 	var dst []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(stateBucket)
 		dst = bkt.Get(blockRoot[:])
 		return nil
@@ -192,7 +202,7 @@ func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, err
 }
 
 // slotByBlockRoot retrieves the corresponding slot of the input block root.
-func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (uint64, error) {
+func slotByBlockRoot(ctx context.Context, tx kvTx, blockRoot []byte) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.slotByBlockRoot")
 	defer span.End()
 
@@ -209,7 +219,16 @@ func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (uint64
 			bkt = tx.Bucket(stateBucket)
 			enc = bkt.Get(blockRoot)
 			if enc == nil {
-				return 0, errors.New("state enc can't be nil")
+				// Fallback and check the state diff.
+				enc = tx.Bucket(stateDiffBucket).Get(blockRoot)
+				if enc == nil {
+					return 0, errors.New("state enc can't be nil")
+				}
+				diff := &dbpb.StateDiff{}
+				if err := decode(ctx, enc, diff); err != nil {
+					return 0, err
+				}
+				return diff.Slot, nil
 			}
 			s, err := createState(ctx, enc)
 			if err != nil {
@@ -246,7 +265,7 @@ func (s *Store) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*sta
 	defer span.End()
 
 	var best []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		c := bkt.Cursor()
 		for s, root := c.First(); s != nil; s, root = c.Next() {
@@ -329,7 +348,7 @@ func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint ui
 	}
 	deletedRoots := make([][32]byte, 0)
 
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		return bkt.ForEach(func(k, v []byte) error {
 			if ctx.Err() != nil {
@@ -0,0 +1,339 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveArchivedState stores an archived state to the db using the block root of the state.
+// When state diffs are enabled, the state is stored as a diff against the previously archived
+// state, and a full snapshot of the state is stored every snapshot interval.
+func (s *Store) SaveArchivedState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedState")
+	defer span.End()
+	if st == nil {
+		return errors.New("nil state")
+	}
+	if s.stateDiffSnapshotInterval == 0 {
+		return s.SaveState(ctx, st, blockRoot)
+	}
+
+	baseRoot, depth, err := s.lastArchivedState()
+	if err != nil {
+		return err
+	}
+	if baseRoot != nil && depth+1 < s.stateDiffSnapshotInterval {
+		saved, err := s.saveStateDiff(ctx, st, blockRoot, bytesutil.ToBytes32(baseRoot), depth+1)
+		if err != nil {
+			return err
+		}
+		if saved {
+			return nil
+		}
+	}
+
+	if err := s.SaveState(ctx, st, blockRoot); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx kvTx) error {
+		return putLastArchivedState(tx, blockRoot[:], 0)
+	})
+}
+
+// saveStateDiff stores the state as a diff against the archived state of the base root. It returns
+// false without error when no diff can be computed, in which case the state should be stored in full.
+func (s *Store) saveStateDiff(
+	ctx context.Context, st *state.BeaconState, blockRoot, baseRoot [32]byte, depth uint64,
+) (bool, error) {
+	base, err := s.State(ctx, baseRoot)
+	if err != nil {
+		return false, errors.Wrap(err, "could not retrieve base state of diff")
+	}
+	if base == nil || base.Slot() >= st.Slot() {
+		return false, nil
+	}
+	diff, err := computeStateDiff(base.InnerStateUnsafe(), st.InnerStateUnsafe(), baseRoot[:])
+	if err != nil {
+		log.WithError(err).Debug("Could not compute state diff, saving full state")
+		return false, nil
+	}
+	enc, err := encode(ctx, diff)
+	if err != nil {
+		return false, err
+	}
+
+	return true, s.db.Update(func(tx kvTx) error {
+		indicesByBucket := createStateIndicesFromStateSlot(ctx, st.Slot())
+		if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		baseIndices := map[string][]byte{string(stateDiffBaseRootIndicesBucket): baseRoot[:]}
+		if err := updateValueForIndices(ctx, baseIndices, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		if err := tx.Bucket(stateDiffBucket).Put(blockRoot[:], enc); err != nil {
+			return err
+		}
+		return putLastArchivedState(tx, blockRoot[:], depth)
+	})
+}
+
+// lastArchivedState returns the block root of the last archived state along with the number
+// of diffs between it and the last full state snapshot.
+func (s *Store) lastArchivedState() ([]byte, uint64, error) {
+	var root []byte
+	var depth uint64
+	err := s.db.View(func(tx kvTx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(lastArchivedStateKey)
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != 40 {
+			return fmt.Errorf("last archived state has length %d, expected 40", len(enc))
+		}
+		root = bytesutil.SafeCopyBytes(enc[:32])
+		depth = bytesutil.BytesToUint64BigEndian(enc[32:])
+		// The last archived state may have been deleted since it was saved.
+		if tx.Bucket(stateBucket).Get(root) == nil && tx.Bucket(stateDiffBucket).Get(root) == nil {
+			root = nil
+		}
+		return nil
+	})
+	return root, depth, err
+}
+
+func putLastArchivedState(tx kvTx, blockRoot []byte, depth uint64) error {
+	enc := make([]byte, 0, 40)
+	enc = append(enc, blockRoot...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(depth)...)
+	return tx.Bucket(chainMetadataBucket).Put(lastArchivedStateKey, enc)
+}
+
+// stateFromDiffs reconstructs the state of the block root by applying the chain of state diffs
+// leading to it onto the full state snapshot they are based on. It returns nil when no diff is
+// stored for the block root.
+func (s *Store) stateFromDiffs(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateFromDiffs")
+	defer span.End()
+
+	var st *pb.BeaconState
+	var diffs []*dbpb.StateDiff
+	err := s.db.View(func(tx kvTx) error {
+		stateBkt := tx.Bucket(stateBucket)
+		diffBkt := tx.Bucket(stateDiffBucket)
+		root := blockRoot[:]
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if enc := stateBkt.Get(root); enc != nil {
+				var err error
+				st, err = createState(ctx, enc)
+				return err
+			}
+			enc := diffBkt.Get(root)
+			if enc == nil {
+				if len(diffs) == 0 {
+					return nil
+				}
+				return fmt.Errorf("missing base state %#x of state diff", root)
+			}
+			diff := &dbpb.StateDiff{}
+			if err := decode(ctx, enc, diff); err != nil {
+				return err
+			}
+			diffs = append(diffs, diff)
+			root = diff.BaseRoot
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, nil
+	}
+	for i := len(diffs) - 1; i >= 0; i-- {
+		if err := applyStateDiff(st, diffs[i]); err != nil {
+			return nil, errors.Wrap(err, "could not apply state diff")
+		}
+	}
+	return state.InitializeFromProtoUnsafe(st)
+}
+
+// hasStateDiff checks if a state diff by block root exists in the db.
+func (s *Store) hasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.hasStateDiff")
+	defer span.End()
+	var exists bool
+	if err := s.db.View(func(tx kvTx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(blockRoot[:]) != nil
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return exists
+}
+
+// deleteStateDiff removes the state diff of the block root along with its base root index.
+func deleteStateDiff(ctx context.Context, tx kvTx, blockRoot []byte) error {
+	bkt := tx.Bucket(stateDiffBucket)
+	enc := bkt.Get(blockRoot)
+	if enc == nil {
+		return nil
+	}
+	diff := &dbpb.StateDiff{}
+	if err := decode(ctx, enc, diff); err != nil {
+		return err
+	}
+	baseIndices := map[string][]byte{string(stateDiffBaseRootIndicesBucket): diff.BaseRoot}
+	if err := deleteValueForIndices(ctx, baseIndices, blockRoot, tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+	return bkt.Delete(blockRoot)
+}
+
+// computeStateDiff computes the diff to apply onto the base state to obtain the target state.
+// Fields which are small or which are replaced every epoch are copied in full, whereas only
+// the changed entries of the validator registry, the balances and the root vectors are kept.
+func computeStateDiff(base, target *pb.BeaconState, baseRoot []byte) (*dbpb.StateDiff, error) {
+	if base.GenesisTime != target.GenesisTime || !bytes.Equal(base.GenesisValidatorsRoot, target.GenesisValidatorsRoot) {
+		return nil, errors.New("states have different genesis")
+	}
+	if len(base.BlockRoots) != len(target.BlockRoots) || len(base.StateRoots) != len(target.StateRoots) ||
+		len(base.RandaoMixes) != len(target.RandaoMixes) || len(base.Slashings) != len(target.Slashings) {
+		return nil, errors.New("states have different vector lengths")
+	}
+	if len(base.Validators) > len(target.Validators) || len(base.Balances) > len(target.Balances) {
+		return nil, errors.New("validator registry of target state is smaller than base state")
+	}
+	if len(base.HistoricalRoots) > len(target.HistoricalRoots) {
+		return nil, errors.New("historical roots of target state are fewer than base state")
+	}
+
+	diff := &dbpb.StateDiff{
+		BaseRoot:                    baseRoot,
+		Slot:                        target.Slot,
+		Fork:                        target.Fork,
+		LatestBlockHeader:           target.LatestBlockHeader,
+		BlockRoots:                  diffRoots(base.BlockRoots, target.BlockRoots),
+		StateRoots:                  diffRoots(base.StateRoots, target.StateRoots),
+		AppendedHistoricalRoots:     target.HistoricalRoots[len(base.HistoricalRoots):],
+		Eth1Data:                    target.Eth1Data,
+		Eth1DataVotes:               target.Eth1DataVotes,
+		Eth1DepositIndex:            target.Eth1DepositIndex,
+		BalanceDeltas:               make([]int64, len(base.Balances)),
+		AppendedBalances:            target.Balances[len(base.Balances):],
+		RandaoMixes:                 diffRoots(base.RandaoMixes, target.RandaoMixes),
+		PreviousEpochAttestations:   target.PreviousEpochAttestations,
+		CurrentEpochAttestations:    target.CurrentEpochAttestations,
+		JustificationBits:           target.JustificationBits,
+		PreviousJustifiedCheckpoint: target.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  target.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         target.FinalizedCheckpoint,
+	}
+	for i, v := range target.Validators {
+		if i < len(base.Validators) && validatorsEqual(base.Validators[i], v) {
+			continue
+		}
+		diff.Validators = append(diff.Validators, &dbpb.IndexedValidator{Index: uint64(i), Validator: v})
+	}
+	for i, b := range base.Balances {
+		diff.BalanceDeltas[i] = int64(target.Balances[i] - b)
+	}
+	for i, amount := range target.Slashings {
+		if amount != base.Slashings[i] {
+			diff.Slashings = append(diff.Slashings, &dbpb.IndexedSlashing{Index: uint64(i), Amount: amount})
+		}
+	}
+	return diff, nil
+}
+
+// applyStateDiff applies the diff onto the state, which is modified in place.
+func applyStateDiff(st *pb.BeaconState, diff *dbpb.StateDiff) error {
+	if len(diff.BalanceDeltas) != len(st.Balances) {
+		return fmt.Errorf("diff has %d balance deltas for %d balances", len(diff.BalanceDeltas), len(st.Balances))
+	}
+	st.Slot = diff.Slot
+	st.Fork = diff.Fork
+	st.LatestBlockHeader = diff.LatestBlockHeader
+	if err := applyRoots(st.BlockRoots, diff.BlockRoots); err != nil {
+		return errors.Wrap(err, "could not apply block roots")
+	}
+	if err := applyRoots(st.StateRoots, diff.StateRoots); err != nil {
+		return errors.Wrap(err, "could not apply state roots")
+	}
+	st.HistoricalRoots = append(st.HistoricalRoots, diff.AppendedHistoricalRoots...)
+	st.Eth1Data = diff.Eth1Data
+	st.Eth1DataVotes = diff.Eth1DataVotes
+	st.Eth1DepositIndex = diff.Eth1DepositIndex
+	for _, v := range diff.Validators {
+		switch {
+		case v.Index < uint64(len(st.Validators)):
+			st.Validators[v.Index] = v.Validator
+		case v.Index == uint64(len(st.Validators)):
+			st.Validators = append(st.Validators, v.Validator)
+		default:
+			return fmt.Errorf("validator index %d is out of range", v.Index)
+		}
+	}
+	for i, delta := range diff.BalanceDeltas {
+		st.Balances[i] += uint64(delta)
+	}
+	st.Balances = append(st.Balances, diff.AppendedBalances...)
+	if err := applyRoots(st.RandaoMixes, diff.RandaoMixes); err != nil {
+		return errors.Wrap(err, "could not apply randao mixes")
+	}
+	for _, s := range diff.Slashings {
+		if s.Index >= uint64(len(st.Slashings)) {
+			return fmt.Errorf("slashings index %d is out of range", s.Index)
+		}
+		st.Slashings[s.Index] = s.Amount
+	}
+	st.PreviousEpochAttestations = diff.PreviousEpochAttestations
+	st.CurrentEpochAttestations = diff.CurrentEpochAttestations
+	st.JustificationBits = diff.JustificationBits
+	st.PreviousJustifiedCheckpoint = diff.PreviousJustifiedCheckpoint
+	st.CurrentJustifiedCheckpoint = diff.CurrentJustifiedCheckpoint
+	st.FinalizedCheckpoint = diff.FinalizedCheckpoint
+	return nil
+}
+
+func diffRoots(base, target [][]byte) []*dbpb.IndexedRoot {
+	var roots []*dbpb.IndexedRoot
+	for i, r := range target {
+		if !bytes.Equal(base[i], r) {
+			roots = append(roots, &dbpb.IndexedRoot{Index: uint64(i), Root: r})
+		}
+	}
+	return roots
+}
+
+func applyRoots(roots [][]byte, changed []*dbpb.IndexedRoot) error {
+	for _, r := range changed {
+		if r.Index >= uint64(len(roots)) {
+			return fmt.Errorf("index %d is out of range", r.Index)
+		}
+		roots[r.Index] = r.Root
+	}
+	return nil
+}
+
+func validatorsEqual(a, b *ethpb.Validator) bool {
+	return a.EffectiveBalance == b.EffectiveBalance &&
+		a.Slashed == b.Slashed &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch &&
+		bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials)
+}
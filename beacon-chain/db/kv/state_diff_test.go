@@ -0,0 +1,166 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// nextArchivedState returns a copy of the state advanced to the slot, with changes to the
+// fields stored as diffs.
+func nextArchivedState(t *testing.T, st *state.BeaconState, slot uint64) *state.BeaconState {
+	next := st.Copy()
+	require.NoError(t, next.SetSlot(slot))
+	require.NoError(t, next.UpdateBlockRootAtIndex(slot%uint64(len(next.BlockRoots())), bytesutil.ToBytes32(bytesutil.Bytes8(slot))))
+	require.NoError(t, next.UpdateRandaoMixesAtIndex(slot%uint64(len(next.RandaoMixes())), bytesutil.PadTo(bytesutil.Bytes8(slot), 32)))
+	require.NoError(t, next.UpdateSlashingsAtIndex(slot%uint64(len(next.Slashings())), slot))
+	require.NoError(t, next.UpdateBalancesAtIndex(0, next.Balances()[0]-slot))
+	require.NoError(t, next.UpdateBalancesAtIndex(1, next.Balances()[1]+slot))
+	require.NoError(t, next.AppendHistoricalRoots(bytesutil.ToBytes32(bytesutil.Bytes8(slot))))
+	require.NoError(t, next.AppendValidator(&ethpb.Validator{
+		PublicKey:             bytesutil.PadTo(bytesutil.Bytes8(slot), 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      slot,
+	}))
+	require.NoError(t, next.AppendBalance(slot))
+	return next
+}
+
+func assertStatesEqual(t *testing.T, want, got *state.BeaconState) {
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot, "Retrieved state is different from saved state")
+}
+
+func TestStateDiff_ComputeApply(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 16)
+	target := nextArchivedState(t, base, 64)
+	v, err := target.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	v.ExitEpoch = 10
+	require.NoError(t, target.UpdateValidatorAtIndex(3, v))
+
+	diff, err := computeStateDiff(base.InnerStateUnsafe(), target.InnerStateUnsafe(), make([]byte, 32))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(diff.Validators), "Expected the changed and the appended validator")
+	assert.Equal(t, 1, len(diff.BlockRoots))
+	assert.Equal(t, 1, len(diff.AppendedHistoricalRoots))
+	assert.Equal(t, 1, len(diff.AppendedBalances))
+
+	st := base.CloneInnerState()
+	require.NoError(t, applyStateDiff(st, diff))
+	applied, err := state.InitializeFromProto(st)
+	require.NoError(t, err)
+	assertStatesEqual(t, target, applied)
+}
+
+func TestStateDiff_ComputeShrinkingRegistry(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 16)
+	target := base.CloneInnerState()
+	target.Validators = target.Validators[:8]
+	target.Balances = target.Balances[:8]
+	_, err := computeStateDiff(base.InnerStateUnsafe(), target, make([]byte, 32))
+	assert.ErrorContains(t, "validator registry of target state is smaller", err)
+}
+
+func TestStateDiff_ApplyOutOfRange(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 4)
+	st := base.CloneInnerState()
+	diff, err := computeStateDiff(base.InnerStateUnsafe(), base.InnerStateUnsafe(), make([]byte, 32))
+	require.NoError(t, err)
+	diff.Validators = []*dbpb.IndexedValidator{{Index: 10, Validator: &ethpb.Validator{}}}
+	assert.ErrorContains(t, "validator index 10 is out of range", applyStateDiff(st, diff))
+}
+
+func TestStore_SaveArchivedState_Diffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffSnapshotInterval = 3
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	states := make([]*state.BeaconState, 5)
+	roots := make([][32]byte, len(states))
+	for i := range states {
+		st = nextArchivedState(t, st, uint64(i+1)*64)
+		states[i] = st
+		roots[i] = [32]byte{byte(i + 1)}
+		require.NoError(t, db.SaveArchivedState(ctx, st, roots[i]))
+	}
+
+	// Every third archived state is stored as a full snapshot.
+	snapshots := []bool{true, false, false, true, false}
+	require.NoError(t, db.db.View(func(tx kvTx) error {
+		for i, r := range roots {
+			assert.Equal(t, snapshots[i], tx.Bucket(stateBucket).Get(r[:]) != nil)
+			assert.Equal(t, !snapshots[i], tx.Bucket(stateDiffBucket).Get(r[:]) != nil)
+		}
+		return nil
+	}))
+
+	for i, r := range roots {
+		assert.Equal(t, true, db.HasState(ctx, r))
+		saved, err := db.State(ctx, r)
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assertStatesEqual(t, states[i], saved)
+	}
+
+	highest, err := db.HighestSlotStatesBelow(ctx, states[2].Slot()+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(highest))
+	assert.Equal(t, states[2].Slot(), highest[0].Slot())
+}
+
+func TestStore_DeleteState_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffSnapshotInterval = 4
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	roots := [][32]byte{{'a'}, {'b'}, {'c'}}
+	for i, r := range roots {
+		st = nextArchivedState(t, st, uint64(i+1)*64)
+		require.NoError(t, db.SaveArchivedState(ctx, st, r))
+	}
+
+	assert.ErrorContains(t, "cannot delete base state of state diffs", db.DeleteState(ctx, roots[0]))
+	assert.ErrorContains(t, "cannot delete base state of state diffs", db.DeleteState(ctx, roots[1]))
+
+	require.NoError(t, db.DeleteState(ctx, roots[2]))
+	assert.Equal(t, false, db.HasState(ctx, roots[2]))
+	require.NoError(t, db.DeleteState(ctx, roots[1]))
+	assert.Equal(t, false, db.HasState(ctx, roots[1]))
+	require.NoError(t, db.DeleteState(ctx, roots[0]))
+	assert.Equal(t, false, db.HasState(ctx, roots[0]))
+
+	// Archived states saved after the deletion of the last archived state are stored in full.
+	st = nextArchivedState(t, st, 256)
+	require.NoError(t, db.SaveArchivedState(ctx, st, [32]byte{'d'}))
+	saved, err := db.State(ctx, [32]byte{'d'})
+	require.NoError(t, err)
+	assertStatesEqual(t, st, saved)
+}
+
+func TestStore_SaveArchivedState_NoDiffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(64))
+	r := [32]byte{'a'}
+	require.NoError(t, db.SaveArchivedState(ctx, st, r))
+	require.NoError(t, db.db.View(func(tx kvTx) error {
+		assert.NotNil(t, tx.Bucket(stateBucket).Get(r[:]))
+		assert.Equal(t, true, tx.Bucket(chainMetadataBucket).Get(lastArchivedStateKey) == nil)
+		return nil
+	}))
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -15,7 +14,7 @@ import (
 // attestations and we have an index `[]byte("5")` under the shard indices bucket,
 // we might find roots `0x23` and `0x45` stored under that index. We can then
 // do a batch read for attestations corresponding to those roots.
-func lookupValuesForIndices(ctx context.Context, indicesByBucket map[string][]byte, tx *bolt.Tx) [][][]byte {
+func lookupValuesForIndices(ctx context.Context, indicesByBucket map[string][]byte, tx kvTx) [][][]byte {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.lookupValuesForIndices")
 	defer span.End()
 	values := make([][][]byte, 0, len(indicesByBucket))
@@ -34,7 +33,7 @@ func lookupValuesForIndices(ctx context.Context, indicesByBucket map[string][]by
 // updateValueForIndices updates the value for each index by appending it to the previous
 // values stored at said index. Typically, indices are roots of data that can then
 // be used for reads or batch reads from the DB.
-func updateValueForIndices(ctx context.Context, indicesByBucket map[string][]byte, root []byte, tx *bolt.Tx) error {
+func updateValueForIndices(ctx context.Context, indicesByBucket map[string][]byte, root []byte, tx kvTx) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.updateValueForIndices")
 	defer span.End()
 	for k, idx := range indicesByBucket {
@@ -60,7 +59,7 @@ func updateValueForIndices(ctx context.Context, indicesByBucket map[string][]byt
 }
 
 // deleteValueForIndices clears a root stored at each index.
-func deleteValueForIndices(ctx context.Context, indicesByBucket map[string][]byte, root []byte, tx *bolt.Tx) error {
+func deleteValueForIndices(ctx context.Context, indicesByBucket map[string][]byte, root []byte, tx kvTx) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteValueForIndices")
 	defer span.End()
 	for k, idx := range indicesByBucket {
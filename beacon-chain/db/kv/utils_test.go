@@ -8,7 +8,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-	bolt "go.etcd.io/bbolt"
 )
 
 func Test_deleteValueForIndices(t *testing.T) {
@@ -115,7 +114,7 @@ func Test_deleteValueForIndices(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := db.db.Update(func(tx *bolt.Tx) error {
+			err := db.db.Update(func(tx kvTx) error {
 				for k, idx := range tt.inputIndices {
 					bkt := tx.Bucket([]byte(k))
 					require.NoError(t, bkt.Put(idx, tt.inputIndices[k]))
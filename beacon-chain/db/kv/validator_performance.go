@@ -0,0 +1,149 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveValidatorPerformance saves the precomputed performance and participation of the validators
+// for an epoch, replacing the ones previously saved for the epoch. The epoch following the highest
+// saved epoch is tracked along with them, so that indexing resumes from it after a restart.
+func (s *Store) SaveValidatorPerformance(
+	ctx context.Context, perf *dbpb.ValidatorPerformance, participation *dbpb.ValidatorParticipation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorPerformance")
+	defer span.End()
+
+	if perf == nil || participation == nil {
+		err := errors.New("cannot save nil validator performance")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	if perf.Epoch != participation.Epoch {
+		err := fmt.Errorf("validator performance of epoch %d saved with participation of epoch %d",
+			perf.Epoch, participation.Epoch)
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	encPerf, err := encode(ctx, perf)
+	if err != nil {
+		return err
+	}
+	encParticipation, err := encode(ctx, participation)
+	if err != nil {
+		return err
+	}
+	key := bytesutil.Uint64ToBytesBigEndian(perf.Epoch)
+	err = s.db.Update(func(tx kvTx) error {
+		if err := tx.Bucket(validatorPerformanceBucket).Put(key, encPerf); err != nil {
+			return err
+		}
+		if err := tx.Bucket(validatorParticipationBucket).Put(key, encParticipation); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(chainMetadataBucket)
+		next := bkt.Get(nextPerformanceEpochKey)
+		if next != nil && bytesutil.BytesToUint64BigEndian(next) > perf.Epoch {
+			return nil
+		}
+		return bkt.Put(nextPerformanceEpochKey, bytesutil.Uint64ToBytesBigEndian(perf.Epoch+1))
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// NextValidatorPerformanceEpoch returns the epoch following the highest epoch saved by
+// SaveValidatorPerformance, or 0 if no epoch was saved.
+func (s *Store) NextValidatorPerformanceEpoch(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.NextValidatorPerformanceEpoch")
+	defer span.End()
+
+	var epoch uint64
+	err := s.db.View(func(tx kvTx) error {
+		if enc := tx.Bucket(chainMetadataBucket).Get(nextPerformanceEpochKey); enc != nil {
+			epoch = bytesutil.BytesToUint64BigEndian(enc)
+		}
+		return nil
+	})
+	return epoch, err
+}
+
+// ValidatorPerformance retrieves the precomputed performance of the validators for an epoch,
+// or nil if it was not saved.
+func (s *Store) ValidatorPerformance(ctx context.Context, epoch uint64) (*dbpb.ValidatorPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorPerformance")
+	defer span.End()
+
+	var perf *dbpb.ValidatorPerformance
+	err := s.db.View(func(tx kvTx) error {
+		enc := tx.Bucket(validatorPerformanceBucket).Get(bytesutil.Uint64ToBytesBigEndian(epoch))
+		if enc == nil {
+			return nil
+		}
+		perf = &dbpb.ValidatorPerformance{}
+		return decode(ctx, enc, perf)
+	})
+	return perf, err
+}
+
+// ValidatorPerformances retrieves the precomputed performance of the validators for the saved
+// epochs between the start and the end epochs, inclusive, in ascending epoch order.
+func (s *Store) ValidatorPerformances(ctx context.Context, startEpoch, endEpoch uint64) ([]*dbpb.ValidatorPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorPerformances")
+	defer span.End()
+
+	perfs := make([]*dbpb.ValidatorPerformance, 0)
+	err := s.epochRange(validatorPerformanceBucket, startEpoch, endEpoch, func(enc []byte) error {
+		perf := &dbpb.ValidatorPerformance{}
+		if err := decode(ctx, enc, perf); err != nil {
+			return err
+		}
+		perfs = append(perfs, perf)
+		return nil
+	})
+	return perfs, err
+}
+
+// ValidatorParticipations retrieves the participation of the validators for the saved epochs
+// between the start and the end epochs, inclusive, in ascending epoch order.
+func (s *Store) ValidatorParticipations(ctx context.Context, startEpoch, endEpoch uint64) ([]*dbpb.ValidatorParticipation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorParticipations")
+	defer span.End()
+
+	participations := make([]*dbpb.ValidatorParticipation, 0)
+	err := s.epochRange(validatorParticipationBucket, startEpoch, endEpoch, func(enc []byte) error {
+		participation := &dbpb.ValidatorParticipation{}
+		if err := decode(ctx, enc, participation); err != nil {
+			return err
+		}
+		participations = append(participations, participation)
+		return nil
+	})
+	return participations, err
+}
+
+// epochRange calls the function with the values of the bucket keyed by the epochs between the
+// start and the end epochs, inclusive, in ascending epoch order.
+func (s *Store) epochRange(bucket []byte, startEpoch, endEpoch uint64, fn func(enc []byte) error) error {
+	if endEpoch < startEpoch {
+		return fmt.Errorf("end epoch %d < start epoch %d", endEpoch, startEpoch)
+	}
+	min := bytesutil.Uint64ToBytesBigEndian(startEpoch)
+	max := bytesutil.Uint64ToBytesBigEndian(endEpoch)
+	return s.db.View(func(tx kvTx) error {
+		c := tx.Bucket(bucket).Cursor()
+		for k, enc := c.Seek(min); k != nil && bytes.Compare(k, max) <= 0; k, enc = c.Next() {
+			if err := fn(enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,101 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ValidatorPerformance_RoundTrip(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	perf, err := db.ValidatorPerformance(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.ValidatorPerformance)(nil), perf)
+
+	want := &dbpb.ValidatorPerformance{
+		Epoch:                5,
+		Active:               []bool{true, true},
+		CorrectlyVotedSource: []bool{true, false},
+		CorrectlyVotedTarget: []bool{true, false},
+		CorrectlyVotedHead:   []bool{false, false},
+		InclusionDistance:    []uint64{1, 0},
+		BalanceChange:        []int64{1200, -800},
+	}
+	wantParticipation := &dbpb.ValidatorParticipation{
+		Epoch:               5,
+		ActiveGwei:          64,
+		SourceAttestingGwei: 32,
+		TargetAttestingGwei: 32,
+	}
+	require.NoError(t, db.SaveValidatorPerformance(ctx, want, wantParticipation))
+	perf, err = db.ValidatorPerformance(ctx, 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, perf)
+	participations, err := db.ValidatorParticipations(ctx, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(participations))
+	assert.DeepEqual(t, wantParticipation, participations[0])
+
+	assert.ErrorContains(t, "cannot save nil", db.SaveValidatorPerformance(ctx, nil, wantParticipation))
+	assert.ErrorContains(t, "saved with participation of epoch 5",
+		db.SaveValidatorPerformance(ctx, &dbpb.ValidatorPerformance{Epoch: 6}, wantParticipation))
+}
+
+func TestStore_ValidatorPerformances(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, epoch := range []uint64{1, 2, 4, 8} {
+		require.NoError(t, db.SaveValidatorPerformance(ctx,
+			&dbpb.ValidatorPerformance{Epoch: epoch},
+			&dbpb.ValidatorParticipation{Epoch: epoch, ActiveGwei: epoch}))
+	}
+	perfs, err := db.ValidatorPerformances(ctx, 2, 7)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(perfs))
+	assert.Equal(t, uint64(2), perfs[0].Epoch)
+	assert.Equal(t, uint64(4), perfs[1].Epoch)
+	participations, err := db.ValidatorParticipations(ctx, 2, 7)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(participations))
+	assert.Equal(t, uint64(2), participations[0].ActiveGwei)
+	assert.Equal(t, uint64(4), participations[1].ActiveGwei)
+
+	perfs, err = db.ValidatorPerformances(ctx, 9, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(perfs))
+
+	_, err = db.ValidatorPerformances(ctx, 3, 2)
+	assert.ErrorContains(t, "end epoch 2 < start epoch 3", err)
+	_, err = db.ValidatorParticipations(ctx, 3, 2)
+	assert.ErrorContains(t, "end epoch 2 < start epoch 3", err)
+}
+
+func TestStore_NextValidatorPerformanceEpoch(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	epoch, err := db.NextValidatorPerformanceEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), epoch)
+
+	save := func(epoch uint64) {
+		require.NoError(t, db.SaveValidatorPerformance(ctx,
+			&dbpb.ValidatorPerformance{Epoch: epoch}, &dbpb.ValidatorParticipation{Epoch: epoch}))
+	}
+	save(4)
+	epoch, err = db.NextValidatorPerformanceEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), epoch)
+
+	// Saving an earlier epoch again does not move the cursor back.
+	save(2)
+	epoch, err = db.NextValidatorPerformanceEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), epoch)
+}
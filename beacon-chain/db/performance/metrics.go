@@ -0,0 +1,18 @@
+package performance
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	indexedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validator_performance_indexed_epoch",
+		Help: "The last epoch saved to the validator performance index.",
+	})
+	indexDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "validator_performance_index_duration_seconds",
+		Help:    "The time spent computing and saving the validator performance of an epoch.",
+		Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+	})
+)
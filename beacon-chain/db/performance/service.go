@@ -0,0 +1,245 @@
+// Package performance maintains the validator performance index: after each epoch transition, the
+// per validator output of the epoch precomputation, namely the correct source, target and head
+// votes, the inclusion distance and the balance change, is saved to the database, so that the
+// performance of validators over past epochs is served without regenerating historical states.
+package performance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the validator performance index service.
+type Config struct {
+	BeaconDB      db.NoHeadAccessDatabase
+	StateGen      *stategen.State
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+}
+
+// Service saves the performance of the validators for each epoch once its attestations are
+// rewarded, i.e. the performance for epoch N is saved once the head reaches epoch N+2.
+type Service struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cfg     *Config
+	trigger chan struct{}
+	lock    sync.RWMutex
+	err     error
+}
+
+// New initializes the validator performance index service.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     cfg,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Start the validator performance index service. Indexing resumes from the last indexed epoch, or
+// starts from the last epoch rewarded by the head state, the epochs before it not being indexed.
+func (s *Service) Start() {
+	log.Info("Validator performance index enabled")
+	go s.run()
+	go s.subscribeToBlocks()
+}
+
+// Stop the validator performance index service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator performance index service, which is the error of the last indexing run.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// schedule an indexing run without blocking. If a run is already pending, it picks up the latest
+// head once it starts, so there is no need to queue another one.
+func (s *Service) schedule() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// run executes the scheduled indexing runs one at a time.
+func (s *Service) run() {
+	for {
+		select {
+		case <-s.trigger:
+			err := s.index(s.ctx)
+			if err != nil && s.ctx.Err() == nil {
+				log.WithError(err).Error("Could not index validator performance")
+			}
+			s.lock.Lock()
+			s.err = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribeToBlocks schedules an indexing run when a processed block moves the head to a new
+// epoch.
+func (s *Service) subscribeToBlocks() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	var headEpoch uint64
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			if epoch := helpers.SlotToEpoch(s.cfg.HeadFetcher.HeadSlot()); epoch > headEpoch {
+				headEpoch = epoch
+				s.schedule()
+			}
+		case <-stateSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// index saves the performance of the epochs rewarded since the last indexing run. Indexing resumes
+// from the epoch following the last indexed one, or starts from the last epoch rewarded by the
+// head state when no epoch was indexed yet.
+func (s *Service) index(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "performance.index")
+	defer span.End()
+
+	headEpoch := helpers.SlotToEpoch(s.cfg.HeadFetcher.HeadSlot())
+	if headEpoch < 2 {
+		return nil
+	}
+	// The attestations of an epoch are rewarded by the epoch transition at the end of the next
+	// epoch.
+	lastRewarded := headEpoch - 2
+	nextEpoch, err := s.cfg.BeaconDB.NextValidatorPerformanceEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve the next epoch to index")
+	}
+	if nextEpoch == 0 {
+		nextEpoch = lastRewarded
+	}
+	for epoch := nextEpoch; epoch <= lastRewarded; epoch++ {
+		start := time.Now()
+		perf, participation, err := s.computePerformance(ctx, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute validator performance for epoch %d", epoch)
+		}
+		if err := s.cfg.BeaconDB.SaveValidatorPerformance(ctx, perf, participation); err != nil {
+			return errors.Wrapf(err, "could not save validator performance for epoch %d", epoch)
+		}
+		indexDuration.Observe(time.Since(start).Seconds())
+		indexedEpoch.Set(float64(epoch))
+		log.WithFields(logrus.Fields{
+			"epoch":    epoch,
+			"duration": time.Since(start),
+		}).Debug("Indexed validator performance")
+	}
+	return nil
+}
+
+// computePerformance computes the performance of the validators for the epoch from the state at
+// the last slot of the next epoch, right before the epoch transition rewarding the attestations.
+func (s *Service) computePerformance(
+	ctx context.Context, epoch uint64,
+) (*dbpb.ValidatorPerformance, *dbpb.ValidatorParticipation, error) {
+	nextEpochStart, err := helpers.StartSlot(epoch + 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	st, err := s.cfg.StateGen.StateBySlot(stategen.WithCaller(ctx, "performance_index"), nextEpochStart-1)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve state")
+	}
+	return Compute(ctx, st)
+}
+
+// Compute returns the performance and the participation of the validators for the previous epoch
+// of the state, which must be at the last slot of its epoch so that it holds all the attestations
+// of the previous epoch. The state is mutated by the computation of the rewards and penalties.
+func Compute(ctx context.Context, st *stateTrie.BeaconState) (*dbpb.ValidatorPerformance, *dbpb.ValidatorParticipation, error) {
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, nil, err
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp); err != nil {
+		return nil, nil, err
+	}
+
+	epoch := helpers.PrevEpoch(st)
+	perf := &dbpb.ValidatorPerformance{
+		Epoch:                epoch,
+		Active:               make([]bool, len(vp)),
+		CorrectlyVotedSource: make([]bool, len(vp)),
+		CorrectlyVotedTarget: make([]bool, len(vp)),
+		CorrectlyVotedHead:   make([]bool, len(vp)),
+		InclusionDistance:    make([]uint64, len(vp)),
+		BalanceChange:        make([]int64, len(vp)),
+	}
+	// The totals are summed here rather than taken from the precomputed balances, which are
+	// lower bounded by the effective balance increment for the reward computations.
+	participation := &dbpb.ValidatorParticipation{Epoch: epoch}
+	for i, v := range vp {
+		perf.Active[i] = v.IsActivePrevEpoch
+		perf.CorrectlyVotedSource[i] = v.IsPrevEpochAttester
+		perf.CorrectlyVotedTarget[i] = v.IsPrevEpochTargetAttester
+		perf.CorrectlyVotedHead[i] = v.IsPrevEpochHeadAttester
+		if v.InclusionDistance != params.BeaconConfig().FarFutureEpoch {
+			perf.InclusionDistance[i] = v.InclusionDistance
+		}
+		perf.BalanceChange[i] = int64(v.AfterEpochTransitionBalance) - int64(v.BeforeEpochTransitionBalance)
+
+		if v.IsActivePrevEpoch {
+			participation.ActiveGwei += v.CurrentEpochEffectiveBalance
+		}
+		if v.IsSlashed {
+			continue
+		}
+		if v.IsPrevEpochAttester {
+			participation.SourceAttestingGwei += v.CurrentEpochEffectiveBalance
+		}
+		if v.IsPrevEpochTargetAttester {
+			participation.TargetAttestingGwei += v.CurrentEpochEffectiveBalance
+		}
+		if v.IsPrevEpochHeadAttester {
+			participation.HeadAttestingGwei += v.CurrentEpochEffectiveBalance
+		}
+	}
+	return perf, participation, nil
+}
@@ -0,0 +1,95 @@
+package performance
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCompute_NoAttestations(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch-1))
+
+	perf, participation, err := Compute(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), perf.Epoch)
+	require.Equal(t, 64, len(perf.Active))
+	for i := range perf.Active {
+		assert.Equal(t, true, perf.Active[i])
+		assert.Equal(t, false, perf.CorrectlyVotedSource[i])
+		assert.Equal(t, false, perf.CorrectlyVotedTarget[i])
+		assert.Equal(t, false, perf.CorrectlyVotedHead[i])
+		assert.Equal(t, uint64(0), perf.InclusionDistance[i])
+		assert.Equal(t, true, perf.BalanceChange[i] < 0, "Validator %d was not penalized for missing attestations", i)
+	}
+	assert.Equal(t, uint64(0), participation.Epoch)
+	assert.Equal(t, 64*params.BeaconConfig().MaxEffectiveBalance, participation.ActiveGwei)
+	assert.Equal(t, uint64(0), participation.SourceAttestingGwei)
+	assert.Equal(t, uint64(0), participation.TargetAttestingGwei)
+	assert.Equal(t, uint64(0), participation.HeadAttestingGwei)
+}
+
+func TestService_Index(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	genesis, _ := testutil.DeterministicGenesisState(t, 64)
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, r))
+	require.NoError(t, beaconDB.SaveState(ctx, genesis, r))
+
+	head := testutil.NewBeaconState()
+	require.NoError(t, head.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	s := New(ctx, &Config{
+		BeaconDB:    beaconDB,
+		StateGen:    stategen.New(beaconDB),
+		HeadFetcher: &mock.ChainService{State: head},
+	})
+
+	// Indexing starts from the last epoch rewarded by the head state.
+	require.NoError(t, s.index(ctx))
+	perf, err := beaconDB.ValidatorPerformance(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.ValidatorPerformance)(nil), perf)
+	perf, err = beaconDB.ValidatorPerformance(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, perf)
+	assert.Equal(t, 64, len(perf.Active))
+
+	require.NoError(t, head.SetSlot(5*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, s.index(ctx))
+	perfs, err := beaconDB.ValidatorPerformances(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(perfs))
+	for i, perf := range perfs {
+		assert.Equal(t, uint64(i+1), perf.Epoch)
+	}
+
+	// A restarted service resumes from the last indexed epoch.
+	require.NoError(t, head.SetSlot(7*params.BeaconConfig().SlotsPerEpoch))
+	s = New(ctx, &Config{
+		BeaconDB:    beaconDB,
+		StateGen:    stategen.New(beaconDB),
+		HeadFetcher: &mock.ChainService{State: head},
+	})
+	require.NoError(t, s.index(ctx))
+	perfs, err = beaconDB.ValidatorPerformances(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(perfs))
+	for i, perf := range perfs {
+		assert.Equal(t, uint64(i+1), perf.Epoch)
+	}
+	participations, err := beaconDB.ValidatorParticipations(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(participations))
+}
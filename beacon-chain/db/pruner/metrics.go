@@ -0,0 +1,25 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_pruned_blocks_total",
+		Help: "The number of finalized blocks deleted from the DB by pruning.",
+	})
+	prunedStatesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_pruned_states_total",
+		Help: "The number of archived states deleted from the DB by pruning.",
+	})
+	prunedAttestationsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_pruned_attestations_total",
+		Help: "The number of attestations deleted from the DB by pruning.",
+	})
+	prunedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_pruned_finalized_epoch",
+		Help: "The finalized epoch of the last completed pruning run.",
+	})
+)
@@ -0,0 +1,254 @@
+// Package pruner deletes finalized data from the database of a non-archive beacon node. Blocks
+// and attestations are kept for the weak subjectivity period, and archived states for a
+// configurable window of epochs before the finalized checkpoint. Pruning runs in the background
+// whenever the finalized checkpoint advances, so that it never delays block processing.
+package pruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the pruner service.
+type Config struct {
+	BeaconDB      db.NoHeadAccessDatabase
+	StateGen      *stategen.State
+	StateNotifier statefeed.Notifier
+	// StateWindow is the number of epochs before the finalized epoch for which archived states
+	// are kept. Archived states are kept for the weak subjectivity period when it is zero, and
+	// pruning fails when it is larger than the weak subjectivity period.
+	StateWindow uint64
+}
+
+// Service prunes the finalized data which is older than the retention windows.
+type Service struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	cfg         *Config
+	trigger     chan struct{}
+	prunedEpoch uint64
+	lock        sync.RWMutex
+	err         error
+}
+
+// New initializes the pruner service.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     cfg,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Start the pruner service. A first pruning run is scheduled right away, to catch up with the
+// finalized checkpoint the node starts from.
+func (s *Service) Start() {
+	log.WithField("stateWindow", s.cfg.StateWindow).Info("Database pruning enabled")
+	s.schedule()
+	go s.run()
+	go s.subscribeToBlocks()
+}
+
+// Stop the pruner service. A pruning run in progress is interrupted at the end of its current
+// batch of deletions.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service, which is the error of the last pruning run.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// schedule a pruning run without blocking. If a run is already pending, it picks up the latest
+// finalized checkpoint once it starts, so there is no need to queue another one.
+func (s *Service) schedule() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// run executes the scheduled pruning runs one at a time.
+func (s *Service) run() {
+	for {
+		select {
+		case <-s.trigger:
+			err := s.prune(s.ctx)
+			if err != nil && s.ctx.Err() == nil {
+				log.WithError(err).Error("Could not prune database")
+			}
+			s.lock.Lock()
+			s.err = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribeToBlocks schedules a pruning run when a processed block advanced the finalized
+// checkpoint past the last pruned epoch.
+func (s *Service) subscribeToBlocks() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			cp, err := s.cfg.BeaconDB.FinalizedCheckpoint(s.ctx)
+			if err != nil {
+				log.WithError(err).Debug("Could not retrieve finalized checkpoint")
+				continue
+			}
+			s.lock.RLock()
+			pruned := s.prunedEpoch
+			s.lock.RUnlock()
+			if cp.Epoch > pruned {
+				s.schedule()
+			}
+		case <-stateSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the blocks and attestations older than the weak subjectivity period and the
+// archived states older than the state window, counting back from the finalized checkpoint.
+func (s *Service) prune(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "pruner.prune")
+	defer span.End()
+
+	cp, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	s.lock.RLock()
+	pruned := s.prunedEpoch
+	s.lock.RUnlock()
+	if cp.Epoch == 0 || cp.Epoch <= pruned {
+		return nil
+	}
+
+	wsPeriod, err := s.weakSubjectivityPeriod(ctx, cp)
+	if err != nil {
+		return err
+	}
+	stateWindow := s.cfg.StateWindow
+	if stateWindow == 0 {
+		stateWindow = wsPeriod
+	}
+	if stateWindow > wsPeriod {
+		return errors.Errorf("state window of %d epochs is larger than the weak subjectivity period of %d epochs", stateWindow, wsPeriod)
+	}
+
+	start := time.Now()
+	var blocks, atts, states int
+	if cp.Epoch > wsPeriod {
+		epoch := cp.Epoch - wsPeriod
+		slot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return err
+		}
+		if blocks, err = s.cfg.BeaconDB.PruneBlocks(ctx, slot); err != nil {
+			return errors.Wrap(err, "could not prune blocks")
+		}
+		prunedBlocksCount.Add(float64(blocks))
+		if atts, err = s.cfg.BeaconDB.PruneAttestations(ctx, epoch); err != nil {
+			return errors.Wrap(err, "could not prune attestations")
+		}
+		prunedAttestationsCount.Add(float64(atts))
+	}
+	if cp.Epoch > stateWindow {
+		slot, err := helpers.StartSlot(cp.Epoch - stateWindow)
+		if err != nil {
+			return err
+		}
+		if states, err = s.cfg.BeaconDB.PruneStates(ctx, slot); err != nil {
+			return errors.Wrap(err, "could not prune states")
+		}
+		prunedStatesCount.Add(float64(states))
+	}
+
+	s.lock.Lock()
+	s.prunedEpoch = cp.Epoch
+	s.lock.Unlock()
+	prunedEpoch.Set(float64(cp.Epoch))
+	if blocks+atts+states > 0 {
+		log.WithFields(logrus.Fields{
+			"finalizedEpoch": cp.Epoch,
+			"blocks":         blocks,
+			"states":         states,
+			"attestations":   atts,
+			"duration":       time.Since(start),
+		}).Info("Pruned finalized data")
+	}
+	return nil
+}
+
+// BlockHorizon returns the slot below which blocks are pruned, which is the start of the weak
+// subjectivity period counting back from the finalized checkpoint. It is zero as long as the
+// finalized checkpoint is within the weak subjectivity period of genesis.
+func (s *Service) BlockHorizon(ctx context.Context) (uint64, error) {
+	cp, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	if cp.Epoch == 0 {
+		return 0, nil
+	}
+	wsPeriod, err := s.weakSubjectivityPeriod(ctx, cp)
+	if err != nil {
+		return 0, err
+	}
+	if cp.Epoch <= wsPeriod {
+		return 0, nil
+	}
+	return helpers.StartSlot(cp.Epoch - wsPeriod)
+}
+
+// weakSubjectivityPeriod computes the weak subjectivity period from the active validator count
+// of the finalized state.
+func (s *Service) weakSubjectivityPeriod(ctx context.Context, cp *ethpb.Checkpoint) (uint64, error) {
+	fState, err := s.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve finalized state")
+	}
+	if fState == nil {
+		return 0, errors.New("finalized state not found")
+	}
+	valCount, err := helpers.ActiveValidatorCount(fState, helpers.CurrentEpoch(fState))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not count active validators")
+	}
+	wsPeriod, err := helpers.WeakSubjectivityCheckptEpoch(valCount)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute weak subjectivity period")
+	}
+	return wsPeriod, nil
+}
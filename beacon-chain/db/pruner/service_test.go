@@ -0,0 +1,142 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func saveBlock(ctx context.Context, t *testing.T, s *Service, slot uint64, parentRoot [32]byte) [32]byte {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, b))
+	return r
+}
+
+func TestService_Prune(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := New(ctx, &Config{
+		BeaconDB:    beaconDB,
+		StateGen:    stategen.New(beaconDB),
+		StateWindow: 10,
+	})
+
+	genesisRoot := saveBlock(ctx, t, s, 0, [32]byte{})
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// With 64 validators, the weak subjectivity period is 256 epochs. Finalizing epoch 300 prunes
+	// the blocks before epoch 44, i.e. slot 1408.
+	oldRoots := make([][32]byte, 2)
+	oldRoots[0] = saveBlock(ctx, t, s, 32, genesisRoot)
+	oldRoots[1] = saveBlock(ctx, t, s, 1407, oldRoots[0])
+	keptRoot := saveBlock(ctx, t, s, 1408, oldRoots[1])
+	fRoot := saveBlock(ctx, t, s, 9600, keptRoot)
+
+	fState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, fState.SetSlot(9600))
+	require.NoError(t, beaconDB.SaveState(ctx, fState, fRoot))
+	// States before epoch 290, i.e. slot 9280, are pruned.
+	oldState := testutil.NewBeaconState()
+	require.NoError(t, oldState.SetSlot(9000))
+	require.NoError(t, beaconDB.SaveState(ctx, oldState, [32]byte{'a'}))
+	keptState := testutil.NewBeaconState()
+	require.NoError(t, keptState.SetSlot(9300))
+	require.NoError(t, beaconDB.SaveState(ctx, keptState, [32]byte{'b'}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 300, Root: fRoot[:]}))
+
+	require.NoError(t, s.prune(ctx))
+	assert.Equal(t, uint64(300), s.prunedEpoch)
+	for _, r := range oldRoots {
+		assert.Equal(t, false, beaconDB.HasBlock(ctx, r), "Block should be pruned")
+	}
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, genesisRoot), "Genesis block should not be pruned")
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, keptRoot), "Block in weak subjectivity period should not be pruned")
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, fRoot), "Finalized block should not be pruned")
+	assert.Equal(t, false, beaconDB.HasState(ctx, [32]byte{'a'}), "State should be pruned")
+	assert.Equal(t, true, beaconDB.HasState(ctx, [32]byte{'b'}), "State in window should not be pruned")
+	assert.Equal(t, true, beaconDB.HasState(ctx, fRoot), "Finalized state should not be pruned")
+}
+
+func TestService_Prune_NotFinalized(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := New(ctx, &Config{
+		BeaconDB: beaconDB,
+		StateGen: stategen.New(beaconDB),
+	})
+	r := saveBlock(ctx, t, s, 1, [32]byte{})
+
+	require.NoError(t, s.prune(ctx))
+	assert.Equal(t, uint64(0), s.prunedEpoch)
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, r))
+}
+
+func TestService_Prune_StateWindowTooLarge(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := New(ctx, &Config{
+		BeaconDB:    beaconDB,
+		StateGen:    stategen.New(beaconDB),
+		StateWindow: 257,
+	})
+	genesisRoot := saveBlock(ctx, t, s, 0, [32]byte{})
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	oldRoot := saveBlock(ctx, t, s, 32, genesisRoot)
+	fRoot := saveBlock(ctx, t, s, 9600, oldRoot)
+	fState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, fState.SetSlot(9600))
+	require.NoError(t, beaconDB.SaveState(ctx, fState, fRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 300, Root: fRoot[:]}))
+
+	// With 64 validators, the weak subjectivity period is 256 epochs.
+	err := s.prune(ctx)
+	assert.ErrorContains(t, "state window of 257 epochs is larger than the weak subjectivity period of 256 epochs", err)
+	assert.Equal(t, uint64(0), s.prunedEpoch)
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, oldRoot), "Block should not be pruned")
+}
+
+func TestService_BlockHorizon(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := New(ctx, &Config{
+		BeaconDB: beaconDB,
+		StateGen: stategen.New(beaconDB),
+	})
+	horizon, err := s.BlockHorizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), horizon, "Nothing is pruned before finality")
+
+	genesisRoot := saveBlock(ctx, t, s, 0, [32]byte{})
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	fRoot := saveBlock(ctx, t, s, 9600, genesisRoot)
+	fState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, fState.SetSlot(9600))
+	require.NoError(t, beaconDB.SaveState(ctx, fState, fRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 100, Root: fRoot[:]}))
+	horizon, err = s.BlockHorizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), horizon, "Nothing is pruned within the weak subjectivity period of genesis")
+
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 300, Root: fRoot[:]}))
+	horizon, err = s.BlockHorizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1408), horizon)
+}
+
+func TestService_Schedule(t *testing.T) {
+	s := New(context.Background(), &Config{})
+	s.schedule()
+	// Scheduling while a run is pending must not block.
+	s.schedule()
+	assert.Equal(t, 1, len(s.trigger))
+	require.NoError(t, s.Stop())
+}
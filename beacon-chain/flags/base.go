@@ -106,6 +106,129 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// DBBackend specifies the storage backend of the beacon node database.
+	DBBackend = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "Storage backend of the beacon node database, bolt or badger. An existing database can be " +
+			"converted to another backend with the db convert command.",
+		Value: "bolt",
+	}
+	// StateDiffSnapshotInterval specifies the number of archived states between two full state snapshots in the DB.
+	StateDiffSnapshotInterval = &cli.Uint64Flag{
+		Name: "state-diff-snapshot-interval",
+		Usage: "Stores a full snapshot of every n-th archived state in the DB and the archived states in between " +
+			"as diffs against the previous archived state, to reduce the size of the DB. Archived states are all " +
+			"stored in full when set to 0.",
+		Value: 0,
+	}
+	// EnableDBPruning enables pruning of finalized data which is older than the retention windows.
+	EnableDBPruning = &cli.BoolFlag{
+		Name: "db-pruning",
+		Usage: "Deletes finalized blocks and attestations older than the weak subjectivity period, as well as " +
+			"archived states older than --db-pruning-state-window, in the background after finalization. " +
+			"Historical data older than these windows can no longer be served by a node running with pruning.",
+	}
+	// DBPruningStateWindow specifies the number of finalized epochs of archived states kept when pruning is enabled.
+	DBPruningStateWindow = &cli.Uint64Flag{
+		Name: "db-pruning-state-window",
+		Usage: "Number of epochs before the finalized epoch for which archived states are kept when --db-pruning " +
+			"is enabled. Archived states are kept for the weak subjectivity period when set to 0, which is also the largest " +
+			"allowed window.",
+		Value: 0,
+	}
+	// ExportFileDir specifies the directory the database objects are exported to.
+	ExportFileDir = &cli.StringFlag{
+		Name:  "export-file-dir",
+		Usage: "Exports the database objects to rotating files in the directory.",
+	}
+	// ExportFileFormat specifies the format of the export files.
+	ExportFileFormat = &cli.StringFlag{
+		Name:  "export-file-format",
+		Usage: "Format of the export files, ndjson or ssz.",
+		Value: "ndjson",
+	}
+	// ExportFileMaxSize specifies the size of the export files after which a new file is started.
+	ExportFileMaxSize = &cli.Uint64Flag{
+		Name:  "export-file-max-size-mb",
+		Usage: "Size of an export file in megabytes after which a new file is started.",
+		Value: 256,
+	}
+	// ExportWebhookURL specifies the HTTP endpoint the database objects are posted to.
+	ExportWebhookURL = &cli.StringFlag{
+		Name:  "export-webhook-url",
+		Usage: "Exports the database objects to the HTTP endpoint, as NDJSON POST requests.",
+	}
+	// ExportKafkaServers specifies the Kafka bootstrap servers the database objects are published to.
+	ExportKafkaServers = &cli.StringFlag{
+		Name: "export-kafka-servers",
+		Usage: "Exports the database objects to Kafka, using the comma separated list of bootstrap servers. " +
+			"Requires a build with the kafka_enabled tag.",
+	}
+	// ExportTypes specifies the types of the exported objects.
+	ExportTypes = &cli.StringSliceFlag{
+		Name: "export-types",
+		Usage: "Types of the exported objects: blocks, attestations, deposits, voluntary_exits, proposer_slashings " +
+			"and attester_slashings.",
+		Value: cli.NewStringSlice("blocks", "attestations"),
+	}
+	// ExportReplayFromSlot resets the export cursors to the given slot.
+	ExportReplayFromSlot = &cli.Uint64Flag{
+		Name: "export-replay-from-slot",
+		Usage: "Exports the database objects again from the slot. Each sink resumes from the last delivered slot " +
+			"otherwise, or starts from the finalized slot when it was never used before. Only finalized canonical blocks " +
+			"are exported.",
+	}
+	// StateCacheBudget specifies the memory budget of the state caches.
+	StateCacheBudget = &cli.Uint64Flag{
+		Name: "state-cache-budget-mb",
+		Usage: "Memory budget in megabytes of the hot, epoch boundary and checkpoint state caches. States are " +
+			"evicted across the caches by regeneration cost and recency once the budget is exceeded.",
+		Value: 2048,
+	}
+	// HistoricalStateReplayBudget specifies the number of slots which may be replayed at once to regenerate historical states.
+	HistoricalStateReplayBudget = &cli.Uint64Flag{
+		Name: "historical-state-replay-budget",
+		Usage: "Number of slots which may be replayed at once to regenerate historical states. Requests which do not " +
+			"fit next to the running replays are queued, and requests for states further than this from any stored " +
+			"state are rejected. 0 disables the limit.",
+		Value: 8192,
+	}
+	// ValidatorPerformanceIndex enables the precomputed validator performance index.
+	ValidatorPerformanceIndex = &cli.BoolFlag{
+		Name: "validator-performance-index",
+		Usage: "Stores the performance of every validator at each epoch transition, serving the validator " +
+			"performance and participation history endpoints without regenerating historical states.",
+	}
+	// AttestationTrackerIndices specifies the validators whose attestations are tracked.
+	AttestationTrackerIndices = &cli.Int64SliceFlag{
+		Name: "attestation-tracker-indices",
+		Usage: "Indices of the validators whose attestations are tracked on gossip and in blocks. Their first " +
+			"seen time, inclusion distance and head and target votes are exposed as metrics and over RPC.",
+	}
+	// AttestationTrackerWindow specifies the number of epochs of attestation records kept by the tracker.
+	AttestationTrackerWindow = &cli.Uint64Flag{
+		Name:  "attestation-tracker-window",
+		Usage: "Number of epochs of attestation records kept in the database by the attestation tracker.",
+		Value: 225,
+	}
+	// PubsubTraceDir specifies the directory the gossipsub trace events are written to.
+	PubsubTraceDir = &cli.StringFlag{
+		Name: "pubsub-trace-dir",
+		Usage: "Writes every gossipsub trace event to rotating files in the given directory, for offline " +
+			"analysis with the pubsub-trace-summary tool.",
+	}
+	// PubsubTraceMaxFileSize specifies the size in megabytes at which gossipsub trace files are rotated.
+	PubsubTraceMaxFileSize = &cli.Uint64Flag{
+		Name:  "pubsub-trace-max-file-size",
+		Usage: "Size in megabytes at which a gossipsub trace file is rotated.",
+		Value: 100,
+	}
+	// PubsubTraceMaxFiles specifies the number of gossipsub trace files kept on disk.
+	PubsubTraceMaxFiles = &cli.IntFlag{
+		Name:  "pubsub-trace-max-files",
+		Usage: "Number of gossipsub trace files kept on disk, the oldest being deleted on rotation.",
+		Value: 10,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -123,6 +246,13 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 10,
 	}
+	// RateLimitPolicy specifies the file of the rate limit policies of the RPC protocols.
+	RateLimitPolicy = &cli.StringFlag{
+		Name: "rate-limit-policy",
+		Usage: "YAML file setting the rate and capacity of the RPC rate limits of each protocol, for all peers " +
+			"or for trusted, inbound and outbound peers. The file is reloaded when it changes, each reload " +
+			"resetting the rate limits of every peer to a full capacity.",
+	}
 	// DisableSync disables a node from syncing at start-up. Instead the node enters regular sync
 	// immediately.
 	DisableSync = &cli.BoolFlag{
@@ -160,6 +290,23 @@ var (
 			"If such a sync is not possible, the node will treat it a critical and irrecoverable failure",
 		Value: "",
 	}
+	// CheckpointState defines the path to an SSZ encoded finalized beacon state used to start the node from a checkpoint.
+	CheckpointState = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Path to an SSZ encoded finalized beacon state to start syncing from instead of genesis. " +
+			"Must be used together with --checkpoint-block and is ignored if the database already contains a chain.",
+	}
+	// CheckpointBlock defines the path to the SSZ encoded signed block which produced the checkpoint state.
+	CheckpointBlock = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "Path to the SSZ encoded signed beacon block which produced the state given by --checkpoint-state.",
+	}
+	// CheckpointSyncURL defines the gRPC endpoint of a trusted beacon node to fetch the finalized checkpoint from.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "gRPC endpoint of a trusted beacon node running with --enable-debug-rpc-endpoints. Its latest finalized " +
+			"state and block are used to start syncing instead of genesis. Ignored if the database already contains a chain.",
+	}
 	// Eth1HeaderReqLimit defines a flag to set the maximum number of headers that a deposit log query can fetch. If none is set, 1000 will be the limit.
 	Eth1HeaderReqLimit = &cli.Uint64Flag{
 		Name:  "eth1-header-req-limit",
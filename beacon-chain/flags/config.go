@@ -15,6 +15,7 @@ type GlobalFlags struct {
 	MinimumSyncPeers           int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	RateLimitPolicyFile        string
 }
 
 var globalConfig *GlobalFlags
@@ -51,6 +52,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.RateLimitPolicyFile = ctx.String(RateLimitPolicy.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -43,5 +43,6 @@ type Getter interface {
 	Store() *protoarray.Store
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot uint64) ([]byte, error)
+	CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, uint64, error)
 	IsCanonical(root [32]byte) bool
 }
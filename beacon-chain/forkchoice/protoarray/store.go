@@ -200,6 +200,47 @@ func (f *ForkChoice) AncestorRoot(ctx context.Context, root [32]byte, slot uint6
 	return f.store.nodes[i].root[:], nil
 }
 
+// CommonAncestorRoot returns the root and slot of the closest common ancestor of the two input
+// block roots. A block is its own ancestor, so the common ancestor of a block and one of its
+// descendants is the block itself.
+func (f *ForkChoice) CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArray.CommonAncestorRoot")
+	defer span.End()
+
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	i1, ok := f.store.nodesIndices[r1]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+	i2, ok := f.store.nodesIndices[r2]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+
+	// A node is always inserted after its parent, so walking up from the node with the
+	// higher index reaches the common ancestor.
+	for i1 != i2 {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		if i1 >= uint64(len(f.store.nodes)) || i2 >= uint64(len(f.store.nodes)) {
+			return [32]byte{}, 0, errNoCommonAncestor
+		}
+		if i1 > i2 {
+			i1 = f.store.nodes[i1].parent
+		} else {
+			i2 = f.store.nodes[i2].parent
+		}
+	}
+	if i1 >= uint64(len(f.store.nodes)) {
+		return [32]byte{}, 0, errNoCommonAncestor
+	}
+
+	return f.store.nodes[i1].root, f.store.nodes[i1].slot, nil
+}
+
 // PruneThreshold of fork choice store.
 func (s *Store) PruneThreshold() uint64 {
 	return s.pruneThreshold
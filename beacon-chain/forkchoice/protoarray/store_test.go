@@ -535,6 +535,52 @@ func TestStore_AncestorRootOutOfBound(t *testing.T) {
 	require.ErrorContains(t, "node index out of range", err)
 }
 
+func TestStore_CommonAncestorRoot(t *testing.T) {
+	ctx := context.Background()
+	f := &ForkChoice{store: &Store{}}
+	f.store.nodesIndices = map[[32]byte]uint64{
+		{'a'}: 0,
+		{'b'}: 1,
+		{'c'}: 2,
+		{'d'}: 3,
+		{'e'}: 4,
+	}
+	// a <- b <- c <- e
+	//      ^
+	//      d
+	f.store.nodes = []*Node{
+		{slot: 1, root: [32]byte{'a'}, parent: NonExistentNode},
+		{slot: 2, root: [32]byte{'b'}, parent: 0},
+		{slot: 3, root: [32]byte{'c'}, parent: 1},
+		{slot: 4, root: [32]byte{'d'}, parent: 1},
+		{slot: 5, root: [32]byte{'e'}, parent: 2},
+	}
+
+	r, slot, err := f.CommonAncestorRoot(ctx, [32]byte{'d'}, [32]byte{'e'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'b'}, r)
+	assert.Equal(t, uint64(2), slot)
+
+	r, slot, err = f.CommonAncestorRoot(ctx, [32]byte{'e'}, [32]byte{'d'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'b'}, r)
+	assert.Equal(t, uint64(2), slot)
+
+	r, slot, err = f.CommonAncestorRoot(ctx, [32]byte{'c'}, [32]byte{'e'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'c'}, r)
+	assert.Equal(t, uint64(3), slot)
+
+	_, _, err = f.CommonAncestorRoot(ctx, [32]byte{'c'}, [32]byte{'z'})
+	assert.ErrorContains(t, "node does not exist", err)
+
+	// Two chains with different roots have no common ancestor.
+	f.store.nodesIndices[[32]byte{'f'}] = 5
+	f.store.nodes = append(f.store.nodes, &Node{slot: 2, root: [32]byte{'f'}, parent: NonExistentNode})
+	_, _, err = f.CommonAncestorRoot(ctx, [32]byte{'e'}, [32]byte{'f'})
+	assert.ErrorContains(t, errNoCommonAncestor.Error(), err)
+}
+
 func TestStore_UpdateCanonicalNodes_WholeList(t *testing.T) {
 	ctx := context.Background()
 	f := &ForkChoice{store: &Store{}}
@@ -0,0 +1,110 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"google.golang.org/grpc/status"
+)
+
+// specPathPrefix is the path prefix of the standard beacon node API.
+const specPathPrefix = "/eth/v1/"
+
+// poolAttestationsPath is the path of the attestation pool endpoint of the standard API.
+const poolAttestationsPath = "/eth/v1/beacon/pool/attestations"
+
+// identifierSegments are the path segments followed by a state, block or validator
+// identifier in the beacon endpoints of the standard API.
+var identifierSegments = map[string]bool{
+	"states":     true,
+	"headers":    true,
+	"blocks":     true,
+	"validators": true,
+}
+
+// encodeIdentifiers base64 encodes the state, block and validator identifiers of the beacon
+// endpoints of the standard API, and the hex encoded roots of their queries. The gateway
+// decodes the request fields of type bytes as base64, while these identifiers are either
+// names, such as head, decimal numbers or hex strings.
+func encodeIdentifiers(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/") && !strings.HasPrefix(r.URL.Path, "/eth/v1/debug/beacon/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		segments := strings.Split(r.URL.Path, "/")
+		for i := 1; i < len(segments); i++ {
+			if identifierSegments[segments[i-1]] && segments[i] != "" {
+				segments[i] = base64.URLEncoding.EncodeToString([]byte(segments[i]))
+			}
+		}
+		r.URL.Path = strings.Join(segments, "/")
+		r.URL.RawPath = ""
+
+		query := r.URL.Query()
+		if ids, ok := query["id"]; ok && strings.HasSuffix(r.URL.Path, "/validators") {
+			var encoded []string
+			for _, param := range ids {
+				for _, id := range strings.Split(param, ",") {
+					encoded = append(encoded, base64.URLEncoding.EncodeToString([]byte(id)))
+				}
+			}
+			query["id"] = encoded
+		}
+		if root := query.Get("parent_root"); root != "" {
+			decoded, err := hexutil.Decode(root)
+			if err != nil {
+				writeSpecError(w, http.StatusBadRequest, fmt.Sprintf("Invalid parent root %s", root))
+				return
+			}
+			query.Set("parent_root", base64.URLEncoding.EncodeToString(decoded))
+		}
+		r.URL.RawQuery = query.Encode()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// poolAttestationsHandler submits every attestation of the array sent to the attestation
+// pool endpoint of the standard API, as the beacon chain service accepts a single
+// attestation per request. Other requests are served by next.
+func poolAttestationsHandler(client ethpbv1.BeaconChainClient, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeSpecError(w, http.StatusBadRequest, "Could not read request body")
+			return
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(body, &items); err != nil {
+			writeSpecError(w, http.StatusBadRequest, "Request body must be an array of attestations")
+			return
+		}
+		var failures []string
+		for i, item := range items {
+			att := &ethpbv1.Attestation{}
+			if err := apiutil.UnmarshalSpec(item, att); err != nil {
+				failures = append(failures, fmt.Sprintf("attestation %d: %v", i, err))
+				continue
+			}
+			if _, err := client.SubmitAttestation(r.Context(), att); err != nil {
+				failures = append(failures, fmt.Sprintf("attestation %d: %s", i, status.Convert(err).Message()))
+			}
+		}
+		if len(failures) > 0 {
+			writeSpecError(w, http.StatusBadRequest, "Could not submit attestations: "+strings.Join(failures, "; "))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,164 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockBeaconChainServer struct {
+	ethpbv1.BeaconChainServer
+	stateID     []byte
+	ids         [][]byte
+	slotIsSet   bool
+	attestation *ethpbv1.Attestation
+}
+
+func (m *mockBeaconChainServer) GetFinalityCheckpoints(_ context.Context, req *ethpbv1.StateRequest) (*ethpbv1.StateFinalityCheckpointResponse, error) {
+	m.stateID = req.StateId
+	return &ethpbv1.StateFinalityCheckpointResponse{
+		PreviousJustified: &ethpbv1.Checkpoint{Epoch: 1, Root: []byte{0x01}},
+		CurrentJustified:  &ethpbv1.Checkpoint{Epoch: 2, Root: []byte{0x02}},
+		Finalized:         &ethpbv1.Checkpoint{Epoch: 0, Root: []byte{0x00}},
+	}, nil
+}
+
+func (m *mockBeaconChainServer) ListValidators(_ context.Context, req *ethpbv1.StateValidatorsRequest) (*ethpbv1.StateValidatorsResponse, error) {
+	m.stateID = req.StateId
+	m.ids = req.Id
+	return &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{Index: 3, Status: "active_ongoing", Validator: &ethpbv1.Validator{PublicKey: []byte{0xab}}}},
+	}, nil
+}
+
+func (m *mockBeaconChainServer) ListCommittees(ctx context.Context, req *ethpbv1.StateCommitteesRequest) (*ethpbv1.StateCommitteesResponse, error) {
+	m.stateID = req.StateId
+	m.slotIsSet = grpcutils.IsQueryParamSet(ctx, "slot")
+	return &ethpbv1.StateCommitteesResponse{}, nil
+}
+
+func (m *mockBeaconChainServer) SubmitAttestation(_ context.Context, att *ethpbv1.Attestation) (*empty.Empty, error) {
+	if att.Data.Slot == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid slot")
+	}
+	m.attestation = att
+	return &empty.Empty{}, nil
+}
+
+type mockBeaconChainClient struct {
+	ethpbv1.BeaconChainClient
+	server *mockBeaconChainServer
+}
+
+func (m *mockBeaconChainClient) SubmitAttestation(ctx context.Context, att *ethpbv1.Attestation, _ ...grpc.CallOption) (*empty.Empty, error) {
+	return m.server.SubmitAttestation(ctx, att)
+}
+
+func newSpecTestHandler(t *testing.T, server *mockBeaconChainServer) http.Handler {
+	mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &specMarshaler{}),
+		gwruntime.WithProtoErrorHandler(specErrorHandler),
+		gwruntime.WithMetadata(grpcutils.QueryParamsMetadata),
+	)
+	require.NoError(t, ethpbv1.RegisterBeaconChainHandlerServer(context.Background(), mux, server))
+	return encodeIdentifiers(wrapArrayBodies(mux))
+}
+
+func TestEncodeIdentifiers_StateID(t *testing.T) {
+	server := &mockBeaconChainServer{}
+	handler := newSpecTestHandler(t, server)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/states/head/finality_checkpoints", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "head", string(server.stateID))
+	assert.Equal(t, `{"data":{"current_justified":{"epoch":"2","root":"0x02"},"finalized":{"epoch":"0","root":"0x00"},`+
+		`"previous_justified":{"epoch":"1","root":"0x01"}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/states/12/committees/0?slot=0", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "12", string(server.stateID))
+	assert.Equal(t, true, server.slotIsSet, "Expected the query parameters to be passed as metadata")
+}
+
+func TestEncodeIdentifiers_ValidatorIDs(t *testing.T) {
+	server := &mockBeaconChainServer{}
+	handler := newSpecTestHandler(t, server)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/states/finalized/validators?id=3,0xab&id=5", nil)
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "finalized", string(server.stateID))
+	assert.DeepEqual(t, [][]byte{[]byte("3"), []byte("0xab"), []byte("5")}, server.ids)
+	assert.Equal(t, `{"data":[{"balance":"0","index":"3","status":"active_ongoing","validator":{"activation_eligibility_epoch":"0",`+
+		`"activation_epoch":"0","effective_balance":"0","exit_epoch":"0","pubkey":"0xab","slashed":false,`+
+		`"withdrawable_epoch":"0","withdrawal_credentials":"0x"}}]}`, rec.Body.String())
+}
+
+func TestEncodeIdentifiers_InvalidParentRoot(t *testing.T) {
+	handler := newSpecTestHandler(t, &mockBeaconChainServer{})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/headers?parent_root=foo", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, `{"code":400,"message":"Invalid parent root foo"}`+"\n", rec.Body.String())
+}
+
+func TestPoolAttestationsHandler(t *testing.T) {
+	server := &mockBeaconChainServer{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler := poolAttestationsHandler(&mockBeaconChainClient{server: server}, next)
+	att := `{"aggregation_bits":"0x03","data":{"slot":"%s","index":"1","beacon_block_root":"0x00",` +
+		`"source":{"epoch":"0","root":"0x00"},"target":{"epoch":"0","root":"0x00"}},"signature":"0x00"}`
+
+	rec := httptest.NewRecorder()
+	body := "[" + strings.Replace(att, "%s", "3", 1) + "]"
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, poolAttestationsPath, bytes.NewBufferString(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NotNil(t, server.attestation)
+	assert.Equal(t, uint64(3), server.attestation.Data.Slot)
+	assert.Equal(t, uint64(1), server.attestation.Data.CommitteeIndex)
+
+	rec = httptest.NewRecorder()
+	body = "[" + strings.Replace(att, "%s", "4", 1) + "," + strings.Replace(att, "%s", "0", 1) + "]"
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, poolAttestationsPath, bytes.NewBufferString(body)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, `{"code":400,"message":"Could not submit attestations: attestation 1: invalid slot"}`+"\n", rec.Body.String())
+	assert.Equal(t, uint64(4), server.attestation.Data.Slot)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, poolAttestationsPath, nil))
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+}
+
+func TestSpecMarshaler_Envelope(t *testing.T) {
+	data, err := (&specMarshaler{}).Marshal(&ethpbv1.GenesisResponse{
+		GenesisTime:           &timestamp.Timestamp{Seconds: 1606824023},
+		GenesisValidatorsRoot: []byte{0x4b, 0x36},
+		GenesisForkVersion:    []byte{0, 0, 0, 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"data":{"genesis_fork_version":"0x00000000","genesis_time":"1606824023","genesis_validators_root":"0x4b36"}}`, string(data))
+
+	data, err = (&specMarshaler{}).Marshal(&ethpbv1.StateRootResponse{StateRoot: []byte{0x01}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"data":{"root":"0x01"}}`, string(data))
+}
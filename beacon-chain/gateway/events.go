@@ -0,0 +1,118 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventsPath is the path of the server-sent events endpoint of the standard beacon node API.
+const eventsPath = "/eth/v1/events"
+
+// eventTopics are the topics which can be requested from the events endpoint.
+var eventTopics = map[string]bool{
+	"head":                 true,
+	"block":                true,
+	"attestation":          true,
+	"voluntary_exit":       true,
+	"finalized_checkpoint": true,
+	"chain_reorg":          true,
+}
+
+// eventsHandler serves the events streamed by the beacon node as server-sent events. Topics
+// are requested through the topics query parameter, either repeated or comma separated, and
+// every topic is streamed if none is requested.
+func eventsHandler(client pbrpc.EventsClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		var topics []string
+		for _, param := range r.URL.Query()["topics"] {
+			for _, topic := range strings.Split(param, ",") {
+				if !eventTopics[topic] {
+					http.Error(w, fmt.Sprintf("Topic %s is not supported", topic), http.StatusBadRequest)
+					return
+				}
+				topics = append(topics, topic)
+			}
+		}
+
+		stream, err := client.StreamEvents(r.Context(), &pbrpc.StreamEventsRequest{Topics: topics})
+		if err != nil {
+			log.WithError(err).Error("Could not open event stream")
+			http.Error(w, "Could not open event stream", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if status.Code(err) != codes.Canceled && err != io.EOF {
+					log.WithError(err).Debug("Event stream closed")
+				}
+				return
+			}
+			topic, data, err := eventData(res)
+			if err != nil {
+				log.WithError(err).Error("Could not marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// eventData returns the topic and the data of an event, encoded as the standard API types.
+func eventData(res *pbrpc.EventsResponse) (string, []byte, error) {
+	var topic string
+	var msg interface{}
+	var err error
+	switch e := res.Event.(type) {
+	case *pbrpc.EventsResponse_Head:
+		topic, msg = "head", e.Head
+	case *pbrpc.EventsResponse_Block:
+		topic, msg = "block", e.Block
+	case *pbrpc.EventsResponse_Attestation:
+		topic = "attestation"
+		msg, err = migration.V1Alpha1AttestationToV1(e.Attestation)
+	case *pbrpc.EventsResponse_VoluntaryExit:
+		topic = "voluntary_exit"
+		msg, err = migration.V1Alpha1ExitToV1(e.VoluntaryExit)
+	case *pbrpc.EventsResponse_FinalizedCheckpoint:
+		topic, msg = "finalized_checkpoint", e.FinalizedCheckpoint
+	case *pbrpc.EventsResponse_ChainReorg:
+		topic, msg = "chain_reorg", e.ChainReorg
+	default:
+		return "", nil, fmt.Errorf("unknown event type %T", res.Event)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := apiutil.MarshalSpec(msg)
+	if err != nil {
+		return "", nil, err
+	}
+	return topic, data, nil
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockEventsClient struct {
+	req    *pbrpc.StreamEventsRequest
+	events []*pbrpc.EventsResponse
+}
+
+func (m *mockEventsClient) StreamEvents(_ context.Context, req *pbrpc.StreamEventsRequest, _ ...grpc.CallOption) (pbrpc.Events_StreamEventsClient, error) {
+	m.req = req
+	return &mockEventsStreamClient{events: m.events}, nil
+}
+
+type mockEventsStreamClient struct {
+	grpc.ClientStream
+	events []*pbrpc.EventsResponse
+}
+
+func (m *mockEventsStreamClient) Recv() (*pbrpc.EventsResponse, error) {
+	if len(m.events) == 0 {
+		return nil, io.EOF
+	}
+	res := m.events[0]
+	m.events = m.events[1:]
+	return res, nil
+}
+
+func TestEventsHandler(t *testing.T) {
+	client := &mockEventsClient{
+		events: []*pbrpc.EventsResponse{
+			{Event: &pbrpc.EventsResponse_Head{Head: &pbrpc.EventHead{Slot: 5, EpochTransition: true}}},
+			{Event: &pbrpc.EventsResponse_ChainReorg{ChainReorg: &pbrpc.EventChainReorg{Slot: 4, Depth: 2}}},
+			{Event: &pbrpc.EventsResponse_Attestation{Attestation: &ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0x03},
+				Data: &ethpb.AttestationData{
+					Slot:            3,
+					CommitteeIndex:  1,
+					BeaconBlockRoot: bytesutil.PadTo([]byte{0xab}, 32),
+					Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+					Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+				},
+				Signature: make([]byte, 96),
+			}}},
+		},
+	}
+	req := httptest.NewRequest(http.MethodGet, eventsPath+"?topics=head,chain_reorg&topics=block", nil)
+	rec := httptest.NewRecorder()
+	eventsHandler(client)(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.DeepEqual(t, []string{"head", "chain_reorg", "block"}, client.req.Topics)
+	zeroRoot := "0x" + strings.Repeat("00", 32)
+	want := "event: head\n" +
+		`data: {"block":"0x","epoch_transition":true,"slot":"5","state":"0x"}` + "\n\n" +
+		"event: chain_reorg\n" +
+		`data: {"depth":"2","epoch":"0","new_head_block":"0x","new_head_state":"0x","old_head_block":"0x","old_head_state":"0x","slot":"4"}` + "\n\n" +
+		"event: attestation\n" +
+		`data: {"aggregation_bits":"0x03","data":{"beacon_block_root":"0xab` + strings.Repeat("00", 31) + `","index":"1",` +
+		`"slot":"3","source":{"epoch":"0","root":"` + zeroRoot + `"},"target":{"epoch":"1","root":"` + zeroRoot + `"}},` +
+		`"signature":"0x` + strings.Repeat("00", 96) + `"}` + "\n\n"
+	assert.Equal(t, want, rec.Body.String())
+}
+
+func TestEventsHandler_UnsupportedTopic(t *testing.T) {
+	client := &mockEventsClient{}
+	req := httptest.NewRequest(http.MethodGet, eventsPath+"?topics=head,foo", nil)
+	rec := httptest.NewRecorder()
+	eventsHandler(client)(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, true, client.req == nil, "Expected no stream to be opened")
+}
@@ -12,7 +12,9 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 )
@@ -63,6 +65,9 @@ func (g *Gateway) Start() {
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
 		pbrpc.RegisterHealthHandler,
+		pbrpc.RegisterValidatorHistoryHandler,
+		pbrpc.RegisterValidatorRewardsHandler,
+		pbrpc.RegisterAttestationTrackerHandler,
 	}
 	if g.enableDebugRPCEndpoints {
 		handlers = append(handlers, pbrpc.RegisterDebugHandler)
@@ -75,6 +80,30 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	// The endpoints of the standard API encode integers as strings and bytes as hex, and pass
+	// the query parameters of the requests so that zero values can be told from unset ones.
+	specMux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &specMarshaler{}),
+		gwruntime.WithProtoErrorHandler(specErrorHandler),
+		gwruntime.WithMetadata(grpcutils.QueryParamsMetadata),
+	)
+	specHandlers := []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
+		ethpbv1.RegisterBeaconChainHandler,
+		ethpbv1.RegisterBeaconNodeHandler,
+		pbrpc.RegisterBeaconValidatorHandler,
+	}
+	for _, f := range specHandlers {
+		if err := f(ctx, specMux, conn); err != nil {
+			log.WithError(err).Error("Failed to start gateway")
+			g.startFailure = err
+			return
+		}
+	}
+	specHandler := encodeIdentifiers(wrapArrayBodies(specMux))
+
+	g.mux.Handle(eventsPath, eventsHandler(pbrpc.NewEventsClient(conn)))
+	g.mux.Handle(poolAttestationsPath, poolAttestationsHandler(ethpbv1.NewBeaconChainClient(conn), specHandler))
+	g.mux.Handle(specPathPrefix, specHandler)
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
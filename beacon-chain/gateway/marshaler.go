@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"google.golang.org/grpc/status"
+)
+
+// specMarshaler encodes the messages served by the gateway following the standard beacon node
+// API, with integers as decimal strings and bytes as 0x prefixed hex strings.
+type specMarshaler struct{}
+
+var _ gwruntime.Marshaler = (*specMarshaler)(nil)
+
+// Marshal encodes v following the standard API.
+func (*specMarshaler) Marshal(v interface{}) ([]byte, error) {
+	return apiutil.MarshalSpec(specResponse(v))
+}
+
+// specEnvelope wraps the data of a response of the standard API.
+type specEnvelope struct {
+	Data interface{} `json:"data"`
+}
+
+// specGenesis is the data of the genesis response of the standard API.
+type specGenesis struct {
+	GenesisTime           uint64 `json:"genesis_time"`
+	GenesisValidatorsRoot []byte `json:"genesis_validators_root"`
+	GenesisForkVersion    []byte `json:"genesis_fork_version"`
+}
+
+// specStateRoot is the data of the state root response of the standard API.
+type specStateRoot struct {
+	Root []byte `json:"root"`
+}
+
+// specResponse returns the standard API shape of the responses whose messages do not wrap
+// their data in a data field, and v itself for every other message.
+func specResponse(v interface{}) interface{} {
+	switch res := v.(type) {
+	case *ethpbv1.GenesisResponse:
+		return &specEnvelope{Data: &specGenesis{
+			GenesisTime:           uint64(res.GenesisTime.GetSeconds()),
+			GenesisValidatorsRoot: res.GenesisValidatorsRoot,
+			GenesisForkVersion:    res.GenesisForkVersion,
+		}}
+	case *ethpbv1.StateRootResponse:
+		return &specEnvelope{Data: &specStateRoot{Root: res.StateRoot}}
+	case *ethpbv1.StateForkResponse:
+		return &specEnvelope{Data: res.Fork}
+	case *ethpbv1.StateFinalityCheckpointResponse:
+		return &specEnvelope{Data: res}
+	default:
+		return v
+	}
+}
+
+// Unmarshal decodes data encoded following the standard API into v.
+func (*specMarshaler) Unmarshal(data []byte, v interface{}) error {
+	return apiutil.UnmarshalSpec(data, v)
+}
+
+// NewDecoder returns a decoder reading values encoded following the standard API from r.
+func (m *specMarshaler) NewDecoder(r io.Reader) gwruntime.Decoder {
+	return gwruntime.DecoderFunc(func(v interface{}) error {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return m.Unmarshal(data, v)
+	})
+}
+
+// NewEncoder returns an encoder writing values encoded following the standard API to w.
+func (m *specMarshaler) NewEncoder(w io.Writer) gwruntime.Encoder {
+	return gwruntime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+}
+
+// ContentType of the standard API.
+func (*specMarshaler) ContentType() string {
+	return "application/json"
+}
+
+// specError is the body of the error responses of the standard API.
+type specError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// specErrorHandler writes gRPC errors as the error responses of the standard API.
+func specErrorHandler(_ context.Context, _ *gwruntime.ServeMux, _ gwruntime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	writeSpecError(w, gwruntime.HTTPStatusFromCode(status.Code(err)), status.Convert(err).Message())
+}
+
+// writeSpecError writes an error response of the standard API.
+func writeSpecError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(&specError{Code: code, Message: message}); err != nil {
+		log.WithError(err).Debug("Could not write error response")
+	}
+}
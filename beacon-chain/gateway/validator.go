@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+)
+
+// wrapArrayBodies wraps the JSON array sent as the body of a standard API request in the data
+// field of the request message, as the gateway can only decode request bodies into messages.
+func wrapArrayBodies(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Body == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Could not read request body", http.StatusBadRequest)
+			return
+		}
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+			body = append(append([]byte(`{"data":`), trimmed...), '}')
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		next.ServeHTTP(w, r)
+	})
+}
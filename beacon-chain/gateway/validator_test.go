@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWrapArrayBodies(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		want   string
+	}{
+		{
+			name:   "array body is wrapped",
+			method: http.MethodPost,
+			body:   ` [{"slot":"1"}]`,
+			want:   `{"data":[{"slot":"1"}]}`,
+		},
+		{
+			name:   "object body is unchanged",
+			method: http.MethodPost,
+			body:   `{"data":[]}`,
+			want:   `{"data":[]}`,
+		},
+		{
+			name:   "get request is unchanged",
+			method: http.MethodGet,
+			body:   `[]`,
+			want:   `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []byte
+			handler := wrapArrayBodies(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var err error
+				got, err = ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, int64(len(got)), r.ContentLength)
+			}))
+			req := httptest.NewRequest(tt.method, specPathPrefix+"validator/beacon_committee_subscriptions", bytes.NewBufferString(tt.body))
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestSpecMarshaler(t *testing.T) {
+	m := &specMarshaler{}
+	res := &pbrpc.ProposerDutiesResponse{
+		Data: []*pbrpc.ProposerDuty{{Pubkey: []byte{0x01, 0x02}, ValidatorIndex: 3, Slot: 4}},
+	}
+	data, err := m.Marshal(res)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data":[{"pubkey":"0x0102","slot":"4","validator_index":"3"}]}`, string(data))
+
+	decoded := &pbrpc.ProposerDutiesResponse{}
+	require.NoError(t, m.NewDecoder(bytes.NewReader(data)).Decode(decoded))
+	assert.DeepEqual(t, res, decoded)
+}
+
+func TestSpecErrorHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, specPathPrefix+"validator/duties/proposer/1", nil)
+	specErrorHandler(context.Background(), nil, &specMarshaler{}, rec, req, status.Error(codes.NotFound, "no duties"))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, `{"code":404,"message":"no duties"}`+"\n", rec.Body.String())
+}
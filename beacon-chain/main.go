@@ -48,17 +48,40 @@ var appFlags = []cli.Flag{
 	flags.DisableDiscv5,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.RateLimitPolicy,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateDiffSnapshotInterval,
+	flags.DBBackend,
+	flags.EnableDBPruning,
+	flags.DBPruningStateWindow,
+	flags.ExportFileDir,
+	flags.ExportFileFormat,
+	flags.ExportFileMaxSize,
+	flags.ExportWebhookURL,
+	flags.ExportKafkaServers,
+	flags.ExportTypes,
+	flags.ExportReplayFromSlot,
+	flags.StateCacheBudget,
+	flags.HistoricalStateReplayBudget,
+	flags.ValidatorPerformanceIndex,
+	flags.AttestationTrackerIndices,
+	flags.AttestationTrackerWindow,
+	flags.PubsubTraceDir,
+	flags.PubsubTraceMaxFileSize,
+	flags.PubsubTraceMaxFiles,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
+	flags.CheckpointState,
+	flags.CheckpointBlock,
+	flags.CheckpointSyncURL,
 	flags.Eth1HeaderReqLimit,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
@@ -106,6 +129,8 @@ var appFlags = []cli.Flag{
 	cmd.AcceptTosFlag,
 	cmd.RestoreSourceFileFlag,
 	cmd.RestoreTargetDirFlag,
+	cmd.ConvertSourceBackendFlag,
+	cmd.ConvertTargetBackendFlag,
 }
 
 func init() {
@@ -0,0 +1,123 @@
+package monitor
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Summary aggregates the attestation records of a tracked validator whose inclusion window has
+// passed.
+type Summary struct {
+	ValidatorIndex uint64
+	// Attestations is the number of attestation duties of the validator.
+	Attestations uint64
+	// Included is the number of attestations included in a block, the others were missed.
+	Included      uint64
+	CorrectHead   uint64
+	CorrectTarget uint64
+	// AverageInclusionDistance is the average inclusion distance of the included attestations.
+	AverageInclusionDistance float64
+	// AverageFirstSeenDelay is the average delay between the start of the slot and the time the
+	// attestations were first seen on gossip, for the attestations seen on gossip.
+	AverageFirstSeenDelay time.Duration
+	// Effectiveness is the average of the inverse of the inclusion distance of the attestations,
+	// counting missed attestations as 0. An attestation included at the next slot is fully
+	// effective.
+	Effectiveness float64
+}
+
+// Summarize aggregates the attestation records by validator, sorted by validator index. The first
+// seen delays are relative to the start of the slots given the genesis time.
+func Summarize(records []*dbpb.AttestationRecord, genesisTime time.Time) []*Summary {
+	summaries := make(map[uint64]*Summary)
+	distances := make(map[uint64]uint64)
+	delays := make(map[uint64]time.Duration)
+	seen := make(map[uint64]int64)
+	for _, r := range records {
+		sum, ok := summaries[r.ValidatorIndex]
+		if !ok {
+			sum = &Summary{ValidatorIndex: r.ValidatorIndex}
+			summaries[r.ValidatorIndex] = sum
+		}
+		sum.Attestations++
+		if r.FirstSeen != 0 {
+			slotStart := genesisTime.Add(time.Duration(r.Slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+			delays[r.ValidatorIndex] += time.Unix(0, r.FirstSeen*int64(time.Millisecond)).Sub(slotStart)
+			seen[r.ValidatorIndex]++
+		}
+		if !r.Included || r.InclusionDistance == 0 {
+			continue
+		}
+		sum.Included++
+		if r.CorrectHead {
+			sum.CorrectHead++
+		}
+		if r.CorrectTarget {
+			sum.CorrectTarget++
+		}
+		distances[r.ValidatorIndex] += r.InclusionDistance
+		sum.Effectiveness += 1 / float64(r.InclusionDistance)
+	}
+
+	sorted := make([]*Summary, 0, len(summaries))
+	for idx, sum := range summaries {
+		if sum.Included > 0 {
+			sum.AverageInclusionDistance = float64(distances[idx]) / float64(sum.Included)
+		}
+		if seen[idx] > 0 {
+			sum.AverageFirstSeenDelay = delays[idx] / time.Duration(seen[idx])
+		}
+		sum.Effectiveness /= float64(sum.Attestations)
+		sorted = append(sorted, sum)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ValidatorIndex < sorted[j].ValidatorIndex
+	})
+	return sorted
+}
+
+// Effectiveness returns the summaries of the tracked validators over the tracking window, only
+// counting the attestations whose inclusion window has passed.
+func (s *Service) Effectiveness(ctx context.Context) ([]*Summary, error) {
+	currentSlot := s.cfg.GenesisTimeFetcher.CurrentSlot()
+	if currentSlot <= params.BeaconConfig().SlotsPerEpoch {
+		return []*Summary{}, nil
+	}
+	endSlot := currentSlot - params.BeaconConfig().SlotsPerEpoch - 1
+	startSlot := uint64(0)
+	if currentEpoch := helpers.SlotToEpoch(currentSlot); currentEpoch > s.cfg.WindowEpochs {
+		startSlot = (currentEpoch - s.cfg.WindowEpochs) * params.BeaconConfig().SlotsPerEpoch
+	}
+	if endSlot < startSlot {
+		return []*Summary{}, nil
+	}
+	records, err := s.cfg.BeaconDB.AttestationRecords(ctx, startSlot, endSlot)
+	if err != nil {
+		return nil, err
+	}
+	tracked := make([]*dbpb.AttestationRecord, 0, len(records))
+	for _, r := range records {
+		if s.tracked[r.ValidatorIndex] {
+			tracked = append(tracked, r)
+		}
+	}
+	return Summarize(tracked, s.cfg.GenesisTimeFetcher.GenesisTime()), nil
+}
+
+// updateEffectiveness updates the effectiveness metrics of the tracked validators.
+func (s *Service) updateEffectiveness(ctx context.Context) error {
+	summaries, err := s.Effectiveness(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sum := range summaries {
+		trackedAttestationEffectiveness.WithLabelValues(strconv.FormatUint(sum.ValidatorIndex, 10)).Set(sum.Effectiveness)
+	}
+	return nil
+}
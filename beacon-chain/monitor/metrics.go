@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	trackedAttestations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tracked_attestations_total",
+		Help: "The number of attestations of the tracked validators whose inclusion window has passed, by validator index and outcome, either included or missed.",
+	}, []string{"validator_index", "outcome"})
+	trackedAttestationsCorrectHead = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tracked_attestations_correct_head_total",
+		Help: "The number of included attestations of the tracked validators which voted for the correct head, by validator index.",
+	}, []string{"validator_index"})
+	trackedAttestationsCorrectTarget = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tracked_attestations_correct_target_total",
+		Help: "The number of included attestations of the tracked validators which voted for the correct target, by validator index.",
+	}, []string{"validator_index"})
+	trackedAttestationInclusionDistance = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tracked_attestation_inclusion_distance_slots",
+		Help:    "The distance between the slot of the attestations of the tracked validators and their inclusion slot, by validator index.",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32},
+	}, []string{"validator_index"})
+	trackedAttestationFirstSeenDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tracked_attestation_first_seen_delay_seconds",
+		Help:    "The delay between the start of the slot of the attestations of the tracked validators and the time they were first seen on gossip, by validator index.",
+		Buckets: []float64{1, 2, 4, 6, 8, 12, 24},
+	}, []string{"validator_index"})
+	trackedAttestationEffectiveness = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tracked_attestation_effectiveness",
+		Help: "The attestation effectiveness of the tracked validators over the tracking window, by validator index.",
+	}, []string{"validator_index"})
+	droppedUpdatesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tracked_attestation_updates_dropped_total",
+		Help: "The number of attestation record updates dropped because a subscriber was not keeping up.",
+	})
+)
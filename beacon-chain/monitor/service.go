@@ -0,0 +1,256 @@
+// Package monitor tracks the attestations of chosen validators: for each attestation duty of a
+// tracked validator, it records when the attestation was first seen on gossip, the slot it was
+// first included at and whether it voted for the correct head and target. The records of a rolling
+// window of epochs are saved to the database, summarized into effectiveness metrics and streamed
+// to subscribers, so that operators can diagnose poor attestation performance.
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// DefaultWindowEpochs is the default number of epochs of attestation records kept by the tracker.
+const DefaultWindowEpochs = 225
+
+// Config to set up the attestation tracker service.
+type Config struct {
+	BeaconDB           db.NoHeadAccessDatabase
+	HeadFetcher        blockchain.HeadFetcher
+	GenesisTimeFetcher blockchain.TimeFetcher
+	StateNotifier      statefeed.Notifier
+	OperationNotifier  operation.Notifier
+	// TrackedIndices are the indices of the validators to track.
+	TrackedIndices []uint64
+	// WindowEpochs is the number of epochs of attestation records kept in the database.
+	WindowEpochs uint64
+}
+
+// Update is sent to the subscribers of the tracker each time the attestation record of a tracked
+// validator changes.
+type Update struct {
+	Record *dbpb.AttestationRecord
+	// Final is true once the inclusion window of the attestation has passed, no other update is
+	// sent for the record afterwards.
+	Final bool
+}
+
+type recordKey struct {
+	slot           uint64
+	validatorIndex uint64
+}
+
+type committeeKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+// duty is the position of a tracked validator in the committee it is assigned to.
+type duty struct {
+	validatorIndex uint64
+	position       uint64
+}
+
+// Service tracks the attestations of the tracked validators. Records are created when the duties
+// of an epoch are assigned, updated by the attestations seen on gossip and in blocks, and
+// finalized once the inclusion window of the attestation has passed.
+type Service struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	cfg           *Config
+	tracked       map[uint64]bool
+	duties        map[committeeKey][]duty
+	records       map[recordKey]*dbpb.AttestationRecord
+	dirty         map[recordKey]*dbpb.AttestationRecord
+	assignedEpoch uint64
+	assigned      bool
+	lock          sync.RWMutex
+	err           error
+	// subscribers are the channels the updates are sent to. Updates are sent without blocking,
+	// so that a slow subscriber cannot hold back the tracking of attestations.
+	subscribers     map[chan<- *Update]bool
+	subscribersLock sync.RWMutex
+}
+
+// New initializes the attestation tracker service.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.WindowEpochs == 0 {
+		cfg.WindowEpochs = DefaultWindowEpochs
+	}
+	tracked := make(map[uint64]bool, len(cfg.TrackedIndices))
+	for _, idx := range cfg.TrackedIndices {
+		tracked[idx] = true
+	}
+	return &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		cfg:         cfg,
+		tracked:     tracked,
+		duties:      make(map[committeeKey][]duty),
+		records:     make(map[recordKey]*dbpb.AttestationRecord),
+		dirty:       make(map[recordKey]*dbpb.AttestationRecord),
+		subscribers: make(map[chan<- *Update]bool),
+	}
+}
+
+// Start the attestation tracker service.
+func (s *Service) Start() {
+	log.WithField("validatorIndices", s.cfg.TrackedIndices).Info("Tracking validator attestations")
+	go s.run()
+}
+
+// Stop the attestation tracker service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the attestation tracker service, which is the error of the last processed block.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// SubscribeUpdates subscribes to the updates of the attestation records. Updates are dropped when
+// the channel is full, so it should be buffered. The subscription ends when the service stops.
+func (s *Service) SubscribeUpdates(ch chan<- *Update) event.Subscription {
+	s.subscribersLock.Lock()
+	s.subscribers[ch] = true
+	s.subscribersLock.Unlock()
+	return event.NewSubscription(func(unsub <-chan struct{}) error {
+		select {
+		case <-unsub:
+		case <-s.ctx.Done():
+		}
+		s.subscribersLock.Lock()
+		delete(s.subscribers, ch)
+		s.subscribersLock.Unlock()
+		return nil
+	})
+}
+
+// run handles the attestations seen on gossip and the processed blocks. Records are only accessed
+// from this goroutine.
+func (s *Service) run() {
+	if err := s.loadRecords(s.ctx); err != nil {
+		log.WithError(err).Error("Could not load attestation records")
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok || data.SignedBlock == nil || data.SignedBlock.Block == nil {
+				continue
+			}
+			err := s.onBlock(s.ctx, data.SignedBlock.Block)
+			if err != nil && s.ctx.Err() == nil {
+				log.WithError(err).WithField("slot", data.Slot).Error("Could not track the attestations of block")
+			}
+			s.lock.Lock()
+			s.err = err
+			s.lock.Unlock()
+		case event := <-opChannel:
+			switch data := event.Data.(type) {
+			case *operation.UnAggregatedAttReceivedData:
+				s.onGossipAttestation(data.Attestation, timeutils.Now())
+			case *operation.AggregatedAttReceivedData:
+				if data.Attestation != nil {
+					s.onGossipAttestation(data.Attestation.Aggregate, timeutils.Now())
+				}
+			}
+		case <-stateSub.Err():
+			return
+		case <-opSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// loadRecords loads the records whose inclusion window has not passed yet, so that the gossip
+// records survive a restart.
+func (s *Service) loadRecords(ctx context.Context) error {
+	currentSlot := s.cfg.GenesisTimeFetcher.CurrentSlot()
+	startSlot := uint64(0)
+	if currentSlot > params.BeaconConfig().SlotsPerEpoch {
+		startSlot = currentSlot - params.BeaconConfig().SlotsPerEpoch
+	}
+	records, err := s.cfg.BeaconDB.AttestationRecords(ctx, startSlot, ^uint64(0))
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if s.tracked[r.ValidatorIndex] {
+			s.records[recordKey{slot: r.Slot, validatorIndex: r.ValidatorIndex}] = r
+		}
+	}
+	log.WithField("records", len(s.records)).Debug("Loaded pending attestation records")
+	return nil
+}
+
+// slotStart returns the start time of the slot.
+func (s *Service) slotStart(slot uint64) time.Time {
+	return s.cfg.GenesisTimeFetcher.GenesisTime().Add(time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+}
+
+// notify sends the update of the record to the subscribers whose channel is not full, and drops it
+// for the others.
+func (s *Service) notify(r *dbpb.AttestationRecord, final bool) {
+	s.subscribersLock.RLock()
+	defer s.subscribersLock.RUnlock()
+	if len(s.subscribers) == 0 {
+		return
+	}
+	update := &Update{Record: copyRecord(r), Final: final}
+	for ch := range s.subscribers {
+		select {
+		case ch <- update:
+		default:
+			droppedUpdatesCount.Inc()
+		}
+	}
+}
+
+func copyRecord(r *dbpb.AttestationRecord) *dbpb.AttestationRecord {
+	return proto.Clone(r).(*dbpb.AttestationRecord)
+}
+
+func logRecord(r *dbpb.AttestationRecord) *logrus.Entry {
+	return log.WithFields(logrus.Fields{
+		"validatorIndex":    r.ValidatorIndex,
+		"slot":              r.Slot,
+		"included":          r.Included,
+		"inclusionDistance": r.InclusionDistance,
+		"correctHead":       r.CorrectHead,
+		"correctTarget":     r.CorrectTarget,
+	})
+}
@@ -0,0 +1,231 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// recordOf returns the pending record of the validator in the epoch.
+func recordOf(t *testing.T, s *Service, validatorIndex, epoch uint64) *dbpb.AttestationRecord {
+	for _, r := range s.records {
+		if r.ValidatorIndex == validatorIndex && helpers.SlotToEpoch(r.Slot) == epoch {
+			return r
+		}
+	}
+	t.Fatalf("No record of validator %d in epoch %d", validatorIndex, epoch)
+	return nil
+}
+
+// attestationOf returns an attestation of the validator for its record, voting for the head root
+// and for the target of the head state.
+func attestationOf(t *testing.T, st *stateTrie.BeaconState, r *dbpb.AttestationRecord, headRoot []byte) *ethpb.Attestation {
+	committee, err := helpers.BeaconCommitteeFromState(st, r.Slot, r.CommitteeIndex)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i, v := range committee {
+		if v == r.ValidatorIndex {
+			bits.SetBitAt(uint64(i), true)
+		}
+	}
+	epoch := helpers.SlotToEpoch(r.Slot)
+	targetRoot, err := helpers.BlockRoot(st, epoch)
+	require.NoError(t, err)
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            r.Slot,
+			CommitteeIndex:  r.CommitteeIndex,
+			BeaconBlockRoot: headRoot,
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: epoch, Root: targetRoot},
+		},
+	}
+}
+
+func TestService_TracksAttestations(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, headState.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	s := New(ctx, &Config{
+		BeaconDB:           beaconDB,
+		HeadFetcher:        &mock.ChainService{State: headState},
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()},
+		TrackedIndices:     []uint64{3, 10},
+	})
+	updates := make(chan *Update, 10)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, s.assignDuties(ctx, headState))
+	// Both validators have a duty in each epoch from the previous to the next epoch of the head.
+	assert.Equal(t, 6, len(s.records))
+
+	good := recordOf(t, s, 3, 1)
+	headRoot, err := helpers.BlockRootAtSlot(headState, good.Slot)
+	require.NoError(t, err)
+	goodAtt := attestationOf(t, headState, good, headRoot)
+	bad := recordOf(t, s, 10, 1)
+	badAtt := attestationOf(t, headState, bad, []byte{'a'})
+
+	seen := time.Now()
+	s.onGossipAttestation(goodAtt, seen)
+	update := <-updates
+	assert.Equal(t, uint64(3), update.Record.ValidatorIndex)
+	assert.Equal(t, seen.UnixNano()/int64(time.Millisecond), update.Record.FirstSeen)
+	assert.Equal(t, false, update.Final)
+	// Only the first time an attestation is seen is recorded.
+	s.onGossipAttestation(goodAtt, seen.Add(time.Second))
+	assert.Equal(t, 0, len(updates))
+
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	blk.Body.Attestations = []*ethpb.Attestation{goodAtt, badAtt}
+	require.NoError(t, s.onBlock(ctx, blk))
+	require.Equal(t, 2, len(updates))
+	<-updates
+	<-updates
+	assert.Equal(t, true, good.Included)
+	assert.Equal(t, blk.Slot-good.Slot, good.InclusionDistance)
+	assert.Equal(t, true, good.CorrectHead)
+	assert.Equal(t, true, good.CorrectTarget)
+	assert.Equal(t, true, bad.Included)
+	assert.Equal(t, false, bad.CorrectHead)
+	assert.Equal(t, true, bad.CorrectTarget)
+
+	saved, err := beaconDB.AttestationRecords(ctx, 0, ^uint64(0))
+	require.NoError(t, err)
+	assert.Equal(t, 6, len(saved))
+	for _, r := range saved {
+		if r.ValidatorIndex == good.ValidatorIndex && r.Slot == good.Slot {
+			assert.DeepEqual(t, good, r)
+		}
+	}
+
+	// Records are finalized once their inclusion window has passed.
+	finalizeSlot := good.Slot + params.BeaconConfig().SlotsPerEpoch + 1
+	expected := 0
+	for _, r := range s.records {
+		if r.Slot <= good.Slot {
+			expected++
+		}
+	}
+	assert.Equal(t, expected, s.finalizeRecords(finalizeSlot))
+	assert.Equal(t, 6-expected, len(s.records))
+	found := false
+	for i := 0; i < expected; i++ {
+		update = <-updates
+		assert.Equal(t, true, update.Final)
+		if update.Record.ValidatorIndex == good.ValidatorIndex {
+			assert.DeepEqual(t, good, update.Record)
+			found = true
+		}
+	}
+	assert.Equal(t, true, found, "No final update of the included attestation")
+}
+
+func TestService_Notify_SlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(ctx, &Config{})
+	slow := make(chan *Update)
+	slowSub := s.SubscribeUpdates(slow)
+	defer slowSub.Unsubscribe()
+	fast := make(chan *Update, 1)
+	fastSub := s.SubscribeUpdates(fast)
+
+	// The update is dropped for the subscriber which is not receiving, without blocking the others.
+	s.notify(&dbpb.AttestationRecord{ValidatorIndex: 3}, true)
+	require.Equal(t, 1, len(fast))
+	assert.Equal(t, uint64(3), (<-fast).Record.ValidatorIndex)
+
+	fastSub.Unsubscribe()
+	s.subscribersLock.RLock()
+	assert.Equal(t, 1, len(s.subscribers))
+	s.subscribersLock.RUnlock()
+
+	// Subscriptions end when the service stops.
+	cancel()
+	_, ok := <-slowSub.Err()
+	assert.Equal(t, false, ok)
+	s.subscribersLock.RLock()
+	assert.Equal(t, 0, len(s.subscribers))
+	s.subscribersLock.RUnlock()
+}
+
+func TestSummarize(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	firstSeen := func(slot uint64, delay time.Duration) int64 {
+		return genesis.Add(time.Duration(slot)*slotDuration+delay).UnixNano() / int64(time.Millisecond)
+	}
+	records := []*dbpb.AttestationRecord{
+		{ValidatorIndex: 2, Slot: 1, FirstSeen: firstSeen(1, time.Second), Included: true, InclusionSlot: 2, InclusionDistance: 1, CorrectHead: true, CorrectTarget: true},
+		{ValidatorIndex: 1, Slot: 3},
+		{ValidatorIndex: 2, Slot: 40, FirstSeen: firstSeen(40, 3*time.Second), Included: true, InclusionSlot: 44, InclusionDistance: 4, CorrectTarget: true},
+		{ValidatorIndex: 2, Slot: 70},
+		{ValidatorIndex: 1, Slot: 36, Included: true, InclusionSlot: 38, InclusionDistance: 2},
+	}
+
+	assert.DeepEqual(t, []*Summary{
+		{
+			ValidatorIndex:           1,
+			Attestations:             2,
+			Included:                 1,
+			AverageInclusionDistance: 2,
+			Effectiveness:            0.25,
+		},
+		{
+			ValidatorIndex:           2,
+			Attestations:             3,
+			Included:                 2,
+			CorrectHead:              1,
+			CorrectTarget:            2,
+			AverageInclusionDistance: 2.5,
+			AverageFirstSeenDelay:    2 * time.Second,
+			Effectiveness:            1.25 / 3,
+		},
+	}, Summarize(records, genesis))
+}
+
+func TestService_Effectiveness(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	currentSlot := 10 * params.BeaconConfig().SlotsPerEpoch
+	s := New(ctx, &Config{
+		BeaconDB:           beaconDB,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		TrackedIndices:     []uint64{1},
+		WindowEpochs:       5,
+	})
+	require.NoError(t, beaconDB.SaveAttestationRecords(ctx, []*dbpb.AttestationRecord{
+		// Out of the window.
+		{ValidatorIndex: 1, Slot: params.BeaconConfig().SlotsPerEpoch},
+		{ValidatorIndex: 1, Slot: 6 * params.BeaconConfig().SlotsPerEpoch, Included: true, InclusionDistance: 1},
+		// Not tracked.
+		{ValidatorIndex: 2, Slot: 6 * params.BeaconConfig().SlotsPerEpoch},
+		// Still within its inclusion window.
+		{ValidatorIndex: 1, Slot: 9 * params.BeaconConfig().SlotsPerEpoch},
+	}))
+
+	summaries, err := s.Effectiveness(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(summaries))
+	assert.Equal(t, uint64(1), summaries[0].Attestations)
+	assert.Equal(t, float64(1), summaries[0].Effectiveness)
+}
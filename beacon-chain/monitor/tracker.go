@@ -0,0 +1,219 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// onBlock assigns the duties of the epochs around the head, records the inclusion of the
+// attestations of the block, finalizes the records whose inclusion window has passed and saves
+// the updated records.
+func (s *Service) onBlock(ctx context.Context, blk *ethpb.BeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "monitor.onBlock")
+	defer span.End()
+
+	headState, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("nil head state")
+	}
+	if err := s.assignDuties(ctx, headState); err != nil {
+		return err
+	}
+	for _, att := range blk.Body.Attestations {
+		s.onIncludedAttestation(headState, att, blk.Slot)
+	}
+	finalized := s.finalizeRecords(headState.Slot())
+	if err := s.flush(ctx); err != nil {
+		return err
+	}
+	if finalized > 0 {
+		return s.updateEffectiveness(ctx)
+	}
+	return nil
+}
+
+// assignDuties creates the records of the tracked validators for the epochs from the previous to
+// the next epoch of the head state, which are not assigned yet. The records of the epochs before
+// the tracking window are pruned from the database once a new epoch is assigned.
+func (s *Service) assignDuties(ctx context.Context, headState *stateTrie.BeaconState) error {
+	headEpoch := helpers.SlotToEpoch(headState.Slot())
+	startEpoch := uint64(0)
+	if headEpoch > 0 {
+		startEpoch = headEpoch - 1
+	}
+	if s.assigned && s.assignedEpoch >= startEpoch {
+		startEpoch = s.assignedEpoch + 1
+	}
+	if startEpoch > headEpoch+1 {
+		return nil
+	}
+	for epoch := startEpoch; epoch <= headEpoch+1; epoch++ {
+		// Computing the assignments moves the slot of the state.
+		assignments, _, err := helpers.CommitteeAssignments(headState.Copy(), epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute committee assignments of epoch %d", epoch)
+		}
+		for idx := range s.tracked {
+			a, ok := assignments[idx]
+			if !ok {
+				continue
+			}
+			position := -1
+			for i, v := range a.Committee {
+				if v == idx {
+					position = i
+					break
+				}
+			}
+			if position < 0 {
+				continue
+			}
+			ck := committeeKey{slot: a.AttesterSlot, committeeIndex: a.CommitteeIndex}
+			s.duties[ck] = append(s.duties[ck], duty{validatorIndex: idx, position: uint64(position)})
+			rk := recordKey{slot: a.AttesterSlot, validatorIndex: idx}
+			if r, ok := s.records[rk]; ok {
+				r.CommitteeIndex = a.CommitteeIndex
+				continue
+			}
+			r := &dbpb.AttestationRecord{
+				ValidatorIndex: idx,
+				Slot:           a.AttesterSlot,
+				CommitteeIndex: a.CommitteeIndex,
+			}
+			s.records[rk] = r
+			s.dirty[rk] = r
+		}
+		s.assignedEpoch = epoch
+		s.assigned = true
+	}
+
+	if headEpoch > s.cfg.WindowEpochs {
+		pruneSlot, err := helpers.StartSlot(headEpoch - s.cfg.WindowEpochs)
+		if err != nil {
+			return err
+		}
+		if err := s.cfg.BeaconDB.DeleteAttestationRecordsBefore(ctx, pruneSlot); err != nil {
+			return errors.Wrap(err, "could not prune attestation records")
+		}
+	}
+	return nil
+}
+
+// attestingDuties returns the duties of the tracked validators which took part in the attestation.
+func (s *Service) attestingDuties(att *ethpb.Attestation) []duty {
+	if att == nil || att.Data == nil {
+		return nil
+	}
+	var attesting []duty
+	for _, d := range s.duties[committeeKey{slot: att.Data.Slot, committeeIndex: att.Data.CommitteeIndex}] {
+		if d.position < att.AggregationBits.Len() && att.AggregationBits.BitAt(d.position) {
+			attesting = append(attesting, d)
+		}
+	}
+	return attesting
+}
+
+// onGossipAttestation records the time the attestations of the tracked validators are first seen.
+func (s *Service) onGossipAttestation(att *ethpb.Attestation, seen time.Time) {
+	for _, d := range s.attestingDuties(att) {
+		rk := recordKey{slot: att.Data.Slot, validatorIndex: d.validatorIndex}
+		r, ok := s.records[rk]
+		if !ok || r.FirstSeen != 0 {
+			continue
+		}
+		r.FirstSeen = seen.UnixNano() / int64(time.Millisecond)
+		s.dirty[rk] = r
+		trackedAttestationFirstSeenDelay.WithLabelValues(strconv.FormatUint(d.validatorIndex, 10)).Observe(
+			seen.Sub(s.slotStart(r.Slot)).Seconds())
+		s.notify(r, false)
+	}
+}
+
+// onIncludedAttestation records the first inclusion of the attestations of the tracked validators,
+// checking their head and target votes against the canonical chain of the head state.
+func (s *Service) onIncludedAttestation(headState *stateTrie.BeaconState, att *ethpb.Attestation, inclusionSlot uint64) {
+	for _, d := range s.attestingDuties(att) {
+		rk := recordKey{slot: att.Data.Slot, validatorIndex: d.validatorIndex}
+		r, ok := s.records[rk]
+		if !ok || (r.Included && r.InclusionSlot <= inclusionSlot) || inclusionSlot <= att.Data.Slot {
+			continue
+		}
+		r.Included = true
+		r.InclusionSlot = inclusionSlot
+		r.InclusionDistance = inclusionSlot - att.Data.Slot
+		if root, err := helpers.BlockRootAtSlot(headState, att.Data.Slot); err == nil {
+			r.CorrectHead = bytes.Equal(root, att.Data.BeaconBlockRoot)
+		}
+		if att.Data.Target != nil {
+			if root, err := helpers.BlockRoot(headState, att.Data.Target.Epoch); err == nil {
+				r.CorrectTarget = bytes.Equal(root, att.Data.Target.Root)
+			}
+		}
+		s.dirty[rk] = r
+		s.notify(r, false)
+	}
+}
+
+// finalizeRecords finalizes the records whose inclusion window has passed at the slot, drops the
+// duties of their slots and returns the number of finalized records.
+func (s *Service) finalizeRecords(slot uint64) int {
+	finalized := 0
+	for rk, r := range s.records {
+		if r.Slot+params.BeaconConfig().SlotsPerEpoch >= slot {
+			continue
+		}
+		label := strconv.FormatUint(r.ValidatorIndex, 10)
+		if r.Included {
+			trackedAttestations.WithLabelValues(label, "included").Inc()
+			trackedAttestationInclusionDistance.WithLabelValues(label).Observe(float64(r.InclusionDistance))
+			if r.CorrectHead {
+				trackedAttestationsCorrectHead.WithLabelValues(label).Inc()
+			}
+			if r.CorrectTarget {
+				trackedAttestationsCorrectTarget.WithLabelValues(label).Inc()
+			}
+			logRecord(r).Debug("Attestation included")
+		} else {
+			trackedAttestations.WithLabelValues(label, "missed").Inc()
+			logRecord(r).Warn("Attestation of tracked validator was not included")
+		}
+		delete(s.records, rk)
+		s.notify(r, true)
+		finalized++
+	}
+	for ck := range s.duties {
+		if ck.slot+params.BeaconConfig().SlotsPerEpoch < slot {
+			delete(s.duties, ck)
+		}
+	}
+	return finalized
+}
+
+// flush saves the records updated since the last flush.
+func (s *Service) flush(ctx context.Context) error {
+	if len(s.dirty) == 0 {
+		return nil
+	}
+	records := make([]*dbpb.AttestationRecord, 0, len(s.dirty))
+	for _, r := range s.dirty {
+		records = append(records, r)
+	}
+	if err := s.cfg.BeaconDB.SaveAttestationRecords(ctx, records); err != nil {
+		return errors.Wrap(err, "could not save attestation records")
+	}
+	s.dirty = make(map[recordKey]*dbpb.AttestationRecord)
+	return nil
+}
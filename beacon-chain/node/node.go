@@ -18,14 +18,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/performance"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -34,6 +39,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -173,6 +180,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.initCheckpointSync(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -205,6 +216,32 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.EnableDBPruning.Name) {
+		if err := beacon.registerPrunerService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerExportService(); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Bool(flags.ValidatorPerformanceIndex.Name) {
+		if err := beacon.registerPerformanceIndexService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cliCtx.IsSet(flags.AttestationTrackerIndices.Name) {
+		if err := beacon.registerAttestationTrackerService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -295,10 +332,14 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
+	dbConfig := &kv.Config{
+		Backend:                   cliCtx.String(flags.DBBackend.Name),
+		StateDiffSnapshotInterval: cliCtx.Uint64(flags.StateDiffSnapshotInterval.Name),
+	}
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
-	d, err := db.NewDB(b.ctx, dbPath)
+	d, err := db.NewDB(b.ctx, dbPath, dbConfig)
 	if err != nil {
 		return err
 	}
@@ -320,7 +361,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
-		d, err = db.NewDB(b.ctx, dbPath)
+		d, err = db.NewDB(b.ctx, dbPath, dbConfig)
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
 		}
@@ -341,8 +382,29 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+func (b *BeaconNode) initCheckpointSync(cliCtx *cli.Context) error {
+	var src checkpoint.Source
+	switch {
+	case cliCtx.IsSet(flags.CheckpointSyncURL.Name):
+		src = &checkpoint.RemoteSource{
+			Endpoint:   cliCtx.String(flags.CheckpointSyncURL.Name),
+			MaxMsgSize: cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		}
+	case cliCtx.IsSet(flags.CheckpointState.Name) || cliCtx.IsSet(flags.CheckpointBlock.Name):
+		src = &checkpoint.FileSource{
+			StatePath: cliCtx.String(flags.CheckpointState.Name),
+			BlockPath: cliCtx.String(flags.CheckpointBlock.Name),
+		}
+	default:
+		return nil
+	}
+	return checkpoint.Initialize(b.ctx, b.db, src)
+}
+
 func (b *BeaconNode) startStateGen() {
+	cache.StateCaches.SetBudget(b.cliCtx.Uint64(flags.StateCacheBudget.Name) * 1024 * 1024)
 	b.stateGen = stategen.New(b.db)
+	b.stateGen.SetReplayBudget(b.cliCtx.Uint64(flags.HistoricalStateReplayBudget.Name))
 }
 
 func readbootNodes(fileName string) ([]string, error) {
@@ -386,24 +448,27 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.New(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootnodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           datadir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
+		NoDiscovery:         cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:         sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		BootstrapNodeAddr:   bootnodeAddrs,
+		RelayNodeAddr:       cliCtx.String(cmd.RelayNode.Name),
+		DataDir:             datadir,
+		LocalIP:             cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:         cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:             cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:          cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:         cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:             cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:             cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:            cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:       cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:        sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:          cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:       cliCtx.Bool(flags.DisableDiscv5.Name),
+		StateNotifier:       b,
+		PubsubTraceDir:      cliCtx.String(flags.PubsubTraceDir.Name),
+		PubsubTraceFileSize: cliCtx.Uint64(flags.PubsubTraceMaxFileSize.Name) * 1024 * 1024,
+		PubsubTraceFiles:    cliCtx.Int(flags.PubsubTraceMaxFiles.Name),
 	})
 	if err != nil {
 		return err
@@ -571,6 +636,114 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	cfg := &backfill.Config{
+		DB:  b.db,
+		P2P: b.fetchP2P(),
+	}
+	// Blocks below the pruning horizon would be deleted right after being backfilled.
+	if b.cliCtx.Bool(flags.EnableDBPruning.Name) {
+		var prunerService *pruner.Service
+		if err := b.services.FetchService(&prunerService); err != nil {
+			return err
+		}
+		cfg.Horizon = prunerService
+	}
+	bs := backfill.New(b.ctx, cfg)
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	ps := pruner.New(b.ctx, &pruner.Config{
+		BeaconDB:      b.db,
+		StateGen:      b.stateGen,
+		StateNotifier: b,
+		StateWindow:   b.cliCtx.Uint64(flags.DBPruningStateWindow.Name),
+	})
+	return b.services.RegisterService(ps)
+}
+
+func (b *BeaconNode) registerExportService() error {
+	var sinks []export.Sink
+	if dir := b.cliCtx.String(flags.ExportFileDir.Name); dir != "" {
+		maxSize := int64(b.cliCtx.Uint64(flags.ExportFileMaxSize.Name)) * 1024 * 1024
+		sink, err := export.NewFileSink(dir, export.Format(b.cliCtx.String(flags.ExportFileFormat.Name)), maxSize)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if url := b.cliCtx.String(flags.ExportWebhookURL.Name); url != "" {
+		sinks = append(sinks, export.NewWebhookSink(url))
+	}
+	if servers := b.cliCtx.String(flags.ExportKafkaServers.Name); servers != "" {
+		sink, err := export.NewKafkaSink(servers)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	types, err := export.ParseObjectTypes(b.cliCtx.StringSlice(flags.ExportTypes.Name))
+	if err != nil {
+		return err
+	}
+
+	es, err := export.New(b.ctx, &export.Config{
+		BeaconDB:      b.db,
+		StateNotifier: b,
+		Sinks:         sinks,
+		Types:         types,
+		CursorDir:     filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "export"),
+		Replay:        b.cliCtx.IsSet(flags.ExportReplayFromSlot.Name),
+		ReplaySlot:    b.cliCtx.Uint64(flags.ExportReplayFromSlot.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(es)
+}
+
+func (b *BeaconNode) registerPerformanceIndexService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	ps := performance.New(b.ctx, &performance.Config{
+		BeaconDB:      b.db,
+		StateGen:      b.stateGen,
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+	})
+	return b.services.RegisterService(ps)
+}
+
+func (b *BeaconNode) registerAttestationTrackerService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var indices []uint64
+	for _, idx := range b.cliCtx.Int64Slice(flags.AttestationTrackerIndices.Name) {
+		if idx < 0 {
+			return fmt.Errorf("invalid validator index %d to track", idx)
+		}
+		indices = append(indices, uint64(idx))
+	}
+	ts := monitor.New(b.ctx, &monitor.Config{
+		BeaconDB:           b.db,
+		HeadFetcher:        chainService,
+		GenesisTimeFetcher: chainService,
+		StateNotifier:      b,
+		OperationNotifier:  b,
+		TrackedIndices:     indices,
+		WindowEpochs:       b.cliCtx.Uint64(flags.AttestationTrackerWindow.Name),
+	})
+	return b.services.RegisterService(ts)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -612,6 +785,18 @@ func (b *BeaconNode) registerRPCService() error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
+	// The p2p service also manages the peer rules and accounts for the peer traffic served by the
+	// debug endpoints.
+	var p2pDebugService *p2p.Service
+	if err := b.services.FetchService(&p2pDebugService); err != nil {
+		return err
+	}
+	var attestationTracker *monitor.Service
+	if b.cliCtx.IsSet(flags.AttestationTrackerIndices.Name) {
+		if err := b.services.FetchService(&attestationTracker); err != nil {
+			return err
+		}
+	}
 	p2pService := b.fetchP2P()
 	rpcService := rpc.New(b.ctx, &rpc.Config{
 		Host:                    host,
@@ -624,9 +809,12 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		PeerRulesManager:        p2pDebugService,
+		TrafficProvider:         p2pDebugService,
 		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
+		ReorgFetcher:            chainService,
 		CanonicalFetcher:        chainService,
 		ForkFetcher:             chainService,
 		FinalizationFetcher:     chainService,
@@ -647,6 +835,7 @@ func (b *BeaconNode) registerRPCService() error {
 		StateNotifier:           b,
 		OperationNotifier:       b,
 		StateGen:                b.stateGen,
+		AttestationTracker:      attestationTracker,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
 	})
@@ -25,5 +25,8 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	PubsubTraceDir      string
+	PubsubTraceFileSize uint64
+	PubsubTraceFiles    int
 	StateNotifier       statefeed.Notifier
 }
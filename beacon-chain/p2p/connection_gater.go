@@ -25,15 +25,16 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (s *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow dialing banned peers, bans are kept across restarts.
+	return !s.peers.IsBanned(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
 	// Disallow bad peers from dialing in.
-	if s.peers.IsBad(pid) {
+	if s.peers.IsBad(pid) || s.peers.IsBannedAddr(m) {
 		return false
 	}
 	return filterConnections(s.addrFilter, m)
@@ -41,6 +42,16 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 
 // InterceptAccept checks whether the incidental inbound connection is allowed.
 func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
+	if s.peers.IsBannedAddr(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned network"}).Trace("Not accepting inbound dial from ip address")
+		return false
+	}
+	// Peers of the trusted networks are exempt from the dial and peer limits. Peers trusted by ID
+	// are not known before the connection is secured, they are dialed by this node instead.
+	if s.peers.IsTrustedAddr(n.RemoteMultiaddr()) {
+		return true
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -59,7 +70,13 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// The identity of inbound peers is only known once the connection is secured.
+	if s.peers.IsBanned(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned peer"}).Trace("Not accepting connection")
+		return false
+	}
 	return true
 }
 
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
 
 	"github.com/kevinms/leakybucket-go"
@@ -128,6 +129,33 @@ func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 	}
 }
 
+func TestService_InterceptAccept_TrustedNetworkBeyondLimit(t *testing.T) {
+	limit := 20
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    limit,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	// Add peers beyond the inbound peer limit.
+	for i := 0; i < int(float64(limit)*peers.InboundRatio)+1; i++ {
+		addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	multiAddress, err := multiaddr.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	conn := &testConnMultiaddrs{remote: multiAddress}
+	assert.Equal(t, false, s.InterceptAccept(conn), "Inbound dial beyond the peer limit was accepted")
+
+	_, trustedNet, err := net.ParseCIDR("212.67.10.0/24")
+	require.NoError(t, err)
+	s.peers.SetTrustedPeers(nil, []*net.IPNet{trustedNet})
+	assert.Equal(t, true, s.InterceptAccept(conn), "Inbound dial of a trusted network was rejected")
+}
+
 func TestPeer_BelowMaxLimit(t *testing.T) {
 	// create host and remote peer
 	ipAddr, pkey := createAddrAndPrivKey(t)
@@ -285,3 +313,16 @@ func TestService_InterceptAddrDial_Allow(t *testing.T) {
 		t.Errorf("Expected multiaddress with ip %s to not be rejected with an allow cidr mask of %s", ip, cidr)
 	}
 }
+
+// testConnMultiaddrs is the multiaddresses of a connection under test.
+type testConnMultiaddrs struct {
+	remote multiaddr.Multiaddr
+}
+
+func (c *testConnMultiaddrs) LocalMultiaddr() multiaddr.Multiaddr {
+	return nil
+}
+
+func (c *testConnMultiaddrs) RemoteMultiaddr() multiaddr.Multiaddr {
+	return c.remote
+}
@@ -192,6 +192,7 @@ func TestStaticPeering_PeersAreAdded(t *testing.T) {
 	cfg.StaticPeers = staticPeers
 	cfg.StateNotifier = &mock.MockStateNotifier{}
 	cfg.NoDiscovery = true
+	cfg.DataDir = t.TempDir()
 	s, err := New(context.Background(), cfg)
 	require.NoError(t, err)
 
@@ -91,6 +91,7 @@ func TestStartDiscv5_DifferentForkDigests(t *testing.T) {
 	cfg.UDPPort = 14000
 	cfg.TCPPort = 14001
 	cfg.MaxPeers = 30
+	cfg.DataDir = t.TempDir()
 	s, err = New(context.Background(), cfg)
 	require.NoError(t, err)
 	s.genesisTime = genesisTime
@@ -182,6 +183,7 @@ func TestStartDiscv5_SameForkDigests_DifferentNextForkData(t *testing.T) {
 	cfg.TCPPort = 14001
 	cfg.MaxPeers = 30
 	cfg.StateNotifier = &mock.MockStateNotifier{}
+	cfg.DataDir = t.TempDir()
 	s, err = New(context.Background(), cfg)
 	require.NoError(t, err)
 
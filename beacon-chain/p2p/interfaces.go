@@ -80,6 +80,19 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerRulesManager manages the peers trusted and banned by the operator at runtime.
+type PeerRulesManager interface {
+	AddPeerRule(kind PeerRuleKind, rule string) error
+	RemovePeerRule(kind PeerRuleKind, rule string) error
+	PeerRules(kind PeerRuleKind) []string
+}
+
+// TrafficProvider provides the traffic exchanged with peers.
+type TrafficProvider interface {
+	PeerTraffic(pid peer.ID) *PeerTraffic
+	TopPeersByTraffic(n int) []*PeerTraffic
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
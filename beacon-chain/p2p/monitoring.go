@@ -25,6 +25,30 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	topicMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_topic_messages_total",
+		Help: "The number of gossip messages exchanged with peers on a given topic, by direction. Topics the node cannot subscribe to are counted as other.",
+	},
+		[]string{"topic", "direction"})
+	protocolRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_protocol_requests_total",
+		Help: "The number of request streams of a given protocol, by direction.",
+	},
+		[]string{"protocol", "direction"})
+	protocolBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_protocol_bytes_total",
+		Help: "The number of bytes exchanged with peers on streams of a given protocol, by direction.",
+	},
+		[]string{"protocol", "direction"})
+	topPeerBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_top_peer_bytes",
+		Help: "The number of bytes exchanged with the peers which exchanged the most bytes with the node, by direction.",
+	},
+		[]string{"peer", "direction"})
+	droppedPubsubTraceEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_pubsub_trace_dropped_events_total",
+		Help: "The number of gossipsub trace events dropped because the trace files were not written fast enough.",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -33,4 +57,10 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+
+	topPeerBytes.Reset()
+	for _, traffic := range s.traffic.topPeers(s.peers.Connected(), trafficTopPeers) {
+		topPeerBytes.WithLabelValues(traffic.ID.String(), "in").Set(float64(traffic.Total.BytesIn))
+		topPeerBytes.WithLabelValues(traffic.ID.String(), "out").Set(float64(traffic.Total.BytesOut))
+	}
 }
@@ -34,6 +34,7 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 		libp2p.ListenAddrs(listen),
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
+		libp2p.BandwidthReporter(s.traffic),
 	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
@@ -0,0 +1,102 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const peerRecordsPath = "peer-records"
+
+// peerRecordsSaveInterval is the interval at which the peer records are saved to disk.
+const peerRecordsSaveInterval = 5 * time.Minute
+
+// peerRecordTTL is the time after which the record of a peer which has not been seen is dropped.
+const peerRecordTTL = 7 * 24 * time.Hour
+
+// peerBanDuration is the duration of the ban of a bad peer, which is the time it takes for its
+// bad responses to decay.
+const peerBanDuration = maxBadResponses * scorers.DefaultBadResponsesDecayInterval
+
+// loadPeerRecords restores the reputation of the peers saved by a previous run of the node.
+func (s *Service) loadPeerRecords() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(path.Join(s.cfg.DataDir, peerRecordsPath))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read peer records")
+	}
+	records := &dbpb.PeerRecords{}
+	if err := records.Unmarshal(enc); err != nil {
+		return errors.Wrap(err, "could not unmarshal peer records")
+	}
+	now := timeutils.Now()
+	recent := make([]*dbpb.PeerRecord, 0, len(records.Peers))
+	for _, record := range records.Peers {
+		banned := time.Unix(record.BannedUntil, 0).After(now)
+		if banned || now.Sub(time.Unix(record.LastSeen, 0)) < peerRecordTTL {
+			recent = append(recent, record)
+		}
+	}
+	loaded := s.peers.LoadRecords(recent)
+	log.WithField("peers", loaded).Debug("Loaded peer records")
+	return nil
+}
+
+// savePeerRecords bans the peers which are currently bad and saves the reputation of the peers to
+// disk, so that bans are honoured after a restart. The records are written to a temporary file
+// first, so that a crash never leaves truncated records behind.
+func (s *Service) savePeerRecords() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	banUntil := timeutils.Now().Add(peerBanDuration)
+	for _, pid := range s.peers.Scorers().BadPeers() {
+		if !s.peers.IsBanned(pid) {
+			s.peers.Ban(pid, banUntil)
+		}
+	}
+	records := &dbpb.PeerRecords{Peers: s.peers.Records()}
+	enc, err := records.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal peer records")
+	}
+	recordsPath := path.Join(s.cfg.DataDir, peerRecordsPath)
+	tmp := recordsPath + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not write peer records")
+	}
+	if err := os.Rename(tmp, recordsPath); err != nil {
+		return errors.Wrap(err, "could not replace peer records")
+	}
+	return nil
+}
+
+// connectToKnownPeers dials the known good peers of the previous run of the node, so that the node
+// does not depend on discovery to find its first peers.
+func (s *Service) connectToKnownPeers() {
+	infos := s.peers.KnownGoodPeers(int(s.cfg.MaxPeers))
+	if len(infos) == 0 {
+		return
+	}
+	log.WithField("peers", len(infos)).Debug("Connecting to known peers")
+	for _, info := range infos {
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with known peer %s", info.String())
+			}
+		}(info)
+	}
+}
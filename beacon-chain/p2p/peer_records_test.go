@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_PeerRecords(t *testing.T) {
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		return &Service{
+			cfg: &Config{DataDir: dataDir, MaxPeers: 30},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+						Threshold: maxBadResponses,
+					},
+				},
+			}),
+		}
+	}
+	s := newService()
+	// No records are saved yet.
+	require.NoError(t, s.loadPeerRecords())
+
+	addr, err := multiaddr.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	good, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	s.peers.Add(nil, good, addr, network.DirOutbound)
+	s.peers.SetConnectionState(good, peers.PeerConnected)
+	s.peers.SetConnectionState(good, peers.PeerDisconnected)
+	bad, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	s.peers.Add(nil, bad, nil, network.DirInbound)
+	s.peers.SetConnectionState(bad, peers.PeerConnected)
+	for i := 0; i < maxBadResponses; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	require.NoError(t, s.savePeerRecords())
+	// Bad peers are banned when the records are saved.
+	assert.Equal(t, true, s.peers.IsBanned(bad))
+	// The records replace the previous ones, without leaving the temporary file behind.
+	require.NoError(t, s.savePeerRecords())
+	assert.Equal(t, true, fileutil.FileExists(path.Join(dataDir, peerRecordsPath)))
+	assert.Equal(t, false, fileutil.FileExists(path.Join(dataDir, peerRecordsPath+".tmp")))
+
+	restarted := newService()
+	require.NoError(t, restarted.loadPeerRecords())
+	assert.Equal(t, 2, len(restarted.peers.All()))
+	assert.Equal(t, true, restarted.peers.IsBanned(bad))
+	assert.Equal(t, false, restarted.InterceptPeerDial(bad))
+	assert.Equal(t, true, restarted.InterceptPeerDial(good))
+	infos := restarted.peers.KnownGoodPeers(int(restarted.cfg.MaxPeers))
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, good, infos[0].ID)
+
+	// The ban outlives the bad responses of the peer.
+	restarted.peers.Scorers().BadResponsesScorer().Decay()
+	assert.Equal(t, true, restarted.peers.IsBad(bad))
+	restarted.peers.Ban(bad, time.Now().Add(-time.Second))
+	assert.Equal(t, true, restarted.peers.IsBanned(bad), "Ban must not be shortened")
+}
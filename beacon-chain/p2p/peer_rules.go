@@ -0,0 +1,291 @@
+package p2p
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+const peerRulesPath = "peer-rules"
+
+var (
+	// ErrPeerRuleNotFound is returned when removing a peer rule which does not exist.
+	ErrPeerRuleNotFound = errors.New("peer rule not found")
+	// ErrInvalidPeerRule is returned when adding a peer rule which can not be parsed.
+	ErrInvalidPeerRule = errors.New("invalid peer rule")
+)
+
+// PeerRuleKind is the kind of a peer rule set by the operator at runtime.
+type PeerRuleKind int
+
+const (
+	// TrustedPeerRule trusts the peers of the rule: they are kept connected, re-dialed on
+	// disconnect, and are exempt from peer limits, pruning and rate limiting.
+	TrustedPeerRule PeerRuleKind = iota
+	// BannedPeerRule bans the peers of the rule: they are disconnected, and are neither dialed nor
+	// accepted.
+	BannedPeerRule
+)
+
+// peerRule is a parsed peer rule, which either identifies a single peer, along with its addresses
+// when the rule is an ENR or a multiaddress, or a network.
+type peerRule struct {
+	pid   peer.ID
+	addrs []multiaddr.Multiaddr
+	ipNet *net.IPNet
+}
+
+// parsePeerRule parses a peer rule, which is a peer ID, an ENR, a multiaddress or a CIDR.
+func parsePeerRule(rule string) (*peerRule, error) {
+	if _, ipNet, err := net.ParseCIDR(rule); err == nil {
+		return &peerRule{ipNet: ipNet}, nil
+	}
+	if node, err := enode.Parse(enode.ValidSchemes, rule); err == nil {
+		info, _, err := convertToAddrInfo(node)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert ENR to peer address")
+		}
+		return &peerRule{pid: info.ID, addrs: info.Addrs}, nil
+	}
+	if strings.HasPrefix(rule, "/") {
+		info, err := MakePeer(rule)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse multiaddress")
+		}
+		return &peerRule{pid: info.ID, addrs: info.Addrs}, nil
+	}
+	pid, err := peer.Decode(rule)
+	if err != nil {
+		return nil, errors.Errorf("%q is neither a peer ID, an ENR, a multiaddress nor a CIDR", rule)
+	}
+	return &peerRule{pid: pid}, nil
+}
+
+// AddPeerRule adds a rule trusting or banning peers, and saves the rules to disk. A rule of the
+// other kind for the same peers is removed.
+func (s *Service) AddPeerRule(kind PeerRuleKind, rule string) error {
+	if _, err := parsePeerRule(rule); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPeerRule, err)
+	}
+	s.peerRulesLock.Lock()
+	defer s.peerRulesLock.Unlock()
+
+	rules := &dbpb.PeerRules{
+		Trusted: removeString(s.peerRules.Trusted, rule),
+		Banned:  removeString(s.peerRules.Banned, rule),
+	}
+	switch kind {
+	case TrustedPeerRule:
+		rules.Trusted = append(rules.Trusted, rule)
+	case BannedPeerRule:
+		rules.Banned = append(rules.Banned, rule)
+	default:
+		return errors.Errorf("unknown peer rule kind %d", kind)
+	}
+	return s.setPeerRules(rules)
+}
+
+// RemovePeerRule removes a rule trusting or banning peers, and saves the rules to disk.
+func (s *Service) RemovePeerRule(kind PeerRuleKind, rule string) error {
+	s.peerRulesLock.Lock()
+	defer s.peerRulesLock.Unlock()
+
+	rules := &dbpb.PeerRules{
+		Trusted: s.peerRules.Trusted,
+		Banned:  s.peerRules.Banned,
+	}
+	switch kind {
+	case TrustedPeerRule:
+		rules.Trusted = removeString(rules.Trusted, rule)
+		if len(rules.Trusted) == len(s.peerRules.Trusted) {
+			return ErrPeerRuleNotFound
+		}
+	case BannedPeerRule:
+		rules.Banned = removeString(rules.Banned, rule)
+		if len(rules.Banned) == len(s.peerRules.Banned) {
+			return ErrPeerRuleNotFound
+		}
+	default:
+		return errors.Errorf("unknown peer rule kind %d", kind)
+	}
+	return s.setPeerRules(rules)
+}
+
+// PeerRules returns the rules of the given kind.
+func (s *Service) PeerRules(kind PeerRuleKind) []string {
+	s.peerRulesLock.Lock()
+	defer s.peerRulesLock.Unlock()
+
+	var rules []string
+	switch kind {
+	case TrustedPeerRule:
+		rules = s.peerRules.Trusted
+	case BannedPeerRule:
+		rules = s.peerRules.Banned
+	}
+	return append([]string{}, rules...)
+}
+
+// loadPeerRules applies the peer rules saved by a previous run of the node.
+func (s *Service) loadPeerRules() error {
+	s.peerRulesLock.Lock()
+	defer s.peerRulesLock.Unlock()
+
+	s.peerRules = &dbpb.PeerRules{}
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(path.Join(s.cfg.DataDir, peerRulesPath))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read peer rules")
+	}
+	rules := &dbpb.PeerRules{}
+	if err := rules.Unmarshal(enc); err != nil {
+		return errors.Wrap(err, "could not unmarshal peer rules")
+	}
+	if err := s.applyPeerRules(rules); err != nil {
+		return err
+	}
+	s.peerRules = rules
+	log.WithField("trusted", len(rules.Trusted)).WithField("banned", len(rules.Banned)).Info("Loaded peer rules")
+	return nil
+}
+
+// setPeerRules saves the rules to disk, then applies them. The rules in effect are left unchanged
+// when they can not be saved. The peer rules lock must be held.
+func (s *Service) setPeerRules(rules *dbpb.PeerRules) error {
+	if err := s.savePeerRules(rules); err != nil {
+		return err
+	}
+	if err := s.applyPeerRules(rules); err != nil {
+		return err
+	}
+	s.peerRules = rules
+	return nil
+}
+
+// savePeerRules writes the rules to a temporary file which then replaces the rules file, so that
+// the rules file is never left partially written.
+func (s *Service) savePeerRules(rules *dbpb.PeerRules) error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	enc, err := rules.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal peer rules")
+	}
+	rulesPath := path.Join(s.cfg.DataDir, peerRulesPath)
+	tmp := rulesPath + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not write peer rules")
+	}
+	if err := os.Rename(tmp, rulesPath); err != nil {
+		return errors.Wrap(err, "could not replace peer rules")
+	}
+	return nil
+}
+
+// applyPeerRules updates the trusted and banned peers and networks of the peer status, then
+// disconnects the peers which are now banned. The peer rules lock must be held.
+func (s *Service) applyPeerRules(rules *dbpb.PeerRules) error {
+	var trustedPeers []peer.ID
+	var trustedNets []*net.IPNet
+	var trustedDials []peer.AddrInfo
+	for _, r := range rules.Trusted {
+		rule, err := parsePeerRule(r)
+		if err != nil {
+			return err
+		}
+		if rule.ipNet != nil {
+			trustedNets = append(trustedNets, rule.ipNet)
+			continue
+		}
+		trustedPeers = append(trustedPeers, rule.pid)
+		trustedDials = append(trustedDials, peer.AddrInfo{ID: rule.pid, Addrs: rule.addrs})
+	}
+	var bannedPeers []peer.ID
+	var bannedNets []*net.IPNet
+	for _, r := range rules.Banned {
+		rule, err := parsePeerRule(r)
+		if err != nil {
+			return err
+		}
+		if rule.ipNet != nil {
+			bannedNets = append(bannedNets, rule.ipNet)
+			continue
+		}
+		bannedPeers = append(bannedPeers, rule.pid)
+	}
+
+	// Banned networks are kept apart from the address filter of the allow and deny list flags, so
+	// that removing a rule never drops a filter set by the flags.
+	s.peers.SetTrustedPeers(trustedPeers, trustedNets)
+	s.peers.SetBannedPeers(bannedPeers, bannedNets)
+	s.trustedDials = trustedDials
+
+	s.disconnectBannedPeers()
+	return nil
+}
+
+// disconnectBannedPeers disconnects from the connected peers which are banned by ID or network.
+func (s *Service) disconnectBannedPeers() {
+	if s.host == nil {
+		return
+	}
+	for _, pid := range s.peers.Connected() {
+		if !s.peers.IsBanned(pid) {
+			continue
+		}
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not disconnect from banned peer")
+		}
+	}
+}
+
+// ensureTrustedPeerConnections dials the trusted peers the node is not connected to. Peers trusted
+// by ID are dialed at their last known address.
+func (s *Service) ensureTrustedPeerConnections() {
+	s.peerRulesLock.Lock()
+	dials := s.trustedDials
+	s.peerRulesLock.Unlock()
+
+	for _, info := range dials {
+		if s.host.Network().Connectedness(info.ID) == network.Connected {
+			continue
+		}
+		if len(info.Addrs) == 0 {
+			addr, err := s.peers.Address(info.ID)
+			if err != nil || addr == nil {
+				continue
+			}
+			info.Addrs = []multiaddr.Multiaddr{addr}
+		}
+		if err := connectWithTimeout(s.ctx, s.host, &info); err != nil {
+			log.WithError(err).WithField("peer", info.ID).Debug("Could not connect with trusted peer")
+		}
+	}
+}
+
+func removeString(list []string, str string) []string {
+	result := make([]string, 0, len(list))
+	for _, s := range list {
+		if s != str {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestParsePeerRule(t *testing.T) {
+	rule, err := parsePeerRule("192.168.0.0/16")
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.0.0/16", rule.ipNet.String())
+
+	rule, err = parsePeerRule("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	assert.Equal(t, "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", rule.pid.String())
+	assert.Equal(t, 0, len(rule.addrs))
+
+	rule, err = parsePeerRule("/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	assert.Equal(t, "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", rule.pid.String())
+	require.Equal(t, 1, len(rule.addrs))
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/13000", rule.addrs[0].String())
+
+	_, err = parsePeerRule("not a peer")
+	assert.ErrorContains(t, "is neither a peer ID, an ENR, a multiaddress nor a CIDR", err)
+}
+
+func TestService_PeerRules(t *testing.T) {
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		s := &Service{
+			cfg: &Config{DataDir: dataDir},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit:    30,
+				ScorerParams: &scorers.Config{},
+			}),
+		}
+		var err error
+		s.addrFilter, err = configureFilter(s.cfg)
+		require.NoError(t, err)
+		require.NoError(t, s.loadPeerRules())
+		return s
+	}
+	s := newService()
+
+	trusted, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	banned, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	bannedAddr, err := multiaddr.NewMultiaddr("/ip4/10.1.2.3/tcp/13000")
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddPeerRule(TrustedPeerRule, trusted.String()))
+	require.NoError(t, s.AddPeerRule(BannedPeerRule, banned.String()))
+	require.NoError(t, s.AddPeerRule(BannedPeerRule, "10.0.0.0/8"))
+	err = s.AddPeerRule(BannedPeerRule, "10.0.0.0")
+	assert.ErrorContains(t, "is neither", err)
+	assert.Equal(t, true, errors.Is(err, ErrInvalidPeerRule))
+	assert.Equal(t, false, fileutil.FileExists(path.Join(dataDir, peerRulesPath+".tmp")))
+
+	// Rules are applied, and kept after a restart.
+	restarted := newService()
+	for _, svc := range []*Service{s, restarted} {
+		assert.Equal(t, true, svc.peers.IsTrusted(trusted))
+		assert.Equal(t, true, svc.peers.IsBanned(banned))
+		assert.Equal(t, false, svc.InterceptPeerDial(banned))
+		assert.Equal(t, true, svc.peers.IsBannedAddr(bannedAddr))
+		assert.Equal(t, false, svc.InterceptAddrDial(trusted, bannedAddr))
+		assert.DeepEqual(t, []string{banned.String(), "10.0.0.0/8"}, svc.PeerRules(BannedPeerRule))
+	}
+
+	// Trusted peers are not banned for bad responses.
+	for i := 0; i < scorers.DefaultBadResponsesThreshold; i++ {
+		restarted.peers.Scorers().BadResponsesScorer().Increment(trusted)
+	}
+	assert.Equal(t, false, restarted.peers.IsBad(trusted))
+
+	// Adding a rule of the other kind replaces the previous rule.
+	require.NoError(t, restarted.AddPeerRule(BannedPeerRule, trusted.String()))
+	assert.Equal(t, 0, len(restarted.PeerRules(TrustedPeerRule)))
+	assert.Equal(t, true, restarted.peers.IsBad(trusted))
+
+	assert.ErrorContains(t, ErrPeerRuleNotFound.Error(), restarted.RemovePeerRule(TrustedPeerRule, trusted.String()))
+	require.NoError(t, restarted.RemovePeerRule(BannedPeerRule, "10.0.0.0/8"))
+	assert.Equal(t, false, restarted.peers.IsBannedAddr(bannedAddr))
+	assert.Equal(t, true, filterConnections(restarted.addrFilter, bannedAddr))
+}
+
+func TestService_PeerRules_KeepDenyList(t *testing.T) {
+	s := &Service{
+		cfg: &Config{DenyListCIDR: []string{"10.0.0.0/8"}},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(s.cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.loadPeerRules())
+	addr, err := multiaddr.NewMultiaddr("/ip4/10.1.2.3/tcp/13000")
+	require.NoError(t, err)
+
+	// Removing a ban of the network of the deny list keeps the network denied.
+	require.NoError(t, s.AddPeerRule(BannedPeerRule, "10.0.0.0/8"))
+	require.NoError(t, s.RemovePeerRule(BannedPeerRule, "10.0.0.0/8"))
+	assert.Equal(t, false, filterConnections(s.addrFilter, addr))
+}
+
+func TestService_PeerRules_SaveFailure(t *testing.T) {
+	s := &Service{
+		cfg: &Config{DataDir: t.TempDir()},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	require.NoError(t, s.loadPeerRules())
+	// The data directory is replaced by a file, so that the rules can not be saved.
+	s.cfg.DataDir = path.Join(t.TempDir(), "file")
+	require.NoError(t, ioutil.WriteFile(s.cfg.DataDir, []byte{}, 0600))
+	addr, err := multiaddr.NewMultiaddr("/ip4/10.1.2.3/tcp/13000")
+	require.NoError(t, err)
+
+	err = s.AddPeerRule(BannedPeerRule, "10.0.0.0/8")
+	assert.ErrorContains(t, "could not write peer rules", err)
+	assert.Equal(t, false, errors.Is(err, ErrInvalidPeerRule))
+	// Rules which are not saved are not applied.
+	assert.Equal(t, 0, len(s.PeerRules(BannedPeerRule)))
+	assert.Equal(t, false, s.peers.IsBannedAddr(addr))
+}
@@ -48,6 +48,8 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	LastSeen      time.Time
+	BannedUntil   time.Time
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
@@ -0,0 +1,176 @@
+package peers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Ban bans the peer until the given time. Banned peers are considered bad, they are neither dialed
+// nor accepted, and their ban is kept across restarts through the peer records.
+func (p *Status) Ban(pid peer.ID, until time.Time) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if until.After(peerData.BannedUntil) {
+		peerData.BannedUntil = until
+	}
+}
+
+// IsBanned checks whether the peer is currently banned, either by the operator or as a bad peer. Bans
+// of bad peers do not apply to trusted peers.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if p.bannedPeers[pid] {
+		return true
+	}
+	peerData, ok := p.store.PeerData(pid)
+	if ok && addrInNets(peerData.Address, p.bannedNets) {
+		return true
+	}
+	if p.isTrusted(pid) {
+		return false
+	}
+	return ok && peerData.BannedUntil.After(timeutils.Now())
+}
+
+// Records returns the reputation records of the peers the node has been connected to, and of the
+// banned peers. Other peers were only discovered, there is nothing worth remembering about them.
+func (p *Status) Records() []*dbpb.PeerRecord {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := timeutils.Now()
+	records := make([]*dbpb.PeerRecord, 0)
+	for pid, peerData := range p.store.Peers() {
+		banned := peerData.BannedUntil.After(now)
+		if peerData.LastSeen.IsZero() && !banned {
+			continue
+		}
+		record := &dbpb.PeerRecord{
+			PeerId:           peer.Encode(pid),
+			Outbound:         peerData.Direction == network.DirOutbound,
+			BadResponses:     int64(peerData.BadResponses),
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+		}
+		if peerData.Address != nil {
+			record.Address = peerData.Address.String()
+		}
+		if !peerData.LastSeen.IsZero() {
+			record.LastSeen = peerData.LastSeen.Unix()
+		}
+		if banned {
+			record.BannedUntil = peerData.BannedUntil.Unix()
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// LoadRecords restores the reputation of the peers from their records, and returns the number of
+// loaded records. Invalid records and the records of peers already known are skipped, the data of
+// known peers being more recent. The bad responses of peers whose ban has expired are forgiven.
+func (p *Status) LoadRecords(records []*dbpb.PeerRecord) int {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := timeutils.Now()
+	loaded := 0
+	for _, record := range records {
+		pid, err := peer.Decode(record.PeerId)
+		if err != nil {
+			continue
+		}
+		if _, ok := p.store.PeerData(pid); ok {
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			ConnState:        PeerDisconnected,
+			BadResponses:     int(record.BadResponses),
+			ProcessedBlocks:  record.ProcessedBlocks,
+			GossipScore:      record.GossipScore,
+			BehaviourPenalty: record.BehaviourPenalty,
+		}
+		if record.Address != "" {
+			address, err := ma.NewMultiaddr(record.Address)
+			if err != nil {
+				continue
+			}
+			peerData.Address = address
+		}
+		if record.Outbound {
+			peerData.Direction = network.DirOutbound
+		} else {
+			peerData.Direction = network.DirInbound
+		}
+		if record.LastSeen != 0 {
+			peerData.LastSeen = time.Unix(record.LastSeen, 0)
+		}
+		if record.BannedUntil != 0 {
+			peerData.BannedUntil = time.Unix(record.BannedUntil, 0)
+			if !peerData.BannedUntil.After(now) {
+				peerData.BannedUntil = time.Time{}
+				peerData.BadResponses = 0
+			}
+		}
+		p.store.SetPeerData(pid, peerData)
+		p.addIpToTracker(pid)
+		loaded++
+	}
+	return loaded
+}
+
+// KnownGoodPeers returns the address info of at most limit disconnected peers which are neither
+// bad nor banned and were dialed by the node before, so that they can be dialed again. Peers are
+// ordered by decreasing score, then by most recently seen.
+func (p *Status) KnownGoodPeers(limit int) []peer.AddrInfo {
+	p.store.RLock()
+	candidates := make([]peer.ID, 0)
+	addresses := make(map[peer.ID]ma.Multiaddr)
+	lastSeen := make(map[peer.ID]time.Time)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.ConnState != PeerDisconnected || peerData.Direction != network.DirOutbound ||
+			peerData.Address == nil || peerData.LastSeen.IsZero() {
+			continue
+		}
+		candidates = append(candidates, pid)
+		addresses[pid] = peerData.Address
+		lastSeen[pid] = peerData.LastSeen
+	}
+	p.store.RUnlock()
+
+	scores := make(map[peer.ID]float64, len(candidates))
+	goodPeers := make([]peer.ID, 0, len(candidates))
+	for _, pid := range candidates {
+		if p.IsBad(pid) {
+			continue
+		}
+		scores[pid] = p.scorers.Score(pid)
+		goodPeers = append(goodPeers, pid)
+	}
+	sort.Slice(goodPeers, func(i, j int) bool {
+		if scores[goodPeers[i]] == scores[goodPeers[j]] {
+			return lastSeen[goodPeers[i]].After(lastSeen[goodPeers[j]])
+		}
+		return scores[goodPeers[i]] > scores[goodPeers[j]]
+	})
+	if len(goodPeers) > limit {
+		goodPeers = goodPeers[:limit]
+	}
+	infos := make([]peer.AddrInfo, 0, len(goodPeers))
+	for _, pid := range goodPeers {
+		infos = append(infos, peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addresses[pid]}})
+	}
+	return infos
+}
@@ -0,0 +1,97 @@
+package peers_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func newRecordsTestStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+}
+
+// createRecordedPeer adds a peer with a real peer ID, which unlike the IDs of createPeer can be
+// decoded from its record.
+func createRecordedPeer(t *testing.T, p *peers.Status, addr ma.Multiaddr,
+	dir network.Direction, state peerdata.PeerConnectionState) peer.ID {
+	_, pubKey, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pubKey)
+	require.NoError(t, err)
+	p.Add(new(enr.Record), id, addr, dir)
+	p.SetConnectionState(id, state)
+	return id
+}
+
+func TestStatus_Records(t *testing.T) {
+	p := newRecordsTestStatus()
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	good := createRecordedPeer(t, p, addr, network.DirOutbound, peers.PeerConnected)
+	p.SetConnectionState(good, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(good)
+	banned := createRecordedPeer(t, p, nil, network.DirInbound, peers.PeerDisconnected)
+	p.Ban(banned, time.Now().Add(time.Hour))
+	// Never connected, the peer is not recorded.
+	createRecordedPeer(t, p, nil, network.DirOutbound, peers.PeerDisconnected)
+
+	records := p.Records()
+	require.Equal(t, 2, len(records))
+
+	restored := newRecordsTestStatus()
+	assert.Equal(t, 2, restored.LoadRecords(records))
+	assert.Equal(t, true, restored.IsBanned(banned))
+	assert.Equal(t, true, restored.IsBad(banned))
+	assert.Equal(t, false, restored.IsBad(good))
+	badResponses, err := restored.Scorers().BadResponsesScorer().Count(good)
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	address, err := restored.Address(good)
+	require.NoError(t, err)
+	assert.Equal(t, addr.String(), address.String())
+
+	assert.DeepEqual(t, []peer.AddrInfo{{ID: good, Addrs: []ma.Multiaddr{address}}}, restored.KnownGoodPeers(10))
+	assert.Equal(t, 0, len(restored.KnownGoodPeers(0)))
+}
+
+func TestStatus_LoadRecords(t *testing.T) {
+	p := newRecordsTestStatus()
+	known := createRecordedPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+
+	expired := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	loaded := p.LoadRecords([]*dbpb.PeerRecord{
+		{PeerId: peer.Encode(known), BadResponses: 2},
+		{PeerId: "invalid"},
+		{PeerId: expired, BadResponses: 5, BannedUntil: time.Now().Add(-time.Minute).Unix()},
+	})
+	assert.Equal(t, 1, loaded)
+
+	// Data of known peers is more recent than their records.
+	assert.Equal(t, false, p.IsBad(known))
+	// The bad responses of peers whose ban has expired are forgiven.
+	pid, err := peer.Decode(expired)
+	require.NoError(t, err)
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsBad(pid))
+}
@@ -19,11 +19,13 @@
 //
 // Peer information is persistent for the run of the service. This allows for collection of useful
 // long-term statistics such as number of bad responses obtained from the peer, giving the basis for
-// decisions to not talk to known-bad peers (by de-scoring them).
+// decisions to not talk to known-bad peers (by de-scoring them). The reputation of the peers can be
+// exported as records and loaded back, so that it survives restarts.
 package peers
 
 import (
 	"context"
+	"net"
 	"sort"
 	"time"
 
@@ -66,10 +68,14 @@ const (
 
 // Status is the structure holding the peer status information.
 type Status struct {
-	ctx       context.Context
-	scorers   *scorers.Service
-	store     *peerdata.Store
-	ipTracker map[string]uint64
+	ctx          context.Context
+	scorers      *scorers.Service
+	store        *peerdata.Store
+	ipTracker    map[string]uint64
+	trustedPeers map[peer.ID]bool
+	trustedNets  []*net.IPNet
+	bannedPeers  map[peer.ID]bool
+	bannedNets   []*net.IPNet
 }
 
 // StatusConfig represents peer status service params.
@@ -86,10 +92,12 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
 	return &Status{
-		ctx:       ctx,
-		store:     store,
-		scorers:   scorers.New(ctx, store, config.ScorerParams),
-		ipTracker: map[string]uint64{},
+		ctx:          ctx,
+		store:        store,
+		scorers:      scorers.New(ctx, store, config.ScorerParams),
+		ipTracker:    map[string]uint64{},
+		trustedPeers: map[peer.ID]bool{},
+		bannedPeers:  map[peer.ID]bool{},
 	}
 }
 
@@ -271,6 +279,9 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	if peerData.ConnState == PeerConnected || state == PeerConnected {
+		peerData.LastSeen = timeutils.Now()
+	}
 	peerData.ConnState = state
 }
 
@@ -298,12 +309,67 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 	return timeutils.Now(), peerdata.ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
+// IsBad states if the peer is to be considered bad (by *any* of the registered scorers), or is banned.
+// Trusted peers are only considered bad when banned by the operator.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
+	if p.IsBanned(pid) {
+		return true
+	}
+	if p.IsTrusted(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
+// SetTrustedPeers replaces the peers trusted by the operator, which are the peers with one of the
+// given IDs or whose address is in one of the given networks. Trusted peers are exempt from pruning.
+func (p *Status) SetTrustedPeers(pids []peer.ID, nets []*net.IPNet) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	p.trustedPeers = make(map[peer.ID]bool, len(pids))
+	for _, pid := range pids {
+		p.trustedPeers[pid] = true
+	}
+	p.trustedNets = nets
+}
+
+// IsTrusted checks whether the peer is trusted by the operator.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isTrusted(pid)
+}
+
+// IsTrustedAddr checks whether the address is in one of the networks trusted by the operator.
+func (p *Status) IsTrustedAddr(addr ma.Multiaddr) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isTrustedAddr(addr)
+}
+
+// IsBannedAddr checks whether the address is in one of the networks banned by the operator.
+func (p *Status) IsBannedAddr(addr ma.Multiaddr) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return addrInNets(addr, p.bannedNets)
+}
+
+// SetBannedPeers replaces the peers banned by the operator, which are the peers with one of the
+// given IDs or whose address is in one of the given networks. Unlike bans of bad peers, these bans
+// do not expire.
+func (p *Status) SetBannedPeers(pids []peer.ID, nets []*net.IPNet) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	p.bannedPeers = make(map[peer.ID]bool, len(pids))
+	for _, pid := range pids {
+		p.bannedPeers[pid] = true
+	}
+	p.bannedNets = nets
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -499,8 +565,10 @@ func (p *Status) Prune() {
 		return
 	}
 
+	now := timeutils.Now()
 	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold &&
+			!peerData.BannedUntil.After(now)
 	}
 	type peerResp struct {
 		pid     peer.ID
@@ -509,7 +577,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.isTrusted(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -663,7 +731,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerConnected && peerData.Direction == network.DirInbound {
+		if peerData.ConnState == PeerConnected && peerData.Direction == network.DirInbound && !p.isTrusted(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -714,6 +782,37 @@ func (p *Status) ConnectedPeerLimit() uint64 {
 	return uint64(maxLim) - maxLimitBuffer
 }
 
+// isTrusted is a lock-free version of IsTrusted.
+func (p *Status) isTrusted(pid peer.ID) bool {
+	if p.trustedPeers[pid] {
+		return true
+	}
+	peerData, ok := p.store.PeerData(pid)
+	return ok && p.isTrustedAddr(peerData.Address)
+}
+
+// isTrustedAddr is a lock-free version of IsTrustedAddr.
+func (p *Status) isTrustedAddr(addr ma.Multiaddr) bool {
+	return addrInNets(addr, p.trustedNets)
+}
+
+// addrInNets checks whether the ip address of the multiaddress is in one of the networks.
+func addrInNets(addr ma.Multiaddr, nets []*net.IPNet) bool {
+	if addr == nil || len(nets) == 0 {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Status) isfromBadIP(pid peer.ID) bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
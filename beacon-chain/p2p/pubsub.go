@@ -152,3 +152,14 @@ func convertTopicScores(topicMap map[string]*pubsub.TopicScoreSnapshot) map[stri
 	}
 	return newMap
 }
+
+// eventTracers passes the pubsub trace events to each of its tracers, as the pubsub router only
+// takes a single event tracer.
+type eventTracers []pubsub.EventTracer
+
+// Trace passes the event to each tracer.
+func (t eventTracers) Trace(evt *pubsub_pb.TraceEvent) {
+	for _, tracer := range t {
+		tracer.Trace(evt)
+	}
+}
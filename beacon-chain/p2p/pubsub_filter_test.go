@@ -327,6 +327,7 @@ func TestService_MonitorsStateForkUpdates(t *testing.T) {
 	notifier := &mock.MockStateNotifier{}
 	s, err := New(ctx, &Config{
 		StateNotifier: notifier,
+		DataDir:       t.TempDir(),
 	})
 	require.NoError(t, err)
 
@@ -21,6 +21,7 @@ import (
 func TestService_PublishToTopicConcurrentMapWrite(t *testing.T) {
 	s, err := New(context.Background(), &Config{
 		StateNotifier: &mock.MockStateNotifier{},
+		DataDir:       t.TempDir(),
 	})
 	require.NoError(t, err)
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -0,0 +1,184 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// pubsubTraceFilePrefix and pubsubTraceFileSuffix frame the name of the gossipsub trace files.
+	// The creation time and the sequence number of the file in between keep the files sorted by
+	// name, even when several files are created within the resolution of the clock.
+	pubsubTraceFilePrefix = "pubsub-trace-"
+	pubsubTraceFileSuffix = ".pb"
+	// pubsubTraceBufferSize is the number of trace events buffered before events are dropped.
+	pubsubTraceBufferSize = 1 << 14
+	// pubsubTraceFlushInterval is the interval at which the buffered trace events are written to disk.
+	pubsubTraceFlushInterval = time.Second
+)
+
+// pubsubTracer writes the gossipsub trace events to rotating files in a directory. Events are
+// written as length-delimited protobuf messages, the format of the libp2p protobuf tracer, so that
+// trace files can be read by the libp2p tracing tools as well as by tools/pubsub-trace-summary.
+//
+// Tracing runs in the pubsub event loop, so events are written in the background and are dropped
+// when the writer falls behind, rather than slowing down gossip.
+type pubsubTracer struct {
+	dir         string
+	maxFileSize uint64
+	maxFiles    int
+	events      chan *pubsubpb.TraceEvent
+	done        chan struct{}
+	file        *os.File
+	writer      *bufio.Writer
+	written     uint64
+	sequence    uint64
+}
+
+// newPubsubTracer creates the trace directory and the first trace file, then writes the traced
+// events until the context is canceled. The events queued by then are written before the trace
+// file is closed.
+func newPubsubTracer(ctx context.Context, dir string, maxFileSize uint64, maxFiles int) (*pubsubTracer, error) {
+	if maxFiles < 1 {
+		return nil, errors.Errorf("at least one trace file must be kept, got %d", maxFiles)
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, errors.Wrap(err, "could not create trace directory")
+	}
+	t := &pubsubTracer{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		events:      make(chan *pubsubpb.TraceEvent, pubsubTraceBufferSize),
+		done:        make(chan struct{}),
+	}
+	if err := t.rotate(); err != nil {
+		return nil, err
+	}
+	go t.run(ctx)
+	return t, nil
+}
+
+// Trace queues the event to be written to the trace files.
+func (t *pubsubTracer) Trace(evt *pubsubpb.TraceEvent) {
+	select {
+	case t.events <- evt:
+	default:
+		droppedPubsubTraceEvents.Inc()
+	}
+}
+
+func (t *pubsubTracer) run(ctx context.Context) {
+	defer close(t.done)
+	ticker := time.NewTicker(pubsubTraceFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case evt := <-t.events:
+			t.writeEvent(evt)
+		case <-ticker.C:
+			if err := t.writer.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush pubsub trace events")
+			}
+		case <-ctx.Done():
+			// The events queued before the cancellation would be lost otherwise, as the select
+			// picks any of its ready cases.
+			for drained := false; !drained; {
+				select {
+				case evt := <-t.events:
+					t.writeEvent(evt)
+				default:
+					drained = true
+				}
+			}
+			if err := t.close(); err != nil {
+				log.WithError(err).Error("Could not close pubsub trace file")
+			}
+			return
+		}
+	}
+}
+
+func (t *pubsubTracer) writeEvent(evt *pubsubpb.TraceEvent) {
+	if err := t.write(evt); err != nil {
+		log.WithError(err).Error("Could not write pubsub trace event")
+	}
+}
+
+// write writes the event to the current trace file, rotating the file when it is full.
+func (t *pubsubTracer) write(evt *pubsubpb.TraceEvent) error {
+	enc, err := evt.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal trace event")
+	}
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(enc)))
+	if _, err := t.writer.Write(prefix[:n]); err != nil {
+		return err
+	}
+	if _, err := t.writer.Write(enc); err != nil {
+		return err
+	}
+	t.written += uint64(n + len(enc))
+	if t.written >= t.maxFileSize {
+		return t.rotate()
+	}
+	return nil
+}
+
+// rotate closes the current trace file, opens a new one, and deletes the oldest files beyond the
+// maximum number of files.
+func (t *pubsubTracer) rotate() error {
+	if err := t.close(); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s%s-%06d%s", pubsubTraceFilePrefix, timeutils.Now().UTC().Format("20060102T150405.000000000"),
+		t.sequence, pubsubTraceFileSuffix)
+	t.sequence++
+	file, err := os.OpenFile(path.Join(t.dir, name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not create trace file")
+	}
+	t.file = file
+	t.writer = bufio.NewWriter(file)
+	t.written = 0
+
+	files, err := filepath.Glob(path.Join(t.dir, pubsubTraceFilePrefix+"*"+pubsubTraceFileSuffix))
+	if err != nil {
+		return errors.Wrap(err, "could not list trace files")
+	}
+	sort.Strings(files)
+	for len(files) > t.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return errors.Wrap(err, "could not delete trace file")
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// close flushes and closes the current trace file, if any.
+func (t *pubsubTracer) close() error {
+	if t.file == nil {
+		return nil
+	}
+	file := t.file
+	t.file = nil
+	if err := t.writer.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
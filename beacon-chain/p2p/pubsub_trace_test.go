@@ -0,0 +1,100 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPubsubTracer_Rotation(t *testing.T) {
+	dir := path.Join(t.TempDir(), "trace")
+	ctx, cancel := context.WithCancel(context.Background())
+	tracer, err := newPubsubTracer(ctx, dir, 64 /* maxFileSize */, 3 /* maxFiles */)
+	require.NoError(t, err)
+
+	topic := "/eth2/abcd1234/beacon_block/ssz_snappy"
+	typ := pubsubpb.TraceEvent_DELIVER_MESSAGE
+	for i := 0; i < 20; i++ {
+		timestamp := int64(i)
+		tracer.Trace(&pubsubpb.TraceEvent{
+			Type:      &typ,
+			Timestamp: &timestamp,
+			DeliverMessage: &pubsubpb.TraceEvent_DeliverMessage{
+				MessageID: []byte{byte(i)},
+				Topic:     &topic,
+			},
+		})
+	}
+	// The queued events are written and the trace file is closed once the context is canceled.
+	cancel()
+	<-tracer.done
+
+	files, err := filepath.Glob(path.Join(dir, pubsubTraceFilePrefix+"*"+pubsubTraceFileSuffix))
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(files))
+
+	// The most recent events are kept, in order.
+	sort.Strings(files)
+	var timestamps []int64
+	for _, file := range files {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		reader := bufio.NewReader(f)
+		for {
+			size, err := binary.ReadUvarint(reader)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			buf := make([]byte, size)
+			_, err = io.ReadFull(reader, buf)
+			require.NoError(t, err)
+			evt := &pubsubpb.TraceEvent{}
+			require.NoError(t, evt.Unmarshal(buf))
+			assert.Equal(t, topic, evt.DeliverMessage.GetTopic())
+			timestamps = append(timestamps, evt.GetTimestamp())
+		}
+		require.NoError(t, f.Close())
+	}
+	require.NotEqual(t, 0, len(timestamps))
+	assert.Equal(t, int64(19), timestamps[len(timestamps)-1])
+	for i := 1; i < len(timestamps); i++ {
+		assert.Equal(t, timestamps[i-1]+1, timestamps[i])
+	}
+}
+
+func TestPubsubTracer_RotationSequence(t *testing.T) {
+	dir := t.TempDir()
+	tracer := &pubsubTracer{dir: dir, maxFiles: 3}
+
+	// Files are ordered by their sequence number, even when created within the clock resolution.
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tracer.rotate())
+	}
+	require.NoError(t, tracer.close())
+	files, err := filepath.Glob(path.Join(dir, pubsubTraceFilePrefix+"*"+pubsubTraceFileSuffix))
+	require.NoError(t, err)
+	sort.Strings(files)
+	require.Equal(t, 3, len(files))
+	for i, file := range files {
+		assert.Equal(t, true, strings.HasSuffix(file, fmt.Sprintf("-%06d%s", i+1, pubsubTraceFileSuffix)),
+			"Unexpected trace file %s", file)
+	}
+}
+
+func TestPubsubTracer_InvalidConfig(t *testing.T) {
+	_, err := newPubsubTracer(context.Background(), t.TempDir(), 1024, 0)
+	assert.ErrorContains(t, "at least one trace file must be kept", err)
+}
@@ -32,6 +32,7 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
+	s.traffic.countRequest(pid, stream.Protocol(), false /* inbound */)
 	// do not encode anything if we are sending a metadata request
 	if baseTopic != RPCMetaDataTopic {
 		if _, err := s.Encoding().EncodeWithMaxLength(stream, message); err != nil {
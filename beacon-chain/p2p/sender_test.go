@@ -22,8 +22,9 @@ func TestService_Send(t *testing.T) {
 	p1.Connect(p2)
 
 	svc := &Service{
-		host: p1.BHost,
-		cfg:  &Config{},
+		host:    p1.BHost,
+		cfg:     &Config{},
+		traffic: newTrafficTracker(nil),
 	}
 
 	msg := &pb.Fork{
@@ -59,4 +60,6 @@ func TestService_Send(t *testing.T) {
 	if !proto.Equal(rcvd, msg) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
+	traffic := svc.PeerTraffic(p2.BHost.ID())
+	assert.Equal(t, uint64(1), traffic.Protocols[topic+"/ssz_snappy"].MessagesOut)
 }
@@ -27,12 +27,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -80,6 +82,10 @@ type Service struct {
 	host                  host.Host
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
+	peerRules             *dbpb.PeerRules
+	peerRulesLock         sync.Mutex
+	trustedDials          []peer.AddrInfo
+	traffic               *trafficTracker
 }
 
 // New initializes a new p2p service compatible with shared.Service interface. No
@@ -98,6 +104,7 @@ func New(ctx context.Context, cfg *Config) (*Service, error) {
 		joinedTopics:  make(map[string]*pubsub.Topic, len(GossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
 	}
+	s.traffic = newTrafficTracker(s.CanSubscribe)
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
 
@@ -149,6 +156,17 @@ func New(ctx context.Context, cfg *Config) (*Service, error) {
 			pubsub.WithPeerScore(peerScoringParams()),
 			pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute))
 	}
+	tracers := eventTracers{s.traffic}
+	if s.cfg.PubsubTraceDir != "" {
+		tracer, err := newPubsubTracer(s.ctx, s.cfg.PubsubTraceDir, s.cfg.PubsubTraceFileSize, s.cfg.PubsubTraceFiles)
+		if err != nil {
+			log.WithError(err).Error("Failed to create pubsub tracer")
+			return nil, err
+		}
+		tracers = append(tracers, tracer)
+		log.WithField("dir", s.cfg.PubsubTraceDir).Info("Tracing gossipsub events")
+	}
+	psOpts = append(psOpts, pubsub.WithEventTracer(tracers))
 	// Set the pubsub global parameters that we require.
 	setPubSubParameters()
 
@@ -168,6 +186,12 @@ func New(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	if err := s.loadPeerRecords(); err != nil {
+		log.WithError(err).Error("Could not load peer records")
+	}
+	if err := s.loadPeerRules(); err != nil {
+		log.WithError(err).Error("Could not load peer rules")
+	}
 
 	return s, nil
 }
@@ -222,12 +246,24 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	if !s.cfg.NoDiscovery {
+		s.connectToKnownPeers()
+	}
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
-	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, s.ensureTrustedPeerConnections)
+	runutil.RunEvery(s.ctx, 30*time.Minute, func() {
+		s.peers.Prune()
+		s.traffic.prune(s.peers.All(), timeutils.Now().Add(-trafficIdleTimeout))
+	})
+	runutil.RunEvery(s.ctx, peerRecordsSaveInterval, func() {
+		if err := s.savePeerRecords(); err != nil {
+			log.WithError(err).Error("Could not save peer records")
+		}
+	})
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -264,6 +300,11 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if s.peers != nil {
+		if err := s.savePeerRecords(); err != nil {
+			log.WithError(err).Error("Could not save peer records")
+		}
+	}
 	return nil
 }
 
@@ -304,9 +345,13 @@ func (s *Service) Host() host.Host {
 }
 
 // SetStreamHandler sets the protocol handler on the p2p host multiplexer.
-// This method is a pass through to libp2pcore.Host.SetStreamHandler.
+// This method is a pass through to libp2pcore.Host.SetStreamHandler, which
+// counts the inbound requests of each peer.
 func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler) {
-	s.host.SetStreamHandler(protocol.ID(topic), handler)
+	s.host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		s.traffic.countRequest(stream.Conn().RemotePeer(), stream.Protocol(), true /* inbound */)
+		handler(stream)
+	})
 }
 
 // PeerID returns the Peer ID of the local peer.
@@ -81,7 +81,7 @@ func createHost(t *testing.T, port int) (host.Host, *ecdsa.PrivateKey, net.IP) {
 }
 
 func TestService_Stop_SetsStartedToFalse(t *testing.T) {
-	s, err := New(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := New(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 	s.started = true
 	s.dv5Listener = &mockListener{}
@@ -90,7 +90,7 @@ func TestService_Stop_SetsStartedToFalse(t *testing.T) {
 }
 
 func TestService_Stop_DontPanicIfDv5ListenerIsNotInited(t *testing.T) {
-	s, err := New(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := New(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 	assert.NoError(t, s.Stop())
 }
@@ -102,6 +102,7 @@ func TestService_Start_OnlyStartsOnce(t *testing.T) {
 		TCPPort:       2000,
 		UDPPort:       2000,
 		StateNotifier: &mock.MockStateNotifier{},
+		DataDir:       t.TempDir(),
 	}
 	s, err := New(context.Background(), cfg)
 	require.NoError(t, err)
@@ -205,6 +206,7 @@ func TestListenForNewNodes(t *testing.T) {
 
 	cfg.UDPPort = 14000
 	cfg.TCPPort = 14001
+	cfg.DataDir = t.TempDir()
 
 	s, err = New(context.Background(), cfg)
 	require.NoError(t, err)
@@ -263,7 +265,7 @@ func TestPeer_Disconnect(t *testing.T) {
 func TestService_JoinLeaveTopic(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	s, err := New(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := New(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 
 	go s.awaitStateInitialized()
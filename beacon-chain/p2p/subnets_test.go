@@ -82,6 +82,7 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 		UDPPort:             uint(port),
 	}
 	cfg.StateNotifier = &mock.MockStateNotifier{}
+	cfg.DataDir = t.TempDir()
 	s, err = New(context.Background(), cfg)
 	require.NoError(t, err)
 	exitRoutine := make(chan bool)
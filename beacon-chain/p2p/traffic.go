@@ -0,0 +1,282 @@
+package p2p
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+const (
+	// trafficTopPeers is the number of peers exposed by the top peers traffic metric.
+	trafficTopPeers = 10
+	// trafficIdleTimeout is the time after which the bandwidth meters of a peer or protocol which
+	// did not exchange any traffic are dropped.
+	trafficIdleTimeout = time.Hour
+	// otherTopic is the topic the gossip messages of topics the node cannot subscribe to are
+	// accounted for under, so that peers cannot create metric series by sending arbitrary topics.
+	otherTopic = "other"
+)
+
+// Traffic is an amount of traffic exchanged with peers.
+type Traffic struct {
+	BytesIn     uint64
+	BytesOut    uint64
+	MessagesIn  uint64
+	MessagesOut uint64
+}
+
+// PeerTraffic is the traffic exchanged with a peer since the node started, in total and by gossip
+// topic and RPC protocol. Messages of a protocol are the request streams of that protocol, and
+// total bytes include the traffic of every protocol, such as identify and gossip control messages.
+// Gossip bytes are accounted for by protocol only, so the traffic of a topic only counts messages.
+type PeerTraffic struct {
+	ID        peer.ID
+	Total     Traffic
+	RateIn    float64
+	RateOut   float64
+	Topics    map[string]Traffic
+	Protocols map[string]Traffic
+}
+
+// peerTrafficCounters are the traffic counters of a peer.
+type peerTrafficCounters struct {
+	topics    map[string]*Traffic
+	protocols map[protocol.ID]*Traffic
+}
+
+// trafficTracker accounts for the traffic exchanged with each peer. It is both the bandwidth
+// reporter of the libp2p host, which provides the bytes exchanged by protocol, and an event tracer
+// of the pubsub router, which provides the gossip messages exchanged by topic.
+type trafficTracker struct {
+	*metrics.BandwidthCounter
+	lock  sync.RWMutex
+	peers map[peer.ID]*peerTrafficCounters
+	// canSubscribe tells whether a topic is accounted for under its own name. The allowed topics
+	// are cached, there are only as many of them as gossip topics of the current fork.
+	canSubscribe  func(topic string) bool
+	allowedTopics map[string]bool
+}
+
+var _ metrics.Reporter = (*trafficTracker)(nil)
+var _ pubsub.EventTracer = (*trafficTracker)(nil)
+
+func newTrafficTracker(canSubscribe func(topic string) bool) *trafficTracker {
+	return &trafficTracker{
+		BandwidthCounter: metrics.NewBandwidthCounter(),
+		peers:            make(map[peer.ID]*peerTrafficCounters),
+		canSubscribe:     canSubscribe,
+		allowedTopics:    make(map[string]bool),
+	}
+}
+
+// counters returns the traffic counters of the peer. The lock must be held.
+func (t *trafficTracker) counters(pid peer.ID) *peerTrafficCounters {
+	counters, ok := t.peers[pid]
+	if !ok {
+		counters = &peerTrafficCounters{
+			topics:    make(map[string]*Traffic),
+			protocols: make(map[protocol.ID]*Traffic),
+		}
+		t.peers[pid] = counters
+	}
+	return counters
+}
+
+// LogSentMessageStream records the bytes sent to a peer on a stream of the given protocol.
+func (t *trafficTracker) LogSentMessageStream(size int64, proto protocol.ID, pid peer.ID) {
+	t.BandwidthCounter.LogSentMessageStream(size, proto, pid)
+	t.lock.Lock()
+	t.protocolTraffic(pid, proto).BytesOut += uint64(size)
+	t.lock.Unlock()
+	protocolBytes.WithLabelValues(string(proto), "out").Add(float64(size))
+}
+
+// LogRecvMessageStream records the bytes received from a peer on a stream of the given protocol.
+func (t *trafficTracker) LogRecvMessageStream(size int64, proto protocol.ID, pid peer.ID) {
+	t.BandwidthCounter.LogRecvMessageStream(size, proto, pid)
+	t.lock.Lock()
+	t.protocolTraffic(pid, proto).BytesIn += uint64(size)
+	t.lock.Unlock()
+	protocolBytes.WithLabelValues(string(proto), "in").Add(float64(size))
+}
+
+// countRequest records a request stream of the given protocol, opened by the peer when inbound
+// or by the node otherwise.
+func (t *trafficTracker) countRequest(pid peer.ID, proto protocol.ID, inbound bool) {
+	t.lock.Lock()
+	traffic := t.protocolTraffic(pid, proto)
+	direction := "out"
+	if inbound {
+		traffic.MessagesIn++
+		direction = "in"
+	} else {
+		traffic.MessagesOut++
+	}
+	t.lock.Unlock()
+	protocolRequests.WithLabelValues(string(proto), direction).Inc()
+}
+
+// protocolTraffic returns the traffic of the peer for the protocol. The lock must be held.
+func (t *trafficTracker) protocolTraffic(pid peer.ID, proto protocol.ID) *Traffic {
+	counters := t.counters(pid)
+	traffic, ok := counters.protocols[proto]
+	if !ok {
+		traffic = &Traffic{}
+		counters.protocols[proto] = traffic
+	}
+	return traffic
+}
+
+// topicLabel returns the topic the messages of the topic are accounted for under, which is
+// otherTopic unless the node can subscribe to the topic. The lock must be held.
+func (t *trafficTracker) topicLabel(topic string) string {
+	if t.allowedTopics[topic] {
+		return topic
+	}
+	if t.canSubscribe == nil || !t.canSubscribe(topic) {
+		return otherTopic
+	}
+	t.allowedTopics[topic] = true
+	return topic
+}
+
+// topicTraffic returns the traffic of the peer for the topic. The lock must be held.
+func (t *trafficTracker) topicTraffic(pid peer.ID, topic string) *Traffic {
+	counters := t.counters(pid)
+	traffic, ok := counters.topics[topic]
+	if !ok {
+		traffic = &Traffic{}
+		counters.topics[topic] = traffic
+	}
+	return traffic
+}
+
+// Trace records the gossip messages exchanged with peers, as traced by the pubsub router. The
+// traced RPCs do not carry the size of their messages, so gossip bytes are only accounted for by
+// protocol.
+func (t *trafficTracker) Trace(evt *pubsubpb.TraceEvent) {
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_RECV_RPC:
+		t.countMessages(peer.ID(evt.RecvRPC.GetReceivedFrom()), evt.RecvRPC.GetMeta().GetMessages(), true /* inbound */)
+	case pubsubpb.TraceEvent_SEND_RPC:
+		t.countMessages(peer.ID(evt.SendRPC.GetSendTo()), evt.SendRPC.GetMeta().GetMessages(), false /* inbound */)
+	}
+}
+
+// countMessages records the gossip messages received from the peer when inbound, or sent to the
+// peer otherwise. Messages of topics the node cannot subscribe to are counted under otherTopic.
+func (t *trafficTracker) countMessages(pid peer.ID, msgs []*pubsubpb.TraceEvent_MessageMeta, inbound bool) {
+	if len(msgs) == 0 {
+		return
+	}
+	direction := "out"
+	if inbound {
+		direction = "in"
+	}
+	topics := make([]string, len(msgs))
+	t.lock.Lock()
+	for i, msg := range msgs {
+		topics[i] = t.topicLabel(msg.GetTopic())
+		traffic := t.topicTraffic(pid, topics[i])
+		if inbound {
+			traffic.MessagesIn++
+		} else {
+			traffic.MessagesOut++
+		}
+	}
+	t.lock.Unlock()
+	for _, topic := range topics {
+		topicMessages.WithLabelValues(topic, direction).Inc()
+	}
+}
+
+// peerTraffic returns the traffic exchanged with the peer.
+func (t *trafficTracker) peerTraffic(pid peer.ID) *PeerTraffic {
+	stats := t.GetBandwidthForPeer(pid)
+	traffic := &PeerTraffic{
+		ID: pid,
+		Total: Traffic{
+			BytesIn:  uint64(stats.TotalIn),
+			BytesOut: uint64(stats.TotalOut),
+		},
+		RateIn:    stats.RateIn,
+		RateOut:   stats.RateOut,
+		Topics:    make(map[string]Traffic),
+		Protocols: make(map[string]Traffic),
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	counters, ok := t.peers[pid]
+	if !ok {
+		return traffic
+	}
+	for topic, topicTraffic := range counters.topics {
+		traffic.Topics[topic] = *topicTraffic
+		traffic.Total.MessagesIn += topicTraffic.MessagesIn
+		traffic.Total.MessagesOut += topicTraffic.MessagesOut
+	}
+	for proto, protoTraffic := range counters.protocols {
+		traffic.Protocols[string(proto)] = *protoTraffic
+		traffic.Total.MessagesIn += protoTraffic.MessagesIn
+		traffic.Total.MessagesOut += protoTraffic.MessagesOut
+	}
+	return traffic
+}
+
+// topPeers returns the traffic of at most n of the given peers, ordered by decreasing bytes
+// exchanged.
+func (t *trafficTracker) topPeers(pids []peer.ID, n int) []*PeerTraffic {
+	totals := make(map[peer.ID]int64, len(pids))
+	for _, pid := range pids {
+		stats := t.GetBandwidthForPeer(pid)
+		totals[pid] = stats.TotalIn + stats.TotalOut
+	}
+	sorted := make([]peer.ID, len(pids))
+	copy(sorted, pids)
+	sort.Slice(sorted, func(i, j int) bool {
+		return totals[sorted[i]] > totals[sorted[j]]
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	traffic := make([]*PeerTraffic, 0, len(sorted))
+	for _, pid := range sorted {
+		traffic = append(traffic, t.peerTraffic(pid))
+	}
+	return traffic
+}
+
+// prune drops the traffic counters of the peers which are no longer known, and the bandwidth
+// meters which have been idle since the given time.
+func (t *trafficTracker) prune(known []peer.ID, idleSince time.Time) {
+	t.TrimIdle(idleSince)
+	keep := make(map[peer.ID]bool, len(known))
+	for _, pid := range known {
+		keep[pid] = true
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for pid := range t.peers {
+		if !keep[pid] {
+			delete(t.peers, pid)
+		}
+	}
+}
+
+// PeerTraffic returns the traffic exchanged with the peer since the node started.
+func (s *Service) PeerTraffic(pid peer.ID) *PeerTraffic {
+	return s.traffic.peerTraffic(pid)
+}
+
+// TopPeersByTraffic returns the traffic of at most n connected peers, ordered by decreasing bytes
+// exchanged.
+func (s *Service) TopPeersByTraffic(n int) []*PeerTraffic {
+	return s.traffic.topPeers(s.peers.Connected(), n)
+}
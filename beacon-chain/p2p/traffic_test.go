@@ -0,0 +1,69 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestTrafficTracker(t *testing.T) {
+	topic := "/eth2/abcd1234/beacon_block/ssz_snappy"
+	tracker := newTrafficTracker(func(t string) bool {
+		return t == topic
+	})
+	pid, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	other, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	msgs := []*pubsubpb.TraceEvent_MessageMeta{{MessageID: []byte("a"), Topic: &topic}, {MessageID: []byte("b"), Topic: &topic}}
+	tracker.Trace(&pubsubpb.TraceEvent{
+		Type:    pubsubpb.TraceEvent_RECV_RPC.Enum(),
+		RecvRPC: &pubsubpb.TraceEvent_RecvRPC{ReceivedFrom: []byte(pid), Meta: &pubsubpb.TraceEvent_RPCMeta{Messages: msgs}},
+	})
+	tracker.Trace(&pubsubpb.TraceEvent{
+		Type:    pubsubpb.TraceEvent_SEND_RPC.Enum(),
+		SendRPC: &pubsubpb.TraceEvent_SendRPC{SendTo: []byte(pid), Meta: &pubsubpb.TraceEvent_RPCMeta{Messages: msgs[:1]}},
+	})
+	// Messages of topics the node cannot subscribe to are all counted under the same topic.
+	for _, unknown := range []string{"/eth2/abcd1234/unknown/ssz_snappy", "/random"} {
+		unknown := unknown
+		tracker.Trace(&pubsubpb.TraceEvent{
+			Type:    pubsubpb.TraceEvent_RECV_RPC.Enum(),
+			RecvRPC: &pubsubpb.TraceEvent_RecvRPC{ReceivedFrom: []byte(pid), Meta: &pubsubpb.TraceEvent_RPCMeta{Messages: []*pubsubpb.TraceEvent_MessageMeta{{MessageID: []byte("c"), Topic: &unknown}}}},
+		})
+	}
+	// RPCs without messages, such as control messages, are not counted.
+	tracker.Trace(&pubsubpb.TraceEvent{
+		Type:    pubsubpb.TraceEvent_SEND_RPC.Enum(),
+		SendRPC: &pubsubpb.TraceEvent_SendRPC{SendTo: []byte(other), Meta: &pubsubpb.TraceEvent_RPCMeta{}},
+	})
+
+	proto := "/eth2/beacon_chain/req/status/1/ssz_snappy"
+	tracker.countRequest(pid, protocol.ID(proto), true /* inbound */)
+	tracker.LogRecvMessageStream(84, protocol.ID(proto), pid)
+	tracker.LogSentMessageStream(84, protocol.ID(proto), pid)
+	tracker.LogRecvMessageStream(10, protocol.ID(proto), other)
+
+	traffic := tracker.peerTraffic(pid)
+	assert.DeepEqual(t, Traffic{MessagesIn: 2, MessagesOut: 1}, traffic.Topics[topic])
+	assert.DeepEqual(t, Traffic{MessagesIn: 2}, traffic.Topics[otherTopic])
+	assert.Equal(t, 2, len(traffic.Topics))
+	assert.DeepEqual(t, Traffic{BytesIn: 84, BytesOut: 84, MessagesIn: 1}, traffic.Protocols[proto])
+	assert.Equal(t, uint64(5), traffic.Total.MessagesIn)
+	assert.Equal(t, uint64(1), traffic.Total.MessagesOut)
+	assert.Equal(t, 0, len(tracker.peerTraffic(other).Topics))
+
+	// Only the given peers are ranked.
+	top := tracker.topPeers([]peer.ID{other}, trafficTopPeers)
+	require.Equal(t, 1, len(top))
+	assert.Equal(t, other, top[0].ID)
+
+	tracker.prune([]peer.ID{other}, time.Now().Add(-time.Hour))
+	assert.Equal(t, 0, len(tracker.peerTraffic(pid).Topics))
+}
@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errAttestationTrackerDisabled = status.Error(codes.FailedPrecondition,
+	"Attestation tracker is not enabled, no validator is tracked by the beacon node")
+
+// StreamTrackedAttestations streams the updates of the attestation records of the validators
+// tracked by the attestation tracker.
+func (bs *Server) StreamTrackedAttestations(
+	req *pbrpc.StreamTrackedAttestationsRequest, stream pbrpc.AttestationTracker_StreamTrackedAttestationsServer,
+) error {
+	if bs.AttestationTracker == nil {
+		return errAttestationTrackerDisabled
+	}
+	requested := make(map[uint64]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		requested[idx] = true
+	}
+
+	updates := make(chan *monitor.Update, 16)
+	sub := bs.AttestationTracker.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case update := <-updates:
+			r := update.Record
+			if len(requested) > 0 && !requested[r.ValidatorIndex] {
+				continue
+			}
+			if err := stream.Send(&pbrpc.TrackedAttestation{
+				ValidatorIndex:    r.ValidatorIndex,
+				Slot:              r.Slot,
+				CommitteeIndex:    r.CommitteeIndex,
+				FirstSeen:         r.FirstSeen,
+				Included:          r.Included,
+				InclusionSlot:     r.InclusionSlot,
+				InclusionDistance: r.InclusionDistance,
+				CorrectHead:       r.CorrectHead,
+				CorrectTarget:     r.CorrectTarget,
+				Final:             update.Final,
+			}); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// GetAttestationEffectiveness retrieves the attestation effectiveness of the validators tracked by
+// the attestation tracker, over the tracking window.
+func (bs *Server) GetAttestationEffectiveness(
+	ctx context.Context, req *pbrpc.AttestationEffectivenessRequest,
+) (*pbrpc.AttestationEffectivenessResponse, error) {
+	if bs.AttestationTracker == nil {
+		return nil, errAttestationTrackerDisabled
+	}
+	requested := make(map[uint64]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		requested[idx] = true
+	}
+
+	summaries, err := bs.AttestationTracker.Effectiveness(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation effectiveness: %v", err)
+	}
+	validators := make([]*pbrpc.AttestationEffectiveness, 0, len(summaries))
+	for _, sum := range summaries {
+		if len(requested) > 0 && !requested[sum.ValidatorIndex] {
+			continue
+		}
+		validators = append(validators, &pbrpc.AttestationEffectiveness{
+			ValidatorIndex:           sum.ValidatorIndex,
+			Attestations:             sum.Attestations,
+			Included:                 sum.Included,
+			CorrectHead:              sum.CorrectHead,
+			CorrectTarget:            sum.CorrectTarget,
+			AverageInclusionDistance: sum.AverageInclusionDistance,
+			AverageFirstSeenDelay:    int64(sum.AverageFirstSeenDelay / time.Millisecond),
+			Effectiveness:            sum.Effectiveness,
+		})
+	}
+	return &pbrpc.AttestationEffectivenessResponse{Validators: validators}, nil
+}
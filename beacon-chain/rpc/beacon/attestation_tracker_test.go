@@ -0,0 +1,58 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetAttestationEffectiveness(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	currentSlot := 4 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, beaconDB.SaveAttestationRecords(ctx, []*dbpb.AttestationRecord{
+		{ValidatorIndex: 1, Slot: 1, Included: true, InclusionSlot: 2, InclusionDistance: 1, CorrectHead: true, CorrectTarget: true},
+		{ValidatorIndex: 1, Slot: 9},
+		{ValidatorIndex: 2, Slot: 3, Included: true, InclusionSlot: 5, InclusionDistance: 2, CorrectTarget: true},
+	}))
+	bs := &Server{
+		AttestationTracker: monitor.New(ctx, &monitor.Config{
+			BeaconDB:           beaconDB,
+			GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+			TrackedIndices:     []uint64{1, 2},
+		}),
+	}
+
+	res, err := bs.GetAttestationEffectiveness(ctx, &pbrpc.AttestationEffectivenessRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Validators))
+	assert.DeepEqual(t, &pbrpc.AttestationEffectiveness{
+		ValidatorIndex:           1,
+		Attestations:             2,
+		Included:                 1,
+		CorrectHead:              1,
+		CorrectTarget:            1,
+		AverageInclusionDistance: 1,
+		Effectiveness:            0.5,
+	}, res.Validators[0])
+
+	res, err = bs.GetAttestationEffectiveness(ctx, &pbrpc.AttestationEffectivenessRequest{Indices: []uint64{2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Validators))
+	assert.Equal(t, uint64(2), res.Validators[0].ValidatorIndex)
+	assert.Equal(t, 0.5, res.Validators[0].Effectiveness)
+}
+
+func TestServer_GetAttestationEffectiveness_Disabled(t *testing.T) {
+	bs := &Server{}
+	_, err := bs.GetAttestationEffectiveness(context.Background(), &pbrpc.AttestationEffectivenessRequest{})
+	assert.ErrorContains(t, "Attestation tracker is not enabled", err)
+}
@@ -0,0 +1,187 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPerformanceHistoryEpochs is the maximum number of epochs of a validator performance
+	// history request. Each epoch loads the performance of the whole validator registry.
+	maxPerformanceHistoryEpochs = 64
+	// maxParticipationHistoryEpochs is the maximum number of epochs of a validator participation
+	// history request.
+	maxParticipationHistoryEpochs = 1024
+)
+
+// GetValidatorPerformanceHistory retrieves the performance of validators over a range of epochs
+// from the validator performance index, without regenerating historical states.
+func (bs *Server) GetValidatorPerformanceHistory(
+	ctx context.Context, req *pbrpc.ValidatorPerformanceHistoryRequest,
+) (*pbrpc.ValidatorPerformanceHistoryResponse, error) {
+	headEpoch := helpers.SlotToEpoch(bs.HeadFetcher.HeadSlot())
+	if err := validateHistoryRange(req.StartEpoch, req.EndEpoch, headEpoch, maxPerformanceHistoryEpochs); err != nil {
+		return nil, err
+	}
+	if len(req.Indices)+len(req.PublicKeys) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested %d validators, can not be greater than max size %d",
+			len(req.Indices)+len(req.PublicKeys), cmd.Get().MaxRPCPageSize)
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	validatorIndices, missingValidators, err := requestedValidatorIndices(headState, req.Indices, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	perfs, err := bs.BeaconDB.ValidatorPerformances(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validator performance: %v", err)
+	}
+	indexedEpochs := make([]uint64, len(perfs))
+	for i, perf := range perfs {
+		indexedEpochs[i] = perf.Epoch
+	}
+	histories := make([]*pbrpc.ValidatorPerformanceHistory, len(validatorIndices))
+	for i, idx := range validatorIndices {
+		pubKey := headState.PubkeyAtIndex(idx)
+		history := &pbrpc.ValidatorPerformanceHistory{
+			Index:     idx,
+			PublicKey: pubKey[:],
+			Epochs:    make([]*pbrpc.ValidatorEpochPerformance, 0, len(perfs)),
+		}
+		for _, perf := range perfs {
+			// Validators which joined the registry after the epoch are not part of its performance.
+			if idx >= uint64(len(perf.Active)) || !perf.Active[idx] {
+				continue
+			}
+			history.Epochs = append(history.Epochs, &pbrpc.ValidatorEpochPerformance{
+				Epoch:                perf.Epoch,
+				CorrectlyVotedSource: perf.CorrectlyVotedSource[idx],
+				CorrectlyVotedTarget: perf.CorrectlyVotedTarget[idx],
+				CorrectlyVotedHead:   perf.CorrectlyVotedHead[idx],
+				InclusionDistance:    perf.InclusionDistance[idx],
+				BalanceChange:        perf.BalanceChange[idx],
+			})
+		}
+		histories[i] = history
+	}
+
+	return &pbrpc.ValidatorPerformanceHistoryResponse{
+		Validators:        histories,
+		MissingEpochs:     missingEpochs(req.StartEpoch, req.EndEpoch, indexedEpochs),
+		MissingValidators: missingValidators,
+	}, nil
+}
+
+// GetValidatorParticipationHistory retrieves the participation of the validator set over a range
+// of epochs from the validator performance index, without regenerating historical states.
+func (bs *Server) GetValidatorParticipationHistory(
+	ctx context.Context, req *pbrpc.ValidatorParticipationHistoryRequest,
+) (*pbrpc.ValidatorParticipationHistoryResponse, error) {
+	headEpoch := helpers.SlotToEpoch(bs.HeadFetcher.HeadSlot())
+	if err := validateHistoryRange(req.StartEpoch, req.EndEpoch, headEpoch, maxParticipationHistoryEpochs); err != nil {
+		return nil, err
+	}
+	participations, err := bs.BeaconDB.ValidatorParticipations(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validator participation: %v", err)
+	}
+	epochs := make([]*pbrpc.EpochParticipation, len(participations))
+	indexedEpochs := make([]uint64, len(participations))
+	for i, participation := range participations {
+		epochs[i] = &pbrpc.EpochParticipation{
+			Epoch:               participation.Epoch,
+			ActiveGwei:          participation.ActiveGwei,
+			SourceAttestingGwei: participation.SourceAttestingGwei,
+			TargetAttestingGwei: participation.TargetAttestingGwei,
+			HeadAttestingGwei:   participation.HeadAttestingGwei,
+		}
+		indexedEpochs[i] = participation.Epoch
+	}
+	return &pbrpc.ValidatorParticipationHistoryResponse{
+		Epochs:        epochs,
+		MissingEpochs: missingEpochs(req.StartEpoch, req.EndEpoch, indexedEpochs),
+	}, nil
+}
+
+// requestedValidatorIndices resolves the requested indices and public keys to a sorted list of
+// unique validator indices, along with the public keys which are not known in the head state.
+func requestedValidatorIndices(headState *stateTrie.BeaconState, indices []uint64, pubKeys [][]byte) ([]uint64, [][]byte, error) {
+	filtered := map[uint64]bool{} // Track filtered validators to prevent duplication in the response.
+	validatorIndices := make([]uint64, 0, len(indices)+len(pubKeys))
+	missingValidators := make([][]byte, 0)
+	for _, pubKey := range pubKeys {
+		// Skip empty public key.
+		if len(pubKey) == 0 {
+			continue
+		}
+		idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			missingValidators = append(missingValidators, pubKey)
+			continue
+		}
+		if !filtered[idx] {
+			validatorIndices = append(validatorIndices, idx)
+			filtered[idx] = true
+		}
+	}
+	for _, idx := range indices {
+		if idx >= uint64(headState.NumValidators()) {
+			return nil, nil, status.Errorf(codes.OutOfRange, "Requesting index %d, but there are only %d validators",
+				idx, headState.NumValidators())
+		}
+		if !filtered[idx] {
+			validatorIndices = append(validatorIndices, idx)
+			filtered[idx] = true
+		}
+	}
+	sort.Slice(validatorIndices, func(i, j int) bool {
+		return validatorIndices[i] < validatorIndices[j]
+	})
+	return validatorIndices, missingValidators, nil
+}
+
+func validateHistoryRange(startEpoch, endEpoch, headEpoch, maxEpochs uint64) error {
+	if endEpoch < startEpoch {
+		return status.Errorf(codes.InvalidArgument, "End epoch %d is lower than start epoch %d", endEpoch, startEpoch)
+	}
+	if endEpoch-startEpoch >= maxEpochs {
+		return status.Errorf(codes.InvalidArgument, "Requested %d epochs, can not be greater than max size %d",
+			endEpoch-startEpoch+1, maxEpochs)
+	}
+	if endEpoch > headEpoch {
+		return status.Errorf(codes.InvalidArgument, "End epoch %d is greater than the current head epoch %d", endEpoch, headEpoch)
+	}
+	return nil
+}
+
+// missingEpochs returns the epochs of the range which are not among the indexed epochs, given in
+// ascending order.
+func missingEpochs(startEpoch, endEpoch uint64, indexedEpochs []uint64) []uint64 {
+	missing := make([]uint64, 0)
+	next := 0
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if next < len(indexedEpochs) && indexedEpochs[next] == epoch {
+			next++
+		} else {
+			missing = append(missing, epoch)
+		}
+		// Stop before incrementing past the last epoch, which would overflow at the max epoch.
+		if epoch == endEpoch {
+			break
+		}
+	}
+	return missing
+}
@@ -0,0 +1,159 @@
+package beacon
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	headState := testutil.NewBeaconState()
+	publicKey1 := bytesutil.ToBytes48([]byte{1})
+	publicKey2 := bytesutil.ToBytes48([]byte{2})
+	require.NoError(t, headState.SetValidators([]*ethpb.Validator{
+		{PublicKey: publicKey1[:], ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		{PublicKey: publicKey2[:], ActivationEpoch: 2, ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+	}))
+	require.NoError(t, headState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+
+	// Epoch 2 is not indexed, the second validator is only active from epoch 3.
+	require.NoError(t, beaconDB.SaveValidatorPerformance(ctx, &dbpb.ValidatorPerformance{
+		Epoch:                1,
+		Active:               []bool{true},
+		CorrectlyVotedSource: []bool{true},
+		CorrectlyVotedTarget: []bool{true},
+		CorrectlyVotedHead:   []bool{false},
+		InclusionDistance:    []uint64{2},
+		BalanceChange:        []int64{-10},
+	}, &dbpb.ValidatorParticipation{Epoch: 1}))
+	require.NoError(t, beaconDB.SaveValidatorPerformance(ctx, &dbpb.ValidatorPerformance{
+		Epoch:                3,
+		Active:               []bool{true, true},
+		CorrectlyVotedSource: []bool{true, true},
+		CorrectlyVotedTarget: []bool{true, false},
+		CorrectlyVotedHead:   []bool{true, false},
+		InclusionDistance:    []uint64{1, 3},
+		BalanceChange:        []int64{20, 5},
+	}, &dbpb.ValidatorParticipation{Epoch: 3}))
+	bs := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	unknownKey := bytesutil.ToBytes48([]byte{9})
+	res, err := bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{
+		StartEpoch: 1,
+		EndEpoch:   3,
+		Indices:    []uint64{1},
+		PublicKeys: [][]byte{publicKey1[:], publicKey2[:], unknownKey[:]},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{2}, res.MissingEpochs)
+	assert.DeepEqual(t, [][]byte{unknownKey[:]}, res.MissingValidators)
+	require.Equal(t, 2, len(res.Validators))
+
+	assert.Equal(t, uint64(0), res.Validators[0].Index)
+	assert.DeepEqual(t, publicKey1[:], res.Validators[0].PublicKey)
+	require.Equal(t, 2, len(res.Validators[0].Epochs))
+	assert.DeepEqual(t, &pbrpc.ValidatorEpochPerformance{
+		Epoch:                1,
+		CorrectlyVotedSource: true,
+		CorrectlyVotedTarget: true,
+		InclusionDistance:    2,
+		BalanceChange:        -10,
+	}, res.Validators[0].Epochs[0])
+	assert.Equal(t, uint64(3), res.Validators[0].Epochs[1].Epoch)
+
+	assert.Equal(t, uint64(1), res.Validators[1].Index)
+	assert.DeepEqual(t, []*pbrpc.ValidatorEpochPerformance{{
+		Epoch:                3,
+		CorrectlyVotedSource: true,
+		InclusionDistance:    3,
+		BalanceChange:        5,
+	}}, res.Validators[1].Epochs)
+}
+
+func TestServer_GetValidatorPerformanceHistory_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	headState := testutil.NewBeaconState()
+	bs := &Server{
+		BeaconDB:    dbTest.SetupDB(t),
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	_, err := bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{StartEpoch: 2, EndEpoch: 1})
+	assert.ErrorContains(t, "is lower than start epoch", err)
+	_, err = bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{EndEpoch: maxPerformanceHistoryEpochs})
+	assert.ErrorContains(t, "can not be greater than max size", err)
+	_, err = bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{StartEpoch: 1, EndEpoch: 1})
+	assert.ErrorContains(t, "is greater than the current head epoch 0", err)
+	_, err = bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{
+		StartEpoch: math.MaxUint64 - 1,
+		EndEpoch:   math.MaxUint64,
+	})
+	assert.ErrorContains(t, "is greater than the current head epoch 0", err)
+	_, err = bs.GetValidatorPerformanceHistory(ctx, &pbrpc.ValidatorPerformanceHistoryRequest{Indices: []uint64{1}})
+	assert.ErrorContains(t, "but there are only 0 validators", err)
+}
+
+func TestServer_GetValidatorParticipationHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	for _, epoch := range []uint64{0, 2} {
+		require.NoError(t, beaconDB.SaveValidatorPerformance(ctx, &dbpb.ValidatorPerformance{Epoch: epoch}, &dbpb.ValidatorParticipation{
+			Epoch:               epoch,
+			ActiveGwei:          100,
+			SourceAttestingGwei: 90,
+			TargetAttestingGwei: 80,
+			HeadAttestingGwei:   70 + epoch,
+		}))
+	}
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	bs := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	res, err := bs.GetValidatorParticipationHistory(ctx, &pbrpc.ValidatorParticipationHistoryRequest{
+		StartEpoch: 0,
+		EndEpoch:   3,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, res.MissingEpochs)
+	assert.DeepEqual(t, []*pbrpc.EpochParticipation{
+		{Epoch: 0, ActiveGwei: 100, SourceAttestingGwei: 90, TargetAttestingGwei: 80, HeadAttestingGwei: 70},
+		{Epoch: 2, ActiveGwei: 100, SourceAttestingGwei: 90, TargetAttestingGwei: 80, HeadAttestingGwei: 72},
+	}, res.Epochs)
+
+	_, err = bs.GetValidatorParticipationHistory(ctx, &pbrpc.ValidatorParticipationHistoryRequest{
+		EndEpoch: maxParticipationHistoryEpochs,
+	})
+	assert.ErrorContains(t, "can not be greater than max size", err)
+	_, err = bs.GetValidatorParticipationHistory(ctx, &pbrpc.ValidatorParticipationHistoryRequest{
+		StartEpoch: math.MaxUint64 - 1,
+		EndEpoch:   math.MaxUint64,
+	})
+	assert.ErrorContains(t, "is greater than the current head epoch 3", err)
+}
+
+func TestMissingEpochs(t *testing.T) {
+	assert.DeepEqual(t, []uint64{1, 3}, missingEpochs(0, 3, []uint64{0, 2}))
+	assert.DeepEqual(t, []uint64{}, missingEpochs(2, 2, []uint64{2}))
+	// The range ends at the max epoch without wrapping around.
+	assert.DeepEqual(t, []uint64{math.MaxUint64 - 1}, missingEpochs(math.MaxUint64-1, math.MaxUint64, []uint64{math.MaxUint64}))
+	assert.DeepEqual(t, []uint64{math.MaxUint64}, missingEpochs(math.MaxUint64, math.MaxUint64, nil))
+}
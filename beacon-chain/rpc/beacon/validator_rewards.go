@@ -0,0 +1,104 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorRewards retrieves the itemized rewards and penalties of validators for their duties
+// of an epoch. The attestations of an epoch are rewarded at the end of the following epoch, so the
+// rewards are computed by replaying the state up to the last slot of the following epoch.
+func (bs *Server) GetValidatorRewards(
+	ctx context.Context, req *pbrpc.ValidatorRewardsRequest,
+) (*pbrpc.ValidatorRewardsResponse, error) {
+	if len(req.Indices)+len(req.PublicKeys) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested %d validators, can not be greater than max size %d",
+			len(req.Indices)+len(req.PublicKeys), cmd.Get().MaxRPCPageSize)
+	}
+	// The comparison is made against the head epoch rather than the requested epoch, which may be
+	// as high as the maximum epoch.
+	headEpoch := helpers.SlotToEpoch(bs.HeadFetcher.HeadSlot())
+	if headEpoch < 2 || req.Epoch > headEpoch-2 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Rewards of epoch %d are applied at the end of the following epoch, current head epoch %d", req.Epoch, headEpoch)
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	validatorIndices, missingValidators, err := requestedValidatorIndices(headState, req.Indices, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	endSlot, err := helpers.StartSlot(req.Epoch + 2)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get end slot of epoch %d: %v", req.Epoch+1, err)
+	}
+	st, err := bs.StateGen.StateBySlot(stategen.WithCaller(ctx, "validator_rewards"), endSlot-1)
+	if errors.Is(err, stategen.ErrReplayBudgetExceeded) {
+		return nil, status.Errorf(codes.ResourceExhausted, "Could not compute state by slot: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+	}
+	st = st.Copy()
+
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
+	}
+	attRewards := precompute.AttestationsRewards(st, bp, vp)
+	proposerRewards, err := precompute.ProposersDelta(st, bp, vp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer rewards: %v", err)
+	}
+	if _, err := precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process rewards and penalties: %v", err)
+	}
+
+	rewards := make([]*pbrpc.ValidatorEpochRewards, 0, len(validatorIndices))
+	for _, idx := range validatorIndices {
+		pubKey := headState.PubkeyAtIndex(idx)
+		// Validators which joined the registry after the epoch have no rewards for it.
+		if idx >= uint64(len(vp)) {
+			missingValidators = append(missingValidators, pubKey[:])
+			continue
+		}
+		r := attRewards[idx]
+		rewards = append(rewards, &pbrpc.ValidatorEpochRewards{
+			Index:                idx,
+			PublicKey:            pubKey[:],
+			SourceReward:         r.SourceReward,
+			SourcePenalty:        r.SourcePenalty,
+			TargetReward:         r.TargetReward,
+			TargetPenalty:        r.TargetPenalty,
+			HeadReward:           r.HeadReward,
+			HeadPenalty:          r.HeadPenalty,
+			InclusionDelayReward: r.InclusionDelayReward,
+			ProposerReward:       proposerRewards[idx],
+			InactivityPenalty:    r.InactivityPenalty,
+			BalanceBefore:        vp[idx].BeforeEpochTransitionBalance,
+			BalanceAfter:         vp[idx].AfterEpochTransitionBalance,
+		})
+	}
+
+	return &pbrpc.ValidatorRewardsResponse{
+		Epoch:             req.Epoch,
+		Rewards:           rewards,
+		MissingValidators: missingValidators,
+	}, nil
+}
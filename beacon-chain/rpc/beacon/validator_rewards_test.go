@@ -0,0 +1,88 @@
+package beacon
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorRewards(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	stateWithValidators, _ := testutil.DeterministicGenesisState(t, 64)
+	beaconState := testutil.NewBeaconState()
+	require.NoError(t, beaconState.SetValidators(stateWithValidators.Validators()))
+	require.NoError(t, beaconState.SetBalances(stateWithValidators.Balances()))
+	// The rewards of epoch 0 are applied at the last slot of epoch 1.
+	endSlot := 2*params.BeaconConfig().SlotsPerEpoch - 1
+	require.NoError(t, beaconState.SetSlot(endSlot))
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = endSlot
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(beaconDB)
+	require.NoError(t, gen.SaveState(ctx, root, beaconState))
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, root))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+
+	headState := beaconState.Copy()
+	require.NoError(t, headState.SetSlot(endSlot+1))
+	bs := &Server{
+		StateGen:    gen,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	unknownKey := []byte{'a'}
+	res, err := bs.GetValidatorRewards(ctx, &pbrpc.ValidatorRewardsRequest{
+		Epoch:      0,
+		Indices:    []uint64{3, 1},
+		PublicKeys: [][]byte{unknownKey},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{unknownKey}, res.MissingValidators)
+	require.Equal(t, 2, len(res.Rewards))
+	assert.Equal(t, uint64(1), res.Rewards[0].Index)
+	assert.Equal(t, uint64(3), res.Rewards[1].Index)
+	for _, r := range res.Rewards {
+		pubKey := headState.PubkeyAtIndex(r.Index)
+		assert.DeepEqual(t, pubKey[:], r.PublicKey)
+		// Nobody attested, so every validator is penalized for each vote.
+		assert.Equal(t, uint64(0), r.SourceReward+r.TargetReward+r.HeadReward+r.InclusionDelayReward+r.ProposerReward)
+		assert.Equal(t, true, r.SourcePenalty > 0)
+		assert.Equal(t, r.SourcePenalty, r.TargetPenalty)
+		assert.Equal(t, r.SourcePenalty, r.HeadPenalty)
+		assert.Equal(t, uint64(0), r.InactivityPenalty)
+		assert.Equal(t, r.BalanceBefore-r.SourcePenalty-r.TargetPenalty-r.HeadPenalty, r.BalanceAfter)
+	}
+}
+
+func TestServer_GetValidatorRewards_EpochNotRewarded(t *testing.T) {
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	_, err := bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: 1})
+	assert.ErrorContains(t, "are applied at the end of the following epoch, current head epoch 2", err)
+	_, err = bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: math.MaxUint64})
+	assert.ErrorContains(t, "are applied at the end of the following epoch", err)
+
+	require.NoError(t, headState.SetSlot(0))
+	_, err = bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: 0})
+	assert.ErrorContains(t, "are applied at the end of the following epoch, current head epoch 0", err)
+}
@@ -0,0 +1,268 @@
+package beaconv1
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	corestate "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAttesterDuties returns the attester duties of the requested validators for the requested
+// epoch, which can be up to the next epoch.
+func (bs *Server) GetAttesterDuties(ctx context.Context, req *pbrpc.AttesterDutiesRequest) (*pbrpc.AttesterDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetAttesterDuties")
+	defer span.End()
+
+	if bs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	st, err := bs.dutiesState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	committeeAssignments, _, err := helpers.CommitteeAssignments(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	activeValidatorCount, err := helpers.ActiveValidatorCount(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeesAtSlot := helpers.SlotCommitteeCount(activeValidatorCount)
+
+	duties := make([]*pbrpc.AttesterDuty, 0, len(req.Data))
+	for _, index := range req.Data {
+		if index >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+		ca, ok := committeeAssignments[index]
+		if !ok {
+			// The validator is not active in the requested epoch.
+			continue
+		}
+		pubkey := st.PubkeyAtIndex(index)
+		var positionInCommittee uint64
+		for i, v := range ca.Committee {
+			if v == index {
+				positionInCommittee = uint64(i)
+				break
+			}
+		}
+		duties = append(duties, &pbrpc.AttesterDuty{
+			Pubkey:                  pubkey[:],
+			ValidatorIndex:          index,
+			CommitteeIndex:          ca.CommitteeIndex,
+			CommitteeLength:         uint64(len(ca.Committee)),
+			CommitteesAtSlot:        committeesAtSlot,
+			ValidatorCommitteeIndex: positionInCommittee,
+			Slot:                    ca.AttesterSlot,
+		})
+	}
+
+	return &pbrpc.AttesterDutiesResponse{
+		Data: duties,
+	}, nil
+}
+
+// GetProposerDuties returns the proposer of every slot of the requested epoch, which can be up
+// to the next epoch.
+func (bs *Server) GetProposerDuties(ctx context.Context, req *pbrpc.ProposerDutiesRequest) (*pbrpc.ProposerDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetProposerDuties")
+	defer span.End()
+
+	if bs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	st, err := bs.dutiesState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	_, proposerIndexToSlots, err := helpers.CommitteeAssignments(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+
+	duties := make([]*pbrpc.ProposerDuty, 0, params.BeaconConfig().SlotsPerEpoch)
+	for index, slots := range proposerIndexToSlots {
+		pubkey := st.PubkeyAtIndex(index)
+		for _, slot := range slots {
+			duties = append(duties, &pbrpc.ProposerDuty{
+				Pubkey:         pubkey[:],
+				ValidatorIndex: index,
+				Slot:           slot,
+			})
+		}
+	}
+	sort.Slice(duties, func(i, j int) bool {
+		return duties[i].Slot < duties[j].Slot
+	})
+
+	return &pbrpc.ProposerDutiesResponse{
+		Data: duties,
+	}, nil
+}
+
+// ProduceBlock returns an unsigned block for the requested slot, built on the head of the node
+// by the Prysm validator server.
+func (bs *Server) ProduceBlock(ctx context.Context, req *pbrpc.ProduceBlockRequest) (*pbrpc.ProduceBlockResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ProduceBlock")
+	defer span.End()
+
+	blk, err := bs.V1Alpha1ValidatorServer.GetBlock(ctx, &ethpb_alpha.BlockRequest{
+		Slot:         req.Slot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbrpc.ProduceBlockResponse{
+		Data: blk,
+	}, nil
+}
+
+// ProduceAttestationData returns the attestation data for the requested slot and committee
+// index, produced by the Prysm validator server.
+func (bs *Server) ProduceAttestationData(ctx context.Context, req *pbrpc.ProduceAttestationDataRequest) (*pbrpc.ProduceAttestationDataResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ProduceAttestationData")
+	defer span.End()
+
+	data, err := bs.V1Alpha1ValidatorServer.GetAttestationData(ctx, &ethpb_alpha.AttestationDataRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbrpc.ProduceAttestationDataResponse{
+		Data: data,
+	}, nil
+}
+
+// GetAggregateAttestation aggregates the attestations in the pool matching the requested
+// attestation data root and slot, and returns the aggregate with the most attesters.
+func (bs *Server) GetAggregateAttestation(ctx context.Context, req *pbrpc.AggregateAttestationRequest) (*pbrpc.AggregateAttestationResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetAggregateAttestation")
+	defer span.End()
+
+	unaggregated, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	var matching []*ethpb_alpha.Attestation
+	for _, att := range append(bs.AttestationsPool.AggregatedAttestations(), unaggregated...) {
+		if att.Data == nil || att.Data.Slot != req.Slot {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash attestation data: %v", err)
+		}
+		if bytes.Equal(root[:], req.AttestationDataRoot) {
+			// Aggregation happens in place, so the attestations of the pool are copied.
+			matching = append(matching, stateTrie.CopyAttestation(att))
+		}
+	}
+	if len(matching) == 0 {
+		return nil, status.Error(codes.NotFound, "No matching attestation found")
+	}
+	aggregates, err := attaggregation.Aggregate(matching)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not aggregate attestations: %v", err)
+	}
+	best := aggregates[0]
+	for _, aggregate := range aggregates[1:] {
+		if aggregate.AggregationBits.Count() > best.AggregationBits.Count() {
+			best = aggregate
+		}
+	}
+
+	return &pbrpc.AggregateAttestationResponse{
+		Data: best,
+	}, nil
+}
+
+// SubmitAggregateAndProofs verifies and broadcasts the signed aggregates and proofs of
+// aggregators through the Prysm validator server.
+func (bs *Server) SubmitAggregateAndProofs(ctx context.Context, req *pbrpc.SubmitAggregateAndProofsRequest) (*ptypes.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitAggregateAndProofs")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No aggregate and proof provided")
+	}
+	for _, agg := range req.Data {
+		if _, err := bs.V1Alpha1ValidatorServer.SubmitSignedAggregateSelectionProof(
+			ctx,
+			&ethpb_alpha.SignedAggregateSubmitRequest{SignedAggregateAndProof: agg},
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ptypes.Empty{}, nil
+}
+
+// SubmitBeaconCommitteeSubscription subscribes the node to the attestation subnets of the
+// requested beacon committees, and to their aggregation subnets for aggregators.
+func (bs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *pbrpc.SubmitBeaconCommitteeSubscriptionsRequest) (*ptypes.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitBeaconCommitteeSubscription")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscription provided")
+	}
+	subscriptions := &ethpb_alpha.CommitteeSubnetsSubscribeRequest{
+		Slots:        make([]uint64, len(req.Data)),
+		CommitteeIds: make([]uint64, len(req.Data)),
+		IsAggregator: make([]bool, len(req.Data)),
+	}
+	for i, sub := range req.Data {
+		subscriptions.Slots[i] = sub.Slot
+		subscriptions.CommitteeIds[i] = sub.CommitteeIndex
+		subscriptions.IsAggregator[i] = sub.IsAggregator
+	}
+	if _, err := bs.V1Alpha1ValidatorServer.SubscribeCommitteeSubnets(ctx, subscriptions); err != nil {
+		return nil, err
+	}
+
+	return &ptypes.Empty{}, nil
+}
+
+// dutiesState returns the head state advanced to the start of the requested epoch, which can
+// be up to the next epoch.
+func (bs *Server) dutiesState(ctx context.Context, epoch uint64) (*stateTrie.BeaconState, error) {
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if epoch > currentEpoch+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than next epoch %d", epoch, currentEpoch+1)
+	}
+
+	st, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	epochStartSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch %d: %v", epoch, err)
+	}
+	if st.Slot() < epochStartSlot {
+		st, err = corestate.ProcessSlots(ctx, st, epochStartSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+	return st, nil
+}
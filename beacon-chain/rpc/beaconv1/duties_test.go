@@ -0,0 +1,162 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	bs := &Server{
+		ChainInfoFetcher:   &chainMock.ChainService{State: st.Copy()},
+		GenesisTimeFetcher: &chainMock.ChainService{Genesis: time.Now()},
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Current epoch", func(t *testing.T) {
+		resp, err := bs.GetAttesterDuties(ctx, &pbrpc.AttesterDutiesRequest{Epoch: 0, Data: []uint64{0, 63}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+
+		assignments, _, err := helpers.CommitteeAssignments(st.Copy(), 0)
+		require.NoError(t, err)
+		for i, index := range []uint64{0, 63} {
+			duty := resp.Data[i]
+			ca := assignments[index]
+			pubkey := st.PubkeyAtIndex(index)
+			assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+			assert.Equal(t, index, duty.ValidatorIndex)
+			assert.Equal(t, ca.AttesterSlot, duty.Slot)
+			assert.Equal(t, ca.CommitteeIndex, duty.CommitteeIndex)
+			assert.Equal(t, uint64(len(ca.Committee)), duty.CommitteeLength)
+			assert.Equal(t, uint64(1), duty.CommitteesAtSlot)
+			assert.Equal(t, index, ca.Committee[duty.ValidatorCommitteeIndex])
+		}
+	})
+
+	t.Run("Next epoch", func(t *testing.T) {
+		resp, err := bs.GetAttesterDuties(ctx, &pbrpc.AttesterDutiesRequest{Epoch: 1, Data: []uint64{5}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, uint64(1), helpers.SlotToEpoch(resp.Data[0].Slot))
+	})
+
+	t.Run("Epoch too far in the future", func(t *testing.T) {
+		_, err := bs.GetAttesterDuties(ctx, &pbrpc.AttesterDutiesRequest{Epoch: 2, Data: []uint64{0}})
+		assert.ErrorContains(t, "can not be greater than next epoch", err)
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		_, err := bs.GetAttesterDuties(ctx, &pbrpc.AttesterDutiesRequest{Epoch: 0, Data: []uint64{64}})
+		assert.ErrorContains(t, "Invalid validator index 64", err)
+	})
+
+	t.Run("Syncing", func(t *testing.T) {
+		syncingServer := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+		_, err := syncingServer.GetAttesterDuties(ctx, &pbrpc.AttesterDutiesRequest{Epoch: 0})
+		assert.ErrorContains(t, "Syncing to latest head", err)
+	})
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	bs := &Server{
+		ChainInfoFetcher:   &chainMock.ChainService{State: st.Copy()},
+		GenesisTimeFetcher: &chainMock.ChainService{Genesis: time.Now()},
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+	}
+
+	resp, err := bs.GetProposerDuties(ctx, &pbrpc.ProposerDutiesRequest{Epoch: 0})
+	require.NoError(t, err)
+	// The genesis slot has no proposer.
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch-1), len(resp.Data))
+	for i, duty := range resp.Data {
+		assert.Equal(t, uint64(i+1), duty.Slot)
+		require.NoError(t, st.SetSlot(duty.Slot))
+		proposer, err := helpers.BeaconProposerIndex(st)
+		require.NoError(t, err)
+		assert.Equal(t, proposer, duty.ValidatorIndex)
+		pubkey := st.PubkeyAtIndex(proposer)
+		assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+	}
+}
+
+func TestGetAggregateAttestation(t *testing.T) {
+	ctx := context.Background()
+	pool := attestations.NewPool()
+	bs := &Server{AttestationsPool: pool}
+
+	data := testutil.HydrateAttestationData(&ethpb_alpha.AttestationData{Slot: 3})
+	otherData := testutil.HydrateAttestationData(&ethpb_alpha.AttestationData{Slot: 3, CommitteeIndex: 1})
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("foo")).Marshal()
+	atts := []*ethpb_alpha.Attestation{
+		{AggregationBits: bitfield.Bitlist{0b10001}, Data: data, Signature: sig},
+		{AggregationBits: bitfield.Bitlist{0b10100}, Data: data, Signature: sig},
+		{AggregationBits: bitfield.Bitlist{0b11000}, Data: otherData, Signature: sig},
+	}
+	for _, att := range atts {
+		require.NoError(t, pool.SaveUnaggregatedAttestation(att))
+	}
+	root, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	resp, err := bs.GetAggregateAttestation(ctx, &pbrpc.AggregateAttestationRequest{AttestationDataRoot: root[:], Slot: 3})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, resp.Data.Data)
+	assert.DeepEqual(t, bitfield.Bitlist{0b10101}, resp.Data.AggregationBits)
+	// The attestations of the pool are left untouched.
+	assert.DeepEqual(t, bitfield.Bitlist{0b10001}, atts[0].AggregationBits)
+
+	_, err = bs.GetAggregateAttestation(ctx, &pbrpc.AggregateAttestationRequest{AttestationDataRoot: root[:], Slot: 4})
+	assert.ErrorContains(t, "No matching attestation found", err)
+}
+
+func TestSubmitBeaconCommitteeSubscription(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	bs := &Server{
+		V1Alpha1ValidatorServer: &validator.Server{
+			HeadFetcher: &chainMock.ChainService{State: st},
+		},
+	}
+
+	// The subnet cache is global, so only the subnets added by this request are counted.
+	attesters50 := len(cache.SubnetIDs.GetAttesterSubnetIDs(50))
+	aggregators50 := len(cache.SubnetIDs.GetAggregatorSubnetIDs(50))
+	attesters51 := len(cache.SubnetIDs.GetAttesterSubnetIDs(51))
+	aggregators51 := len(cache.SubnetIDs.GetAggregatorSubnetIDs(51))
+	_, err := bs.SubmitBeaconCommitteeSubscription(ctx, &pbrpc.SubmitBeaconCommitteeSubscriptionsRequest{
+		Data: []*pbrpc.BeaconCommitteeSubscription{
+			{Slot: 50, CommitteeIndex: 2, IsAggregator: false},
+			{Slot: 51, CommitteeIndex: 3, IsAggregator: true},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, attesters50+1, len(cache.SubnetIDs.GetAttesterSubnetIDs(50)))
+	assert.Equal(t, aggregators50, len(cache.SubnetIDs.GetAggregatorSubnetIDs(50)))
+	assert.Equal(t, attesters51+1, len(cache.SubnetIDs.GetAttesterSubnetIDs(51)))
+	assert.Equal(t, aggregators51+1, len(cache.SubnetIDs.GetAggregatorSubnetIDs(51)))
+
+	_, err = bs.SubmitBeaconCommitteeSubscription(ctx, &pbrpc.SubmitBeaconCommitteeSubscriptionsRequest{})
+	assert.ErrorContains(t, "No subscription provided", err)
+}
@@ -0,0 +1,273 @@
+package beaconv1
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	headTopic                = "head"
+	blockTopic               = "block"
+	attestationTopic         = "attestation"
+	voluntaryExitTopic       = "voluntary_exit"
+	finalizedCheckpointTopic = "finalized_checkpoint"
+	chainReorgTopic          = "chain_reorg"
+)
+
+var eventTopics = []string{
+	headTopic,
+	blockTopic,
+	attestationTopic,
+	voluntaryExitTopic,
+	finalizedCheckpointTopic,
+	chainReorgTopic,
+}
+
+// StreamEvents streams the events of the requested topics, fed from the state, block and
+// operation feeds of the beacon node. Every topic is streamed if none is requested.
+func (bs *Server) StreamEvents(req *pbrpc.StreamEventsRequest, stream pbrpc.Events_StreamEventsServer) error {
+	topics, err := requestedTopics(req.Topics)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	blockChannel := make(chan *feed.Event, 1)
+	blockSub := bs.BlockNotifier.BlockFeed().Subscribe(blockChannel)
+	defer blockSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := bs.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	// The head and the finalized checkpoint are tracked to only send events when they change.
+	headRoot, err := bs.ChainInfoFetcher.HeadRoot(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	tracker := &eventTracker{
+		headRoot:       bytesutil.ToBytes32(headRoot),
+		headSlot:       bs.ChainInfoFetcher.HeadSlot(),
+		finalizedEpoch: bs.ChainInfoFetcher.FinalizedCheckpt().Epoch,
+	}
+
+	for {
+		var responses []*pbrpc.EventsResponse
+		select {
+		case ev := <-stateChannel:
+			responses, err = bs.stateEventResponses(ctx, ev, topics, tracker)
+			if err != nil {
+				return err
+			}
+		case ev := <-blockChannel:
+			responses, err = blockEventResponses(ev, topics)
+			if err != nil {
+				return err
+			}
+		case ev := <-opChannel:
+			responses = operationEventResponses(ev, topics)
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-blockSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-opSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		for _, res := range responses {
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		}
+	}
+}
+
+// eventTracker holds the head and finalized checkpoint last seen by an event stream.
+type eventTracker struct {
+	headRoot       [32]byte
+	headSlot       uint64
+	finalizedEpoch uint64
+}
+
+// stateEventResponses returns the head and finalized checkpoint events following a processed
+// block, or the chain reorg event of a reorg.
+func (bs *Server) stateEventResponses(
+	ctx context.Context,
+	ev *feed.Event,
+	topics map[string]bool,
+	tracker *eventTracker,
+) ([]*pbrpc.EventsResponse, error) {
+	switch ev.Type {
+	case statefeed.Reorg:
+		data, ok := ev.Data.(*statefeed.ReorgData)
+		if !ok || !topics[chainReorgTopic] {
+			return nil, nil
+		}
+		oldHeadRoot, newHeadRoot := data.OldHeadRoot, data.NewHeadRoot
+		return []*pbrpc.EventsResponse{{
+			Event: &pbrpc.EventsResponse_ChainReorg{
+				ChainReorg: &pbrpc.EventChainReorg{
+					Slot:         data.NewSlot,
+					Depth:        data.Depth,
+					OldHeadBlock: oldHeadRoot[:],
+					NewHeadBlock: newHeadRoot[:],
+					OldHeadState: data.OldHeadStateRoot,
+					NewHeadState: data.NewHeadStateRoot,
+					Epoch:        helpers.SlotToEpoch(data.NewSlot),
+				},
+			},
+		}}, nil
+	case statefeed.BlockProcessed:
+		var responses []*pbrpc.EventsResponse
+		headRoot, err := bs.ChainInfoFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+		}
+		if root := bytesutil.ToBytes32(headRoot); root != tracker.headRoot {
+			headBlock, err := bs.ChainInfoFetcher.HeadBlock(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get head block: %v", err)
+			}
+			if headBlock == nil || headBlock.Block == nil {
+				return nil, status.Error(codes.Internal, "Head block is nil")
+			}
+			slot := headBlock.Block.Slot
+			if topics[headTopic] {
+				responses = append(responses, &pbrpc.EventsResponse{
+					Event: &pbrpc.EventsResponse_Head{
+						Head: &pbrpc.EventHead{
+							Slot:            slot,
+							Block:           root[:],
+							State:           headBlock.Block.StateRoot,
+							EpochTransition: helpers.SlotToEpoch(slot) > helpers.SlotToEpoch(tracker.headSlot),
+						},
+					},
+				})
+			}
+			tracker.headRoot = root
+			tracker.headSlot = slot
+		}
+
+		finalized := bs.ChainInfoFetcher.FinalizedCheckpt()
+		if finalized.Epoch > tracker.finalizedEpoch {
+			tracker.finalizedEpoch = finalized.Epoch
+			if topics[finalizedCheckpointTopic] {
+				var stateRoot []byte
+				if finalizedRoot := bytesutil.ToBytes32(finalized.Root); finalizedRoot != params.BeaconConfig().ZeroHash {
+					finalizedBlock, err := bs.BeaconDB.Block(ctx, finalizedRoot)
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "Could not get finalized block: %v", err)
+					}
+					if finalizedBlock != nil && finalizedBlock.Block != nil {
+						stateRoot = finalizedBlock.Block.StateRoot
+					}
+				}
+				responses = append(responses, &pbrpc.EventsResponse{
+					Event: &pbrpc.EventsResponse_FinalizedCheckpoint{
+						FinalizedCheckpoint: &pbrpc.EventFinalizedCheckpoint{
+							Block: finalized.Root,
+							State: stateRoot,
+							Epoch: finalized.Epoch,
+						},
+					},
+				})
+			}
+		}
+		return responses, nil
+	default:
+		return nil, nil
+	}
+}
+
+// blockEventResponses returns the block event of a block received by the node.
+func blockEventResponses(ev *feed.Event, topics map[string]bool) ([]*pbrpc.EventsResponse, error) {
+	if ev.Type != blockfeed.ReceivedBlock || !topics[blockTopic] {
+		return nil, nil
+	}
+	data, ok := ev.Data.(*blockfeed.ReceivedBlockData)
+	if !ok || data.SignedBlock == nil || data.SignedBlock.Block == nil {
+		return nil, nil
+	}
+	root, err := data.SignedBlock.Block.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+	}
+	return []*pbrpc.EventsResponse{{
+		Event: &pbrpc.EventsResponse_Block{
+			Block: &pbrpc.EventBlock{
+				Slot:  data.SignedBlock.Block.Slot,
+				Block: root[:],
+			},
+		},
+	}}, nil
+}
+
+// operationEventResponses returns the attestation and voluntary exit events of operations
+// received by the node.
+func operationEventResponses(ev *feed.Event, topics map[string]bool) []*pbrpc.EventsResponse {
+	switch ev.Type {
+	case operation.UnaggregatedAttReceived:
+		data, ok := ev.Data.(*operation.UnAggregatedAttReceivedData)
+		if !ok || data.Attestation == nil || !topics[attestationTopic] {
+			return nil
+		}
+		return []*pbrpc.EventsResponse{{
+			Event: &pbrpc.EventsResponse_Attestation{Attestation: data.Attestation},
+		}}
+	case operation.AggregatedAttReceived:
+		data, ok := ev.Data.(*operation.AggregatedAttReceivedData)
+		if !ok || data.Attestation == nil || data.Attestation.Aggregate == nil || !topics[attestationTopic] {
+			return nil
+		}
+		return []*pbrpc.EventsResponse{{
+			Event: &pbrpc.EventsResponse_Attestation{Attestation: data.Attestation.Aggregate},
+		}}
+	case operation.ExitReceived:
+		data, ok := ev.Data.(*operation.ExitReceivedData)
+		if !ok || data.Exit == nil || !topics[voluntaryExitTopic] {
+			return nil
+		}
+		return []*pbrpc.EventsResponse{{
+			Event: &pbrpc.EventsResponse_VoluntaryExit{VoluntaryExit: data.Exit},
+		}}
+	default:
+		return nil
+	}
+}
+
+// requestedTopics returns the set of requested topics, or of every topic if none is requested.
+func requestedTopics(requested []string) (map[string]bool, error) {
+	if len(requested) == 0 {
+		requested = eventTopics
+	}
+	topics := make(map[string]bool, len(requested))
+	for _, topic := range requested {
+		known := false
+		for _, t := range eventTopics {
+			if t == topic {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, status.Errorf(codes.InvalidArgument, "Topic %s is not supported", topic)
+		}
+		topics[topic] = true
+	}
+	return topics, nil
+}
@@ -0,0 +1,212 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.EventsResponse
+}
+
+func (m *mockEventsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockEventsStream) Send(res *pbrpc.EventsResponse) error {
+	m.sent <- res
+	return nil
+}
+
+// startEventsStream runs StreamEvents in the background and returns the stream receiving the
+// events along with the error channel of the call.
+func startEventsStream(t *testing.T, bs *Server, topics ...string) (*mockEventsStream, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &mockEventsStream{ctx: ctx, sent: make(chan *pbrpc.EventsResponse, 10)}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- bs.StreamEvents(&pbrpc.StreamEventsRequest{Topics: topics}, stream)
+	}()
+	return stream, errCh
+}
+
+// sendEvent sends the event once the stream has subscribed to the feed.
+func sendEvent(t *testing.T, f *event.Feed, ev *feed.Event) {
+	for i := 0; f.Send(ev) == 0; i++ {
+		require.Equal(t, true, i < 100, "Stream did not subscribe to the feed")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func receiveEvent(t *testing.T, stream *mockEventsStream) *pbrpc.EventsResponse {
+	select {
+	case res := <-stream.sent:
+		return res
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive event")
+		return nil
+	}
+}
+
+func newEventsServer(chainService *chainMock.ChainService) *Server {
+	bs := &Server{
+		Ctx:                 context.Background(),
+		ChainInfoFetcher:    chainService,
+		StateNotifier:       chainService.StateNotifier(),
+		BlockNotifier:       chainService.BlockNotifier(),
+		AttestationNotifier: chainService.OperationNotifier(),
+	}
+	// Initialize the feeds of the mocks before they are used concurrently.
+	bs.StateNotifier.StateFeed()
+	bs.BlockNotifier.BlockFeed()
+	bs.AttestationNotifier.OperationFeed()
+	return bs
+}
+
+func TestStreamEvents_UnsupportedTopic(t *testing.T) {
+	bs := newEventsServer(&chainMock.ChainService{FinalizedCheckPoint: &ethpb_alpha.Checkpoint{}})
+	_, errCh := startEventsStream(t, bs, headTopic, "foo")
+	assert.ErrorContains(t, "Topic foo is not supported", <-errCh)
+}
+
+func TestStreamEvents_OperationEvents(t *testing.T) {
+	bs := newEventsServer(&chainMock.ChainService{FinalizedCheckPoint: &ethpb_alpha.Checkpoint{}})
+	stream, _ := startEventsStream(t, bs, attestationTopic, voluntaryExitTopic)
+
+	// Blocks are not requested and must not be streamed.
+	sendEvent(t, bs.BlockNotifier.BlockFeed(), &feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: testutil.NewBeaconBlock()},
+	})
+	att := testutil.NewAttestation()
+	sendEvent(t, bs.AttestationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	exit := &ethpb_alpha.SignedVoluntaryExit{
+		Exit:      &ethpb_alpha.VoluntaryExit{Epoch: 5, ValidatorIndex: 3},
+		Signature: make([]byte, 96),
+	}
+	sendEvent(t, bs.AttestationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{Exit: exit},
+	})
+
+	res := receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventsResponse_Attestation{Attestation: att}, res.Event)
+	res = receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventsResponse_VoluntaryExit{VoluntaryExit: exit}, res.Event)
+}
+
+func TestStreamEvents_BlockEvents(t *testing.T) {
+	bs := newEventsServer(&chainMock.ChainService{FinalizedCheckPoint: &ethpb_alpha.Checkpoint{}})
+	stream, _ := startEventsStream(t, bs)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 7
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	sendEvent(t, bs.BlockNotifier.BlockFeed(), &feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: blk},
+	})
+
+	res := receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventBlock{Slot: 7, Block: root[:]}, res.GetBlock())
+}
+
+func TestStreamEvents_StateEvents(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st := testutil.NewBeaconState()
+	chainService := &chainMock.ChainService{
+		State:               st,
+		Root:                bytesutil.PadTo([]byte("old head"), 32),
+		FinalizedCheckPoint: &ethpb_alpha.Checkpoint{Root: make([]byte, 32)},
+	}
+	bs := newEventsServer(chainService)
+	bs.BeaconDB = beaconDB
+	stream, _ := startEventsStream(t, bs, headTopic, finalizedCheckpointTopic, chainReorgTopic)
+
+	// Processing a block which changes neither the head nor the finalized checkpoint sends nothing.
+	sendEvent(t, bs.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{},
+	})
+
+	sendEvent(t, bs.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			NewSlot:          40,
+			OldSlot:          41,
+			Depth:            3,
+			OldHeadRoot:      [32]byte{'a'},
+			NewHeadRoot:      [32]byte{'b'},
+			OldHeadStateRoot: bytesutil.PadTo([]byte("old state"), 32),
+			NewHeadStateRoot: bytesutil.PadTo([]byte("new state"), 32),
+		},
+	})
+	res := receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventChainReorg{
+		Slot:         40,
+		Depth:        3,
+		OldHeadBlock: bytesutil.PadTo([]byte{'a'}, 32),
+		NewHeadBlock: bytesutil.PadTo([]byte{'b'}, 32),
+		OldHeadState: bytesutil.PadTo([]byte("old state"), 32),
+		NewHeadState: bytesutil.PadTo([]byte("new state"), 32),
+		Epoch:        1,
+	}, res.GetChainReorg())
+
+	finalizedBlock := testutil.NewBeaconBlock()
+	finalizedBlock.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	finalizedBlock.Block.StateRoot = bytesutil.PadTo([]byte("finalized state"), 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, finalizedBlock))
+	finalizedRoot, err := finalizedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headBlock := testutil.NewBeaconBlock()
+	headBlock.Block.Slot = 40
+	headBlock.Block.StateRoot = bytesutil.PadTo([]byte("head state"), 32)
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chainService.Block = headBlock
+	chainService.Root = headRoot[:]
+	chainService.FinalizedCheckPoint = &ethpb_alpha.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}
+	sendEvent(t, bs.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{},
+	})
+
+	res = receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventHead{
+		Slot:            40,
+		Block:           headRoot[:],
+		State:           headBlock.Block.StateRoot,
+		EpochTransition: true,
+	}, res.GetHead())
+	res = receiveEvent(t, stream)
+	assert.DeepEqual(t, &pbrpc.EventFinalizedCheckpoint{
+		Block: finalizedRoot[:],
+		State: finalizedBlock.Block.StateRoot,
+		Epoch: 1,
+	}, res.GetFinalizedCheckpoint())
+}
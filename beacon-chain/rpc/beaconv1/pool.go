@@ -2,57 +2,281 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
 
 	ptypes "github.com/gogo/protobuf/types"
-
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ListPoolAttestations retrieves attestations known by the node but
-// not necessarily incorporated into any block.
+// not necessarily incorporated into any block. Attestations can be filtered
+// by slot and committee index, which are set when they are not zero or when
+// their query parameters are passed in the request metadata.
 func (bs *Server) ListPoolAttestations(ctx context.Context, req *ethpb.AttestationsPoolRequest) (*ethpb.AttestationsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolAttestations")
+	defer span.End()
+
+	unaggregated, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	poolAtts := append(bs.AttestationsPool.AggregatedAttestations(), unaggregated...)
+
+	filterSlot := isSet(ctx, "slot", req.Slot)
+	filterIndex := isSet(ctx, "committee_index", req.CommitteeIndex)
+	atts := make([]*ethpb.Attestation, 0, len(poolAtts))
+	for _, att := range poolAtts {
+		if filterSlot && att.Data.Slot != req.Slot {
+			continue
+		}
+		if filterIndex && att.Data.CommitteeIndex != req.CommitteeIndex {
+			continue
+		}
+		v1Att, err := migration.V1Alpha1AttestationToV1(att)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attestation: %v", err)
+		}
+		atts = append(atts, v1Att)
+	}
+
+	return &ethpb.AttestationsPoolResponse{
+		Data: atts,
+	}, nil
 }
 
 // SubmitAttestation submits Attestation object to node. If attestation passes all validation
 // constraints, node MUST publish attestation on appropriate subnet.
 func (bs *Server) SubmitAttestation(ctx context.Context, req *ethpb.Attestation) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitAttestation")
+	defer span.End()
+
+	att, err := migration.V1AttestationToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert attestation: %v", err)
+	}
+	if err := helpers.ValidateNilAttestation(att); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid attestation: %v", err)
+	}
+	if att.AggregationBits.Count() != 1 {
+		return nil, status.Error(codes.InvalidArgument, "Attestation must have exactly one aggregation bit set")
+	}
+	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid attestation: %v", err)
+	}
+	if err := helpers.ValidateAttestationTime(att.Data.Slot, bs.GenesisTimeFetcher.GenesisTime()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid attestation: %v", err)
+	}
+	if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Incorrect attestation signature")
+	}
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := blocks.VerifyAttestationSignature(ctx, headState, att); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not verify attestation: %v", err)
+	}
+
+	// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
+	// of a received unaggregated attestation.
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{
+			Attestation: att,
+		},
+	})
+
+	activeCount, err := helpers.ActiveValidatorCount(headState, att.Data.Target.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	subnet := helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, att.Data.CommitteeIndex, att.Data.Slot)
+	if err := bs.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast attestation: %v", err)
+	}
+	if err := bs.AttestationsPool.SaveUnaggregatedAttestation(stateTrie.CopyAttestation(att)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save attestation in pool: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolAttesterSlashings retrieves attester slashings known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolAttesterSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.AttesterSlashingsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolAttesterSlashings")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	poolSlashings := bs.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* no limit */)
+	slashings := make([]*ethpb.AttesterSlashing, len(poolSlashings))
+	for i, s := range poolSlashings {
+		slashings[i], err = migration.V1Alpha1AttSlashingToV1(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attester slashing: %v", err)
+		}
+	}
+
+	return &ethpb.AttesterSlashingsPoolResponse{
+		Data: slashings,
+	}, nil
 }
 
 // SubmitAttesterSlashing submits AttesterSlashing object to node's pool and
 // if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitAttesterSlashing(ctx context.Context, req *ethpb.AttesterSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitAttesterSlashing")
+	defer span.End()
+
+	slashing, err := migration.V1AttSlashingToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert attester slashing: %v", err)
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid attester slashing: %v", err)
+	}
+	if err := bs.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert attester slashing into pool: %v", err)
+	}
+	if !featureconfig.Get().DisableBroadcastSlashings {
+		if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast attester slashing: %v", err)
+		}
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolProposerSlashings retrieves proposer slashings known by the node
 // but not necessarily incorporated into any block.
 func (bs *Server) ListPoolProposerSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.ProposerSlashingPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolProposerSlashings")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	poolSlashings := bs.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* no limit */)
+	slashings := make([]*ethpb.ProposerSlashing, len(poolSlashings))
+	for i, s := range poolSlashings {
+		slashings[i], err = migration.V1Alpha1ProposerSlashingToV1(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert proposer slashing: %v", err)
+		}
+	}
+
+	return &ethpb.ProposerSlashingPoolResponse{
+		Data: slashings,
+	}, nil
 }
 
 // SubmitProposerSlashing submits AttesterSlashing object to node's pool and if
 // passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitProposerSlashing(ctx context.Context, req *ethpb.ProposerSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitProposerSlashing")
+	defer span.End()
+
+	slashing, err := migration.V1ProposerSlashingToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert proposer slashing: %v", err)
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid proposer slashing: %v", err)
+	}
+	if err := bs.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert proposer slashing into pool: %v", err)
+	}
+	if !featureconfig.Get().DisableBroadcastSlashings {
+		if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast proposer slashing: %v", err)
+		}
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolVoluntaryExits retrieves voluntary exits known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolVoluntaryExits(ctx context.Context, req *ptypes.Empty) (*ethpb.VoluntaryExitsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolVoluntaryExits")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	poolExits := bs.ExitPool.PendingExits(headState, headState.Slot(), true /* no limit */)
+	exits := make([]*ethpb.SignedVoluntaryExit, len(poolExits))
+	for i, e := range poolExits {
+		exits[i], err = migration.V1Alpha1ExitToV1(e)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert voluntary exit: %v", err)
+		}
+	}
+
+	return &ethpb.VoluntaryExitsPoolResponse{
+		Data: exits,
+	}, nil
 }
 
 // SubmitVoluntaryExit submits SignedVoluntaryExit object to node's pool
 // and if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitVoluntaryExit(ctx context.Context, req *ethpb.SignedVoluntaryExit) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitVoluntaryExit")
+	defer span.End()
+
+	exit, err := migration.V1ExitToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert voluntary exit: %v", err)
+	}
+	if exit.Exit == nil {
+		return nil, status.Error(codes.InvalidArgument, "Voluntary exit does not exist")
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Validator index exceeds validator set length")
+	}
+	if err := blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorRoot()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid voluntary exit: %v", err)
+	}
+
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{
+			Exit: exit,
+		},
+	})
+	bs.ExitPool.InsertVoluntaryExit(ctx, headState, exit)
+	if err := bs.Broadcaster.Broadcast(ctx, exit); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast voluntary exit: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
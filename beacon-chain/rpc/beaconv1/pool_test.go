@@ -0,0 +1,231 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	p2pMock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestListPoolAttestations(t *testing.T) {
+	pool := attestations.NewPool()
+	for slot := uint64(1); slot <= 3; slot++ {
+		for committee := uint64(0); committee < 2; committee++ {
+			att := testutil.HydrateAttestation(&ethpb_alpha.Attestation{
+				Data: &ethpb_alpha.AttestationData{
+					Slot:           slot,
+					CommitteeIndex: committee,
+				},
+				AggregationBits: bitfield.Bitlist{0b11},
+			})
+			require.NoError(t, pool.SaveUnaggregatedAttestation(att))
+		}
+	}
+	bs := &Server{AttestationsPool: pool}
+
+	resp, err := bs.ListPoolAttestations(context.Background(), &ethpb.AttestationsPoolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 6, len(resp.Data))
+
+	resp, err = bs.ListPoolAttestations(context.Background(), &ethpb.AttestationsPoolRequest{Slot: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	for _, att := range resp.Data {
+		assert.Equal(t, uint64(2), att.Data.Slot)
+	}
+
+	resp, err = bs.ListPoolAttestations(context.Background(), &ethpb.AttestationsPoolRequest{
+		Slot:           3,
+		CommitteeIndex: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(3), resp.Data[0].Data.Slot)
+	assert.Equal(t, uint64(1), resp.Data[0].Data.CommitteeIndex)
+
+	// Committee index 0 is a filter when its query parameter is set.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutils.QueryParamsMetadataKey, "committee_index"))
+	resp, err = bs.ListPoolAttestations(ctx, &ethpb.AttestationsPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Data))
+	for _, att := range resp.Data {
+		assert.Equal(t, uint64(0), att.Data.CommitteeIndex)
+	}
+}
+
+func TestSubmitAttestation(t *testing.T) {
+	ctx := context.Background()
+	st, privs := testutil.DeterministicGenesisState(t, 64)
+	chainService := &chainMock.ChainService{State: st, Genesis: time.Now()}
+	broadcaster := &p2pMock.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher:    chainService,
+		GenesisTimeFetcher:  chainService,
+		AttestationNotifier: chainService.OperationNotifier(),
+		AttestationsPool:    attestations.NewPool(),
+		Broadcaster:         broadcaster,
+	}
+	// Two single bit attestations are generated for the only committee of slot 0.
+	atts, err := testutil.GenerateAttestations(st, privs, 2, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(atts))
+
+	t.Run("Valid", func(t *testing.T) {
+		opChannel := make(chan *feed.Event, 1)
+		opSub := bs.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+		defer opSub.Unsubscribe()
+
+		req, err := migration.V1Alpha1AttestationToV1(atts[0])
+		require.NoError(t, err)
+		_, err = bs.SubmitAttestation(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		event := <-opChannel
+		assert.Equal(t, operation.UnaggregatedAttReceived, int(event.Type))
+
+		poolAtts, err := bs.AttestationsPool.UnaggregatedAttestations()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(poolAtts))
+		assert.DeepEqual(t, atts[0], poolAtts[0])
+	})
+
+	t.Run("Aggregated", func(t *testing.T) {
+		req, err := migration.V1Alpha1AttestationToV1(atts[1])
+		require.NoError(t, err)
+		req.AggregationBits = bitfield.Bitlist{0b111}
+		_, err = bs.SubmitAttestation(ctx, req)
+		assert.ErrorContains(t, "exactly one aggregation bit", err)
+	})
+
+	t.Run("Bad signature", func(t *testing.T) {
+		req, err := migration.V1Alpha1AttestationToV1(atts[1])
+		require.NoError(t, err)
+		req.Signature = atts[0].Signature
+		_, err = bs.SubmitAttestation(ctx, req)
+		assert.ErrorContains(t, "Could not verify attestation", err)
+	})
+}
+
+func TestSubmitAttesterSlashing(t *testing.T) {
+	ctx := context.Background()
+	st, privs := testutil.DeterministicGenesisState(t, 64)
+	broadcaster := &p2pMock.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+		SlashingsPool:    slashings.NewPool(),
+		Broadcaster:      broadcaster,
+	}
+
+	slashing, err := testutil.GenerateAttesterSlashingForValidator(st, privs[2], 2)
+	require.NoError(t, err)
+	req, err := migration.V1Alpha1AttSlashingToV1(slashing)
+	require.NoError(t, err)
+	_, err = bs.SubmitAttesterSlashing(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err := bs.ListPoolAttesterSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, req, resp.Data[0])
+
+	// Identical attestations are not slashable.
+	req.Attestation_2 = req.Attestation_1
+	_, err = bs.SubmitAttesterSlashing(ctx, req)
+	assert.ErrorContains(t, "Invalid attester slashing", err)
+}
+
+func TestSubmitProposerSlashing(t *testing.T) {
+	ctx := context.Background()
+	st, privs := testutil.DeterministicGenesisState(t, 64)
+	broadcaster := &p2pMock.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+		SlashingsPool:    slashings.NewPool(),
+		Broadcaster:      broadcaster,
+	}
+
+	slashing, err := testutil.GenerateProposerSlashingForValidator(st, privs[2], 2)
+	require.NoError(t, err)
+	req, err := migration.V1Alpha1ProposerSlashingToV1(slashing)
+	require.NoError(t, err)
+	_, err = bs.SubmitProposerSlashing(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err := bs.ListPoolProposerSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, req, resp.Data[0])
+
+	// A slashing signed by the wrong key is rejected.
+	slashing, err = testutil.GenerateProposerSlashingForValidator(st, privs[3], 4)
+	require.NoError(t, err)
+	req, err = migration.V1Alpha1ProposerSlashingToV1(slashing)
+	require.NoError(t, err)
+	_, err = bs.SubmitProposerSlashing(ctx, req)
+	assert.ErrorContains(t, "Invalid proposer slashing", err)
+}
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	st, privs := testutil.DeterministicGenesisState(t, 64)
+	epoch := params.BeaconConfig().ShardCommitteePeriod
+	require.NoError(t, st.SetSlot(epoch*params.BeaconConfig().SlotsPerEpoch))
+	chainService := &chainMock.ChainService{State: st}
+	broadcaster := &p2pMock.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher:    chainService,
+		AttestationNotifier: chainService.OperationNotifier(),
+		ExitPool:            voluntaryexits.NewPool(),
+		Broadcaster:         broadcaster,
+	}
+
+	exit := &ethpb_alpha.SignedVoluntaryExit{
+		Exit: &ethpb_alpha.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: 0,
+		},
+	}
+	var err error
+	exit.Signature, err = helpers.ComputeDomainAndSign(st, epoch, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, privs[0])
+	require.NoError(t, err)
+	req, err := migration.V1Alpha1ExitToV1(exit)
+	require.NoError(t, err)
+
+	_, err = bs.SubmitVoluntaryExit(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err := bs.ListPoolVoluntaryExits(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, req, resp.Data[0])
+
+	req.Exit.ValidatorIndex = 1
+	_, err = bs.SubmitVoluntaryExit(ctx, req)
+	assert.ErrorContains(t, "Invalid voluntary exit", err)
+
+	req.Exit.ValidatorIndex = 100
+	_, err = bs.SubmitVoluntaryExit(ctx, req)
+	assert.ErrorContains(t, "Validator index exceeds validator set length", err)
+}
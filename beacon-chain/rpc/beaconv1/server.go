@@ -1,6 +1,6 @@
 // Package beaconv1 defines a gRPC beacon service implementation,
 // following the official API standards https://ethereum.github.io/eth2.0-APIs/#/.
-// This package includes the beacon and config endpoints.
+// This package includes the beacon, config, events and validator endpoints.
 package beaconv1
 
 import (
@@ -15,8 +15,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -40,8 +42,12 @@ type Server struct {
 	Broadcaster         p2p.Broadcaster
 	AttestationsPool    attestations.Pool
 	SlashingsPool       *slashings.Pool
+	ExitPool            *voluntaryexits.Pool
 	CanonicalStateChan  chan *pbp2p.BeaconState
 	ChainStartChan      chan time.Time
 	StateGen            *stategen.State
 	SyncChecker         sync.Checker
+	// V1Alpha1ValidatorServer produces the blocks and attestation data of the validator
+	// endpoints, and verifies and broadcasts their submissions.
+	V1Alpha1ValidatorServer *validator.Server
 }
@@ -3,16 +3,28 @@ package beaconv1
 import (
 	"bytes"
 	"context"
-	"errors"
+	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var (
+	errInvalidStateID = errors.New("invalid state ID")
+	errStateNotFound  = errors.New("state not found")
+)
+
 // GetGenesis retrieves details of the chain's genesis which can be used to identify chain.
 func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.GenesisResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beaconv1.GetGenesis")
@@ -40,16 +52,225 @@ func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.Genes
 
 // GetStateRoot calculates HashTreeRoot for state with given 'stateId'. If stateId is root, same value will be returned.
 func (bs *Server) GetStateRoot(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateRootResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetStateRoot")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash state: %v", err)
+	}
+
+	return &ethpb.StateRootResponse{
+		StateRoot: root[:],
+	}, nil
 }
 
 // GetStateFork returns Fork object for state with given 'stateId'.
 func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateForkResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetStateFork")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	fork := st.Fork()
+
+	return &ethpb.StateForkResponse{
+		Fork: &ethpb.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           fork.Epoch,
+		},
+	}, nil
 }
 
 // GetFinalityCheckpoints returns finality checkpoints for state with given 'stateId'. In case finality is
 // not yet achieved, checkpoint should return epoch 0 and ZERO_HASH as root.
 func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateFinalityCheckpointResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetFinalityCheckpoints")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	return &ethpb.StateFinalityCheckpointResponse{
+		PreviousJustified: checkpoint(st.PreviousJustifiedCheckpoint()),
+		CurrentJustified:  checkpoint(st.CurrentJustifiedCheckpoint()),
+		Finalized:         checkpoint(st.FinalizedCheckpoint()),
+	}, nil
+}
+
+// checkpoint converts a v1alpha1 checkpoint to a v1 checkpoint, using epoch 0 and the zero hash
+// when there is no checkpoint.
+func checkpoint(cp *ethpb_alpha.Checkpoint) *ethpb.Checkpoint {
+	if cp == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	}
+	return &ethpb.Checkpoint{
+		Epoch: cp.Epoch,
+		Root:  bytesutil.PadTo(cp.Root, 32),
+	}
+}
+
+// stateFromStateID resolves a state ID, which is one of "head", "genesis", "finalized", "justified",
+// a slot or a state root, to a beacon state. States are regenerated through stategen when needed.
+func (bs *Server) stateFromStateID(ctx context.Context, stateID []byte) (*state.BeaconState, error) {
+	var (
+		st  *state.BeaconState
+		err error
+	)
+	switch string(stateID) {
+	case "head":
+		st, err = bs.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve head state")
+		}
+	case "genesis":
+		st, err = bs.StateGen.StateBySlot(ctx, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve genesis state")
+		}
+	case "finalized":
+		st, err = bs.stateFromCheckpoint(ctx, bs.ChainInfoFetcher.FinalizedCheckpt())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve finalized state")
+		}
+	case "justified":
+		st, err = bs.stateFromCheckpoint(ctx, bs.ChainInfoFetcher.CurrentJustifiedCheckpt())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve justified state")
+		}
+	default:
+		switch {
+		case len(stateID) == 32:
+			st, err = bs.stateFromStateRoot(ctx, stateID)
+		case strings.HasPrefix(string(stateID), "0x"):
+			root, decodeErr := hexutil.Decode(string(stateID))
+			if decodeErr != nil || len(root) != 32 {
+				return nil, errors.Wrapf(errInvalidStateID, "could not decode state root %s", stateID)
+			}
+			st, err = bs.stateFromStateRoot(ctx, root)
+		default:
+			slot, parseErr := strconv.ParseUint(string(stateID), 10, 64)
+			if parseErr != nil {
+				return nil, errors.Wrapf(errInvalidStateID, "could not decode state ID %s", stateID)
+			}
+			st, err = bs.stateFromSlot(ctx, slot)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if st == nil {
+		return nil, errStateNotFound
+	}
+	return st, nil
+}
+
+// stateFromCheckpoint returns the state of a checkpoint root. A zero root refers to the genesis
+// state, as the finalized and justified roots are zero until the first epoch is justified.
+func (bs *Server) stateFromCheckpoint(ctx context.Context, checkpoint *ethpb_alpha.Checkpoint) (*state.BeaconState, error) {
+	if checkpoint == nil {
+		return nil, errStateNotFound
+	}
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	if root == params.BeaconConfig().ZeroHash {
+		return bs.StateGen.StateBySlot(ctx, 0)
+	}
+	return bs.StateGen.StateByRoot(ctx, root)
+}
+
+// stateFromSlot returns the canonical state at a slot which is not in the future.
+func (bs *Server) stateFromSlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	if slot > bs.GenesisTimeFetcher.CurrentSlot() {
+		return nil, errors.Wrapf(errInvalidStateID, "slot %d is in the future", slot)
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve state at slot %d", slot)
+	}
+	return st, nil
+}
+
+// stateFromStateRoot returns the canonical state with the given root. The head state is checked
+// first. Otherwise the state roots kept by the head state give the slot of the state, whose state
+// is loaded by block root through the state summaries when a block was processed at that slot, or
+// regenerated by slot through stategen otherwise.
+func (bs *Server) stateFromStateRoot(ctx context.Context, stateRoot []byte) (*state.BeaconState, error) {
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState == nil {
+		return nil, errStateNotFound
+	}
+	headRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash head state")
+	}
+	if bytes.Equal(headRoot[:], stateRoot) {
+		return headState, nil
+	}
+
+	stateRoots := headState.StateRoots()
+	blockRoots := headState.BlockRoots()
+	historicalRoots := uint64(len(stateRoots))
+	if historicalRoots == 0 || uint64(len(blockRoots)) != historicalRoots {
+		return nil, errors.Wrapf(errStateNotFound, "state root %#x", stateRoot)
+	}
+	headSlot := headState.Slot()
+	for slot := headSlot; slot > 0 && headSlot-slot < historicalRoots; {
+		slot--
+		if !bytes.Equal(stateRoots[slot%historicalRoots], stateRoot) {
+			continue
+		}
+		st, err := bs.stateAtHistoricalSlot(ctx, slot, bytesutil.ToBytes32(blockRoots[slot%historicalRoots]))
+		if err != nil {
+			return nil, err
+		}
+		return st, nil
+	}
+	return nil, errors.Wrapf(errStateNotFound, "state root %#x is not a canonical state root of the last %d slots", stateRoot, historicalRoots)
+}
+
+// stateAtHistoricalSlot returns the canonical state at a past slot, given the root of the latest
+// block at that slot.
+func (bs *Server) stateAtHistoricalSlot(ctx context.Context, slot uint64, blockRoot [32]byte) (*state.BeaconState, error) {
+	summary, err := bs.BeaconDB.StateSummary(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve state summary of block %#x", blockRoot)
+	}
+	if summary != nil && summary.Slot == slot {
+		st, err := bs.StateGen.StateByRoot(ctx, blockRoot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve state of block %#x", blockRoot)
+		}
+		return st, nil
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve state at slot %d", slot)
+	}
+	return st, nil
+}
+
+// stateError converts an error returned by stateFromStateID to a gRPC status error.
+func stateError(err error) error {
+	switch {
+	case errors.Is(err, errInvalidStateID):
+		return status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", err)
+	case errors.Is(err, errStateNotFound):
+		return status.Errorf(codes.NotFound, "Could not find state: %v", err)
+	case errors.Is(err, stategen.ErrReplayBudgetExceeded):
+		return status.Errorf(codes.ResourceExhausted, "Could not get state: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
 }
@@ -2,12 +2,24 @@ package beaconv1
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -63,3 +75,131 @@ func TestGetGenesis(t *testing.T) {
 		assert.ErrorContains(t, "Chain genesis info is not yet known", err)
 	})
 }
+
+// saveGenesisState saves a genesis block and state to the db and returns the state root.
+func saveGenesisState(t *testing.T, beaconDB db.Database, st *state.BeaconState) [32]byte {
+	ctx := context.Background()
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlock := blocks.NewGenesisBlock(stateRoot[:])
+	blockRoot, err := genesisBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesisBlock))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, blockRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, blockRoot))
+	return stateRoot
+}
+
+func TestGetStateRoot(t *testing.T) {
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesisRoot := saveGenesisState(t, db, genesisState)
+
+	headState, err := transition.ProcessSlots(ctx, genesisState.Copy(), 8)
+	require.NoError(t, err)
+	headRoot, err := headState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	slot := uint64(100)
+	s := Server{
+		BeaconDB:           db,
+		ChainInfoFetcher:   &chainMock.ChainService{State: headState},
+		GenesisTimeFetcher: &chainMock.ChainService{Slot: &slot},
+		StateGen:           stategen.New(db),
+	}
+
+	t.Run("Head", func(t *testing.T) {
+		resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		assert.DeepEqual(t, headRoot[:], resp.StateRoot)
+	})
+
+	t.Run("Genesis", func(t *testing.T) {
+		resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("genesis")})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.StateRoot)
+	})
+
+	t.Run("Slot", func(t *testing.T) {
+		resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("0")})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.StateRoot)
+	})
+
+	t.Run("Root", func(t *testing.T) {
+		resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: headRoot[:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, headRoot[:], resp.StateRoot)
+
+		resp, err = s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte(fmt.Sprintf("%#x", headRoot))})
+		require.NoError(t, err)
+		assert.DeepEqual(t, headRoot[:], resp.StateRoot)
+	})
+
+	t.Run("Historical root", func(t *testing.T) {
+		resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: genesisRoot[:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.StateRoot)
+
+		// States of slots without blocks are regenerated.
+		emptySlotRoot := headState.StateRoots()[5]
+		resp, err = s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: emptySlotRoot})
+		require.NoError(t, err)
+		assert.DeepEqual(t, emptySlotRoot, resp.StateRoot)
+	})
+
+	t.Run("Unknown root", func(t *testing.T) {
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: bytesutil.PadTo([]byte{1, 2, 3}, 32)})
+		assert.ErrorContains(t, "Could not find state", err)
+	})
+
+	t.Run("Future slot", func(t *testing.T) {
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("101")})
+		assert.ErrorContains(t, "is in the future", err)
+	})
+
+	t.Run("Invalid state ID", func(t *testing.T) {
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("foo")})
+		assert.ErrorContains(t, "Invalid state ID", err)
+	})
+}
+
+func TestGetStateFork(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	fork := &pb.Fork{
+		PreviousVersion: []byte("prev"),
+		CurrentVersion:  []byte("curr"),
+		Epoch:           100,
+	}
+	require.NoError(t, st.SetFork(fork))
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	resp, err := s.GetStateFork(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fork.PreviousVersion, resp.Fork.PreviousVersion)
+	assert.DeepEqual(t, fork.CurrentVersion, resp.Fork.CurrentVersion)
+	assert.Equal(t, fork.Epoch, resp.Fork.Epoch)
+}
+
+func TestGetFinalityCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, st.SetPreviousJustifiedCheckpoint(&ethpb_alpha.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("prev"), 32)}))
+	require.NoError(t, st.SetCurrentJustifiedCheckpoint(&ethpb_alpha.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte("curr"), 32)}))
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	resp, err := s.GetFinalityCheckpoints(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.PreviousJustified.Epoch)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("prev"), 32), resp.PreviousJustified.Root)
+	assert.Equal(t, uint64(2), resp.CurrentJustified.Epoch)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("curr"), 32), resp.CurrentJustified.Root)
+	// Finality is not yet achieved.
+	assert.Equal(t, uint64(0), resp.Finalized.Epoch)
+	assert.DeepEqual(t, params.BeaconConfig().ZeroHash[:], resp.Finalized.Root)
+}
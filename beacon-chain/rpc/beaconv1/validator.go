@@ -2,27 +2,307 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
+	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
 func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorRequest) (*ethpb.StateValidatorResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetValidator")
+	defer span.End()
+
+	if len(req.ValidatorId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Validator ID is required")
+	}
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	indices, err := validatorIndices(st, [][]byte{req.ValidatorId})
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator %s", req.ValidatorId)
+	}
+	containers, err := validatorContainers(st, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.StateValidatorResponse{
+		Data: containers[0],
+	}, nil
 }
 
 // ListValidators returns filterable list of validators with their balance, status and index.
 func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidatorsRequest) (*ethpb.StateValidatorsResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListValidators")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	indices, err := validatorIndices(st, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := validatorContainers(st, indices)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status == "" {
+		return &ethpb.StateValidatorsResponse{Data: containers}, nil
+	}
+
+	wanted := strings.Split(req.Status, ",")
+	for _, filter := range wanted {
+		if !isValidatorStatusFilter(filter) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator status %s", filter)
+		}
+	}
+	filtered := make([]*ethpb.ValidatorContainer, 0, len(containers))
+	for _, c := range containers {
+		for _, filter := range wanted {
+			if c.Status == filter || strings.HasPrefix(c.Status, filter+"_") {
+				filtered = append(filtered, c)
+				break
+			}
+		}
+	}
+	return &ethpb.StateValidatorsResponse{
+		Data: filtered,
+	}, nil
 }
 
 // ListValidatorBalances returns a filterable list of validator balances.
 func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.ValidatorBalancesRequest) (*ethpb.ValidatorBalancesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListValidatorBalances")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	ids := make([][]byte, len(req.Id))
+	for i, id := range req.Id {
+		ids[i] = []byte(id)
+	}
+	indices, err := validatorIndices(st, ids)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]*ethpb.ValidatorBalance, len(indices))
+	for i, idx := range indices {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", idx, err)
+		}
+		balances[i] = &ethpb.ValidatorBalance{
+			Index:   idx,
+			Balance: balance,
+		}
+	}
+
+	return &ethpb.ValidatorBalancesResponse{
+		Data: balances,
+	}, nil
 }
 
 // ListCommittees retrieves the committees for the given state at the given epoch.
+// The epoch defaults to the epoch of the state, and committees can be filtered by
+// slot and committee index. A request field is set when it is not zero, or when its
+// query parameter is passed in the request metadata, so that zero values can be requested.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListCommittees")
+	defer span.End()
+
+	st, err := bs.stateFromStateID(ctx, req.StateId)
+	if err != nil {
+		return nil, stateError(err)
+	}
+	epoch := helpers.CurrentEpoch(st)
+	if isSet(ctx, "epoch", req.Epoch) {
+		epoch = req.Epoch
+	}
+	filterSlot := isSet(ctx, "slot", req.Slot)
+	filterIndex := isSet(ctx, "index", req.Index)
+	if epoch > helpers.NextEpoch(st) {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot compute committees of epoch %d from a state at epoch %d", epoch, helpers.CurrentEpoch(st))
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+
+	committees := make([]*ethpb.Committee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if filterSlot && slot != req.Slot {
+			continue
+		}
+		for index := uint64(0); index < committeesPerSlot; index++ {
+			if filterIndex && index != req.Index {
+				continue
+			}
+			committee, err := helpers.BeaconCommitteeFromState(st, slot, index)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get committee %d at slot %d: %v", index, slot, err)
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      index,
+				Slot:       slot,
+				Validators: committee,
+			})
+		}
+	}
+
+	return &ethpb.StateCommitteesResponse{
+		Data: committees,
+	}, nil
+}
+
+// validatorIndices resolves validator IDs, each being either a public key or a decimal
+// validator index, to indices of validators in the state. IDs of validators which are not
+// in the state are skipped. All validator indices are returned if no ID is requested.
+func validatorIndices(st *state.BeaconState, ids [][]byte) ([]uint64, error) {
+	numVals := uint64(st.NumValidators())
+	if len(ids) == 0 {
+		indices := make([]uint64, numVals)
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+		return indices, nil
+	}
+
+	indices := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		pubkey := id
+		if strings.HasPrefix(string(id), "0x") {
+			decoded, err := hexutil.Decode(string(id))
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Could not decode validator public key %s: %v", id, err)
+			}
+			pubkey = decoded
+		}
+		if len(pubkey) == params.BeaconConfig().BLSPubkeyLength {
+			if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey)); ok {
+				indices = append(indices, idx)
+			}
+			continue
+		}
+		idx, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator ID %s", id)
+		}
+		if idx < numVals {
+			indices = append(indices, idx)
+		}
+	}
+	return indices, nil
+}
+
+// validatorContainers returns the validators at the given indices of the state, along with
+// their balance and status at the epoch of the state.
+func validatorContainers(st *state.BeaconState, indices []uint64) ([]*ethpb.ValidatorContainer, error) {
+	epoch := helpers.CurrentEpoch(st)
+	containers := make([]*ethpb.ValidatorContainer, len(indices))
+	for i, idx := range indices {
+		val, err := st.ValidatorAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", idx, err)
+		}
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", idx, err)
+		}
+		containers[i] = &ethpb.ValidatorContainer{
+			Index:     idx,
+			Balance:   balance,
+			Status:    validatorStatus(val, epoch),
+			Validator: migration.V1Alpha1ValidatorToV1(val),
+		}
+	}
+	return containers, nil
+}
+
+// Validator statuses of the standard beacon node API. Statuses can be filtered on, as can their
+// groups, the part of the status before the underscore.
+const (
+	statusPendingInitialized = "pending_initialized"
+	statusPendingQueued      = "pending_queued"
+	statusActiveOngoing      = "active_ongoing"
+	statusActiveExiting      = "active_exiting"
+	statusActiveSlashed      = "active_slashed"
+	statusExitedUnslashed    = "exited_unslashed"
+	statusExitedSlashed      = "exited_slashed"
+	statusWithdrawalPossible = "withdrawal_possible"
+	statusWithdrawalDone     = "withdrawal_done"
+)
+
+// isValidatorStatusFilter returns whether the filter is a validator status or a group of statuses.
+func isValidatorStatusFilter(filter string) bool {
+	switch filter {
+	case statusPendingInitialized, statusPendingQueued, statusActiveOngoing, statusActiveExiting,
+		statusActiveSlashed, statusExitedUnslashed, statusExitedSlashed, statusWithdrawalPossible,
+		statusWithdrawalDone, "pending", "active", "exited", "withdrawal":
+		return true
+	default:
+		return false
+	}
+}
+
+// validatorStatus returns the status of a validator at the given epoch, as defined by the
+// standard beacon node API.
+func validatorStatus(val *ethpb_alpha.Validator, epoch uint64) string {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case val.ActivationEpoch > epoch:
+		if val.ActivationEligibilityEpoch == farFutureEpoch {
+			return statusPendingInitialized
+		}
+		return statusPendingQueued
+	case epoch < val.ExitEpoch:
+		if val.ExitEpoch == farFutureEpoch {
+			return statusActiveOngoing
+		}
+		if val.Slashed {
+			return statusActiveSlashed
+		}
+		return statusActiveExiting
+	case epoch < val.WithdrawableEpoch:
+		if val.Slashed {
+			return statusExitedSlashed
+		}
+		return statusExitedUnslashed
+	default:
+		if val.EffectiveBalance != 0 {
+			return statusWithdrawalPossible
+		}
+		return statusWithdrawalDone
+	}
+}
+
+// isSet returns whether the request field of the query parameter is set, either to a value which
+// is not zero or as passed in the request metadata.
+func isSet(ctx context.Context, param string, value uint64) bool {
+	return value != 0 || grpcutils.IsQueryParamSet(ctx, param)
 }
@@ -0,0 +1,313 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetValidator(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	t.Run("By index", func(t *testing.T) {
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("15"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(15), resp.Data.Index)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, resp.Data.Balance)
+		assert.Equal(t, statusActiveOngoing, resp.Data.Status)
+		pubkey := st.PubkeyAtIndex(15)
+		assert.DeepEqual(t, pubkey[:], resp.Data.Validator.PublicKey)
+	})
+
+	t.Run("By public key", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(20)
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: pubkey[:],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(20), resp.Data.Index)
+
+		resp, err = s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte(fmt.Sprintf("%#x", pubkey)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(20), resp.Data.Index)
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("32"),
+		})
+		assert.ErrorContains(t, "Could not find validator", err)
+	})
+
+	t.Run("No validator ID", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head")})
+		assert.ErrorContains(t, "Validator ID is required", err)
+	})
+}
+
+func TestListValidators(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, 32, len(resp.Data))
+		for i, v := range resp.Data {
+			assert.Equal(t, uint64(i), v.Index)
+		}
+	})
+
+	t.Run("By ID", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(7)
+		resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Id:      [][]byte{[]byte("2"), pubkey[:], []byte("100")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, uint64(2), resp.Data[0].Index)
+		assert.Equal(t, uint64(7), resp.Data[1].Index)
+	})
+
+	t.Run("By status", func(t *testing.T) {
+		resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  statusPendingQueued,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, uint64(3), resp.Data[0].Index)
+
+		resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  "active",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 31, len(resp.Data))
+
+		resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  "pending,active_ongoing",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 32, len(resp.Data))
+
+		_, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  "foo",
+		})
+		assert.ErrorContains(t, "Invalid validator status", err)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Id:      [][]byte{[]byte("foo")},
+		})
+		assert.ErrorContains(t, "Invalid validator ID", err)
+	})
+}
+
+func TestListValidatorBalances(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	balances := make([]uint64, 32)
+	for i := range balances {
+		balances[i] = uint64(i) * 1000
+	}
+	require.NoError(t, st.SetBalances(balances))
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	resp, err := s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, 32, len(resp.Data))
+	for i, b := range resp.Data {
+		assert.Equal(t, uint64(i), b.Index)
+		assert.Equal(t, balances[i], b.Balance)
+	}
+
+	resp, err = s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{
+		StateId: []byte("head"),
+		Id:      []string{strconv.Itoa(10)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(10000), resp.Data[0].Balance)
+}
+
+func TestListCommittees(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 128)
+	s := Server{
+		ChainInfoFetcher: &chainMock.ChainService{State: st},
+	}
+
+	resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	activeCount, err := helpers.ActiveValidatorCount(st, 0)
+	require.NoError(t, err)
+	committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+	assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch*committeesPerSlot), len(resp.Data))
+	seen := 0
+	for _, c := range resp.Data {
+		committee, err := helpers.BeaconCommitteeFromState(st, c.Slot, c.Index)
+		require.NoError(t, err)
+		assert.DeepEqual(t, committee, c.Validators)
+		seen += len(c.Validators)
+	}
+	assert.Equal(t, 128, seen)
+
+	resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+		StateId: []byte("head"),
+		Slot:    4,
+	})
+	require.NoError(t, err)
+	for _, c := range resp.Data {
+		assert.Equal(t, uint64(4), c.Slot)
+	}
+
+	// Zero values are filters when their query parameters are set.
+	zeroCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+		grpcutils.QueryParamsMetadataKey, "slot",
+		grpcutils.QueryParamsMetadataKey, "index",
+	))
+	resp, err = s.ListCommittees(zeroCtx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(0), resp.Data[0].Slot)
+	assert.Equal(t, uint64(0), resp.Data[0].Index)
+
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+		StateId: []byte("head"),
+		Epoch:   10,
+	})
+	assert.ErrorContains(t, "Cannot compute committees", err)
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		name      string
+		validator *ethpb_alpha.Validator
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: farFuture,
+				ActivationEpoch:            farFuture,
+			},
+			want: statusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: 5,
+				ActivationEpoch:            farFuture,
+			},
+			want: statusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       farFuture,
+			},
+			want: statusActiveOngoing,
+		},
+		{
+			name: "active exiting",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       20,
+			},
+			want: statusActiveExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       20,
+				Slashed:         true,
+			},
+			want: statusActiveSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 20,
+			},
+			want: statusExitedUnslashed,
+		},
+		{
+			name: "exited slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 20,
+				Slashed:           true,
+			},
+			want: statusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         2,
+				WithdrawableEpoch: 3,
+				EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+			},
+			want: statusWithdrawalPossible,
+		},
+		{
+			name: "withdrawal done",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         2,
+				WithdrawableEpoch: 3,
+			},
+			want: statusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorStatus(tt.validator, 10))
+		})
+	}
+}
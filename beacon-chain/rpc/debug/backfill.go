@@ -0,0 +1,56 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBackfillStatus reports how far the blocks preceding the origin block of the node have
+// been backfilled. A node which synced from genesis has nothing to backfill and is reported
+// as complete.
+func (ds *Server) GetBackfillStatus(ctx context.Context, _ *ptypes.Empty) (*pbrpc.BackfillStatusResponse, error) {
+	originRoot, err := ds.BeaconDB.OriginBlockRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve origin block root: %v", err)
+	}
+	if originRoot == params.BeaconConfig().ZeroHash {
+		return &pbrpc.BackfillStatusResponse{Complete: true}, nil
+	}
+	originBlock, err := ds.BeaconDB.Block(ctx, originRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve origin block: %v", err)
+	}
+	if originBlock == nil {
+		return nil, status.Errorf(codes.NotFound, "Origin block %#x not found", originRoot)
+	}
+
+	lowestRoot, err := ds.BeaconDB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve backfill block root: %v", err)
+	}
+	lowestBlock := originBlock
+	if lowestRoot == params.BeaconConfig().ZeroHash {
+		lowestRoot = originRoot
+	} else {
+		lowestBlock, err = ds.BeaconDB.Block(ctx, lowestRoot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve lowest backfilled block: %v", err)
+		}
+		if lowestBlock == nil {
+			return nil, status.Errorf(codes.NotFound, "Lowest backfilled block %#x not found", lowestRoot)
+		}
+	}
+
+	return &pbrpc.BackfillStatusResponse{
+		OriginSlot: originBlock.Block.Slot,
+		OriginRoot: originRoot[:],
+		LowestSlot: lowestBlock.Block.Slot,
+		LowestRoot: lowestRoot[:],
+		Complete:   lowestBlock.Block.Slot == 0,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBackfillStatus_NoOrigin(t *testing.T) {
+	ds := &Server{BeaconDB: dbTest.SetupDB(t)}
+	res, err := ds.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.BackfillStatusResponse{Complete: true}, res)
+}
+
+func TestServer_GetBackfillStatus(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	origin := testutil.NewBeaconBlock()
+	origin.Block.Slot = 640
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, origin))
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+
+	ds := &Server{BeaconDB: db}
+	res, err := ds.GetBackfillStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.BackfillStatusResponse{
+		OriginSlot: 640,
+		OriginRoot: originRoot[:],
+		LowestSlot: 640,
+		LowestRoot: originRoot[:],
+	}, res)
+
+	lowest := testutil.NewBeaconBlock()
+	lowest.Block.Slot = 320
+	lowestRoot, err := lowest.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, lowest))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, lowestRoot))
+
+	res, err = ds.GetBackfillStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(320), res.LowestSlot)
+	assert.DeepEqual(t, lowestRoot[:], res.LowestRoot)
+	assert.Equal(t, false, res.Complete)
+}
@@ -13,6 +13,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// defaultPeerTrafficLimit is the number of peers returned by ListPeerTraffic when no limit is requested.
+	defaultPeerTrafficLimit = 10
+	// maxPeerTrafficLimit is the maximum number of peers returned by ListPeerTraffic.
+	maxPeerTrafficLimit = 1000
+)
+
 // GetPeer returns the data known about the peer defined by the provided peer id.
 func (ds *Server) GetPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*pbrpc.DebugPeerResponse, error) {
 	pid, err := peer.Decode(peerReq.PeerId)
@@ -137,6 +144,10 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		BehaviourPenalty:   float32(bPenalty),
 		ValidationError:    errorToString(peers.Scorers().ValidationError(pid)),
 	}
+	var traffic *pbrpc.PeerTraffic
+	if ds.TrafficProvider != nil {
+		traffic = peerTraffic(ds.TrafficProvider.PeerTraffic(pid))
+	}
 	return &pbrpc.DebugPeerResponse{
 		ListeningAddresses: stringAddrs,
 		Direction:          pbDirection,
@@ -147,9 +158,52 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
 		ScoreInfo:          scoreInfo,
+		Traffic:            traffic,
 	}, nil
 }
 
+// ListPeerTraffic returns the traffic of the peers which exchanged the most bytes with the node.
+func (ds *Server) ListPeerTraffic(_ context.Context, req *pbrpc.PeerTrafficRequest) (*pbrpc.PeerTrafficResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPeerTrafficLimit
+	}
+	if limit > maxPeerTrafficLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested limit %d is greater than the maximum %d", limit, maxPeerTrafficLimit)
+	}
+	resp := &pbrpc.PeerTrafficResponse{}
+	for _, traffic := range ds.TrafficProvider.TopPeersByTraffic(int(limit)) {
+		resp.Peers = append(resp.Peers, peerTraffic(traffic))
+	}
+	return resp, nil
+}
+
+func peerTraffic(traffic *p2p.PeerTraffic) *pbrpc.PeerTraffic {
+	convert := func(traffic p2p.Traffic) *pbrpc.Traffic {
+		return &pbrpc.Traffic{
+			BytesIn:     traffic.BytesIn,
+			BytesOut:    traffic.BytesOut,
+			MessagesIn:  traffic.MessagesIn,
+			MessagesOut: traffic.MessagesOut,
+		}
+	}
+	resp := &pbrpc.PeerTraffic{
+		PeerId:    traffic.ID.String(),
+		Total:     convert(traffic.Total),
+		RateIn:    traffic.RateIn,
+		RateOut:   traffic.RateOut,
+		Topics:    make(map[string]*pbrpc.Traffic, len(traffic.Topics)),
+		Protocols: make(map[string]*pbrpc.Traffic, len(traffic.Protocols)),
+	}
+	for topic, topicTraffic := range traffic.Topics {
+		resp.Topics[topic] = convert(topicTraffic)
+	}
+	for proto, protoTraffic := range traffic.Protocols {
+		resp.Protocols[proto] = convert(protoTraffic)
+	}
+	return resp
+}
+
 func errorToString(err error) string {
 	if err == nil {
 		return ""
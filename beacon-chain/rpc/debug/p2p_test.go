@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -49,3 +52,62 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+type mockTrafficProvider struct {
+	traffic []*p2p.PeerTraffic
+}
+
+func (m *mockTrafficProvider) PeerTraffic(pid peer.ID) *p2p.PeerTraffic {
+	for _, traffic := range m.traffic {
+		if traffic.ID == pid {
+			return traffic
+		}
+	}
+	return &p2p.PeerTraffic{ID: pid}
+}
+
+func (m *mockTrafficProvider) TopPeersByTraffic(n int) []*p2p.PeerTraffic {
+	if len(m.traffic) > n {
+		return m.traffic[:n]
+	}
+	return m.traffic
+}
+
+func TestDebugServer_PeerTraffic(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	firstPeer := peersProvider.Peers().All()[0]
+	secondPeer := peersProvider.Peers().All()[1]
+	topic := "/eth2/abcd1234/beacon_block/ssz_snappy"
+	trafficProvider := &mockTrafficProvider{traffic: []*p2p.PeerTraffic{
+		{
+			ID:     firstPeer,
+			Total:  p2p.Traffic{BytesIn: 2048, BytesOut: 1024, MessagesIn: 3},
+			Topics: map[string]p2p.Traffic{topic: {BytesIn: 1024, MessagesIn: 3}},
+		},
+		{ID: secondPeer, Total: p2p.Traffic{BytesIn: 1024}},
+	}}
+	ds := &Server{
+		PeersFetcher:    peersProvider,
+		PeerManager:     &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+		TrafficProvider: trafficProvider,
+	}
+
+	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res.Traffic)
+	assert.Equal(t, uint64(2048), res.Traffic.Total.BytesIn)
+	assert.Equal(t, uint64(3), res.Traffic.Topics[topic].MessagesIn)
+
+	top, err := ds.ListPeerTraffic(context.Background(), &pbrpc.PeerTrafficRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(top.Peers))
+	assert.Equal(t, firstPeer.String(), top.Peers[0].PeerId)
+
+	top, err = ds.ListPeerTraffic(context.Background(), &pbrpc.PeerTrafficRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(top.Peers))
+
+	_, err = ds.ListPeerTraffic(context.Background(), &pbrpc.PeerTrafficRequest{Limit: maxPeerTrafficLimit + 1})
+	assert.ErrorContains(t, "greater than the maximum", err)
+}
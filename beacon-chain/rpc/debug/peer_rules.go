@@ -0,0 +1,61 @@
+package debug
+
+import (
+	"context"
+	"errors"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddPeerRule trusts or bans peers at runtime.
+func (ds *Server) AddPeerRule(_ context.Context, req *pbrpc.PeerRule) (*ptypes.Empty, error) {
+	kind, err := peerRuleKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.PeerRulesManager.AddPeerRule(kind, req.Peer); err != nil {
+		if errors.Is(err, p2p.ErrInvalidPeerRule) {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not add peer rule: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not add peer rule: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// RemovePeerRule removes a rule previously added with AddPeerRule.
+func (ds *Server) RemovePeerRule(_ context.Context, req *pbrpc.PeerRule) (*ptypes.Empty, error) {
+	kind, err := peerRuleKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.PeerRulesManager.RemovePeerRule(kind, req.Peer); err != nil {
+		if errors.Is(err, p2p.ErrPeerRuleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Could not remove peer rule: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not remove peer rule: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// ListPeerRules returns the peers trusted and banned at runtime.
+func (ds *Server) ListPeerRules(_ context.Context, _ *ptypes.Empty) (*pbrpc.PeerRulesResponse, error) {
+	return &pbrpc.PeerRulesResponse{
+		Trusted: ds.PeerRulesManager.PeerRules(p2p.TrustedPeerRule),
+		Banned:  ds.PeerRulesManager.PeerRules(p2p.BannedPeerRule),
+	}, nil
+}
+
+func peerRuleKind(kind pbrpc.PeerRule_Kind) (p2p.PeerRuleKind, error) {
+	switch kind {
+	case pbrpc.PeerRule_TRUSTED:
+		return p2p.TrustedPeerRule, nil
+	case pbrpc.PeerRule_BANNED:
+		return p2p.BannedPeerRule, nil
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "Unknown peer rule kind %v", kind)
+	}
+}
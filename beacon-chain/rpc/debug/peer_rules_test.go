@@ -0,0 +1,83 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPeerRulesManager struct {
+	rules  map[p2p.PeerRuleKind][]string
+	addErr error
+}
+
+func (m *mockPeerRulesManager) AddPeerRule(kind p2p.PeerRuleKind, rule string) error {
+	if m.addErr != nil {
+		return m.addErr
+	}
+	m.rules[kind] = append(m.rules[kind], rule)
+	return nil
+}
+
+func (m *mockPeerRulesManager) RemovePeerRule(kind p2p.PeerRuleKind, rule string) error {
+	for i, r := range m.rules[kind] {
+		if r == rule {
+			m.rules[kind] = append(m.rules[kind][:i], m.rules[kind][i+1:]...)
+			return nil
+		}
+	}
+	return p2p.ErrPeerRuleNotFound
+}
+
+func (m *mockPeerRulesManager) PeerRules(kind p2p.PeerRuleKind) []string {
+	return m.rules[kind]
+}
+
+func TestServer_PeerRules(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{
+		PeerRulesManager: &mockPeerRulesManager{rules: make(map[p2p.PeerRuleKind][]string)},
+	}
+	_, err := ds.AddPeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_TRUSTED, Peer: "10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = ds.AddPeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_BANNED, Peer: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"})
+	require.NoError(t, err)
+	_, err = ds.AddPeerRule(ctx, &pbrpc.PeerRule{Kind: 5, Peer: "10.0.0.0/8"})
+	assert.ErrorContains(t, "Unknown peer rule kind", err)
+
+	res, err := ds.ListPeerRules(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"10.0.0.0/8"}, res.Trusted)
+	assert.DeepEqual(t, []string{"16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"}, res.Banned)
+
+	_, err = ds.RemovePeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_BANNED, Peer: "10.0.0.0/8"})
+	assert.ErrorContains(t, "peer rule not found", err)
+	_, err = ds.RemovePeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_TRUSTED, Peer: "10.0.0.0/8"})
+	require.NoError(t, err)
+	res, err = ds.ListPeerRules(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Trusted))
+}
+
+func TestServer_AddPeerRule_ErrorCodes(t *testing.T) {
+	ctx := context.Background()
+	manager := &mockPeerRulesManager{rules: make(map[p2p.PeerRuleKind][]string)}
+	ds := &Server{PeerRulesManager: manager}
+
+	manager.addErr = fmt.Errorf("%w: bad rule", p2p.ErrInvalidPeerRule)
+	_, err := ds.AddPeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_BANNED, Peer: "10.0.0.0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	manager.addErr = errors.New("could not write peer rules")
+	_, err = ds.AddPeerRule(ctx, &pbrpc.PeerRule{Kind: pbrpc.PeerRule_BANNED, Peer: "10.0.0.0/8"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -0,0 +1,33 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListReorgs returns the chain reorgs recently seen by the beacon node, the most recent first.
+func (ds *Server) ListReorgs(_ context.Context, _ *ptypes.Empty) (*pbrpc.ReorgsResponse, error) {
+	if ds.ReorgFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Reorgs are not tracked by the node")
+	}
+	recent := ds.ReorgFetcher.RecentReorgs()
+	reorgs := make([]*pbrpc.Reorg, len(recent))
+	for i, r := range recent {
+		// Copy the roots as they are held by the reorg events.
+		ancestorRoot, oldRoot, newRoot := r.CommonAncestorRoot, r.OldHeadRoot, r.NewHeadRoot
+		reorgs[i] = &pbrpc.Reorg{
+			OldSlot:            r.OldSlot,
+			NewSlot:            r.NewSlot,
+			Depth:              r.Depth,
+			Distance:           r.Distance,
+			CommonAncestorRoot: ancestorRoot[:],
+			OldHeadRoot:        oldRoot[:],
+			NewHeadRoot:        newRoot[:],
+		}
+	}
+	return &pbrpc.ReorgsResponse{Reorgs: reorgs}, nil
+}
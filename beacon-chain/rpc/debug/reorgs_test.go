@@ -0,0 +1,52 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListReorgs(t *testing.T) {
+	ds := &Server{
+		ReorgFetcher: &mock.ChainService{Reorgs: []*statefeed.ReorgData{
+			{
+				NewSlot:            12,
+				OldSlot:            11,
+				Depth:              2,
+				Distance:           3,
+				CommonAncestorRoot: [32]byte{'a'},
+				OldHeadRoot:        [32]byte{'b'},
+				NewHeadRoot:        [32]byte{'c'},
+			},
+			{NewSlot: 5, OldSlot: 5},
+		}},
+	}
+	res, err := ds.ListReorgs(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Reorgs))
+	ancestorRoot, oldRoot, newRoot := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	assert.DeepEqual(t, &pbrpc.Reorg{
+		OldSlot:            11,
+		NewSlot:            12,
+		Depth:              2,
+		Distance:           3,
+		CommonAncestorRoot: ancestorRoot[:],
+		OldHeadRoot:        oldRoot[:],
+		NewHeadRoot:        newRoot[:],
+	}, res.Reorgs[0])
+	assert.Equal(t, uint64(5), res.Reorgs[1].NewSlot)
+	assert.Equal(t, uint64(0), res.Reorgs[1].Depth)
+}
+
+func TestServer_ListReorgs_NoReorgs(t *testing.T) {
+	ds := &Server{ReorgFetcher: &mock.ChainService{}}
+	res, err := ds.ListReorgs(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Reorgs))
+}
@@ -30,6 +30,9 @@ type Server struct {
 	HeadFetcher        blockchain.HeadFetcher
 	PeerManager        p2p.PeerManager
 	PeersFetcher       p2p.PeersProvider
+	PeerRulesManager   p2p.PeerRulesManager
+	TrafficProvider    p2p.TrafficProvider
+	ReorgFetcher       blockchain.ReorgFetcher
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -2,7 +2,9 @@ package debug
 
 import (
 	"context"
+	"errors"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"google.golang.org/grpc/codes"
@@ -29,6 +31,9 @@ func (ds *Server) GetBeaconState(
 		}
 
 		st, err := ds.StateGen.StateBySlot(ctx, q.Slot)
+		if errors.Is(err, stategen.ErrReplayBudgetExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "Could not compute state by slot: %v", err)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
 		}
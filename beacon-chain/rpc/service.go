@@ -22,6 +22,7 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -63,6 +64,7 @@ type Service struct {
 	finalizationFetcher     blockchain.FinalizationFetcher
 	timeFetcher             blockchain.TimeFetcher
 	genesisFetcher          blockchain.GenesisFetcher
+	reorgFetcher            blockchain.ReorgFetcher
 	attestationReceiver     blockchain.AttestationReceiver
 	blockReceiver           blockchain.BlockReceiver
 	powChainService         powchain.Chain
@@ -87,6 +89,8 @@ type Service struct {
 	p2p                     p2p.Broadcaster
 	peersFetcher            p2p.PeersProvider
 	peerManager             p2p.PeerManager
+	peerRulesManager        p2p.PeerRulesManager
+	trafficProvider         p2p.TrafficProvider
 	metadataProvider        p2p.MetadataProvider
 	depositFetcher          depositcache.DepositFetcher
 	pendingDepositFetcher   depositcache.PendingDepositsFetcher
@@ -94,6 +98,7 @@ type Service struct {
 	blockNotifier           blockfeed.Notifier
 	operationNotifier       opfeed.Notifier
 	stateGen                *stategen.State
+	attestationTracker      *monitor.Service
 	connectedRPCClients     map[net.Addr]bool
 	clientConnectionLock    sync.Mutex
 	maxMsgSize              int
@@ -119,6 +124,7 @@ type Config struct {
 	ChainStartFetcher       powchain.ChainStartFetcher
 	GenesisTimeFetcher      blockchain.TimeFetcher
 	GenesisFetcher          blockchain.GenesisFetcher
+	ReorgFetcher            blockchain.ReorgFetcher
 	EnableDebugRPCEndpoints bool
 	MockEth1Votes           bool
 	AttestationsPool        attestations.Pool
@@ -128,6 +134,8 @@ type Config struct {
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	PeerRulesManager        p2p.PeerRulesManager
+	TrafficProvider         p2p.TrafficProvider
 	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
@@ -135,6 +143,7 @@ type Config struct {
 	BlockNotifier           blockfeed.Notifier
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
+	AttestationTracker      *monitor.Service
 	MaxMsgSize              int
 }
 
@@ -153,11 +162,14 @@ func New(ctx context.Context, cfg *Config) *Service {
 		canonicalFetcher:        cfg.CanonicalFetcher,
 		timeFetcher:             cfg.GenesisTimeFetcher,
 		genesisFetcher:          cfg.GenesisFetcher,
+		reorgFetcher:            cfg.ReorgFetcher,
 		attestationReceiver:     cfg.AttestationReceiver,
 		blockReceiver:           cfg.BlockReceiver,
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
+		peerRulesManager:        cfg.PeerRulesManager,
+		trafficProvider:         cfg.TrafficProvider,
 		metadataProvider:        cfg.MetadataProvider,
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
@@ -180,6 +192,7 @@ func New(ctx context.Context, cfg *Config) *Service {
 		blockNotifier:           cfg.BlockNotifier,
 		operationNotifier:       cfg.OperationNotifier,
 		stateGen:                cfg.StateGen,
+		attestationTracker:      cfg.AttestationTracker,
 		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
 		connectedRPCClients:     make(map[net.Addr]bool),
 		maxMsgSize:              cfg.MaxMsgSize,
@@ -301,6 +314,7 @@ func (s *Service) Start() {
 		Broadcaster:                 s.p2p,
 		StateGen:                    s.stateGen,
 		SyncChecker:                 s.syncService,
+		AttestationTracker:          s.attestationTracker,
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
@@ -309,6 +323,7 @@ func (s *Service) Start() {
 		BeaconDB:            s.beaconDB,
 		AttestationsPool:    s.attestationsPool,
 		SlashingsPool:       s.slashingsPool,
+		ExitPool:            s.exitPool,
 		ChainInfoFetcher:    s.chainInfoFetcher,
 		ChainStartFetcher:   s.chainStartFetcher,
 		DepositFetcher:      s.depositFetcher,
@@ -321,12 +336,19 @@ func (s *Service) Start() {
 		Broadcaster:         s.p2p,
 		StateGen:            s.stateGen,
 		SyncChecker:         s.syncService,
+
+		V1Alpha1ValidatorServer: validatorServer,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
+	pbrpc.RegisterEventsServer(s.grpcServer, beaconChainServerV1)
+	pbrpc.RegisterBeaconValidatorServer(s.grpcServer, beaconChainServerV1)
+	pbrpc.RegisterValidatorHistoryServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterValidatorRewardsServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterAttestationTrackerServer(s.grpcServer, beaconChainServer)
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
@@ -336,6 +358,9 @@ func (s *Service) Start() {
 			HeadFetcher:        s.headFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+			PeerRulesManager:   s.peerRulesManager,
+			TrafficProvider:    s.trafficProvider,
+			ReorgFetcher:       s.reorgFetcher,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
@@ -0,0 +1,28 @@
+package stategen
+
+import "context"
+
+type callerKey struct{}
+
+// WithCaller tags the context with the name of the caller requesting states, so that the state
+// cache hit ratio and the replay durations are reported per caller. Requests from untagged
+// contexts are reported under the name of the state getter.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// withDefaultCaller tags the context with the caller, unless it is already tagged.
+func withDefaultCaller(ctx context.Context, caller string) context.Context {
+	if _, ok := ctx.Value(callerKey{}).(string); ok {
+		return ctx
+	}
+	return WithCaller(ctx, caller)
+}
+
+// callerFromContext returns the caller the context is tagged with.
+func callerFromContext(ctx context.Context) string {
+	if caller, ok := ctx.Value(callerKey{}).(string); ok {
+		return caller
+	}
+	return "unknown"
+}
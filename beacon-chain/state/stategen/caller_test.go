@@ -0,0 +1,17 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestCallerFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "unknown", callerFromContext(ctx))
+	assert.Equal(t, "state_by_root", callerFromContext(withDefaultCaller(ctx, "state_by_root")))
+
+	ctx = WithCaller(ctx, "block_validation")
+	assert.Equal(t, "block_validation", callerFromContext(withDefaultCaller(ctx, "state_by_root")))
+}
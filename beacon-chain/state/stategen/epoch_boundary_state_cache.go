@@ -5,14 +5,24 @@ import (
 	"strconv"
 	"sync"
 
+	stateCache "github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"k8s.io/client-go/tools/cache"
 )
 
+const (
+	// epochBoundaryStateCacheName identifies the epoch boundary state cache in the state cache manager.
+	epochBoundaryStateCacheName = "epoch_boundary"
+	// epochBoundaryStateCost is the relative cost of regenerating an epoch boundary state. Hot
+	// states are replayed from epoch boundary states, losing one means replaying a whole epoch
+	// or more for each of them.
+	epochBoundaryStateCost = 4
+)
+
 var (
-	// maxCacheSize is 8. That means 8 epochs and roughly an hour
-	// of no finality can be endured.
-	maxCacheSize        = uint64(8)
+	// maxCacheSize is 256. That means 256 epochs and more than a day of no finality can be endured,
+	// provided the states fit in the memory budget of the state cache manager.
+	maxCacheSize        = uint64(256)
 	errNotSlotRootInfo  = errors.New("not slot root info type")
 	errNotRootStateInfo = errors.New("not root state info type")
 )
@@ -54,6 +64,7 @@ type epochBoundaryState struct {
 	rootStateCache *cache.FIFO
 	slotRootCache  *cache.FIFO
 	lock           sync.RWMutex
+	manager        *stateCache.StateCacheHandle
 }
 
 // newBoundaryStateCache creates a new block newBoundaryStateCache for storing and accessing epoch boundary states from
@@ -62,6 +73,7 @@ func newBoundaryStateCache() *epochBoundaryState {
 	return &epochBoundaryState{
 		rootStateCache: cache.NewFIFO(rootKeyFn),
 		slotRootCache:  cache.NewFIFO(slotKeyFn),
+		manager:        stateCache.StateCaches.Handle(epochBoundaryStateCacheName),
 	}
 }
 
@@ -75,12 +87,14 @@ func (e *epochBoundaryState) getByRoot(r [32]byte) (*rootStateInfo, bool, error)
 		return nil, false, err
 	}
 	if !exists {
+		e.manager.Miss()
 		return nil, false, nil
 	}
 	s, ok := obj.(*rootStateInfo)
 	if !ok {
 		return nil, false, errNotRootStateInfo
 	}
+	e.manager.Hit(string(r[:]))
 
 	return &rootStateInfo{
 		root:  r,
@@ -108,44 +122,95 @@ func (e *epochBoundaryState) getBySlot(s uint64) (*rootStateInfo, bool, error) {
 	return e.getByRoot(info.root)
 }
 
+// hasSlot returns true if the cache holds a state of the slot. Unlike getBySlot, it neither copies
+// the state nor counts as a hit.
+func (e *epochBoundaryState) hasSlot(s uint64) bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	_, exists, err := e.slotRootCache.GetByKey(slotToString(s))
+	return err == nil && exists
+}
+
 // put adds a state to the epoch boundary state cache. This method also trims the
 // least recently added state info if the cache size has reached the max cache
-// size limit.
+// size limit, and registers the state with the state cache manager which may evict
+// it to stay within its budget.
 func (e *epochBoundaryState) put(r [32]byte, s *stateTrie.BeaconState) error {
 	e.lock.Lock()
-	defer e.lock.Unlock()
-
 	if err := e.slotRootCache.AddIfNotPresent(&slotRootInfo{
 		slot: s.Slot(),
 		root: r,
 	}); err != nil {
+		e.lock.Unlock()
 		return err
 	}
+	copied := s.Copy()
 	if err := e.rootStateCache.AddIfNotPresent(&rootStateInfo{
 		root:  r,
-		state: s.Copy(),
+		state: copied,
 	}); err != nil {
+		e.lock.Unlock()
 		return err
 	}
 
-	trim(e.rootStateCache, maxCacheSize)
+	trimmed := trim(e.rootStateCache, maxCacheSize)
 	trim(e.slotRootCache, maxCacheSize)
+	for _, obj := range trimmed {
+		if info, ok := obj.(*rootStateInfo); ok {
+			e.manager.Remove(string(info.root[:]))
+		}
+	}
+	evicted := e.manager.Add(string(r[:]), copied, epochBoundaryStateCost, func() {
+		if err := e.delete(r); err != nil {
+			log.WithError(err).Error("Could not evict epoch boundary state")
+		}
+	})
+	e.lock.Unlock()
+	stateCache.RunEvictions(evicted)
+	return nil
+}
+
+// delete removes the state of the block root from the cache.
+func (e *epochBoundaryState) delete(r [32]byte) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
 
+	obj, exists, err := e.rootStateCache.GetByKey(string(r[:]))
+	if err != nil || !exists {
+		return err
+	}
+	info, ok := obj.(*rootStateInfo)
+	if !ok {
+		return errNotRootStateInfo
+	}
+	if err := e.rootStateCache.Delete(info); err != nil {
+		return err
+	}
+	slotInfo := &slotRootInfo{slot: info.state.Slot(), root: r}
+	obj, exists, err = e.slotRootCache.Get(slotInfo)
+	if err != nil || !exists {
+		return err
+	}
+	// The slot may have been cached again for another root.
+	if stored, ok := obj.(*slotRootInfo); ok && stored.root == r {
+		return e.slotRootCache.Delete(slotInfo)
+	}
 	return nil
 }
 
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize uint64) {
+// trim the FIFO queue to the maxSize, returning the removed objects.
+func trim(queue *cache.FIFO, maxSize uint64) []interface{} {
+	var trimmed []interface{}
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
-		if _, err := queue.Pop(popProcessNoopFunc); err != nil { // This never returns an error, but we'll handle anyway for sanity.
+		if _, err := queue.Pop(func(obj interface{}) error {
+			trimmed = append(trimmed, obj)
+			return nil
+		}); err != nil { // This never returns an error, but we'll handle anyway for sanity.
 			panic(err)
 		}
 	}
-}
-
-// popProcessNoopFunc is a no-op function that never returns an error.
-func popProcessNoopFunc(_ interface{}) error {
-	return nil
+	return trimmed
 }
 
 // Converts input uint64 to string. To be used as key for slot to get root.
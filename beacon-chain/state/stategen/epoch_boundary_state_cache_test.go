@@ -1,8 +1,11 @@
 package stategen
 
 import (
+	"math"
 	"testing"
 
+	stateCache "github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -48,11 +51,13 @@ func TestEpochBoundaryStateCache_CanSave(t *testing.T) {
 
 func TestEpochBoundaryStateCache_CanTrim(t *testing.T) {
 	e := newBoundaryStateCache()
+	// Use a manager of its own so the states fit in the budget regardless of the other tests.
+	e.manager = stateCache.NewStateCacheManager(math.MaxUint64).Handle(epochBoundaryStateCacheName)
 	offSet := uint64(10)
 	for i := uint64(0); i < maxCacheSize+offSet; i++ {
 		s := testutil.NewBeaconState()
 		require.NoError(t, s.SetSlot(i))
-		r := [32]byte{byte(i)}
+		r := bytesutil.ToBytes32(bytesutil.Bytes8(i))
 		require.NoError(t, e.put(r, s))
 	}
 
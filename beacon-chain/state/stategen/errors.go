@@ -5,3 +5,7 @@ import "errors"
 var errUnknownBoundaryState = errors.New("unknown boundary state")
 var errUnknownState = errors.New("unknown state")
 var errUnknownBlock = errors.New("unknown block")
+
+// ErrReplayBudgetExceeded is returned when the state of a slot is too far from the states kept by
+// the node to be regenerated within the replay budget.
+var ErrReplayBudgetExceeded = errors.New("replay cost exceeds the replay budget")
@@ -2,6 +2,7 @@ package stategen
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -39,6 +40,7 @@ func (s *State) HasStateInCache(ctx context.Context, blockRoot [32]byte) (bool,
 func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateByRoot")
 	defer span.End()
+	ctx = withDefaultCaller(ctx, "state_by_root")
 
 	// Genesis case. If block root is zero hash, short circuit to use genesis cachedState stored in DB.
 	if blockRoot == params.BeaconConfig().ZeroHash {
@@ -57,11 +59,14 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 	if blockRoot == params.BeaconConfig().ZeroHash {
 		return s.beaconDB.State(ctx, blockRoot)
 	}
+	ctx = withDefaultCaller(ctx, "state_by_root_initial_sync")
+	caller := callerFromContext(ctx)
 
 	// To invalidate cache for parent root because pre state will get mutated.
 	defer s.hotStateCache.delete(blockRoot)
 
 	if s.hotStateCache.has(blockRoot) {
+		stateRequestsCount.WithLabelValues(caller, sourceHotCache).Inc()
 		return s.hotStateCache.getWithoutCopy(blockRoot), nil
 	}
 
@@ -70,6 +75,7 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 		return nil, err
 	}
 	if ok {
+		stateRequestsCount.WithLabelValues(caller, sourceEpochBoundaryCache).Inc()
 		return cachedInfo.state, nil
 	}
 
@@ -92,20 +98,30 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not load blocks")
 	}
+	stateRequestsCount.WithLabelValues(caller, sourceReplay).Inc()
+	start := time.Now()
 	startState, err = s.ReplayBlocks(ctx, startState, blks, summary.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not replay blocks")
 	}
+	replayDuration.WithLabelValues(caller).Observe(time.Since(start).Seconds())
 
 	return startState, nil
 }
 
 // StateBySlot retrieves the state using input slot.
+// States which have to be regenerated by replaying blocks are regenerated by a historical state
+// job, shared with the concurrent requests for the same slot and subject to the replay budget.
 func (s *State) StateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
 	defer span.End()
+	ctx = withDefaultCaller(ctx, "state_by_slot")
 
-	return s.loadStateBySlot(ctx, slot)
+	cost := s.replayCost(slot)
+	if cost == 0 {
+		return s.loadStateBySlot(ctx, slot)
+	}
+	return s.historicalStates.stateBySlot(ctx, slot, cost, s.loadStateBySlot)
 }
 
 // This returns the state summary object of a given block root, it first checks the cache
@@ -145,10 +161,12 @@ func (s *State) RecoverStateSummary(ctx context.Context, blockRoot [32]byte) (*p
 func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.loadStateByRoot")
 	defer span.End()
+	caller := callerFromContext(ctx)
 
 	// First, it checks if the state exists in hot state cache.
 	cachedState := s.hotStateCache.get(blockRoot)
 	if cachedState != nil {
+		stateRequestsCount.WithLabelValues(caller, sourceHotCache).Inc()
 		return cachedState, nil
 	}
 
@@ -158,11 +176,13 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (*state
 		return nil, err
 	}
 	if ok {
+		stateRequestsCount.WithLabelValues(caller, sourceEpochBoundaryCache).Inc()
 		return cachedInfo.state, nil
 	}
 
 	// Short cut if the cachedState is already in the DB.
 	if s.beaconDB.HasState(ctx, blockRoot) {
+		stateRequestsCount.WithLabelValues(caller, sourceDB).Inc()
 		return s.beaconDB.State(ctx, blockRoot)
 	}
 
@@ -193,8 +213,15 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (*state
 	}
 
 	replayBlockCount.Observe(float64(len(blks)))
+	stateRequestsCount.WithLabelValues(caller, sourceReplay).Inc()
 
-	return s.ReplayBlocks(ctx, startState, blks, targetSlot)
+	start := time.Now()
+	replayed, err := s.ReplayBlocks(ctx, startState, blks, targetSlot)
+	if err != nil {
+		return nil, err
+	}
+	replayDuration.WithLabelValues(caller).Observe(time.Since(start).Seconds())
+	return replayed, nil
 }
 
 // This loads a state by slot.
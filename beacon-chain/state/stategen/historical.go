@@ -0,0 +1,248 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// DefaultReplayBudget is the default number of slots which may be replayed at once to regenerate
+// historical states.
+const DefaultReplayBudget = 8192
+
+// replayProgressLogPeriod is how often the progress of a historical state job is logged.
+var replayProgressLogPeriod = 10 * time.Second
+
+type replayProgressKey struct{}
+
+// loadStateFn regenerates the state of a slot.
+type loadStateFn func(ctx context.Context, slot uint64) (*state.BeaconState, error)
+
+// historicalStateJobs runs the replays regenerating historical states. Concurrent requests for the
+// same slot share a single job, and the estimated number of slots replayed by the running jobs is
+// kept within the budget: a request costing more than the budget is rejected, and a job which does
+// not fit next to the running ones is queued until they complete.
+type historicalStateJobs struct {
+	lock     sync.Mutex
+	budget   uint64
+	inFlight uint64
+	released chan struct{}
+	jobs     map[uint64]*historicalStateJob
+}
+
+// historicalStateJob is the regeneration of the state of a slot. It is cancelled once all the
+// requests waiting for it are gone.
+type historicalStateJob struct {
+	slot     uint64
+	cost     uint64
+	replayed uint64
+	waiters  int
+	cancel   context.CancelFunc
+	done     chan struct{}
+	state    *state.BeaconState
+	err      error
+}
+
+func newHistoricalStateJobs(budget uint64) *historicalStateJobs {
+	return &historicalStateJobs{
+		budget:   budget,
+		released: make(chan struct{}),
+		jobs:     make(map[uint64]*historicalStateJob),
+	}
+}
+
+// SetReplayBudget sets the number of slots which may be replayed at once to regenerate historical
+// states. Requests for states further away from the states kept by the node are rejected with
+// ErrReplayBudgetExceeded. A budget of 0 disables the limit.
+func (s *State) SetReplayBudget(budget uint64) {
+	s.historicalStates.setBudget(budget)
+}
+
+// replayCost estimates the number of slots to replay to regenerate the state of the slot, from the
+// closest state kept by the node: the archived point below the slot for finalized slots, and the
+// finalized state or a cached epoch boundary state otherwise. The estimate is an upper bound, the
+// state may be closer to a hot state cached or saved in the DB.
+func (s *State) replayCost(slot uint64) uint64 {
+	if slot == 0 {
+		return 0
+	}
+	s.finalizedInfo.lock.RLock()
+	finalizedSlot := s.finalizedInfo.slot
+	s.finalizedInfo.lock.RUnlock()
+
+	if slot < finalizedSlot {
+		return slot % s.slotsPerArchivedPoint
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	for start := slot - slot%slotsPerEpoch; start > finalizedSlot; start -= slotsPerEpoch {
+		if s.epochBoundaryStateCache.hasSlot(start) {
+			return slot - start
+		}
+	}
+	return slot - finalizedSlot
+}
+
+func (j *historicalStateJobs) setBudget(budget uint64) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.budget = budget
+	j.notifyReleased()
+}
+
+// stateBySlot returns the state of the slot regenerated by a job, joining the job of the slot if
+// one is already running. The request stops waiting for the job when the context is cancelled.
+func (j *historicalStateJobs) stateBySlot(ctx context.Context, slot, cost uint64, load loadStateFn) (*state.BeaconState, error) {
+	j.lock.Lock()
+	if j.budget > 0 && cost > j.budget {
+		budget := j.budget
+		j.lock.Unlock()
+		historicalStateRejectedCount.Inc()
+		return nil, errors.Wrapf(ErrReplayBudgetExceeded, "state of slot %d requires replaying %d slots, budget is %d slots",
+			slot, cost, budget)
+	}
+	job, ok := j.jobs[slot]
+	if ok {
+		historicalStateDeduplicatedCount.Inc()
+	} else {
+		// The job outlives the request which started it, as other requests may join it.
+		jobCtx, cancel := context.WithCancel(WithCaller(context.Background(), callerFromContext(ctx)))
+		job = &historicalStateJob{
+			slot:   slot,
+			cost:   cost,
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+		j.jobs[slot] = job
+		go j.run(jobCtx, job, load)
+	}
+	job.waiters++
+	j.lock.Unlock()
+
+	select {
+	case <-job.done:
+		j.leave(job)
+		if job.err != nil {
+			return nil, job.err
+		}
+		// Each request gets its own copy, the callers may mutate the state.
+		return job.state.Copy(), nil
+	case <-ctx.Done():
+		j.leave(job)
+		return nil, ctx.Err()
+	}
+}
+
+// leave removes a request waiting for the job, and cancels the job if no request is left.
+func (j *historicalStateJobs) leave(job *historicalStateJob) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	job.waiters--
+	if job.waiters > 0 {
+		return
+	}
+	job.cancel()
+	if j.jobs[job.slot] == job {
+		delete(j.jobs, job.slot)
+	}
+}
+
+func (j *historicalStateJobs) run(ctx context.Context, job *historicalStateJob, load loadStateFn) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.historicalStateJob")
+	defer span.End()
+	span.AddAttributes(
+		trace.Int64Attribute("slot", int64(job.slot)),
+		trace.Int64Attribute("estimatedSlots", int64(job.cost)),
+	)
+	defer job.cancel()
+
+	historicalStateJobsQueued.Inc()
+	err := j.acquire(ctx, job.cost)
+	historicalStateJobsQueued.Dec()
+	if err != nil {
+		job.err = err
+	} else {
+		historicalStateJobsRunning.Inc()
+		stop := j.logProgress(job)
+		job.state, job.err = load(context.WithValue(ctx, replayProgressKey{}, &job.replayed), job.slot)
+		close(stop)
+		j.release(job.cost)
+		historicalStateJobsRunning.Dec()
+	}
+
+	j.lock.Lock()
+	if j.jobs[job.slot] == job {
+		delete(j.jobs, job.slot)
+	}
+	j.lock.Unlock()
+	close(job.done)
+}
+
+// acquire waits until the cost fits in the budget next to the running jobs. A job always runs
+// when no other job is running, so that lowering the budget does not leave it queued forever.
+func (j *historicalStateJobs) acquire(ctx context.Context, cost uint64) error {
+	for {
+		j.lock.Lock()
+		if j.budget == 0 || j.inFlight == 0 || j.inFlight+cost <= j.budget {
+			j.inFlight += cost
+			j.lock.Unlock()
+			return nil
+		}
+		released := j.released
+		j.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+func (j *historicalStateJobs) release(cost uint64) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.inFlight -= cost
+	j.notifyReleased()
+}
+
+// notifyReleased wakes up the queued jobs. The lock must be held.
+func (j *historicalStateJobs) notifyReleased() {
+	close(j.released)
+	j.released = make(chan struct{})
+}
+
+// logProgress periodically logs the progress of the job until the returned channel is closed.
+func (j *historicalStateJobs) logProgress(job *historicalStateJob) chan struct{} {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(replayProgressLogPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				log.WithFields(logrus.Fields{
+					"slot":           job.slot,
+					"replayedSlots":  atomic.LoadUint64(&job.replayed),
+					"estimatedSlots": job.cost,
+				}).Info("Regenerating historical state")
+			}
+		}
+	}()
+	return stop
+}
+
+// reportReplayedSlot counts a slot processed by the historical state job of the context, if any.
+func reportReplayedSlot(ctx context.Context) {
+	if replayed, ok := ctx.Value(replayProgressKey{}).(*uint64); ok {
+		atomic.AddUint64(replayed, 1)
+	}
+}
@@ -0,0 +1,150 @@
+package stategen
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReplayCost(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	service.slotsPerArchivedPoint = 32
+	service.finalizedInfo.slot = 100
+
+	assert.Equal(t, uint64(0), service.replayCost(0))
+	assert.Equal(t, uint64(6), service.replayCost(70), "Cold state should be replayed from the archived point")
+	assert.Equal(t, uint64(0), service.replayCost(100))
+	assert.Equal(t, uint64(30), service.replayCost(130), "Hot state should be replayed from the finalized state")
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(128))
+	require.NoError(t, service.epochBoundaryStateCache.put([32]byte{'a'}, st))
+	assert.Equal(t, uint64(2), service.replayCost(130), "Hot state should be replayed from the epoch boundary state")
+}
+
+func TestStateBySlot_ReplayBudgetExceeded(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	service.SetReplayBudget(10)
+
+	_, err := service.StateBySlot(context.Background(), 100)
+	assert.Equal(t, true, errors.Is(err, ErrReplayBudgetExceeded), "Unexpected error %v", err)
+}
+
+func TestHistoricalStateJobs_Deduplicated(t *testing.T) {
+	jobs := newHistoricalStateJobs(DefaultReplayBudget)
+	release := make(chan struct{})
+	var loads int
+	var lock sync.Mutex
+	load := func(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+		lock.Lock()
+		loads++
+		lock.Unlock()
+		<-release
+		st := testutil.NewBeaconState()
+		return st, st.SetSlot(slot)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			st, err := jobs.stateBySlot(context.Background(), 10, 5, load)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(10), st.Slot())
+		}()
+	}
+	// Wait for all the requests to join the job.
+	for {
+		jobs.lock.Lock()
+		job, ok := jobs.jobs[10]
+		waiters := 0
+		if ok {
+			waiters = job.waiters
+		}
+		jobs.lock.Unlock()
+		if waiters == 3 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 1, loads, "Concurrent requests were not deduplicated")
+	assert.Equal(t, 0, len(jobs.jobs))
+	assert.Equal(t, uint64(0), jobs.inFlight)
+}
+
+func TestHistoricalStateJobs_CancelledWithLastRequest(t *testing.T) {
+	jobs := newHistoricalStateJobs(DefaultReplayBudget)
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	load := func(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err := jobs.stateBySlot(ctx, 10, 5, load)
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Job was not cancelled")
+	}
+}
+
+func TestHistoricalStateJobs_QueuedOverBudget(t *testing.T) {
+	jobs := newHistoricalStateJobs(10)
+	release := make(chan struct{})
+	loaded := make(chan uint64, 2)
+	load := func(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+		loaded <- slot
+		if slot == 1 {
+			<-release
+		}
+		return testutil.NewBeaconState(), nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_, err := jobs.stateBySlot(context.Background(), 1, 8, load)
+		assert.NoError(t, err)
+		close(done)
+	}()
+	require.Equal(t, uint64(1), <-loaded)
+
+	queuedDone := make(chan struct{})
+	go func() {
+		_, err := jobs.stateBySlot(context.Background(), 2, 5, load)
+		assert.NoError(t, err)
+		close(queuedDone)
+	}()
+	select {
+	case <-loaded:
+		t.Fatal("Job over the budget was not queued")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-done
+	require.Equal(t, uint64(2), <-loaded)
+	<-queuedDone
+
+	_, err := jobs.stateBySlot(context.Background(), 3, 11, load)
+	assert.Equal(t, true, errors.Is(err, ErrReplayBudgetExceeded), "Unexpected error %v", err)
+}
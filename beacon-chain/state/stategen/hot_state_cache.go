@@ -6,12 +6,22 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 )
 
+const (
+	// hotStateCacheName identifies the hot state cache in the state cache manager.
+	hotStateCacheName = "hot"
+	// hotStateCost is the relative cost of regenerating a hot state, which is usually a few blocks
+	// away from an epoch boundary state.
+	hotStateCost = 1
+)
+
 var (
-	// hotStateCacheSize defines the max number of hot state this can cache.
-	hotStateCacheSize = 32
+	// hotStateCacheSize defines the max number of hot state this can cache. The memory held by the
+	// cached states is bounded by the state cache manager.
+	hotStateCacheSize = 1024
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "hot_state_cache_hit",
@@ -25,19 +35,25 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point..
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache   *lru.Cache
+	lock    sync.RWMutex
+	manager *cache.StateCacheHandle
 }
 
 // newHotStateCache initializes the map and underlying cache.
 func newHotStateCache() *hotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+	c := &hotStateCache{
+		manager: cache.StateCaches.Handle(hotStateCacheName),
+	}
+	lruCache, err := lru.NewWithEvict(hotStateCacheSize, func(key, _ interface{}) {
+		root := key.([32]byte)
+		c.manager.Remove(string(root[:]))
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &hotStateCache{
-		cache: cache,
-	}
+	c.cache = lruCache
+	return c
 }
 
 // Get returns a cached response via input block root, if any.
@@ -49,9 +65,11 @@ func (c *hotStateCache) get(root [32]byte) *stateTrie.BeaconState {
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.manager.Hit(string(root[:]))
 		return item.(*stateTrie.BeaconState).Copy()
 	}
 	hotStateCacheMiss.Inc()
+	c.manager.Miss()
 	return nil
 }
 
@@ -62,17 +80,25 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) *stateTrie.BeaconState {
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.manager.Hit(string(root[:]))
 		return item.(*stateTrie.BeaconState)
 	}
 	hotStateCacheMiss.Inc()
+	c.manager.Miss()
 	return nil
 }
 
-// put the response in the cache.
+// put the response in the cache, and register it with the state cache manager which may evict it
+// to stay within its budget. Both happen under the lock, so that a concurrent eviction or delete
+// of the root cannot interleave and leave the manager accounting for a state which is not cached.
 func (c *hotStateCache) put(root [32]byte, state *stateTrie.BeaconState) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
 	c.cache.Add(root, state)
+	evicted := c.manager.Add(string(root[:]), state, hotStateCost, func() {
+		c.delete(root)
+	})
+	c.lock.Unlock()
+	cache.RunEvictions(evicted)
 }
 
 // has returns true if the key exists in the cache.
@@ -1,8 +1,11 @@
 package stategen
 
 import (
+	"math"
+	"sync"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -31,3 +34,73 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_EvictedForEpochBoundaryState(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 32})
+	require.NoError(t, err)
+	manager := cache.NewStateCacheManager(cache.EstimateStateSize(st))
+	hot := newHotStateCache()
+	hot.manager = manager.Handle(hotStateCacheName)
+	boundary := newBoundaryStateCache()
+	boundary.manager = manager.Handle(epochBoundaryStateCacheName)
+
+	hotRoot := [32]byte{'A'}
+	hot.put(hotRoot, st)
+	assert.Equal(t, true, hot.has(hotRoot))
+
+	// Epoch boundary states are more expensive to regenerate, the hot state is evicted first.
+	boundaryRoot := [32]byte{'B'}
+	require.NoError(t, boundary.put(boundaryRoot, st))
+	assert.Equal(t, false, hot.has(hotRoot), "Hot state was not evicted")
+	_, ok, err := boundary.getByRoot(boundaryRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok, "Epoch boundary state was evicted")
+	assert.Equal(t, cache.EstimateStateSize(st), manager.Used())
+}
+
+func TestHotStateCache_InstancesShareManager(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10})
+	require.NoError(t, err)
+	manager := cache.NewStateCacheManager(math.MaxUint64)
+	first := newHotStateCache()
+	first.manager = manager.Handle(hotStateCacheName)
+	second := newHotStateCache()
+	second.manager = manager.Handle(hotStateCacheName)
+
+	// Both instances hold a state of the same root, removing it from one keeps it accounted for
+	// the other.
+	root := [32]byte{'A'}
+	first.put(root, st)
+	second.put(root, st)
+	assert.Equal(t, 2*cache.EstimateStateSize(st), manager.Used())
+	first.delete(root)
+	assert.Equal(t, cache.EstimateStateSize(st), manager.Used())
+	assert.Equal(t, true, second.has(root))
+}
+
+func TestHotStateCache_ConcurrentPutDelete(t *testing.T) {
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10})
+	require.NoError(t, err)
+	manager := cache.NewStateCacheManager(math.MaxUint64)
+	c := newHotStateCache()
+	c.manager = manager.Handle(hotStateCacheName)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				root := [32]byte{byte(j % 4)}
+				if (i+j)%2 == 0 {
+					c.put(root, st)
+				} else {
+					c.delete(root)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	// The manager accounts for exactly the states left in the cache.
+	assert.Equal(t, uint64(c.cache.Len())*cache.EstimateStateSize(st), manager.Used())
+}
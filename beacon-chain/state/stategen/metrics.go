@@ -5,6 +5,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+const (
+	sourceHotCache           = "hot_cache"
+	sourceEpochBoundaryCache = "epoch_boundary_cache"
+	sourceDB                 = "db"
+	sourceReplay             = "replay"
+)
+
 var (
 	replayBlockCount = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -13,4 +20,44 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	stateRequestsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "stategen_state_requests_total",
+			Help: "The number of state requests by caller and by source of the state: hot_cache, " +
+				"epoch_boundary_cache, db or replay.",
+		},
+		[]string{"caller", "source"},
+	)
+	replayDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "stategen_replay_duration_seconds",
+			Help:    "The time spent replaying blocks to generate a state, by caller.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+		},
+		[]string{"caller"},
+	)
+	historicalStateJobsQueued = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "stategen_historical_state_jobs_queued",
+			Help: "The number of historical state jobs waiting for the replay budget.",
+		},
+	)
+	historicalStateJobsRunning = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "stategen_historical_state_jobs_running",
+			Help: "The number of historical state jobs replaying blocks.",
+		},
+	)
+	historicalStateRejectedCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "stategen_historical_state_rejected_total",
+			Help: "The number of historical state requests rejected for exceeding the replay budget.",
+		},
+	)
+	historicalStateDeduplicatedCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "stategen_historical_state_deduplicated_total",
+			Help: "The number of historical state requests which joined the job of a concurrent request.",
+		},
+	)
 )
@@ -95,7 +95,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				continue
 			}
 
-			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
+			if err := s.beaconDB.SaveArchivedState(ctx, aState, aRoot); err != nil {
 				return err
 			}
 			log.WithFields(
@@ -165,6 +165,9 @@ func processSlotsStateGen(ctx context.Context, state *stateTrie.BeaconState, slo
 
 	var err error
 	for state.Slot() < slot {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		state, err = transition.ProcessSlot(ctx, state)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process slot")
@@ -178,6 +181,7 @@ func processSlotsStateGen(ctx context.Context, state *stateTrie.BeaconState, slo
 		if err := state.SetSlot(state.Slot() + 1); err != nil {
 			return nil, err
 		}
+		reportReplayedSlot(ctx)
 	}
 
 	return state, nil
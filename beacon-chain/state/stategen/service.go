@@ -26,6 +26,7 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	historicalStates        *historicalStateJobs
 }
 
 // This tracks the config in the event of long non-finality,
@@ -58,6 +59,7 @@ func New(beaconDB db.NoHeadAccessDatabase) *State {
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
+		historicalStates: newHistoricalStateJobs(DefaultReplayBudget),
 	}
 }
 
@@ -0,0 +1,11 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var backfillSlot = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "backfill_lowest_slot",
+	Help: "The slot of the lowest block stored by the backfill service.",
+})
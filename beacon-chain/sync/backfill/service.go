@@ -0,0 +1,322 @@
+// Package backfill downloads the blocks preceding the origin block of a node which was started
+// from a finalized checkpoint. Blocks are requested from peers backwards, in batches, and each
+// batch is linked to the already stored chain through block parent roots. Backfilled blocks are
+// only stored, no state transition is run on them.
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// blocksPerRequest is the number of slots requested from a peer in a single batch.
+	blocksPerRequest = 64
+	// retryInterval is the time to wait before retrying, when no peer is available or a batch failed.
+	retryInterval = 5 * time.Second
+)
+
+var errBatchNotLinked = errors.New("batch does not link to the lowest stored block")
+
+// HorizonFetcher returns the slot below which blocks are pruned from the database.
+type HorizonFetcher interface {
+	BlockHorizon(ctx context.Context) (uint64, error)
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P p2p.P2P
+	DB  db.NoHeadAccessDatabase
+	// Horizon stops the backfill at the pruning horizon when the database is pruned. Blocks are
+	// backfilled down to genesis when it is nil.
+	Horizon HorizonFetcher
+}
+
+// Service fetches and stores the blocks below the origin block of the node.
+type Service struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	p2p      p2p.P2P
+	db       db.NoHeadAccessDatabase
+	horizon  HorizonFetcher
+	rand     *rand.Rand
+	lock     sync.RWMutex
+	lowBlock *ethpb.SignedBeaconBlock
+	// cursor is the slot below which blocks are yet to be requested. It trails the slot of the
+	// lowest stored block when peers return empty ranges, i.e. skipped slots.
+	cursor   uint64
+	complete bool
+	err      error
+}
+
+// New initializes the backfill service.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:     ctx,
+		cancel:  cancel,
+		p2p:     cfg.P2P,
+		db:      cfg.DB,
+		horizon: cfg.Horizon,
+		rand:    rand.NewGenerator(),
+	}
+}
+
+// Start the backfill service. Backfilling runs in the background and only does work if the
+// node was started from a non-genesis origin block.
+func (s *Service) Start() {
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		s.setError(err)
+		return
+	}
+	if s.isComplete() {
+		log.Debug("No blocks to backfill")
+		return
+	}
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// initialize positions the service on the lowest block stored so far, which is either a block
+// stored by a previous run of the backfill or the origin block itself.
+func (s *Service) initialize(ctx context.Context) error {
+	originRoot, err := s.db.OriginBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin block root")
+	}
+	if originRoot == params.BeaconConfig().ZeroHash {
+		s.lock.Lock()
+		s.complete = true
+		s.lock.Unlock()
+		return nil
+	}
+	lowRoot, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve backfill block root")
+	}
+	if lowRoot == params.BeaconConfig().ZeroHash {
+		lowRoot = originRoot
+	}
+	lowBlock, err := s.db.Block(ctx, lowRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve lowest stored block")
+	}
+	if lowBlock == nil || lowBlock.Block == nil {
+		return errors.Errorf("lowest stored block %#x not found in db", lowRoot)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowBlock = lowBlock
+	s.cursor = lowBlock.Block.Slot
+	s.complete = lowBlock.Block.Slot == 0
+	return nil
+}
+
+// run requests batches of blocks until the genesis block or the pruning horizon is reached, or
+// the service is stopped.
+func (s *Service) run() {
+	log.WithField("slot", s.lowSlot()).Info("Backfilling blocks below origin")
+	for !s.isComplete() {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if err := s.step(s.ctx); err != nil {
+			log.WithError(err).Debug("Could not backfill batch")
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+		}
+	}
+	log.Info("Backfill complete")
+}
+
+// step requests the next batch of blocks from a peer and stores it. The batch does not extend
+// below the pruning horizon, and the backfill is complete once the horizon is reached.
+func (s *Service) step(ctx context.Context) error {
+	horizon, err := s.pruningHorizon(ctx)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	end := s.cursor
+	if end <= horizon {
+		s.complete = true
+		s.lock.Unlock()
+		log.WithField("slot", horizon).Info("Backfill reached the pruning horizon")
+		return nil
+	}
+	s.lock.Unlock()
+	pid, err := s.selectPeer()
+	if err != nil {
+		return err
+	}
+	start := uint64(0)
+	if end > blocksPerRequest {
+		start = end - blocksPerRequest
+	}
+	if start < horizon {
+		start = horizon
+	}
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     end - start,
+		Step:      1,
+	}
+	blocks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.p2p, pid, req, nil)
+	if err != nil {
+		s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if err := s.processBatch(ctx, start, blocks); err != nil {
+		if errors.Is(err, errBatchNotLinked) {
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
+		return err
+	}
+	return nil
+}
+
+// processBatch verifies that the blocks, sorted by slot in ascending order, form a chain ending
+// at the parent of the lowest stored block, saves them and moves the service below the batch.
+// The batch starts at slot start, which becomes the new cursor if the batch is empty.
+func (s *Service) processBatch(ctx context.Context, start uint64, blocks []*ethpb.SignedBeaconBlock) error {
+	s.lock.RLock()
+	lowBlock := s.lowBlock
+	s.lock.RUnlock()
+
+	if len(blocks) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if start == 0 {
+			// No parent was found down to genesis, an earlier empty batch must have skipped it.
+			s.cursor = lowBlock.Block.Slot
+			return errors.Wrap(errBatchNotLinked, "no parent block found before genesis")
+		}
+		s.cursor = start
+		return nil
+	}
+
+	lowRoot, err := verifyBatch(bytesutil.ToBytes32(lowBlock.Block.ParentRoot), blocks)
+	if err != nil {
+		// Request the whole range below the lowest stored block again, in case a previous
+		// batch was incomplete.
+		s.lock.Lock()
+		s.cursor = lowBlock.Block.Slot
+		s.lock.Unlock()
+		return err
+	}
+	if err := s.db.SaveBlocks(ctx, blocks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.db.SaveBackfillBlockRoot(ctx, lowRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowBlock = blocks[0]
+	s.cursor = blocks[0].Block.Slot
+	s.complete = s.cursor == 0
+	backfillSlot.Set(float64(s.cursor))
+	log.WithFields(logrus.Fields{
+		"count": len(blocks),
+		"slot":  s.cursor,
+	}).Debug("Backfilled blocks")
+	return nil
+}
+
+// selectPeer picks a random peer which has finalized the epoch of the lowest stored block.
+func (s *Service) selectPeer() (peer.ID, error) {
+	epoch := helpers.SlotToEpoch(s.lowSlot())
+	_, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, epoch)
+	if len(peers) == 0 {
+		return "", errors.New("no peers available to backfill from")
+	}
+	return peers[s.rand.Intn(len(peers))], nil
+}
+
+// pruningHorizon returns the slot below which blocks are pruned, or zero if the database is not
+// pruned.
+func (s *Service) pruningHorizon(ctx context.Context) (uint64, error) {
+	if s.horizon == nil {
+		return 0, nil
+	}
+	horizon, err := s.horizon.BlockHorizon(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve pruning horizon")
+	}
+	return horizon, nil
+}
+
+func (s *Service) lowSlot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowBlock.Block.Slot
+}
+
+func (s *Service) isComplete() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.complete
+}
+
+func (s *Service) setError(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+}
+
+// verifyBatch checks that each block, walking backwards from the last one, is the parent of the
+// block after it, and that the last block has the expected root. The root of the first block is
+// returned.
+func verifyBatch(expected [32]byte, blocks []*ethpb.SignedBeaconBlock) ([32]byte, error) {
+	var root [32]byte
+	for i := len(blocks) - 1; i >= 0; i-- {
+		blk := blocks[i]
+		if blk == nil || blk.Block == nil {
+			return [32]byte{}, errors.Wrap(errBatchNotLinked, "nil block in batch")
+		}
+		var err error
+		root, err = blk.Block.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not hash block")
+		}
+		if root != expected {
+			return [32]byte{}, errors.Wrapf(errBatchNotLinked, "block at slot %d has root %#x, expected %#x", blk.Block.Slot, root, expected)
+		}
+		expected = bytesutil.ToBytes32(blk.Block.ParentRoot)
+	}
+	return root, nil
+}
@@ -0,0 +1,200 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// chainOfBlocks returns blocks at every slot in [0, n), each linked to the previous one.
+func chainOfBlocks(t *testing.T, n uint64) []*ethpb.SignedBeaconBlock {
+	blocks := make([]*ethpb.SignedBeaconBlock, 0, n)
+	var parentRoot [32]byte
+	for i := uint64(0); i < n; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		root := parentRoot
+		blk.Block.ParentRoot = root[:]
+		blocks = append(blocks, blk)
+		var err error
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return blocks
+}
+
+func connectPeerHavingBlocks(t *testing.T, host *p2ptest.TestP2P, blocks []*ethpb.SignedBeaconBlock) {
+	p := p2ptest.NewTestP2P(t)
+	p.SetStreamHandler(fmt.Sprintf("%s/ssz_snappy", p2p.RPCBlocksByRangeTopic), func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &pb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		for i := req.StartSlot; i < req.StartSlot+req.Count*req.Step && i < uint64(len(blocks)); i += req.Step {
+			assert.NoError(t, prysmsync.WriteChunk(stream, p.Encoding(), blocks[i]))
+		}
+	})
+	p.Connect(host)
+
+	host.Peers().Add(new(enr.Record), p.PeerID(), nil, network.DirOutbound)
+	host.Peers().SetConnectionState(p.PeerID(), peers.PeerConnected)
+	host.Peers().SetChainState(p.PeerID(), &pb.Status{
+		ForkDigest:     params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: 10,
+		HeadRoot:       make([]byte, 32),
+		HeadSlot:       blocks[len(blocks)-1].Block.Slot,
+	})
+}
+
+func TestService_Initialize_NoOrigin(t *testing.T) {
+	s := New(context.Background(), &Config{DB: dbtest.SetupDB(t)})
+	require.NoError(t, s.initialize(context.Background()))
+	assert.Equal(t, true, s.isComplete())
+}
+
+func TestService_Initialize_ResumesFromBackfillRoot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks := chainOfBlocks(t, 10)
+	require.NoError(t, beaconDB.SaveBlocks(ctx, blocks))
+	originRoot, err := blocks[9].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, originRoot))
+
+	s := New(ctx, &Config{DB: beaconDB})
+	require.NoError(t, s.initialize(ctx))
+	assert.Equal(t, false, s.isComplete())
+	assert.Equal(t, uint64(9), s.cursor)
+
+	backfillRoot, err := blocks[4].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, backfillRoot))
+	require.NoError(t, s.initialize(ctx))
+	assert.Equal(t, uint64(4), s.cursor)
+}
+
+func TestService_Initialize_MissingBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, [32]byte{'a'}))
+
+	s := New(ctx, &Config{DB: beaconDB})
+	assert.ErrorContains(t, "not found in db", s.initialize(ctx))
+}
+
+func TestVerifyBatch(t *testing.T) {
+	blocks := chainOfBlocks(t, 5)
+	tip, err := blocks[4].Block.HashTreeRoot()
+	require.NoError(t, err)
+	first, err := blocks[2].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := verifyBatch(tip, blocks[2:])
+	require.NoError(t, err)
+	assert.Equal(t, first, root)
+
+	_, err = verifyBatch([32]byte{'a'}, blocks[2:])
+	assert.ErrorContains(t, errBatchNotLinked.Error(), err)
+
+	// A block missing in the middle of the batch breaks the chain.
+	_, err = verifyBatch(tip, []*ethpb.SignedBeaconBlock{blocks[1], blocks[2], blocks[4]})
+	assert.ErrorContains(t, errBatchNotLinked.Error(), err)
+}
+
+func TestService_ProcessBatch_EmptyBatchMovesCursor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 200
+	s := New(ctx, &Config{DB: beaconDB})
+	s.lowBlock = blk
+	s.cursor = 200
+
+	require.NoError(t, s.processBatch(ctx, 136, nil))
+	assert.Equal(t, uint64(136), s.cursor)
+
+	err := s.processBatch(ctx, 0, nil)
+	assert.ErrorContains(t, "no parent block found before genesis", err)
+	assert.Equal(t, uint64(200), s.cursor)
+}
+
+func TestService_BackfillsToGenesis(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks := chainOfBlocks(t, 3*blocksPerRequest+10)
+	origin := blocks[len(blocks)-1]
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, origin))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, originRoot))
+
+	host := p2ptest.NewTestP2P(t)
+	connectPeerHavingBlocks(t, host, blocks)
+
+	s := New(ctx, &Config{DB: beaconDB, P2P: host})
+	require.NoError(t, s.initialize(ctx))
+	for !s.isComplete() {
+		require.NoError(t, s.step(ctx))
+	}
+
+	for _, blk := range blocks {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+	}
+	genesisRoot, err := blocks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+}
+
+type mockHorizon uint64
+
+func (m mockHorizon) BlockHorizon(_ context.Context) (uint64, error) {
+	return uint64(m), nil
+}
+
+func TestService_StopsAtPruningHorizon(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks := chainOfBlocks(t, 3*blocksPerRequest+10)
+	origin := blocks[len(blocks)-1]
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, origin))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, originRoot))
+
+	host := p2ptest.NewTestP2P(t)
+	connectPeerHavingBlocks(t, host, blocks)
+
+	horizon := uint64(100)
+	s := New(ctx, &Config{DB: beaconDB, P2P: host, Horizon: mockHorizon(horizon)})
+	require.NoError(t, s.initialize(ctx))
+	for !s.isComplete() {
+		require.NoError(t, s.step(ctx))
+	}
+
+	for _, blk := range blocks {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, blk.Block.Slot >= horizon, beaconDB.HasBlock(ctx, root), "Unexpected block at slot %d", blk.Block.Slot)
+	}
+	assert.Equal(t, horizon, s.lowSlot())
+}
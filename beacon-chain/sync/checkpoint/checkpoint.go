@@ -0,0 +1,91 @@
+// Package checkpoint allows a beacon node to start syncing from a finalized
+// checkpoint instead of genesis, by seeding an empty database with a trusted
+// finalized beacon state and the block which produced it.
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Source provides a finalized beacon state and the block which produced it.
+type Source interface {
+	Load(ctx context.Context) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock, error)
+}
+
+// FileSource loads the checkpoint state and block from SSZ encoded files.
+type FileSource struct {
+	StatePath string
+	BlockPath string
+}
+
+// Load reads and decodes the SSZ encoded state and block files.
+func (f *FileSource) Load(_ context.Context) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock, error) {
+	if f.StatePath == "" || f.BlockPath == "" {
+		return nil, nil, errors.New("both a checkpoint state and a checkpoint block file are required")
+	}
+	stateEnc, err := ioutil.ReadFile(f.StatePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint state file")
+	}
+	blockEnc, err := ioutil.ReadFile(f.BlockPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint block file")
+	}
+	return decode(stateEnc, blockEnc)
+}
+
+// Initialize seeds the database with the checkpoint provided by the source. A database which
+// already contains a chain is left untouched, so restarting a node with the same checkpoint
+// flags resumes from its own data.
+func Initialize(ctx context.Context, beaconDB db.HeadAccessDatabase, src Source) error {
+	head, err := beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head block")
+	}
+	if head != nil {
+		log.Info("Database already contains a chain, skipping checkpoint sync initialization")
+		return nil
+	}
+
+	st, blk, err := src.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if err := beaconDB.SaveOrigin(ctx, st, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state and block")
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot": blk.Block.Slot,
+		"root": bytesutil.Trunc(root[:]),
+	}).Info("Initialized database from finalized checkpoint")
+	return nil
+}
+
+func decode(stateEnc, blockEnc []byte) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock, error) {
+	protoState := &pb.BeaconState{}
+	if err := protoState.UnmarshalSSZ(stateEnc); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	st, err := stateTrie.InitializeFromProtoUnsafe(protoState)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize checkpoint state")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(blockEnc); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	return st, blk, nil
+}
@@ -0,0 +1,77 @@
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func writeCheckpointFiles(t *testing.T) (*FileSource, [32]byte) {
+	ctx := context.Background()
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*4))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = st.Slot()
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	stateEnc, err := st.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	blockEnc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	src := &FileSource{
+		StatePath: filepath.Join(dir, "state.ssz"),
+		BlockPath: filepath.Join(dir, "block.ssz"),
+	}
+	require.NoError(t, ioutil.WriteFile(src.StatePath, stateEnc, 0600))
+	require.NoError(t, ioutil.WriteFile(src.BlockPath, blockEnc, 0600))
+	return src, root
+}
+
+func TestInitialize_FromFiles(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	src, root := writeCheckpointFiles(t)
+
+	require.NoError(t, Initialize(ctx, beaconDB, src))
+
+	origin, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, origin)
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), finalized.Epoch)
+	assert.Equal(t, true, beaconDB.HasState(ctx, root))
+}
+
+func TestInitialize_SkipsInitializedDatabase(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	src, root := writeCheckpointFiles(t)
+	require.NoError(t, Initialize(ctx, beaconDB, src))
+
+	// A missing file would fail to load, which proves the source is not used again.
+	require.NoError(t, Initialize(ctx, beaconDB, &FileSource{StatePath: "missing", BlockPath: "missing"}))
+	assert.LogsContain(t, hook, "Database already contains a chain")
+	origin, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, origin)
+}
+
+func TestFileSource_RequiresBothFiles(t *testing.T) {
+	_, _, err := (&FileSource{StatePath: "state.ssz"}).Load(context.Background())
+	assert.ErrorContains(t, "both a checkpoint state and a checkpoint block file are required", err)
+}
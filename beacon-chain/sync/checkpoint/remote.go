@@ -0,0 +1,71 @@
+package checkpoint
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+)
+
+// RemoteSource fetches the latest finalized state and block from the debug RPC
+// endpoints of a trusted beacon node, which must run with --enable-debug-rpc-endpoints.
+type RemoteSource struct {
+	Endpoint   string
+	MaxMsgSize int
+}
+
+// Load requests the finalized checkpoint of the trusted node, then its block and state.
+func (r *RemoteSource) Load(ctx context.Context) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if r.MaxMsgSize > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(r.MaxMsgSize)))
+	}
+	conn, err := grpc.DialContext(ctx, r.Endpoint, opts...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not dial trusted beacon node %s", r.Endpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to trusted beacon node")
+		}
+	}()
+
+	head, err := ethpb.NewBeaconChainClient(conn).GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get chain head from trusted beacon node")
+	}
+	root := head.FinalizedBlockRoot
+	if bytesutil.ToBytes32(root) == [32]byte{} {
+		return nil, nil, errors.New("trusted beacon node has not finalized a checkpoint yet")
+	}
+	log.WithField("epoch", head.FinalizedEpoch).Info("Requesting finalized state and block from trusted beacon node")
+
+	debugClient := pbrpc.NewDebugClient(conn)
+	blockResp, err := debugClient.GetBlock(ctx, &pbrpc.BlockRequest{BlockRoot: root})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized block from trusted beacon node")
+	}
+	stateResp, err := debugClient.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: root},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized state from trusted beacon node")
+	}
+	st, blk, err := decode(stateResp.Encoded, blockResp.Encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	blkRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	if blkRoot != bytesutil.ToBytes32(root) {
+		return nil, nil, errors.Errorf("received block root %#x does not match finalized root %#x", blkRoot, root)
+	}
+	return st, blk, nil
+}
@@ -41,6 +41,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	throttledRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_throttled_requests_total",
+			Help: "Count of RPC requests rejected by the rate limiter, by peer and protocol.",
+		},
+		[]string{"peer", "topic"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
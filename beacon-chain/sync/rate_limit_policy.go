@@ -0,0 +1,211 @@
+package sync
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"gopkg.in/yaml.v2"
+)
+
+// rateLimitPolicyReloadInterval is the interval over which changes to the rate limit policy file are
+// debounced before the policies are reloaded.
+const rateLimitPolicyReloadInterval = time.Second
+
+// peerClass is the class of a peer, which may have its own rate limits.
+type peerClass int
+
+const (
+	// defaultPeerClass is the class of the peers which do not belong to another class, and whose
+	// policies apply to the other classes unless overridden.
+	defaultPeerClass peerClass = iota
+	// trustedPeerClass is the class of the peers trusted by the operator, which are not rate limited
+	// unless a policy is set for them.
+	trustedPeerClass
+	// inboundPeerClass is the class of the peers which dialed the node.
+	inboundPeerClass
+	// outboundPeerClass is the class of the peers dialed by the node.
+	outboundPeerClass
+)
+
+func (c peerClass) String() string {
+	switch c {
+	case trustedPeerClass:
+		return "trusted"
+	case inboundPeerClass:
+		return "inbound"
+	case outboundPeerClass:
+		return "outbound"
+	default:
+		return "default"
+	}
+}
+
+// rateLimitPolicy is the rate limit of a protocol: a peer may send up to capacity requests at once,
+// and the capacity refills at rate requests per second. Block requests cost a request per block.
+type rateLimitPolicy struct {
+	Rate     float64 `yaml:"rate"`
+	Capacity int64   `yaml:"capacity"`
+}
+
+// protocolPolicies are the rate limit policies of a protocol, by peer class.
+type protocolPolicies struct {
+	Default  *rateLimitPolicy `yaml:"default"`
+	Trusted  *rateLimitPolicy `yaml:"trusted"`
+	Inbound  *rateLimitPolicy `yaml:"inbound"`
+	Outbound *rateLimitPolicy `yaml:"outbound"`
+}
+
+// classes returns the policies set for the peer classes.
+func (p *protocolPolicies) classes() map[peerClass]*rateLimitPolicy {
+	classes := make(map[peerClass]*rateLimitPolicy)
+	for class, policy := range map[peerClass]*rateLimitPolicy{
+		defaultPeerClass:  p.Default,
+		trustedPeerClass:  p.Trusted,
+		inboundPeerClass:  p.Inbound,
+		outboundPeerClass: p.Outbound,
+	} {
+		if policy != nil {
+			classes[class] = policy
+		}
+	}
+	return classes
+}
+
+// rateLimitPolicies are the rate limit policies of each protocol, as read from a policy file:
+//
+//	status:
+//	  default: {rate: 1, capacity: 5}
+//	  inbound: {rate: 0.5, capacity: 2}
+//	beacon_blocks:
+//	  trusted: {rate: 256, capacity: 2560}
+type rateLimitPolicies map[string]*protocolPolicies
+
+// rateLimitProtocols are the RPC topics of the protocols of the rate limit policies. Blocks by range
+// and by root requests share their rate limit, as a block costs the same to serve either way.
+var rateLimitProtocols = map[string][]string{
+	"goodbye":       {p2p.RPCGoodByeTopic},
+	"metadata":      {p2p.RPCMetaDataTopic},
+	"ping":          {p2p.RPCPingTopic},
+	"status":        {p2p.RPCStatusTopic},
+	"beacon_blocks": {p2p.RPCBlocksByRangeTopic, p2p.RPCBlocksByRootTopic},
+}
+
+// defaultRateLimitPolicies returns the policies used for the protocols and classes which are not
+// set by the policy file. Block limits are set by the block batch limit flags.
+func defaultRateLimitPolicies() rateLimitPolicies {
+	return rateLimitPolicies{
+		"goodbye":  {Default: &rateLimitPolicy{Rate: 1, Capacity: 1}},
+		"metadata": {Default: &rateLimitPolicy{Rate: 1, Capacity: defaultBurstLimit}},
+		"ping":     {Default: &rateLimitPolicy{Rate: 1, Capacity: defaultBurstLimit}},
+		"status":   {Default: &rateLimitPolicy{Rate: 1, Capacity: defaultBurstLimit}},
+		"beacon_blocks": {Default: &rateLimitPolicy{
+			Rate:     float64(flags.Get().BlockBatchLimit),
+			Capacity: int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit),
+		}},
+	}
+}
+
+// loadRateLimitPolicies reads the policy file, and returns the default policies overridden by the
+// policies of the file. The default policies are returned when no file is given.
+func loadRateLimitPolicies(file string) (rateLimitPolicies, error) {
+	policies := defaultRateLimitPolicies()
+	if file == "" {
+		return policies, nil
+	}
+	enc, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read rate limit policy file")
+	}
+	filePolicies := make(rateLimitPolicies)
+	if err := yaml.UnmarshalStrict(enc, &filePolicies); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal rate limit policy file")
+	}
+	for protocol, protoPolicies := range filePolicies {
+		if _, ok := rateLimitProtocols[protocol]; !ok {
+			return nil, errors.Errorf("unknown rate limit protocol %q", protocol)
+		}
+		if protoPolicies == nil {
+			continue
+		}
+		for class, policy := range protoPolicies.classes() {
+			if policy.Rate <= 0 || policy.Capacity <= 0 {
+				return nil, errors.Errorf("rate and capacity of the %s policy of %s must be positive", class, protocol)
+			}
+			// Block range requests are served in batches of the block batch limit, which must fit
+			// in the capacity.
+			if protocol == "beacon_blocks" && policy.Capacity < int64(flags.Get().BlockBatchLimit) {
+				return nil, errors.Errorf("capacity of the %s policy of %s must be at least the block batch limit %d",
+					class, protocol, flags.Get().BlockBatchLimit)
+			}
+		}
+		if protoPolicies.Default != nil {
+			policies[protocol].Default = protoPolicies.Default
+		}
+		policies[protocol].Trusted = protoPolicies.Trusted
+		policies[protocol].Inbound = protoPolicies.Inbound
+		policies[protocol].Outbound = protoPolicies.Outbound
+	}
+	return policies, nil
+}
+
+// watchPolicyFile reloads the rate limit policies when the policy file changes, until the context
+// is canceled. The directory of the file is watched, so that files replaced by editors are reloaded
+// as well. Invalid policies are logged, and the current policies are kept. Changes are watched for
+// once the function returns.
+func (l *limiter) watchPolicyFile(ctx context.Context, file string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "could not initialize rate limit policy file watcher")
+	}
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		if closeErr := watcher.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close rate limit policy file watcher")
+		}
+		return errors.Wrapf(err, "could not watch rate limit policy file %s", file)
+	}
+	go l.reloadPolicies(ctx, watcher, file)
+	return nil
+}
+
+// reloadPolicies reloads the rate limit policies on the changes of the policy file reported by the
+// watcher, until the context is canceled.
+func (l *limiter) reloadPolicies(ctx context.Context, watcher *fsnotify.Watcher, file string) {
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close rate limit policy file watcher")
+		}
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := make(chan interface{}, 100)
+
+	go asyncutil.Debounce(ctx, rateLimitPolicyReloadInterval, changes, func(interface{}) {
+		policies, err := loadRateLimitPolicies(file)
+		if err != nil {
+			log.WithError(err).Error("Could not reload rate limit policies, keeping the current policies")
+			return
+		}
+		l.setPolicies(policies)
+		log.WithField("file", file).Info("Reloaded rate limit policies")
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != filepath.Clean(file) || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			changes <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for changes of rate limit policy file %s", file)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package sync
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLoadRateLimitPolicies(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policy.yaml")
+	writePolicy := func(policy string) {
+		require.NoError(t, ioutil.WriteFile(file, []byte(policy), 0600))
+	}
+
+	writePolicy(`
+status:
+  inbound: {rate: 0.5, capacity: 2}
+beacon_blocks:
+  default: {rate: 128, capacity: 1280}
+  trusted: {rate: 256, capacity: 2560}
+`)
+	policies, err := loadRateLimitPolicies(file)
+	require.NoError(t, err)
+	// Policies which are not set are the defaults.
+	assert.DeepEqual(t, &rateLimitPolicy{Rate: 1, Capacity: defaultBurstLimit}, policies["status"].Default)
+	assert.DeepEqual(t, &rateLimitPolicy{Rate: 0.5, Capacity: 2}, policies["status"].Inbound)
+	assert.DeepEqual(t, &rateLimitPolicy{Rate: 1, Capacity: defaultBurstLimit}, policies["ping"].Default)
+	assert.DeepEqual(t, &rateLimitPolicy{Rate: 128, Capacity: 1280}, policies["beacon_blocks"].Default)
+	assert.DeepEqual(t, &rateLimitPolicy{Rate: 256, Capacity: 2560}, policies["beacon_blocks"].Trusted)
+
+	tests := []struct {
+		policy string
+		err    string
+	}{
+		{policy: "blocks_by_hash:\n  default: {rate: 1, capacity: 1}", err: "unknown rate limit protocol"},
+		{policy: "ping:\n  other: {rate: 1, capacity: 1}", err: "could not unmarshal"},
+		{policy: "ping:\n  inbound: {rate: 0, capacity: 1}", err: "must be positive"},
+		{policy: "beacon_blocks:\n  outbound: {rate: 64, capacity: 32}", err: "must be at least the block batch limit"},
+	}
+	for _, tt := range tests {
+		writePolicy(tt.policy)
+		_, err := loadRateLimitPolicies(file)
+		assert.ErrorContains(t, tt.err, err)
+	}
+}
+
+func TestRateLimiter_PeerClasses(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirInbound)
+
+	topic := p2p.RPCStatusTopic + p1.Encoding().ProtocolSuffix()
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+
+	policies := defaultRateLimitPolicies()
+	policies["status"].Inbound = &rateLimitPolicy{Rate: 0.000001, Capacity: 1}
+	policies["status"].Trusted = &rateLimitPolicy{Rate: 0.000001, Capacity: 3}
+	rlimiter := newRateLimiter(p1)
+	rlimiter.setPolicies(policies)
+	assert.Equal(t, 6, len(rlimiter.limiterMap))
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+
+	// The inbound peer is limited by the inbound policy rather than the default policy.
+	remaining, err := rlimiter.remaining(stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), remaining)
+	require.NoError(t, rlimiter.validateRequest(stream, 1))
+	rlimiter.add(stream, 1)
+	assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateRequest(stream, 1))
+
+	// Trusted peers have their own policy.
+	p1.Peers().SetTrustedPeers([]peer.ID{p2.PeerID()}, nil)
+	remaining, err = rlimiter.remaining(stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), remaining)
+
+	// Trusted peers are not limited without a policy.
+	policies["status"] = defaultRateLimitPolicies()["status"]
+	rlimiter.setPolicies(policies)
+	remaining, err = rlimiter.remaining(stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), remaining)
+	require.NoError(t, rlimiter.validateRequest(stream, 100))
+
+	require.NoError(t, stream.Close())
+}
+
+func TestRateLimiter_WatchPolicyFile(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirInbound)
+
+	topic := p2p.RPCStatusTopic + p1.Encoding().ProtocolSuffix()
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, stream.Close())
+	}()
+
+	file := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("status:\n  inbound: {rate: 0.000001, capacity: 2}\n"), 0600))
+	policies, err := loadRateLimitPolicies(file)
+	require.NoError(t, err)
+	rlimiter := newRateLimiter(p1)
+	rlimiter.setPolicies(policies)
+	remaining, err := rlimiter.remaining(stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), remaining)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, rlimiter.watchPolicyFile(ctx, file))
+
+	// Invalid policies are not applied.
+	require.NoError(t, ioutil.WriteFile(file, []byte("status:\n  inbound: {rate: 0, capacity: 7}\n"), 0600))
+	// The new limits apply once the rewritten file is reloaded.
+	require.NoError(t, ioutil.WriteFile(file, []byte("status:\n  inbound: {rate: 0.000001, capacity: 5}\n"), 0600))
+	deadline := time.Now().Add(10 * time.Second)
+	for remaining != 5 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		remaining, err = rlimiter.remaining(stream)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(5), remaining)
+
+}
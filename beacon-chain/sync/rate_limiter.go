@@ -6,6 +6,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -17,50 +18,74 @@ import (
 const defaultBurstLimit = 5
 
 type limiter struct {
+	// limiterMap holds the collectors of the default peer class, by topic.
 	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	// classLimiterMap holds the collectors of the peer classes with their own policies, by topic.
+	classLimiterMap map[peerClass]map[string]*leakybucket.Collector
+	p2p             p2p.P2P
 	sync.RWMutex
 }
 
 // Instantiates a multi-rpc protocol rate limiter, providing
-// separate collectors for each topic.
+// separate collectors for each topic and peer class, as set
+// by the rate limit policies.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
+	policies, err := loadRateLimitPolicies(flags.Get().RateLimitPolicyFile)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load rate limit policies")
+	}
+	l := &limiter{p2p: p2pProvider}
+	l.setPolicies(policies)
+	return l
+}
+
+// setPolicies replaces the collectors with new collectors for the policies. The capacity used by
+// peers is reset.
+func (l *limiter) setPolicies(policies rateLimitPolicies) {
 	// add encoding suffix
 	addEncoding := func(topic string) string {
-		return topic + p2pProvider.Encoding().ProtocolSuffix()
+		return topic + l.p2p.Encoding().ProtocolSuffix()
 	}
-	// Initialize block limits.
-	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
-	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
-
-	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
-	// Goodbye Message
-	topicMap[addEncoding(p2p.RPCGoodByeTopic)] = leakybucket.NewCollector(1, 1, false /* deleteEmptyBuckets */)
-	// Metadata Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-
-	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */)
-
-	// BlocksByRoots requests
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopic)] = blockCollector
-
-	// BlockByRange requests
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopic)] = blockCollector
+	classTopicMap := make(map[peerClass]map[string]*leakybucket.Collector)
+	for protocol, topics := range rateLimitProtocols {
+		for class, policy := range policies[protocol].classes() {
+			// Topics of the same protocol share a single collector.
+			collector := leakybucket.NewCollector(policy.Rate, policy.Capacity, false /* deleteEmptyBuckets */)
+			for _, topic := range topics {
+				if class == defaultPeerClass {
+					topicMap[addEncoding(topic)] = collector
+					continue
+				}
+				if _, ok := classTopicMap[class]; !ok {
+					classTopicMap[class] = make(map[string]*leakybucket.Collector)
+				}
+				classTopicMap[class][addEncoding(topic)] = collector
+			}
+		}
+	}
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	l.Lock()
+	defer l.Unlock()
+	l.freeCollectors()
+	l.limiterMap = topicMap
+	l.classLimiterMap = classTopicMap
 }
 
-// Returns the current topic collector for the provided topic.
-func (l *limiter) topicCollector(topic string) (*leakybucket.Collector, error) {
+// Returns the remaining capacity of the peer of the stream for its topic, or -1
+// when the peer is not rate limited.
+func (l *limiter) remaining(stream network.Stream) (int64, error) {
 	l.RLock()
 	defer l.RUnlock()
-	return l.retrieveCollector(topic)
+
+	collector, err := l.retrievePeerCollector(string(stream.Protocol()), stream.Conn().RemotePeer())
+	if err != nil {
+		return 0, err
+	}
+	if collector == nil {
+		return -1, nil
+	}
+	return collector.Remaining(stream.Conn().RemotePeer().String()), nil
 }
 
 // validates a request with the accompanying cost.
@@ -69,19 +94,25 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	defer l.RUnlock()
 
 	topic := string(stream.Protocol())
+	pid := stream.Conn().RemotePeer()
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, pid)
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
+	// Peers without a rate limit, such as trusted peers, are not limited.
+	if collector == nil {
+		return nil
+	}
+	key := pid.String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
 	if amt == 0 {
 		amt = 1
 	}
 	if amt > uint64(remaining) {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		throttledRequestsCounter.WithLabelValues(key, topic).Inc()
+		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
 		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
 	}
@@ -96,34 +127,58 @@ func (l *limiter) add(stream network.Stream, amt int64) {
 	topic := string(stream.Protocol())
 	log := l.topicLogger(topic)
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, stream.Conn().RemotePeer())
 	if err != nil {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
+	if collector == nil {
+		return
+	}
 	key := stream.Conn().RemotePeer().String()
 	collector.Add(key, amt)
 }
 
+// removes the throttled requests metrics of a disconnected peer.
+func (l *limiter) peerDisconnected(pid peer.ID) {
+	l.RLock()
+	defer l.RUnlock()
+
+	for topic := range l.limiterMap {
+		throttledRequestsCounter.DeleteLabelValues(pid.String(), topic)
+	}
+}
+
 // frees all the collectors and removes them.
 func (l *limiter) free() {
 	l.Lock()
 	defer l.Unlock()
+	l.freeCollectors()
+}
 
+// frees all the collectors and removes them. The lock must be held.
+func (l *limiter) freeCollectors() {
 	tempMap := map[uintptr]bool{}
-	for t, collector := range l.limiterMap {
-		// Check if collector has already been cleared off
-		// as all collectors are not distinct from each other.
-		ptr := reflect.ValueOf(collector).Pointer()
-		if tempMap[ptr] {
+	freeMap := func(collectors map[string]*leakybucket.Collector) {
+		for t, collector := range collectors {
+			// Check if collector has already been cleared off
+			// as all collectors are not distinct from each other.
+			ptr := reflect.ValueOf(collector).Pointer()
+			if tempMap[ptr] {
+				// Remove from map
+				delete(collectors, t)
+				continue
+			}
+			collector.Free()
 			// Remove from map
-			delete(l.limiterMap, t)
-			continue
+			delete(collectors, t)
+			tempMap[ptr] = true
 		}
-		collector.Free()
-		// Remove from map
-		delete(l.limiterMap, t)
-		tempMap[ptr] = true
+	}
+	freeMap(l.limiterMap)
+	for class, collectors := range l.classLimiterMap {
+		freeMap(collectors)
+		delete(l.classLimiterMap, class)
 	}
 }
 
@@ -140,6 +195,44 @@ func (l *limiter) retrieveCollector(topic string) (*leakybucket.Collector, error
 	return collector, nil
 }
 
+// not to be used outside the rate limiter file as it is unsafe for concurrent usage
+// and is protected by a lock on all of its usages here. Returns the collector of the
+// topic for the class of the peer, or nil when the peer is not rate limited.
+func (l *limiter) retrievePeerCollector(topic string, pid peer.ID) (*leakybucket.Collector, error) {
+	collector, err := l.retrieveCollector(topic)
+	if err != nil {
+		return nil, err
+	}
+	class := l.peerClass(pid)
+	if classCollector, ok := l.classLimiterMap[class][topic]; ok {
+		return classCollector, nil
+	}
+	// Peers trusted by the operator are not rate limited, unless a policy is set for them.
+	if class == trustedPeerClass {
+		return nil, nil
+	}
+	return collector, nil
+}
+
+// Returns the rate limiting class of the peer.
+func (l *limiter) peerClass(pid peer.ID) peerClass {
+	if l.p2p.Peers().IsTrusted(pid) {
+		return trustedPeerClass
+	}
+	direction, err := l.p2p.Peers().Direction(pid)
+	if err != nil {
+		return defaultPeerClass
+	}
+	switch direction {
+	case network.DirInbound:
+		return inboundPeerClass
+	case network.DirOutbound:
+		return outboundPeerClass
+	default:
+		return defaultPeerClass
+	}
+}
+
 func (l *limiter) topicLogger(topic string) *logrus.Entry {
 	return log.WithField("rate limiter", topic)
 }
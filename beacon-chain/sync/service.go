@@ -152,8 +152,10 @@ func (s *Service) Start() {
 	}
 
 	s.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
-	s.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
-		// no-op
+	s.p2p.AddDisconnectionHandler(func(_ context.Context, pid peer.ID) error {
+		if s.rateLimiter != nil {
+			s.rateLimiter.peerDisconnected(pid)
+		}
 		return nil
 	})
 	s.p2p.AddPingMethod(s.sendPingRequest)
@@ -166,6 +168,12 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	runutil.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+
+	if file := flags.Get().RateLimitPolicyFile; file != "" {
+		if err := s.rateLimiter.watchPolicyFile(s.ctx, file); err != nil {
+			log.WithError(err).Error("Rate limit policies will not be reloaded on changes")
+		}
+	}
 }
 
 // Stop the regular sync service.
@@ -72,6 +72,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.AcceptTosFlag,
 			cmd.RestoreSourceFileFlag,
 			cmd.RestoreTargetDirFlag,
+			cmd.ConvertSourceBackendFlag,
+			cmd.ConvertTargetBackendFlag,
 		},
 	},
 	{
@@ -106,15 +108,38 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.StateDiffSnapshotInterval,
+			flags.DBBackend,
+			flags.EnableDBPruning,
+			flags.DBPruningStateWindow,
+			flags.ExportFileDir,
+			flags.ExportFileFormat,
+			flags.ExportFileMaxSize,
+			flags.ExportWebhookURL,
+			flags.ExportKafkaServers,
+			flags.ExportTypes,
+			flags.ExportReplayFromSlot,
+			flags.StateCacheBudget,
+			flags.HistoricalStateReplayBudget,
+			flags.ValidatorPerformanceIndex,
+			flags.AttestationTrackerIndices,
+			flags.AttestationTrackerWindow,
+			flags.PubsubTraceDir,
+			flags.PubsubTraceMaxFileSize,
+			flags.PubsubTraceMaxFiles,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.RateLimitPolicy,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
+			flags.CheckpointState,
+			flags.CheckpointBlock,
+			flags.CheckpointSyncURL,
 			flags.Eth1HeaderReqLimit,
 		},
 	},
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/attestation_record.proto
+
+package db
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AttestationRecord struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	FirstSeen            int64    `protobuf:"varint,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	Included             bool     `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,6,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,7,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectHead          bool     `protobuf:"varint,8,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget        bool     `protobuf:"varint,9,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationRecord) Reset()         { *m = AttestationRecord{} }
+func (m *AttestationRecord) String() string { return proto.CompactTextString(m) }
+func (*AttestationRecord) ProtoMessage()    {}
+func (*AttestationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653f38cd4cb0c43, []int{0}
+}
+func (m *AttestationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationRecord.Merge(m, src)
+}
+func (m *AttestationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationRecord proto.InternalMessageInfo
+
+func (m *AttestationRecord) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetFirstSeen() int64 {
+	if m != nil {
+		return m.FirstSeen
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
+}
+
+func (m *AttestationRecord) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetCorrectHead() bool {
+	if m != nil {
+		return m.CorrectHead
+	}
+	return false
+}
+
+func (m *AttestationRecord) GetCorrectTarget() bool {
+	if m != nil {
+		return m.CorrectTarget
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AttestationRecord)(nil), "prysm.beacon.db.AttestationRecord")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/attestation_record.proto", fileDescriptor_6653f38cd4cb0c43)
+}
+
+var fileDescriptor_6653f38cd4cb0c43 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0x95, 0xb6, 0x5f, 0xbf, 0xd6, 0xd0, 0x56, 0xf5, 0x64, 0x21, 0x51, 0x15, 0x24, 0x44,
+	0x16, 0x92, 0x81, 0x95, 0x05, 0xc4, 0x00, 0x6b, 0xca, 0xc4, 0x12, 0x39, 0xf6, 0xd1, 0x5a, 0x4a,
+	0xec, 0xca, 0xbe, 0x22, 0x78, 0x43, 0x46, 0x06, 0x1e, 0x00, 0xf5, 0x49, 0x50, 0x2e, 0x21, 0x95,
+	0xd8, 0x7c, 0xbf, 0xfb, 0xfd, 0x75, 0x67, 0x1d, 0x8b, 0xb7, 0xde, 0xa1, 0x4b, 0x0b, 0x90, 0xca,
+	0xd9, 0x54, 0x17, 0xa9, 0x44, 0x84, 0x80, 0x12, 0x8d, 0xb3, 0xb9, 0x07, 0xe5, 0xbc, 0x4e, 0x48,
+	0xe1, 0xb3, 0xad, 0x7f, 0x0f, 0x55, 0xd2, 0x98, 0x89, 0x2e, 0xce, 0xbf, 0x7a, 0x6c, 0x7e, 0x7b,
+	0xb0, 0x33, 0x92, 0xf9, 0x25, 0x9b, 0xbd, 0xca, 0xd2, 0x68, 0x89, 0xce, 0xe7, 0xc6, 0x6a, 0x78,
+	0x13, 0xd1, 0x32, 0x8a, 0x07, 0xd9, 0xb4, 0xc3, 0x8f, 0x35, 0xe5, 0x9c, 0x0d, 0x42, 0xe9, 0x50,
+	0xf4, 0xa8, 0x4b, 0xef, 0x3a, 0xac, 0x5c, 0x55, 0x19, 0x44, 0x80, 0x36, 0xdc, 0x6f, 0xc2, 0x1d,
+	0x6e, 0xc2, 0xa7, 0x8c, 0xbd, 0x18, 0x1f, 0x30, 0x0f, 0x00, 0x56, 0x0c, 0x96, 0x51, 0xdc, 0xcf,
+	0xc6, 0x44, 0x56, 0x00, 0x96, 0x9f, 0xb0, 0x91, 0xb1, 0xaa, 0xdc, 0x69, 0xd0, 0xe2, 0xdf, 0x32,
+	0x8a, 0x47, 0x59, 0x57, 0xf3, 0x0b, 0x36, 0xa5, 0x77, 0xa8, 0x7f, 0x48, 0x1b, 0x0c, 0x69, 0xc4,
+	0xa4, 0xa3, 0xab, 0x7a, 0x95, 0x2b, 0xc6, 0x0f, 0x9a, 0x36, 0x01, 0xa5, 0x55, 0x20, 0xfe, 0x93,
+	0x3a, 0xef, 0x3a, 0xf7, 0x6d, 0x83, 0x9f, 0xb1, 0x63, 0xe5, 0xbc, 0x07, 0x85, 0xf9, 0x06, 0xa4,
+	0x16, 0x23, 0x9a, 0x7a, 0xd4, 0xb2, 0x07, 0x90, 0x34, 0xf8, 0x57, 0x41, 0xe9, 0xd7, 0x80, 0x62,
+	0x4c, 0xd2, 0xa4, 0xa5, 0x4f, 0x04, 0xef, 0x6e, 0x3e, 0xf6, 0x8b, 0xe8, 0x73, 0xbf, 0x88, 0xbe,
+	0xf7, 0x8b, 0xe8, 0x39, 0x59, 0x1b, 0xdc, 0xec, 0x8a, 0x44, 0xb9, 0x2a, 0xa5, 0x03, 0x48, 0x34,
+	0xaa, 0x94, 0x45, 0x68, 0xaa, 0xf4, 0xcf, 0xf9, 0x8a, 0x21, 0x81, 0xeb, 0x9f, 0x01, 0x00, 0x58,
+	0x75, 0x23, 0x32, 0xd8, 0x01, 0x00, 0x00,
+}
+
+func (m *AttestationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CorrectTarget {
+		i--
+		if m.CorrectTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorrectHead {
+		i--
+		if m.CorrectHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.InclusionSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FirstSeen != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.FirstSeen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slot != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestationRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestationRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.ValidatorIndex))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.CommitteeIndex))
+	}
+	if m.FirstSeen != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.FirstSeen))
+	}
+	if m.Included {
+		n += 2
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.InclusionDistance))
+	}
+	if m.CorrectHead {
+		n += 2
+	}
+	if m.CorrectTarget {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAttestationRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestationRecord(x uint64) (n int) {
+	return sovAttestationRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
+			}
+			m.FirstSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Included = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
+			}
+			m.InclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectHead = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectTarget = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestationRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestationRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestationRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestationRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestationRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestationRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestationRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestationRecord = fmt.Errorf("proto: unexpected end of group")
+)
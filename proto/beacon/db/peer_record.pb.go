@@ -0,0 +1,1060 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/peer_record.proto
+
+package db
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PeerRecords struct {
+	Peers                []*PeerRecord `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PeerRecords) Reset()         { *m = PeerRecords{} }
+func (m *PeerRecords) String() string { return proto.CompactTextString(m) }
+func (*PeerRecords) ProtoMessage()    {}
+func (*PeerRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf8d82b28084783, []int{0}
+}
+func (m *PeerRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRecords.Merge(m, src)
+}
+func (m *PeerRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRecords proto.InternalMessageInfo
+
+func (m *PeerRecords) GetPeers() []*PeerRecord {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerRecord struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Outbound             bool     `protobuf:"varint,3,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	BadResponses         int64    `protobuf:"varint,4,opt,name=bad_responses,json=badResponses,proto3" json:"bad_responses,omitempty"`
+	ProcessedBlocks      uint64   `protobuf:"varint,5,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	GossipScore          float64  `protobuf:"fixed64,6,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
+	BehaviourPenalty     float64  `protobuf:"fixed64,7,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	LastSeen             int64    `protobuf:"varint,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	BannedUntil          int64    `protobuf:"varint,9,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerRecord) Reset()         { *m = PeerRecord{} }
+func (m *PeerRecord) String() string { return proto.CompactTextString(m) }
+func (*PeerRecord) ProtoMessage()    {}
+func (*PeerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf8d82b28084783, []int{1}
+}
+func (m *PeerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRecord.Merge(m, src)
+}
+func (m *PeerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRecord proto.InternalMessageInfo
+
+func (m *PeerRecord) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *PeerRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerRecord) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+func (m *PeerRecord) GetBadResponses() int64 {
+	if m != nil {
+		return m.BadResponses
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetProcessedBlocks() uint64 {
+	if m != nil {
+		return m.ProcessedBlocks
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetGossipScore() float64 {
+	if m != nil {
+		return m.GossipScore
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetBehaviourPenalty() float64 {
+	if m != nil {
+		return m.BehaviourPenalty
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+type PeerRules struct {
+	Trusted              []string `protobuf:"bytes,1,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	Banned               []string `protobuf:"bytes,2,rep,name=banned,proto3" json:"banned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerRules) Reset()         { *m = PeerRules{} }
+func (m *PeerRules) String() string { return proto.CompactTextString(m) }
+func (*PeerRules) ProtoMessage()    {}
+func (*PeerRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf8d82b28084783, []int{2}
+}
+func (m *PeerRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRules.Merge(m, src)
+}
+func (m *PeerRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRules proto.InternalMessageInfo
+
+func (m *PeerRules) GetTrusted() []string {
+	if m != nil {
+		return m.Trusted
+	}
+	return nil
+}
+
+func (m *PeerRules) GetBanned() []string {
+	if m != nil {
+		return m.Banned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PeerRecords)(nil), "prysm.beacon.db.PeerRecords")
+	proto.RegisterType((*PeerRecord)(nil), "prysm.beacon.db.PeerRecord")
+	proto.RegisterType((*PeerRules)(nil), "prysm.beacon.db.PeerRules")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/peer_record.proto", fileDescriptor_faf8d82b28084783) }
+
+var fileDescriptor_faf8d82b28084783 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x8a, 0xdb, 0x30,
+	0x10, 0x86, 0x51, 0xb2, 0xeb, 0xd8, 0xf2, 0x96, 0xdd, 0xea, 0xd0, 0x8a, 0x06, 0x82, 0x93, 0x5e,
+	0x5c, 0x0a, 0x36, 0x6d, 0xaf, 0x2d, 0x94, 0xdc, 0x7a, 0x0b, 0x0a, 0xbd, 0xf4, 0x62, 0x24, 0x6b,
+	0x48, 0x4c, 0x1d, 0xc9, 0x68, 0xe4, 0x42, 0x9e, 0xad, 0x2f, 0xd0, 0x63, 0x1f, 0xa1, 0xe4, 0x49,
+	0x8a, 0xe5, 0x4d, 0x02, 0x39, 0xfe, 0xdf, 0xff, 0x0d, 0x63, 0xc6, 0xa2, 0xcb, 0xce, 0x59, 0x6f,
+	0x4b, 0x05, 0xb2, 0xb6, 0xa6, 0xd4, 0xaa, 0xec, 0x00, 0x5c, 0xe5, 0xa0, 0xb6, 0x4e, 0x17, 0xa1,
+	0x63, 0x8f, 0x9d, 0x3b, 0xe2, 0xa1, 0x18, 0x95, 0x42, 0xab, 0xd5, 0x57, 0x9a, 0x6e, 0x00, 0x9c,
+	0x08, 0x12, 0xb2, 0x0f, 0xf4, 0x7e, 0x18, 0x42, 0x4e, 0xb2, 0x69, 0x9e, 0x7e, 0x9c, 0x17, 0x37,
+	0x7e, 0x71, 0x95, 0xc5, 0x68, 0xae, 0x7e, 0x4f, 0x28, 0xbd, 0x52, 0xf6, 0x9a, 0xce, 0xc2, 0xda,
+	0x46, 0x73, 0x92, 0x91, 0x3c, 0x11, 0xd1, 0x10, 0xbf, 0x69, 0xc6, 0xe9, 0x4c, 0x6a, 0xed, 0x00,
+	0x91, 0x4f, 0x42, 0x71, 0x8e, 0xec, 0x0d, 0x8d, 0x6d, 0xef, 0x95, 0xed, 0x8d, 0xe6, 0xd3, 0x8c,
+	0xe4, 0xb1, 0xb8, 0x64, 0xf6, 0x96, 0xbe, 0x50, 0x52, 0x57, 0x0e, 0xb0, 0xb3, 0x06, 0x01, 0xf9,
+	0x5d, 0x46, 0xf2, 0xa9, 0x78, 0x50, 0x52, 0x8b, 0x33, 0x63, 0xef, 0xe8, 0x53, 0xe7, 0x6c, 0x0d,
+	0x88, 0xa0, 0x2b, 0xd5, 0xda, 0xfa, 0x27, 0xf2, 0xfb, 0x8c, 0xe4, 0x77, 0xe2, 0xf1, 0xc2, 0xd7,
+	0x01, 0xb3, 0x25, 0x7d, 0xd8, 0x59, 0xc4, 0xa6, 0xab, 0xb0, 0xb6, 0x0e, 0x78, 0x94, 0x91, 0x9c,
+	0x88, 0x74, 0x64, 0xdb, 0x01, 0xb1, 0xf7, 0xf4, 0xa5, 0x82, 0xbd, 0xfc, 0xd5, 0xd8, 0xde, 0x55,
+	0x1d, 0x18, 0xd9, 0xfa, 0x23, 0x9f, 0x05, 0xef, 0xe9, 0x52, 0x6c, 0x46, 0xce, 0xe6, 0x34, 0x69,
+	0x25, 0xfa, 0x0a, 0x01, 0x0c, 0x8f, 0xc3, 0xb7, 0xc5, 0x03, 0xd8, 0x02, 0x98, 0x61, 0x99, 0x92,
+	0xc6, 0x80, 0xae, 0x7a, 0xe3, 0x9b, 0x96, 0x27, 0xa1, 0x4f, 0x47, 0xf6, 0x7d, 0x40, 0xab, 0x2f,
+	0x34, 0x09, 0xc7, 0xeb, 0x5b, 0xc0, 0xe1, 0x44, 0xde, 0xf5, 0xe8, 0x41, 0x87, 0xfb, 0x27, 0xe2,
+	0x1c, 0xd9, 0x2b, 0x1a, 0x8d, 0x53, 0x7c, 0x12, 0x8a, 0xe7, 0xb4, 0xfe, 0xfc, 0xe7, 0xb4, 0x20,
+	0x7f, 0x4f, 0x0b, 0xf2, 0xef, 0xb4, 0x20, 0x3f, 0x8a, 0x5d, 0xe3, 0xf7, 0xbd, 0x2a, 0x6a, 0x7b,
+	0x28, 0xc3, 0x8f, 0x93, 0xbe, 0xa9, 0x5b, 0xa9, 0x70, 0x4c, 0xe5, 0xcd, 0xfb, 0x50, 0x51, 0x00,
+	0x9f, 0xfe, 0x0f, 0x00, 0xd2, 0x76, 0xc1, 0xbe, 0x39, 0x02, 0x00, 0x00,
+}
+
+func (m *PeerRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPeerRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BannedUntil != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.BannedUntil))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastSeen != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.LastSeen))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BehaviourPenalty != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BehaviourPenalty))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.GossipScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GossipScore))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ProcessedBlocks != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.ProcessedBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BadResponses != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.BadResponses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outbound {
+		i--
+		if m.Outbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Banned) > 0 {
+		for iNdEx := len(m.Banned) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Banned[iNdEx])
+			copy(dAtA[i:], m.Banned[iNdEx])
+			i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.Banned[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Trusted) > 0 {
+		for iNdEx := len(m.Trusted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Trusted[iNdEx])
+			copy(dAtA[i:], m.Trusted[iNdEx])
+			i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.Trusted[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeerRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeerRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeerRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovPeerRecord(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPeerRecord(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPeerRecord(uint64(l))
+	}
+	if m.Outbound {
+		n += 2
+	}
+	if m.BadResponses != 0 {
+		n += 1 + sovPeerRecord(uint64(m.BadResponses))
+	}
+	if m.ProcessedBlocks != 0 {
+		n += 1 + sovPeerRecord(uint64(m.ProcessedBlocks))
+	}
+	if m.GossipScore != 0 {
+		n += 9
+	}
+	if m.BehaviourPenalty != 0 {
+		n += 9
+	}
+	if m.LastSeen != 0 {
+		n += 1 + sovPeerRecord(uint64(m.LastSeen))
+	}
+	if m.BannedUntil != 0 {
+		n += 1 + sovPeerRecord(uint64(m.BannedUntil))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trusted) > 0 {
+		for _, s := range m.Trusted {
+			l = len(s)
+			n += 1 + l + sovPeerRecord(uint64(l))
+		}
+	}
+	if len(m.Banned) > 0 {
+		for _, s := range m.Banned {
+			l = len(s)
+			n += 1 + l + sovPeerRecord(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPeerRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeerRecord(x uint64) (n int) {
+	return sovPeerRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PeerRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerRecord{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outbound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadResponses", wireType)
+			}
+			m.BadResponses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadResponses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedBlocks", wireType)
+			}
+			m.ProcessedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipScore", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GossipScore = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BehaviourPenalty", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BehaviourPenalty = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+			}
+			m.LastSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedUntil", wireType)
+			}
+			m.BannedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BannedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trusted = append(m.Trusted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Banned = append(m.Banned, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeerRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeerRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeerRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeerRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeerRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeerRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeerRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2279 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/state_diff.proto
+
+package db
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StateDiff struct {
+	BaseRoot                    []byte                      `protobuf:"bytes,1,opt,name=base_root,json=baseRoot,proto3" json:"base_root,omitempty"`
+	Slot                        uint64                      `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Fork                        *v1.Fork                    `protobuf:"bytes,3,opt,name=fork,proto3" json:"fork,omitempty"`
+	LatestBlockHeader           *v1alpha1.BeaconBlockHeader `protobuf:"bytes,4,opt,name=latest_block_header,json=latestBlockHeader,proto3" json:"latest_block_header,omitempty"`
+	BlockRoots                  []*IndexedRoot              `protobuf:"bytes,5,rep,name=block_roots,json=blockRoots,proto3" json:"block_roots,omitempty"`
+	StateRoots                  []*IndexedRoot              `protobuf:"bytes,6,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots,omitempty"`
+	AppendedHistoricalRoots     [][]byte                    `protobuf:"bytes,7,rep,name=appended_historical_roots,json=appendedHistoricalRoots,proto3" json:"appended_historical_roots,omitempty"`
+	Eth1Data                    *v1alpha1.Eth1Data          `protobuf:"bytes,8,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Eth1DataVotes               []*v1alpha1.Eth1Data        `protobuf:"bytes,9,rep,name=eth1_data_votes,json=eth1DataVotes,proto3" json:"eth1_data_votes,omitempty"`
+	Eth1DepositIndex            uint64                      `protobuf:"varint,10,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	Validators                  []*IndexedValidator         `protobuf:"bytes,11,rep,name=validators,proto3" json:"validators,omitempty"`
+	BalanceDeltas               []int64                     `protobuf:"zigzag64,12,rep,packed,name=balance_deltas,json=balanceDeltas,proto3" json:"balance_deltas,omitempty"`
+	AppendedBalances            []uint64                    `protobuf:"varint,13,rep,packed,name=appended_balances,json=appendedBalances,proto3" json:"appended_balances,omitempty"`
+	RandaoMixes                 []*IndexedRoot              `protobuf:"bytes,14,rep,name=randao_mixes,json=randaoMixes,proto3" json:"randao_mixes,omitempty"`
+	Slashings                   []*IndexedSlashing          `protobuf:"bytes,15,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	PreviousEpochAttestations   []*v1.PendingAttestation    `protobuf:"bytes,16,rep,name=previous_epoch_attestations,json=previousEpochAttestations,proto3" json:"previous_epoch_attestations,omitempty"`
+	CurrentEpochAttestations    []*v1.PendingAttestation    `protobuf:"bytes,17,rep,name=current_epoch_attestations,json=currentEpochAttestations,proto3" json:"current_epoch_attestations,omitempty"`
+	JustificationBits           []byte                      `protobuf:"bytes,18,opt,name=justification_bits,json=justificationBits,proto3" json:"justification_bits,omitempty"`
+	PreviousJustifiedCheckpoint *v1alpha1.Checkpoint        `protobuf:"bytes,19,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	CurrentJustifiedCheckpoint  *v1alpha1.Checkpoint        `protobuf:"bytes,20,opt,name=current_justified_checkpoint,json=currentJustifiedCheckpoint,proto3" json:"current_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint         *v1alpha1.Checkpoint        `protobuf:"bytes,21,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}                    `json:"-"`
+	XXX_unrecognized            []byte                      `json:"-"`
+	XXX_sizecache               int32                       `json:"-"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038db4b8033eb696, []int{0}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetBaseRoot() []byte {
+	if m != nil {
+		return m.BaseRoot
+	}
+	return nil
+}
+
+func (m *StateDiff) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *StateDiff) GetFork() *v1.Fork {
+	if m != nil {
+		return m.Fork
+	}
+	return nil
+}
+
+func (m *StateDiff) GetLatestBlockHeader() *v1alpha1.BeaconBlockHeader {
+	if m != nil {
+		return m.LatestBlockHeader
+	}
+	return nil
+}
+
+func (m *StateDiff) GetBlockRoots() []*IndexedRoot {
+	if m != nil {
+		return m.BlockRoots
+	}
+	return nil
+}
+
+func (m *StateDiff) GetStateRoots() []*IndexedRoot {
+	if m != nil {
+		return m.StateRoots
+	}
+	return nil
+}
+
+func (m *StateDiff) GetAppendedHistoricalRoots() [][]byte {
+	if m != nil {
+		return m.AppendedHistoricalRoots
+	}
+	return nil
+}
+
+func (m *StateDiff) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
+func (m *StateDiff) GetEth1DataVotes() []*v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1DataVotes
+	}
+	return nil
+}
+
+func (m *StateDiff) GetEth1DepositIndex() uint64 {
+	if m != nil {
+		return m.Eth1DepositIndex
+	}
+	return 0
+}
+
+func (m *StateDiff) GetValidators() []*IndexedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *StateDiff) GetBalanceDeltas() []int64 {
+	if m != nil {
+		return m.BalanceDeltas
+	}
+	return nil
+}
+
+func (m *StateDiff) GetAppendedBalances() []uint64 {
+	if m != nil {
+		return m.AppendedBalances
+	}
+	return nil
+}
+
+func (m *StateDiff) GetRandaoMixes() []*IndexedRoot {
+	if m != nil {
+		return m.RandaoMixes
+	}
+	return nil
+}
+
+func (m *StateDiff) GetSlashings() []*IndexedSlashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPreviousEpochAttestations() []*v1.PendingAttestation {
+	if m != nil {
+		return m.PreviousEpochAttestations
+	}
+	return nil
+}
+
+func (m *StateDiff) GetCurrentEpochAttestations() []*v1.PendingAttestation {
+	if m != nil {
+		return m.CurrentEpochAttestations
+	}
+	return nil
+}
+
+func (m *StateDiff) GetJustificationBits() []byte {
+	if m != nil {
+		return m.JustificationBits
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.CurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.FinalizedCheckpoint
+	}
+	return nil
+}
+
+type IndexedRoot struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexedRoot) Reset()         { *m = IndexedRoot{} }
+func (m *IndexedRoot) String() string { return proto.CompactTextString(m) }
+func (*IndexedRoot) ProtoMessage()    {}
+func (*IndexedRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038db4b8033eb696, []int{1}
+}
+func (m *IndexedRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedRoot.Merge(m, src)
+}
+func (m *IndexedRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedRoot proto.InternalMessageInfo
+
+func (m *IndexedRoot) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *IndexedRoot) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type IndexedValidator struct {
+	Index                uint64              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Validator            *v1alpha1.Validator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *IndexedValidator) Reset()         { *m = IndexedValidator{} }
+func (m *IndexedValidator) String() string { return proto.CompactTextString(m) }
+func (*IndexedValidator) ProtoMessage()    {}
+func (*IndexedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038db4b8033eb696, []int{2}
+}
+func (m *IndexedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedValidator.Merge(m, src)
+}
+func (m *IndexedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedValidator proto.InternalMessageInfo
+
+func (m *IndexedValidator) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *IndexedValidator) GetValidator() *v1alpha1.Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+type IndexedSlashing struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexedSlashing) Reset()         { *m = IndexedSlashing{} }
+func (m *IndexedSlashing) String() string { return proto.CompactTextString(m) }
+func (*IndexedSlashing) ProtoMessage()    {}
+func (*IndexedSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038db4b8033eb696, []int{3}
+}
+func (m *IndexedSlashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedSlashing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedSlashing.Merge(m, src)
+}
+func (m *IndexedSlashing) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedSlashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedSlashing proto.InternalMessageInfo
+
+func (m *IndexedSlashing) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *IndexedSlashing) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StateDiff)(nil), "prysm.beacon.db.StateDiff")
+	proto.RegisterType((*IndexedRoot)(nil), "prysm.beacon.db.IndexedRoot")
+	proto.RegisterType((*IndexedValidator)(nil), "prysm.beacon.db.IndexedValidator")
+	proto.RegisterType((*IndexedSlashing)(nil), "prysm.beacon.db.IndexedSlashing")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/state_diff.proto", fileDescriptor_038db4b8033eb696) }
+
+var fileDescriptor_038db4b8033eb696 = []byte{
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xf3, 0x34,
+	0x14, 0x56, 0x68, 0xdf, 0xb1, 0x9e, 0x76, 0x6f, 0x57, 0x6f, 0x80, 0xdf, 0x6d, 0x74, 0x59, 0x25,
+	0xa4, 0x8a, 0x8f, 0x84, 0x8e, 0x0b, 0x24, 0x34, 0x36, 0xad, 0x6c, 0x30, 0x90, 0x90, 0x50, 0x86,
+	0x26, 0xc4, 0x4d, 0xe4, 0x24, 0xee, 0xe2, 0x35, 0x8d, 0xa3, 0xd8, 0xad, 0x36, 0x7e, 0x21, 0x97,
+	0xfc, 0x04, 0xb4, 0x1f, 0xc1, 0x35, 0xb2, 0x9d, 0xa4, 0x1f, 0xb4, 0x52, 0xb9, 0x73, 0xce, 0x73,
+	0x9e, 0xe7, 0x7c, 0xf8, 0x1c, 0x07, 0xec, 0x2c, 0xe7, 0x92, 0xbb, 0x01, 0x25, 0x21, 0x4f, 0xdd,
+	0x28, 0x70, 0x85, 0x24, 0x92, 0xfa, 0x11, 0x1b, 0x8d, 0x1c, 0x0d, 0xa1, 0x76, 0x96, 0xbf, 0x88,
+	0x89, 0x63, 0x3c, 0x9c, 0x28, 0x38, 0x3a, 0xa5, 0x32, 0x76, 0x67, 0x03, 0x92, 0x64, 0x31, 0x19,
+	0x14, 0x4c, 0x3f, 0x48, 0x78, 0x38, 0x36, 0x8c, 0xa3, 0xee, 0x92, 0x03, 0x91, 0x92, 0x2a, 0x51,
+	0xc6, 0xd3, 0x02, 0x3f, 0x59, 0xc2, 0x67, 0x24, 0x61, 0x11, 0x91, 0x3c, 0x2f, 0xd0, 0xd3, 0xa5,
+	0x8c, 0xb2, 0xf3, 0xcc, 0x9d, 0x0d, 0x5c, 0xf9, 0x92, 0x51, 0x61, 0x1c, 0x7a, 0xff, 0x00, 0x34,
+	0xee, 0x55, 0x96, 0x37, 0x6c, 0x34, 0x42, 0xc7, 0xd0, 0x08, 0x88, 0xa0, 0x7e, 0xce, 0xb9, 0xc4,
+	0x96, 0x6d, 0xf5, 0x5b, 0xde, 0xae, 0x32, 0x78, 0x9c, 0x4b, 0x84, 0xa0, 0x2e, 0x12, 0x2e, 0xf1,
+	0x7b, 0xb6, 0xd5, 0xaf, 0x7b, 0xfa, 0x8c, 0xbe, 0x84, 0xfa, 0x88, 0xe7, 0x63, 0x5c, 0xb3, 0xad,
+	0x7e, 0xf3, 0xfc, 0xc4, 0xa1, 0x32, 0xa6, 0x39, 0x9d, 0x56, 0x15, 0x66, 0xe7, 0x99, 0x33, 0x1b,
+	0x38, 0xdf, 0xf3, 0x7c, 0xec, 0x69, 0x4f, 0xf4, 0x1b, 0x1c, 0x24, 0x44, 0x15, 0x61, 0xaa, 0xf4,
+	0x63, 0x4a, 0x22, 0x9a, 0xe3, 0xba, 0x16, 0xe8, 0xcf, 0x05, 0xa8, 0x8c, 0x9d, 0xb2, 0x2c, 0x67,
+	0xa8, 0xd5, 0x86, 0x8a, 0x70, 0xa7, 0xfd, 0xbd, 0x8e, 0x11, 0x59, 0x30, 0xa1, 0x6f, 0xa1, 0x69,
+	0x24, 0x55, 0xf6, 0x02, 0xbf, 0xb1, 0x6b, 0x3a, 0xa5, 0x95, 0x8e, 0x3b, 0x3f, 0xa6, 0x11, 0x7d,
+	0xa6, 0x91, 0x2a, 0xc9, 0x03, 0x4d, 0x50, 0x47, 0xa1, 0xe8, 0xe6, 0xba, 0x0c, 0x7d, 0x67, 0x1b,
+	0xba, 0x26, 0x18, 0xfa, 0x37, 0xf0, 0x8e, 0x64, 0x19, 0x4d, 0x23, 0x1a, 0xf9, 0x31, 0x13, 0x92,
+	0xe7, 0x2c, 0x24, 0x49, 0x21, 0xf6, 0xbe, 0x5d, 0xeb, 0xb7, 0xbc, 0x8f, 0x4a, 0x87, 0xbb, 0x0a,
+	0x37, 0xdc, 0x0b, 0x68, 0x50, 0x19, 0x0f, 0xfc, 0x88, 0x48, 0x82, 0x77, 0x75, 0x27, 0x4e, 0x37,
+	0x74, 0xe2, 0x56, 0xc6, 0x83, 0x1b, 0x22, 0x89, 0xb7, 0x4b, 0x8b, 0x13, 0xfa, 0x01, 0xda, 0x15,
+	0xdb, 0x9f, 0x71, 0x49, 0x05, 0x6e, 0xd8, 0xb5, 0x6d, 0x34, 0xf6, 0x4a, 0x8d, 0x07, 0xc5, 0x42,
+	0x9f, 0x03, 0x32, 0x42, 0x34, 0xe3, 0x82, 0x49, 0x9f, 0xa9, 0x52, 0x31, 0xe8, 0xeb, 0xde, 0xd7,
+	0xae, 0x06, 0xd0, 0x2d, 0x40, 0xd7, 0x00, 0xd5, 0xb4, 0x09, 0xdc, 0xd4, 0x11, 0xcf, 0x36, 0xb5,
+	0xeb, 0xa1, 0xf4, 0xf4, 0x16, 0x48, 0xe8, 0x13, 0x78, 0x1b, 0x90, 0x84, 0xa4, 0x21, 0xf5, 0x23,
+	0x9a, 0x48, 0x22, 0x70, 0xcb, 0xae, 0xf5, 0x91, 0xb7, 0x57, 0x58, 0x6f, 0xb4, 0x11, 0x7d, 0x06,
+	0x9d, 0xaa, 0xb5, 0x05, 0x22, 0xf0, 0x9e, 0x5d, 0x53, 0x69, 0x95, 0xc0, 0xb0, 0xb0, 0xa3, 0x2b,
+	0x68, 0xe5, 0x24, 0x8d, 0x08, 0xf7, 0x27, 0xec, 0x99, 0x0a, 0xfc, 0x76, 0x8b, 0x7b, 0x6c, 0x1a,
+	0xc6, 0xcf, 0x8a, 0x80, 0x2e, 0xa1, 0x21, 0x12, 0x22, 0x62, 0x96, 0x3e, 0x0a, 0xdc, 0xd6, 0x6c,
+	0x7b, 0x13, 0xfb, 0xbe, 0x70, 0xf4, 0xe6, 0x14, 0xf4, 0x04, 0xc7, 0x59, 0x4e, 0x67, 0x8c, 0x4f,
+	0x85, 0x4f, 0x33, 0x1e, 0xc6, 0xfe, 0xc2, 0xd2, 0x0a, 0xbc, 0xaf, 0x15, 0x3f, 0xdd, 0xb4, 0x29,
+	0xbf, 0xd0, 0x34, 0x62, 0xe9, 0xe3, 0xf5, 0x9c, 0xe2, 0xbd, 0x2b, 0xe5, 0x6e, 0x95, 0xda, 0x02,
+	0x22, 0x50, 0x0c, 0x47, 0xe1, 0x34, 0xcf, 0x69, 0x2a, 0xd7, 0x85, 0xea, 0xfc, 0xef, 0x50, 0xb8,
+	0x50, 0xfb, 0x6f, 0xa4, 0x2f, 0x00, 0x3d, 0x4d, 0x85, 0x64, 0x23, 0x16, 0x6a, 0x8b, 0x1f, 0x30,
+	0x29, 0x30, 0xd2, 0x4f, 0x44, 0x67, 0x09, 0x19, 0x32, 0x29, 0x10, 0x85, 0x8f, 0xab, 0x26, 0x14,
+	0x28, 0x8d, 0xfc, 0x30, 0xa6, 0xe1, 0x38, 0xe3, 0x2c, 0x95, 0xf8, 0x40, 0x4f, 0xf9, 0xd9, 0x86,
+	0x09, 0xfd, 0xae, 0x72, 0xf4, 0xaa, 0x66, 0xfe, 0x54, 0xca, 0xcc, 0x41, 0x14, 0xc2, 0x49, 0x59,
+	0xff, 0xda, 0x28, 0x87, 0xdb, 0x46, 0x29, 0xdb, 0xb8, 0x2e, 0xc8, 0xaf, 0x70, 0x38, 0x62, 0x29,
+	0x49, 0xd8, 0x1f, 0xcb, 0xe2, 0x1f, 0x6c, 0x2b, 0x7e, 0x50, 0xd1, 0xe7, 0xc6, 0xde, 0xd7, 0xd0,
+	0x5c, 0x18, 0x41, 0x74, 0x08, 0x6f, 0xcc, 0xba, 0x59, 0x7a, 0xdd, 0xcc, 0x87, 0x7a, 0x72, 0x73,
+	0x5e, 0x3c, 0xb9, 0x2d, 0x4f, 0x9f, 0x7b, 0x31, 0xec, 0xaf, 0x2e, 0xd5, 0x06, 0xf6, 0x25, 0x34,
+	0xaa, 0x65, 0xd3, 0x12, 0x6a, 0x92, 0xd7, 0x67, 0x3b, 0xdf, 0xcf, 0x39, 0xa5, 0x77, 0x05, 0xed,
+	0x95, 0x39, 0xdf, 0x10, 0xe8, 0x43, 0xd8, 0x21, 0x13, 0x3e, 0x4d, 0xcb, 0x7f, 0x43, 0xf1, 0x35,
+	0xbc, 0xf8, 0xf3, 0xb5, 0x6b, 0xfd, 0xf5, 0xda, 0xb5, 0xfe, 0x7e, 0xed, 0x5a, 0xbf, 0x3b, 0x8f,
+	0x4c, 0xc6, 0xd3, 0xc0, 0x09, 0xf9, 0xc4, 0xd5, 0xfb, 0x44, 0x24, 0x0b, 0x13, 0x12, 0x08, 0xf3,
+	0xe5, 0xae, 0xfc, 0x3c, 0x83, 0x1d, 0x6d, 0xf8, 0xea, 0xdf, 0x01, 0x00, 0x8d, 0x7e, 0x69, 0x78,
+	0x56, 0x07, 0x00, 0x00,
+}
+
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinalizedCheckpoint != nil {
+		{
+			size, err := m.FinalizedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		{
+			size, err := m.CurrentJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		{
+			size, err := m.PreviousJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.JustificationBits) > 0 {
+		i -= len(m.JustificationBits)
+		copy(dAtA[i:], m.JustificationBits)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.JustificationBits)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CurrentEpochAttestations) > 0 {
+		for iNdEx := len(m.CurrentEpochAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PreviousEpochAttestations) > 0 {
+		for iNdEx := len(m.PreviousEpochAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousEpochAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RandaoMixes) > 0 {
+		for iNdEx := len(m.RandaoMixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RandaoMixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AppendedBalances) > 0 {
+		dAtA5 := make([]byte, len(m.AppendedBalances)*10)
+		var j4 int
+		for _, num := range m.AppendedBalances {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStateDiff(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BalanceDeltas) > 0 {
+		var j6 int
+		dAtA8 := make([]byte, len(m.BalanceDeltas)*10)
+		for _, num := range m.BalanceDeltas {
+			x7 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x7 >= 1<<7 {
+				dAtA8[j6] = uint8(uint64(x7)&0x7f | 0x80)
+				j6++
+				x7 >>= 7
+			}
+			dAtA8[j6] = uint8(x7)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA8[:j6])
+		i = encodeVarintStateDiff(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Eth1DepositIndex != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Eth1DepositIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Eth1DataVotes) > 0 {
+		for iNdEx := len(m.Eth1DataVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Eth1DataVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Eth1Data != nil {
+		{
+			size, err := m.Eth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AppendedHistoricalRoots) > 0 {
+		for iNdEx := len(m.AppendedHistoricalRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AppendedHistoricalRoots[iNdEx])
+			copy(dAtA[i:], m.AppendedHistoricalRoots[iNdEx])
+			i = encodeVarintStateDiff(dAtA, i, uint64(len(m.AppendedHistoricalRoots[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.StateRoots) > 0 {
+		for iNdEx := len(m.StateRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BlockRoots) > 0 {
+		for iNdEx := len(m.BlockRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LatestBlockHeader != nil {
+		{
+			size, err := m.LatestBlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fork != nil {
+		{
+			size, err := m.Fork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BaseRoot) > 0 {
+		i -= len(m.BaseRoot)
+		copy(dAtA[i:], m.BaseRoot)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.BaseRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStateDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStateDiff(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStateDiff(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseRoot)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovStateDiff(uint64(m.Slot))
+	}
+	if m.Fork != nil {
+		l = m.Fork.Size()
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.LatestBlockHeader != nil {
+		l = m.LatestBlockHeader.Size()
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if len(m.BlockRoots) > 0 {
+		for _, e := range m.BlockRoots {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.StateRoots) > 0 {
+		for _, e := range m.StateRoots {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.AppendedHistoricalRoots) > 0 {
+		for _, b := range m.AppendedHistoricalRoots {
+			l = len(b)
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if len(m.Eth1DataVotes) > 0 {
+		for _, e := range m.Eth1DataVotes {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if m.Eth1DepositIndex != 0 {
+		n += 1 + sovStateDiff(uint64(m.Eth1DepositIndex))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.BalanceDeltas) > 0 {
+		l = 0
+		for _, e := range m.BalanceDeltas {
+			l += sozStateDiff(uint64(e))
+		}
+		n += 1 + sovStateDiff(uint64(l)) + l
+	}
+	if len(m.AppendedBalances) > 0 {
+		l = 0
+		for _, e := range m.AppendedBalances {
+			l += sovStateDiff(uint64(e))
+		}
+		n += 1 + sovStateDiff(uint64(l)) + l
+	}
+	if len(m.RandaoMixes) > 0 {
+		for _, e := range m.RandaoMixes {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.PreviousEpochAttestations) > 0 {
+		for _, e := range m.PreviousEpochAttestations {
+			l = e.Size()
+			n += 2 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.CurrentEpochAttestations) > 0 {
+		for _, e := range m.CurrentEpochAttestations {
+			l = e.Size()
+			n += 2 + l + sovStateDiff(uint64(l))
+		}
+	}
+	l = len(m.JustificationBits)
+	if l > 0 {
+		n += 2 + l + sovStateDiff(uint64(l))
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		l = m.PreviousJustifiedCheckpoint.Size()
+		n += 2 + l + sovStateDiff(uint64(l))
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		l = m.CurrentJustifiedCheckpoint.Size()
+		n += 2 + l + sovStateDiff(uint64(l))
+	}
+	if m.FinalizedCheckpoint != nil {
+		l = m.FinalizedCheckpoint.Size()
+		n += 2 + l + sovStateDiff(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexedRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStateDiff(uint64(m.Index))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStateDiff(uint64(m.Index))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexedSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStateDiff(uint64(m.Index))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovStateDiff(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStateDiff(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStateDiff(x uint64) (n int) {
+	return sovStateDiff(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRoot = append(m.BaseRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BaseRoot == nil {
+				m.BaseRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fork == nil {
+				m.Fork = &v1.Fork{}
+			}
+			if err := m.Fork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBlockHeader == nil {
+				m.LatestBlockHeader = &v1alpha1.BeaconBlockHeader{}
+			}
+			if err := m.LatestBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoots = append(m.BlockRoots, &IndexedRoot{})
+			if err := m.BlockRoots[len(m.BlockRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoots = append(m.StateRoots, &IndexedRoot{})
+			if err := m.StateRoots[len(m.StateRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendedHistoricalRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppendedHistoricalRoots = append(m.AppendedHistoricalRoots, make([]byte, postIndex-iNdEx))
+			copy(m.AppendedHistoricalRoots[len(m.AppendedHistoricalRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DataVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1DataVotes = append(m.Eth1DataVotes, &v1alpha1.Eth1Data{})
+			if err := m.Eth1DataVotes[len(m.Eth1DataVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositIndex", wireType)
+			}
+			m.Eth1DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &IndexedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStateDiff
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.BalanceDeltas = append(m.BalanceDeltas, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStateDiff
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStateDiff
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStateDiff
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BalanceDeltas) == 0 {
+					m.BalanceDeltas = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStateDiff
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.BalanceDeltas = append(m.BalanceDeltas, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDeltas", wireType)
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStateDiff
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AppendedBalances = append(m.AppendedBalances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStateDiff
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStateDiff
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStateDiff
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AppendedBalances) == 0 {
+					m.AppendedBalances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStateDiff
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AppendedBalances = append(m.AppendedBalances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendedBalances", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoMixes = append(m.RandaoMixes, &IndexedRoot{})
+			if err := m.RandaoMixes[len(m.RandaoMixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, &IndexedSlashing{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousEpochAttestations = append(m.PreviousEpochAttestations, &v1.PendingAttestation{})
+			if err := m.PreviousEpochAttestations[len(m.PreviousEpochAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochAttestations = append(m.CurrentEpochAttestations, &v1.PendingAttestation{})
+			if err := m.CurrentEpochAttestations[len(m.CurrentEpochAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustificationBits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustificationBits = append(m.JustificationBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustificationBits == nil {
+				m.JustificationBits = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousJustifiedCheckpoint == nil {
+				m.PreviousJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PreviousJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentJustifiedCheckpoint == nil {
+				m.CurrentJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.CurrentJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedCheckpoint == nil {
+				m.FinalizedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.FinalizedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &v1alpha1.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStateDiff(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStateDiff
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStateDiff
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStateDiff
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStateDiff        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStateDiff          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStateDiff = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1227 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/validator_performance.proto
+
+package db
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorPerformance struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Active               []bool   `protobuf:"varint,2,rep,packed,name=active,proto3" json:"active,omitempty"`
+	CorrectlyVotedSource []bool   `protobuf:"varint,3,rep,packed,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget []bool   `protobuf:"varint,4,rep,packed,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead   []bool   `protobuf:"varint,5,rep,packed,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	InclusionDistance    []uint64 `protobuf:"varint,6,rep,packed,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	BalanceChange        []int64  `protobuf:"zigzag64,7,rep,packed,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648d911ae0a771d2, []int{0}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetActive() []bool {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) GetCorrectlyVotedSource() []bool {
+	if m != nil {
+		return m.CorrectlyVotedSource
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) GetCorrectlyVotedTarget() []bool {
+	if m != nil {
+		return m.CorrectlyVotedTarget
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) GetCorrectlyVotedHead() []bool {
+	if m != nil {
+		return m.CorrectlyVotedHead
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) GetInclusionDistance() []uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) GetBalanceChange() []int64 {
+	if m != nil {
+		return m.BalanceChange
+	}
+	return nil
+}
+
+type ValidatorParticipation struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ActiveGwei           uint64   `protobuf:"varint,2,opt,name=active_gwei,json=activeGwei,proto3" json:"active_gwei,omitempty"`
+	SourceAttestingGwei  uint64   `protobuf:"varint,3,opt,name=source_attesting_gwei,json=sourceAttestingGwei,proto3" json:"source_attesting_gwei,omitempty"`
+	TargetAttestingGwei  uint64   `protobuf:"varint,4,opt,name=target_attesting_gwei,json=targetAttestingGwei,proto3" json:"target_attesting_gwei,omitempty"`
+	HeadAttestingGwei    uint64   `protobuf:"varint,5,opt,name=head_attesting_gwei,json=headAttestingGwei,proto3" json:"head_attesting_gwei,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{} }
+func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipation) ProtoMessage()    {}
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648d911ae0a771d2, []int{1}
+}
+func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipation.Merge(m, src)
+}
+func (m *ValidatorParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipation proto.InternalMessageInfo
+
+func (m *ValidatorParticipation) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetActiveGwei() uint64 {
+	if m != nil {
+		return m.ActiveGwei
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetSourceAttestingGwei() uint64 {
+	if m != nil {
+		return m.SourceAttestingGwei
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetTargetAttestingGwei() uint64 {
+	if m != nil {
+		return m.TargetAttestingGwei
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetHeadAttestingGwei() uint64 {
+	if m != nil {
+		return m.HeadAttestingGwei
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorPerformance)(nil), "prysm.beacon.db.ValidatorPerformance")
+	proto.RegisterType((*ValidatorParticipation)(nil), "prysm.beacon.db.ValidatorParticipation")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/validator_performance.proto", fileDescriptor_648d911ae0a771d2)
+}
+
+var fileDescriptor_648d911ae0a771d2 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcb, 0x6a, 0xe3, 0x30,
+	0x18, 0x85, 0x71, 0xec, 0x64, 0x06, 0x0d, 0x33, 0x43, 0x14, 0x4f, 0xd0, 0x2a, 0x63, 0x02, 0x05,
+	0x43, 0xa9, 0x5d, 0xda, 0x2e, 0xbb, 0xe9, 0x05, 0xda, 0x65, 0x71, 0x4b, 0x16, 0xdd, 0x18, 0x59,
+	0x56, 0x6d, 0x81, 0x63, 0x19, 0xf9, 0x4f, 0x42, 0xde, 0xac, 0x8f, 0xd0, 0x65, 0x1f, 0xa1, 0x64,
+	0xd3, 0xd7, 0x28, 0x96, 0x72, 0x21, 0x26, 0xdd, 0xf9, 0x3f, 0xdf, 0xf9, 0x16, 0x3e, 0x08, 0x1d,
+	0x57, 0x4a, 0x82, 0x0c, 0x13, 0x4e, 0x99, 0x2c, 0xc3, 0x34, 0x09, 0xe7, 0xb4, 0x10, 0x29, 0x05,
+	0xa9, 0xe2, 0x8a, 0xab, 0x17, 0xa9, 0xa6, 0xb4, 0x64, 0x3c, 0xd0, 0x2d, 0xfc, 0xb7, 0x52, 0xcb,
+	0x7a, 0x1a, 0x98, 0x72, 0x90, 0x26, 0xe3, 0xd7, 0x0e, 0x72, 0x27, 0x1b, 0xe1, 0x61, 0xd7, 0xc7,
+	0x2e, 0xea, 0xf2, 0x4a, 0xb2, 0x9c, 0x58, 0x9e, 0xe5, 0x3b, 0x91, 0x39, 0xf0, 0x10, 0xf5, 0x28,
+	0x03, 0x31, 0xe7, 0xa4, 0xe3, 0xd9, 0xfe, 0xcf, 0x68, 0x7d, 0xe1, 0x0b, 0x34, 0x64, 0x52, 0x29,
+	0xce, 0xa0, 0x58, 0xc6, 0x73, 0x09, 0x3c, 0x8d, 0x6b, 0x39, 0x53, 0x8c, 0x13, 0x5b, 0xf7, 0xdc,
+	0x2d, 0x9d, 0x34, 0xf0, 0x51, 0xb3, 0x43, 0x16, 0x50, 0x95, 0x71, 0x20, 0xce, 0x21, 0xeb, 0x49,
+	0x33, 0x7c, 0x8a, 0xdc, 0xb6, 0x95, 0x73, 0x9a, 0x92, 0xae, 0x76, 0xf0, 0xbe, 0x73, 0xcf, 0x69,
+	0x8a, 0x4f, 0x10, 0x16, 0x25, 0x2b, 0x66, 0xb5, 0x90, 0x65, 0x9c, 0x8a, 0x1a, 0x9a, 0x3f, 0x24,
+	0x3d, 0xcf, 0xf6, 0x9d, 0xa8, 0xbf, 0x25, 0xb7, 0x6b, 0x80, 0x8f, 0xd0, 0x9f, 0x84, 0x16, 0xcd,
+	0x67, 0xcc, 0x72, 0x5a, 0x66, 0x9c, 0xfc, 0xf0, 0x6c, 0x1f, 0x47, 0xbf, 0xd7, 0xe9, 0x8d, 0x0e,
+	0xc7, 0x9f, 0x16, 0x1a, 0xee, 0xa6, 0xa3, 0x0a, 0x04, 0x13, 0x15, 0x05, 0x21, 0xcb, 0x6f, 0xc6,
+	0xfb, 0x8f, 0x7e, 0x99, 0xb9, 0xe2, 0x6c, 0xc1, 0x05, 0xe9, 0x68, 0x86, 0x4c, 0x74, 0xb7, 0xe0,
+	0x02, 0x9f, 0xa1, 0x7f, 0x66, 0xb5, 0x98, 0x02, 0xf0, 0x1a, 0x44, 0x99, 0x99, 0xaa, 0xad, 0xab,
+	0x03, 0x03, 0xaf, 0x36, 0x6c, 0xe3, 0x98, 0xcd, 0xda, 0x8e, 0x63, 0x1c, 0x03, 0xf7, 0x9d, 0x00,
+	0x0d, 0x9a, 0xc5, 0xda, 0x46, 0x57, 0x1b, 0xfd, 0x06, 0xed, 0xf5, 0xaf, 0x2f, 0xdf, 0x56, 0x23,
+	0xeb, 0x7d, 0x35, 0xb2, 0x3e, 0x56, 0x23, 0xeb, 0x39, 0xc8, 0x04, 0xe4, 0xb3, 0x24, 0x60, 0x72,
+	0x1a, 0xea, 0xe7, 0x44, 0x41, 0xb0, 0x82, 0x26, 0xb5, 0xb9, 0xc2, 0xd6, 0x7b, 0x4c, 0x7a, 0x3a,
+	0x38, 0xff, 0x1a, 0x00, 0x7e, 0xa8, 0xd9, 0x71, 0xa9, 0x02, 0x00, 0x00,
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BalanceChange) > 0 {
+		var j1 int
+		dAtA3 := make([]byte, len(m.BalanceChange)*10)
+		for _, num := range m.BalanceChange {
+			x2 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x2 >= 1<<7 {
+				dAtA3[j1] = uint8(uint64(x2)&0x7f | 0x80)
+				j1++
+				x2 >>= 7
+			}
+			dAtA3[j1] = uint8(x2)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA3[:j1])
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InclusionDistance) > 0 {
+		dAtA5 := make([]byte, len(m.InclusionDistance)*10)
+		var j4 int
+		for _, num := range m.InclusionDistance {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CorrectlyVotedHead) > 0 {
+		for iNdEx := len(m.CorrectlyVotedHead) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.CorrectlyVotedHead[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.CorrectlyVotedHead)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CorrectlyVotedTarget) > 0 {
+		for iNdEx := len(m.CorrectlyVotedTarget) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.CorrectlyVotedTarget[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.CorrectlyVotedTarget)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CorrectlyVotedSource) > 0 {
+		for iNdEx := len(m.CorrectlyVotedSource) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.CorrectlyVotedSource[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.CorrectlyVotedSource)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Active) > 0 {
+		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Active[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.Active)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeadAttestingGwei != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.HeadAttestingGwei))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetAttestingGwei != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.TargetAttestingGwei))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceAttestingGwei != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.SourceAttestingGwei))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActiveGwei != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.ActiveGwei))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.Epoch))
+	}
+	if len(m.Active) > 0 {
+		n += 1 + sovValidatorPerformance(uint64(len(m.Active))) + len(m.Active)*1
+	}
+	if len(m.CorrectlyVotedSource) > 0 {
+		n += 1 + sovValidatorPerformance(uint64(len(m.CorrectlyVotedSource))) + len(m.CorrectlyVotedSource)*1
+	}
+	if len(m.CorrectlyVotedTarget) > 0 {
+		n += 1 + sovValidatorPerformance(uint64(len(m.CorrectlyVotedTarget))) + len(m.CorrectlyVotedTarget)*1
+	}
+	if len(m.CorrectlyVotedHead) > 0 {
+		n += 1 + sovValidatorPerformance(uint64(len(m.CorrectlyVotedHead))) + len(m.CorrectlyVotedHead)*1
+	}
+	if len(m.InclusionDistance) > 0 {
+		l = 0
+		for _, e := range m.InclusionDistance {
+			l += sovValidatorPerformance(uint64(e))
+		}
+		n += 1 + sovValidatorPerformance(uint64(l)) + l
+	}
+	if len(m.BalanceChange) > 0 {
+		l = 0
+		for _, e := range m.BalanceChange {
+			l += sozValidatorPerformance(uint64(e))
+		}
+		n += 1 + sovValidatorPerformance(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.Epoch))
+	}
+	if m.ActiveGwei != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.ActiveGwei))
+	}
+	if m.SourceAttestingGwei != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.SourceAttestingGwei))
+	}
+	if m.TargetAttestingGwei != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.TargetAttestingGwei))
+	}
+	if m.HeadAttestingGwei != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.HeadAttestingGwei))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorPerformance(x uint64) (n int) {
+	return sovValidatorPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Active = append(m.Active, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Active) == 0 {
+					m.Active = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Active = append(m.Active, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CorrectlyVotedSource = append(m.CorrectlyVotedSource, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.CorrectlyVotedSource) == 0 {
+					m.CorrectlyVotedSource = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CorrectlyVotedSource = append(m.CorrectlyVotedSource, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedSource", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CorrectlyVotedTarget = append(m.CorrectlyVotedTarget, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.CorrectlyVotedTarget) == 0 {
+					m.CorrectlyVotedTarget = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CorrectlyVotedTarget = append(m.CorrectlyVotedTarget, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedTarget", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CorrectlyVotedHead = append(m.CorrectlyVotedHead, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.CorrectlyVotedHead) == 0 {
+					m.CorrectlyVotedHead = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CorrectlyVotedHead = append(m.CorrectlyVotedHead, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedHead", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InclusionDistance = append(m.InclusionDistance, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InclusionDistance) == 0 {
+					m.InclusionDistance = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InclusionDistance = append(m.InclusionDistance, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.BalanceChange = append(m.BalanceChange, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorPerformance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorPerformance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BalanceChange) == 0 {
+					m.BalanceChange = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorPerformance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.BalanceChange = append(m.BalanceChange, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChange", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveGwei", wireType)
+			}
+			m.ActiveGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAttestingGwei", wireType)
+			}
+			m.SourceAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAttestingGwei", wireType)
+			}
+			m.TargetAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadAttestingGwei", wireType)
+			}
+			m.HeadAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorPerformance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1894 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/attestation_tracker.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StreamTrackedAttestationsRequest struct {
+	Indices              []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamTrackedAttestationsRequest) Reset()         { *m = StreamTrackedAttestationsRequest{} }
+func (m *StreamTrackedAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamTrackedAttestationsRequest) ProtoMessage()    {}
+func (*StreamTrackedAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e60d5f1bd473, []int{0}
+}
+func (m *StreamTrackedAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamTrackedAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamTrackedAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamTrackedAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamTrackedAttestationsRequest.Merge(m, src)
+}
+func (m *StreamTrackedAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamTrackedAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamTrackedAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamTrackedAttestationsRequest proto.InternalMessageInfo
+
+func (m *StreamTrackedAttestationsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type TrackedAttestation struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	FirstSeen            int64    `protobuf:"varint,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	Included             bool     `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,6,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,7,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectHead          bool     `protobuf:"varint,8,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget        bool     `protobuf:"varint,9,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	Final                bool     `protobuf:"varint,10,opt,name=final,proto3" json:"final,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackedAttestation) Reset()         { *m = TrackedAttestation{} }
+func (m *TrackedAttestation) String() string { return proto.CompactTextString(m) }
+func (*TrackedAttestation) ProtoMessage()    {}
+func (*TrackedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e60d5f1bd473, []int{1}
+}
+func (m *TrackedAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrackedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrackedAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrackedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackedAttestation.Merge(m, src)
+}
+func (m *TrackedAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrackedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackedAttestation proto.InternalMessageInfo
+
+func (m *TrackedAttestation) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetFirstSeen() int64 {
+	if m != nil {
+		return m.FirstSeen
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
+}
+
+func (m *TrackedAttestation) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *TrackedAttestation) GetCorrectHead() bool {
+	if m != nil {
+		return m.CorrectHead
+	}
+	return false
+}
+
+func (m *TrackedAttestation) GetCorrectTarget() bool {
+	if m != nil {
+		return m.CorrectTarget
+	}
+	return false
+}
+
+func (m *TrackedAttestation) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+type AttestationEffectivenessRequest struct {
+	Indices              []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationEffectivenessRequest) Reset()         { *m = AttestationEffectivenessRequest{} }
+func (m *AttestationEffectivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationEffectivenessRequest) ProtoMessage()    {}
+func (*AttestationEffectivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e60d5f1bd473, []int{2}
+}
+func (m *AttestationEffectivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationEffectivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationEffectivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationEffectivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationEffectivenessRequest.Merge(m, src)
+}
+func (m *AttestationEffectivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationEffectivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationEffectivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationEffectivenessRequest proto.InternalMessageInfo
+
+func (m *AttestationEffectivenessRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type AttestationEffectivenessResponse struct {
+	Validators           []*AttestationEffectiveness `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AttestationEffectivenessResponse) Reset()         { *m = AttestationEffectivenessResponse{} }
+func (m *AttestationEffectivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationEffectivenessResponse) ProtoMessage()    {}
+func (*AttestationEffectivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e60d5f1bd473, []int{3}
+}
+func (m *AttestationEffectivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationEffectivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationEffectivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationEffectivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationEffectivenessResponse.Merge(m, src)
+}
+func (m *AttestationEffectivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationEffectivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationEffectivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationEffectivenessResponse proto.InternalMessageInfo
+
+func (m *AttestationEffectivenessResponse) GetValidators() []*AttestationEffectiveness {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type AttestationEffectiveness struct {
+	ValidatorIndex           uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Attestations             uint64   `protobuf:"varint,2,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	Included                 uint64   `protobuf:"varint,3,opt,name=included,proto3" json:"included,omitempty"`
+	CorrectHead              uint64   `protobuf:"varint,4,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget            uint64   `protobuf:"varint,5,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	AverageInclusionDistance float64  `protobuf:"fixed64,6,opt,name=average_inclusion_distance,json=averageInclusionDistance,proto3" json:"average_inclusion_distance,omitempty"`
+	AverageFirstSeenDelay    int64    `protobuf:"varint,7,opt,name=average_first_seen_delay,json=averageFirstSeenDelay,proto3" json:"average_first_seen_delay,omitempty"`
+	Effectiveness            float64  `protobuf:"fixed64,8,opt,name=effectiveness,proto3" json:"effectiveness,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *AttestationEffectiveness) Reset()         { *m = AttestationEffectiveness{} }
+func (m *AttestationEffectiveness) String() string { return proto.CompactTextString(m) }
+func (*AttestationEffectiveness) ProtoMessage()    {}
+func (*AttestationEffectiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e60d5f1bd473, []int{4}
+}
+func (m *AttestationEffectiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationEffectiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationEffectiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationEffectiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationEffectiveness.Merge(m, src)
+}
+func (m *AttestationEffectiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationEffectiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationEffectiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationEffectiveness proto.InternalMessageInfo
+
+func (m *AttestationEffectiveness) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetAttestations() uint64 {
+	if m != nil {
+		return m.Attestations
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetCorrectHead() uint64 {
+	if m != nil {
+		return m.CorrectHead
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetCorrectTarget() uint64 {
+	if m != nil {
+		return m.CorrectTarget
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetAverageInclusionDistance() float64 {
+	if m != nil {
+		return m.AverageInclusionDistance
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetAverageFirstSeenDelay() int64 {
+	if m != nil {
+		return m.AverageFirstSeenDelay
+	}
+	return 0
+}
+
+func (m *AttestationEffectiveness) GetEffectiveness() float64 {
+	if m != nil {
+		return m.Effectiveness
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StreamTrackedAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.StreamTrackedAttestationsRequest")
+	proto.RegisterType((*TrackedAttestation)(nil), "ethereum.beacon.rpc.v1.TrackedAttestation")
+	proto.RegisterType((*AttestationEffectivenessRequest)(nil), "ethereum.beacon.rpc.v1.AttestationEffectivenessRequest")
+	proto.RegisterType((*AttestationEffectivenessResponse)(nil), "ethereum.beacon.rpc.v1.AttestationEffectivenessResponse")
+	proto.RegisterType((*AttestationEffectiveness)(nil), "ethereum.beacon.rpc.v1.AttestationEffectiveness")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/attestation_tracker.proto", fileDescriptor_b617e60d5f1bd473)
+}
+
+var fileDescriptor_b617e60d5f1bd473 = []byte{
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0xe5, 0xb6, 0xfb, 0x3a, 0xfb, 0x40, 0x58, 0x80, 0x4c, 0x81, 0x51, 0x2a, 0x10, 0x15,
+	0xd2, 0x9a, 0x75, 0x13, 0xda, 0xa4, 0xed, 0x06, 0x34, 0x3e, 0x76, 0x87, 0xb2, 0xdd, 0x47, 0x5e,
+	0x72, 0xda, 0x5a, 0xa4, 0x76, 0xb1, 0xbd, 0x0a, 0x6e, 0xe1, 0x11, 0x78, 0x29, 0x6e, 0x90, 0x90,
+	0x78, 0x01, 0x34, 0x71, 0x35, 0x5e, 0x02, 0xc5, 0x49, 0x93, 0x94, 0x11, 0x6d, 0xbb, 0x8b, 0xff,
+	0xe7, 0xfc, 0x6d, 0xeb, 0xfc, 0x7f, 0x31, 0x6c, 0x8c, 0xb5, 0xb2, 0xca, 0x3b, 0x41, 0x1e, 0x2a,
+	0xe9, 0xe9, 0x71, 0xe8, 0x4d, 0x7a, 0x1e, 0xb7, 0x16, 0x8d, 0xe5, 0x56, 0x28, 0x19, 0x58, 0xcd,
+	0xc3, 0xf7, 0xa8, 0xbb, 0xae, 0x8f, 0xde, 0x41, 0x3b, 0x44, 0x8d, 0xa7, 0xa3, 0x6e, 0xea, 0xe8,
+	0xea, 0x71, 0xd8, 0x9d, 0xf4, 0x9a, 0xf7, 0x07, 0x4a, 0x0d, 0x62, 0xf4, 0xf8, 0x58, 0x78, 0x5c,
+	0x4a, 0x95, 0x9a, 0x4d, 0xea, 0x6a, 0xef, 0x43, 0xeb, 0xc8, 0x6a, 0xe4, 0xa3, 0x63, 0xb7, 0x59,
+	0xf4, 0xa2, 0xd8, 0xdf, 0xf8, 0xf8, 0xe1, 0x14, 0x8d, 0xa5, 0x0c, 0x16, 0x84, 0x8c, 0x44, 0x88,
+	0x86, 0x91, 0x56, 0xbd, 0xd3, 0xf0, 0xa7, 0xcb, 0xf6, 0x9f, 0x1a, 0xd0, 0x8b, 0x46, 0xfa, 0x14,
+	0x6e, 0x4c, 0x78, 0x2c, 0x22, 0x6e, 0x95, 0x0e, 0x84, 0x8c, 0xf0, 0x23, 0x23, 0x2d, 0xd2, 0x69,
+	0xf8, 0x6b, 0xb9, 0x7c, 0x98, 0xa8, 0x94, 0x42, 0xc3, 0xc4, 0xca, 0xb2, 0x9a, 0xab, 0xba, 0xef,
+	0xc4, 0x1c, 0xaa, 0xd1, 0x48, 0x58, 0x8b, 0x98, 0x99, 0xeb, 0xa9, 0x39, 0x97, 0x53, 0xf3, 0x03,
+	0x80, 0xbe, 0xd0, 0xc6, 0x06, 0x06, 0x51, 0xb2, 0x46, 0x8b, 0x74, 0xea, 0xfe, 0x92, 0x53, 0x8e,
+	0x10, 0x25, 0x6d, 0xc2, 0xa2, 0x90, 0x61, 0x7c, 0x1a, 0x61, 0xc4, 0xe6, 0x5a, 0xa4, 0xb3, 0xe8,
+	0xe7, 0x6b, 0xfa, 0x04, 0xd6, 0xdc, 0xb7, 0x49, 0xc6, 0xe8, 0x6e, 0x30, 0xef, 0x8e, 0x58, 0xcd,
+	0xd5, 0xa3, 0xe4, 0x2a, 0x1b, 0x40, 0x8b, 0xb6, 0x48, 0x18, 0xcb, 0x65, 0x88, 0x6c, 0xc1, 0xb5,
+	0xde, 0xcc, 0x2b, 0x07, 0x59, 0x81, 0x3e, 0x82, 0x95, 0x50, 0x69, 0x8d, 0xa1, 0x0d, 0x86, 0xc8,
+	0x23, 0xb6, 0xe8, 0x4e, 0x5d, 0xce, 0xb4, 0xb7, 0xc8, 0xdd, 0xc1, 0xd3, 0x16, 0xcb, 0xf5, 0x00,
+	0x2d, 0x5b, 0x72, 0x4d, 0xab, 0x99, 0x7a, 0xec, 0x44, 0x7a, 0x0b, 0xe6, 0xfa, 0x42, 0xf2, 0x98,
+	0x81, 0xab, 0xa6, 0x8b, 0xf6, 0x1e, 0x3c, 0x2c, 0x4d, 0xf9, 0x55, 0xbf, 0x8f, 0xa1, 0x15, 0x13,
+	0x94, 0x68, 0xae, 0x10, 0x95, 0x85, 0x56, 0xb5, 0xd9, 0x8c, 0x95, 0x34, 0x48, 0xdf, 0x01, 0xe4,
+	0x01, 0xa5, 0x1b, 0x2c, 0x6f, 0x6d, 0x76, 0xff, 0xcf, 0x55, 0xb7, 0x72, 0xb7, 0xd2, 0x1e, 0xed,
+	0xf3, 0x1a, 0xb0, 0xaa, 0xc6, 0xab, 0x63, 0xd2, 0x86, 0x95, 0x12, 0xf7, 0x26, 0xc3, 0x65, 0x46,
+	0x9b, 0x89, 0x3b, 0xe5, 0xa5, 0x88, 0xfb, 0xdf, 0x60, 0x1a, 0xae, 0x7e, 0x49, 0x30, 0x73, 0x29,
+	0x11, 0xb3, 0xc1, 0xec, 0x43, 0x93, 0x4f, 0x50, 0xf3, 0x01, 0x06, 0x79, 0xfe, 0x05, 0x19, 0x09,
+	0x44, 0xc4, 0x67, 0x59, 0xc7, 0xe1, 0x05, 0x40, 0x76, 0x60, 0x5a, 0x0b, 0x0a, 0x72, 0x83, 0x08,
+	0x63, 0xfe, 0xc9, 0x51, 0x55, 0xf7, 0x6f, 0x67, 0xf5, 0xd7, 0x53, 0x8c, 0x0f, 0x92, 0x22, 0x7d,
+	0x0c, 0xab, 0x58, 0x1e, 0x9d, 0x43, 0x8b, 0xf8, 0xb3, 0xe2, 0xd6, 0x79, 0x0d, 0x68, 0x69, 0xd8,
+	0xe9, 0x8f, 0xa9, 0xe9, 0x17, 0x02, 0x77, 0x2b, 0xff, 0x71, 0xba, 0x5b, 0x95, 0xef, 0x65, 0xcf,
+	0x42, 0xf3, 0x59, 0x95, 0xf3, 0xa2, 0x67, 0x93, 0xd0, 0xef, 0x04, 0xee, 0xbd, 0x41, 0x5b, 0x09,
+	0xc3, 0xce, 0xb5, 0x39, 0xcb, 0xae, 0xb1, 0x7b, 0x7d, 0x63, 0x8a, 0x7b, 0x7b, 0xef, 0xf3, 0xcf,
+	0xdf, 0x5f, 0x6b, 0xcf, 0xe9, 0xb6, 0x87, 0x76, 0xe8, 0x4d, 0x7a, 0x3c, 0x1e, 0x0f, 0x79, 0xcf,
+	0x2b, 0xf0, 0x2d, 0xbf, 0xb6, 0xc6, 0x9b, 0x19, 0xf6, 0xcb, 0x95, 0x6f, 0x67, 0xeb, 0xe4, 0xc7,
+	0xd9, 0x3a, 0xf9, 0x75, 0xb6, 0x4e, 0x4e, 0xe6, 0xdd, 0x6b, 0xba, 0xfd, 0x77, 0x00, 0x72, 0x95,
+	0x9c, 0x20, 0xb4, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AttestationTrackerClient is the client API for AttestationTracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttestationTrackerClient interface {
+	StreamTrackedAttestations(ctx context.Context, in *StreamTrackedAttestationsRequest, opts ...grpc.CallOption) (AttestationTracker_StreamTrackedAttestationsClient, error)
+	GetAttestationEffectiveness(ctx context.Context, in *AttestationEffectivenessRequest, opts ...grpc.CallOption) (*AttestationEffectivenessResponse, error)
+}
+
+type attestationTrackerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttestationTrackerClient(cc *grpc.ClientConn) AttestationTrackerClient {
+	return &attestationTrackerClient{cc}
+}
+
+func (c *attestationTrackerClient) StreamTrackedAttestations(ctx context.Context, in *StreamTrackedAttestationsRequest, opts ...grpc.CallOption) (AttestationTracker_StreamTrackedAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AttestationTracker_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.AttestationTracker/StreamTrackedAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attestationTrackerStreamTrackedAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AttestationTracker_StreamTrackedAttestationsClient interface {
+	Recv() (*TrackedAttestation, error)
+	grpc.ClientStream
+}
+
+type attestationTrackerStreamTrackedAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *attestationTrackerStreamTrackedAttestationsClient) Recv() (*TrackedAttestation, error) {
+	m := new(TrackedAttestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attestationTrackerClient) GetAttestationEffectiveness(ctx context.Context, in *AttestationEffectivenessRequest, opts ...grpc.CallOption) (*AttestationEffectivenessResponse, error) {
+	out := new(AttestationEffectivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttestationTracker/GetAttestationEffectiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttestationTrackerServer is the server API for AttestationTracker service.
+type AttestationTrackerServer interface {
+	StreamTrackedAttestations(*StreamTrackedAttestationsRequest, AttestationTracker_StreamTrackedAttestationsServer) error
+	GetAttestationEffectiveness(context.Context, *AttestationEffectivenessRequest) (*AttestationEffectivenessResponse, error)
+}
+
+// UnimplementedAttestationTrackerServer can be embedded to have forward compatible implementations.
+type UnimplementedAttestationTrackerServer struct {
+}
+
+func (*UnimplementedAttestationTrackerServer) StreamTrackedAttestations(req *StreamTrackedAttestationsRequest, srv AttestationTracker_StreamTrackedAttestationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTrackedAttestations not implemented")
+}
+func (*UnimplementedAttestationTrackerServer) GetAttestationEffectiveness(ctx context.Context, req *AttestationEffectivenessRequest) (*AttestationEffectivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationEffectiveness not implemented")
+}
+
+func RegisterAttestationTrackerServer(s *grpc.Server, srv AttestationTrackerServer) {
+	s.RegisterService(&_AttestationTracker_serviceDesc, srv)
+}
+
+func _AttestationTracker_StreamTrackedAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTrackedAttestationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttestationTrackerServer).StreamTrackedAttestations(m, &attestationTrackerStreamTrackedAttestationsServer{stream})
+}
+
+type AttestationTracker_StreamTrackedAttestationsServer interface {
+	Send(*TrackedAttestation) error
+	grpc.ServerStream
+}
+
+type attestationTrackerStreamTrackedAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *attestationTrackerStreamTrackedAttestationsServer) Send(m *TrackedAttestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AttestationTracker_GetAttestationEffectiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationEffectivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationTrackerServer).GetAttestationEffectiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttestationTracker/GetAttestationEffectiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationTrackerServer).GetAttestationEffectiveness(ctx, req.(*AttestationEffectivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttestationTracker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttestationTracker",
+	HandlerType: (*AttestationTrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttestationEffectiveness",
+			Handler:    _AttestationTracker_GetAttestationEffectiveness_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTrackedAttestations",
+			Handler:       _AttestationTracker_StreamTrackedAttestations_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/attestation_tracker.proto",
+}
+
+func (m *StreamTrackedAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamTrackedAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamTrackedAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrackedAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrackedAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrackedAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Final {
+		i--
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CorrectTarget {
+		i--
+		if m.CorrectTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorrectHead {
+		i--
+		if m.CorrectHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.InclusionSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FirstSeen != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.FirstSeen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slot != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationEffectivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationEffectivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationEffectivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA4 := make([]byte, len(m.Indices)*10)
+		var j3 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationEffectivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationEffectivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationEffectivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestationTracker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationEffectiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationEffectiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationEffectiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Effectiveness != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Effectiveness))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.AverageFirstSeenDelay != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.AverageFirstSeenDelay))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AverageInclusionDistance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageInclusionDistance))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.CorrectTarget != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.CorrectTarget))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CorrectHead != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.CorrectHead))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Included != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Attestations != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.Attestations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintAttestationTracker(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestationTracker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestationTracker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamTrackedAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovAttestationTracker(uint64(e))
+		}
+		n += 1 + sovAttestationTracker(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrackedAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.ValidatorIndex))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.CommitteeIndex))
+	}
+	if m.FirstSeen != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.FirstSeen))
+	}
+	if m.Included {
+		n += 2
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.InclusionDistance))
+	}
+	if m.CorrectHead {
+		n += 2
+	}
+	if m.CorrectTarget {
+		n += 2
+	}
+	if m.Final {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationEffectivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovAttestationTracker(uint64(e))
+		}
+		n += 1 + sovAttestationTracker(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationEffectivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovAttestationTracker(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationEffectiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.ValidatorIndex))
+	}
+	if m.Attestations != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.Attestations))
+	}
+	if m.Included != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.Included))
+	}
+	if m.CorrectHead != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.CorrectHead))
+	}
+	if m.CorrectTarget != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.CorrectTarget))
+	}
+	if m.AverageInclusionDistance != 0 {
+		n += 9
+	}
+	if m.AverageFirstSeenDelay != 0 {
+		n += 1 + sovAttestationTracker(uint64(m.AverageFirstSeenDelay))
+	}
+	if m.Effectiveness != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAttestationTracker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestationTracker(x uint64) (n int) {
+	return sovAttestationTracker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamTrackedAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamTrackedAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamTrackedAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestationTracker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestationTracker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAttestationTracker
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAttestationTracker
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAttestationTracker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrackedAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrackedAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrackedAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
+			}
+			m.FirstSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Included = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
+			}
+			m.InclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectHead = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectTarget = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Final = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationEffectivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationEffectivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationEffectivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestationTracker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestationTracker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAttestationTracker
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAttestationTracker
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAttestationTracker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationEffectivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationEffectivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationEffectivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &AttestationEffectiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationEffectiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationEffectiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationEffectiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			m.Attestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attestations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			m.CorrectHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectHead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
+			}
+			m.CorrectTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInclusionDistance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageInclusionDistance = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFirstSeenDelay", wireType)
+			}
+			m.AverageFirstSeenDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageFirstSeenDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effectiveness", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Effectiveness = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestationTracker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestationTracker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationTracker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestationTracker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestationTracker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestationTracker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestationTracker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestationTracker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestationTracker = fmt.Errorf("proto: unexpected end of group")
+)
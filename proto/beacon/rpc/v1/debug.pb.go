@@ -56,7 +56,32 @@ func (x LoggingLevelRequest_Level) String() string {
 }
 
 func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{8, 0}
+}
+
+type PeerRule_Kind int32
+
+const (
+	PeerRule_TRUSTED PeerRule_Kind = 0
+	PeerRule_BANNED  PeerRule_Kind = 1
+)
+
+var PeerRule_Kind_name = map[int32]string{
+	0: "TRUSTED",
+	1: "BANNED",
+}
+
+var PeerRule_Kind_value = map[string]int32{
+	"TRUSTED": 0,
+	"BANNED":  1,
+}
+
+func (x PeerRule_Kind) String() string {
+	return proto.EnumName(PeerRule_Kind_name, int32(x))
+}
+
+func (PeerRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
 }
 
 type InclusionSlotRequest struct {
@@ -161,6 +186,227 @@ func (m *InclusionSlotResponse) GetSlot() uint64 {
 	return 0
 }
 
+type BackfillStatusResponse struct {
+	OriginSlot           uint64   `protobuf:"varint,1,opt,name=origin_slot,json=originSlot,proto3" json:"origin_slot,omitempty"`
+	OriginRoot           []byte   `protobuf:"bytes,2,opt,name=origin_root,json=originRoot,proto3" json:"origin_root,omitempty"`
+	LowestSlot           uint64   `protobuf:"varint,3,opt,name=lowest_slot,json=lowestSlot,proto3" json:"lowest_slot,omitempty"`
+	LowestRoot           []byte   `protobuf:"bytes,4,opt,name=lowest_root,json=lowestRoot,proto3" json:"lowest_root,omitempty"`
+	Complete             bool     `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillStatusResponse) Reset()         { *m = BackfillStatusResponse{} }
+func (m *BackfillStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillStatusResponse) ProtoMessage()    {}
+func (*BackfillStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *BackfillStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillStatusResponse.Merge(m, src)
+}
+func (m *BackfillStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillStatusResponse proto.InternalMessageInfo
+
+func (m *BackfillStatusResponse) GetOriginSlot() uint64 {
+	if m != nil {
+		return m.OriginSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatusResponse) GetOriginRoot() []byte {
+	if m != nil {
+		return m.OriginRoot
+	}
+	return nil
+}
+
+func (m *BackfillStatusResponse) GetLowestSlot() uint64 {
+	if m != nil {
+		return m.LowestSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatusResponse) GetLowestRoot() []byte {
+	if m != nil {
+		return m.LowestRoot
+	}
+	return nil
+}
+
+func (m *BackfillStatusResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type ReorgsResponse struct {
+	Reorgs               []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgsResponse) Reset()         { *m = ReorgsResponse{} }
+func (m *ReorgsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReorgsResponse) ProtoMessage()    {}
+func (*ReorgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+}
+func (m *ReorgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReorgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgsResponse.Merge(m, src)
+}
+func (m *ReorgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgsResponse proto.InternalMessageInfo
+
+func (m *ReorgsResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
+}
+
+type Reorg struct {
+	OldSlot              uint64   `protobuf:"varint,1,opt,name=old_slot,json=oldSlot,proto3" json:"old_slot,omitempty"`
+	NewSlot              uint64   `protobuf:"varint,2,opt,name=new_slot,json=newSlot,proto3" json:"new_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Distance             uint64   `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,6,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,7,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+}
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetOldSlot() uint64 {
+	if m != nil {
+		return m.OldSlot
+	}
+	return 0
+}
+
+func (m *Reorg) GetNewSlot() uint64 {
+	if m != nil {
+		return m.NewSlot
+	}
+	return 0
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *Reorg) GetDistance() uint64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *Reorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
+	}
+	return nil
+}
+
+func (m *Reorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *Reorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
 type BeaconStateRequest struct {
 	// Types that are valid to be assigned to QueryFilter:
 	//	*BeaconStateRequest_Slot
@@ -175,7 +421,7 @@ func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
 func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateRequest) ProtoMessage()    {}
 func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
 }
 func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,7 +506,7 @@ func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
 func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockRequest) ProtoMessage()    {}
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
 }
 func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +553,7 @@ func (m *SSZResponse) Reset()         { *m = SSZResponse{} }
 func (m *SSZResponse) String() string { return proto.CompactTextString(m) }
 func (*SSZResponse) ProtoMessage()    {}
 func (*SSZResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
 }
 func (m *SSZResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +600,7 @@ func (m *LoggingLevelRequest) Reset()         { *m = LoggingLevelRequest{} }
 func (m *LoggingLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*LoggingLevelRequest) ProtoMessage()    {}
 func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
 }
 func (m *LoggingLevelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,29 +636,26 @@ func (m *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
 	return LoggingLevelRequest_INFO
 }
 
-type ProtoArrayForkChoiceResponse struct {
-	PruneThreshold       uint64            `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
-	JustifiedEpoch       uint64            `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       uint64            `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
-	ProtoArrayNodes      []*ProtoArrayNode `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
-	Indices              map[string]uint64 `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type PeerRule struct {
+	Kind                 PeerRule_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.beacon.rpc.v1.PeerRule_Kind" json:"kind,omitempty"`
+	Peer                 string        `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoiceResponse{} }
-func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
-func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+func (m *PeerRule) Reset()         { *m = PeerRule{} }
+func (m *PeerRule) String() string { return proto.CompactTextString(m) }
+func (*PeerRule) ProtoMessage()    {}
+func (*PeerRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
+func (m *PeerRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProtoArrayForkChoiceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerRule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -422,79 +665,106 @@ func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProtoArrayForkChoiceResponse.Merge(m, src)
+func (m *PeerRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRule.Merge(m, src)
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Size() int {
+func (m *PeerRule) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProtoArrayForkChoiceResponse.DiscardUnknown(m)
+func (m *PeerRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProtoArrayForkChoiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_PeerRule proto.InternalMessageInfo
 
-func (m *ProtoArrayForkChoiceResponse) GetPruneThreshold() uint64 {
+func (m *PeerRule) GetKind() PeerRule_Kind {
 	if m != nil {
-		return m.PruneThreshold
+		return m.Kind
 	}
-	return 0
+	return PeerRule_TRUSTED
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetJustifiedEpoch() uint64 {
+func (m *PeerRule) GetPeer() string {
 	if m != nil {
-		return m.JustifiedEpoch
+		return m.Peer
 	}
-	return 0
+	return ""
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetFinalizedEpoch() uint64 {
-	if m != nil {
-		return m.FinalizedEpoch
+type PeerRulesResponse struct {
+	Trusted              []string `protobuf:"bytes,1,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	Banned               []string `protobuf:"bytes,2,rep,name=banned,proto3" json:"banned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerRulesResponse) Reset()         { *m = PeerRulesResponse{} }
+func (m *PeerRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerRulesResponse) ProtoMessage()    {}
+func (*PeerRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
+func (m *PeerRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *PeerRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRulesResponse.Merge(m, src)
+}
+func (m *PeerRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRulesResponse.DiscardUnknown(m)
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetProtoArrayNodes() []*ProtoArrayNode {
+var xxx_messageInfo_PeerRulesResponse proto.InternalMessageInfo
+
+func (m *PeerRulesResponse) GetTrusted() []string {
 	if m != nil {
-		return m.ProtoArrayNodes
+		return m.Trusted
 	}
 	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetIndices() map[string]uint64 {
+func (m *PeerRulesResponse) GetBanned() []string {
 	if m != nil {
-		return m.Indices
+		return m.Banned
 	}
 	return nil
 }
 
-type ProtoArrayNode struct {
-	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
-	Parent               uint64   `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
-	JustifiedEpoch       uint64   `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       uint64   `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
-	Weight               uint64   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	BestChild            uint64   `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
-	BestDescendant       uint64   `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+type PeerTrafficRequest struct {
+	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
-func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
-func (*ProtoArrayNode) ProtoMessage()    {}
-func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+func (m *PeerTrafficRequest) Reset()         { *m = PeerTrafficRequest{} }
+func (m *PeerTrafficRequest) String() string { return proto.CompactTextString(m) }
+func (*PeerTrafficRequest) ProtoMessage()    {}
+func (*PeerTrafficRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
-func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
+func (m *PeerTrafficRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerTrafficRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProtoArrayNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerTrafficRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -504,93 +774,44 @@ func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ProtoArrayNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProtoArrayNode.Merge(m, src)
+func (m *PeerTrafficRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerTrafficRequest.Merge(m, src)
 }
-func (m *ProtoArrayNode) XXX_Size() int {
+func (m *PeerTrafficRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProtoArrayNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProtoArrayNode.DiscardUnknown(m)
+func (m *PeerTrafficRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerTrafficRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProtoArrayNode proto.InternalMessageInfo
+var xxx_messageInfo_PeerTrafficRequest proto.InternalMessageInfo
 
-func (m *ProtoArrayNode) GetSlot() uint64 {
+func (m *PeerTrafficRequest) GetLimit() uint64 {
 	if m != nil {
-		return m.Slot
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *ProtoArrayNode) GetRoot() []byte {
-	if m != nil {
-		return m.Root
-	}
-	return nil
+type PeerTrafficResponse struct {
+	Peers                []*PeerTraffic `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ProtoArrayNode) GetParent() uint64 {
-	if m != nil {
-		return m.Parent
-	}
-	return 0
-}
-
-func (m *ProtoArrayNode) GetJustifiedEpoch() uint64 {
-	if m != nil {
-		return m.JustifiedEpoch
-	}
-	return 0
-}
-
-func (m *ProtoArrayNode) GetFinalizedEpoch() uint64 {
-	if m != nil {
-		return m.FinalizedEpoch
-	}
-	return 0
-}
-
-func (m *ProtoArrayNode) GetWeight() uint64 {
-	if m != nil {
-		return m.Weight
-	}
-	return 0
-}
-
-func (m *ProtoArrayNode) GetBestChild() uint64 {
-	if m != nil {
-		return m.BestChild
-	}
-	return 0
-}
-
-func (m *ProtoArrayNode) GetBestDescendant() uint64 {
-	if m != nil {
-		return m.BestDescendant
-	}
-	return 0
-}
-
-type DebugPeerResponses struct {
-	Responses            []*DebugPeerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
-func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponses) ProtoMessage()    {}
-func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+func (m *PeerTrafficResponse) Reset()         { *m = PeerTrafficResponse{} }
+func (m *PeerTrafficResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerTrafficResponse) ProtoMessage()    {}
+func (*PeerTrafficResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
 }
-func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
+func (m *PeerTrafficResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerTrafficResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponses.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerTrafficResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,52 +821,49 @@ func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponses) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponses.Merge(m, src)
+func (m *PeerTrafficResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerTrafficResponse.Merge(m, src)
 }
-func (m *DebugPeerResponses) XXX_Size() int {
+func (m *PeerTrafficResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponses) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponses.DiscardUnknown(m)
+func (m *PeerTrafficResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerTrafficResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugPeerResponses proto.InternalMessageInfo
+var xxx_messageInfo_PeerTrafficResponse proto.InternalMessageInfo
 
-func (m *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
+func (m *PeerTrafficResponse) GetPeers() []*PeerTraffic {
 	if m != nil {
-		return m.Responses
+		return m.Peers
 	}
 	return nil
 }
 
-type DebugPeerResponse struct {
-	ListeningAddresses   []string                    `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
-	Direction            v1alpha1.PeerDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
-	ConnectionState      v1alpha1.ConnectionState    `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
-	PeerId               string                      `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
-	Enr                  string                      `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
-	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
-	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
-	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	ScoreInfo            *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type PeerTraffic struct {
+	PeerId               string              `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Total                *Traffic            `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	RateIn               float64             `protobuf:"fixed64,3,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	RateOut              float64             `protobuf:"fixed64,4,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+	Topics               map[string]*Traffic `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Protocols            map[string]*Traffic `protobuf:"bytes,6,rep,name=protocols,proto3" json:"protocols,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
-func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponse) ProtoMessage()    {}
-func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+func (m *PeerTraffic) Reset()         { *m = PeerTraffic{} }
+func (m *PeerTraffic) String() string { return proto.CompactTextString(m) }
+func (*PeerTraffic) ProtoMessage()    {}
+func (*PeerTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
 }
-func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
+func (m *PeerTraffic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerTraffic.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -655,105 +873,82 @@ func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponse.Merge(m, src)
+func (m *PeerTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerTraffic.Merge(m, src)
 }
-func (m *DebugPeerResponse) XXX_Size() int {
+func (m *PeerTraffic) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DebugPeerResponse proto.InternalMessageInfo
-
-func (m *DebugPeerResponse) GetListeningAddresses() []string {
-	if m != nil {
-		return m.ListeningAddresses
-	}
-	return nil
-}
-
-func (m *DebugPeerResponse) GetDirection() v1alpha1.PeerDirection {
-	if m != nil {
-		return m.Direction
-	}
-	return v1alpha1.PeerDirection_UNKNOWN
+func (m *PeerTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerTraffic.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) GetConnectionState() v1alpha1.ConnectionState {
-	if m != nil {
-		return m.ConnectionState
-	}
-	return v1alpha1.ConnectionState_DISCONNECTED
-}
+var xxx_messageInfo_PeerTraffic proto.InternalMessageInfo
 
-func (m *DebugPeerResponse) GetPeerId() string {
+func (m *PeerTraffic) GetPeerId() string {
 	if m != nil {
 		return m.PeerId
 	}
 	return ""
 }
 
-func (m *DebugPeerResponse) GetEnr() string {
+func (m *PeerTraffic) GetTotal() *Traffic {
 	if m != nil {
-		return m.Enr
+		return m.Total
 	}
-	return ""
+	return nil
 }
 
-func (m *DebugPeerResponse) GetPeerInfo() *DebugPeerResponse_PeerInfo {
+func (m *PeerTraffic) GetRateIn() float64 {
 	if m != nil {
-		return m.PeerInfo
+		return m.RateIn
 	}
-	return nil
+	return 0
 }
 
-func (m *DebugPeerResponse) GetPeerStatus() *v1.Status {
+func (m *PeerTraffic) GetRateOut() float64 {
 	if m != nil {
-		return m.PeerStatus
+		return m.RateOut
 	}
-	return nil
+	return 0
 }
 
-func (m *DebugPeerResponse) GetLastUpdated() uint64 {
+func (m *PeerTraffic) GetTopics() map[string]*Traffic {
 	if m != nil {
-		return m.LastUpdated
+		return m.Topics
 	}
-	return 0
+	return nil
 }
 
-func (m *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
+func (m *PeerTraffic) GetProtocols() map[string]*Traffic {
 	if m != nil {
-		return m.ScoreInfo
+		return m.Protocols
 	}
 	return nil
 }
 
-type DebugPeerResponse_PeerInfo struct {
-	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
-	FaultCount           uint64       `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
-	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
-	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type Traffic struct {
+	BytesIn              uint64   `protobuf:"varint,1,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut             uint64   `protobuf:"varint,2,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	MessagesIn           uint64   `protobuf:"varint,3,opt,name=messages_in,json=messagesIn,proto3" json:"messages_in,omitempty"`
+	MessagesOut          uint64   `protobuf:"varint,4,opt,name=messages_out,json=messagesOut,proto3" json:"messages_out,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_PeerInfo{} }
-func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
-func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
+func (m *Traffic) Reset()         { *m = Traffic{} }
+func (m *Traffic) String() string { return proto.CompactTextString(m) }
+func (*Traffic) ProtoMessage()    {}
+func (*Traffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
+func (m *Traffic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Traffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponse_PeerInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Traffic.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,85 +958,69 @@ func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponse_PeerInfo.Merge(m, src)
+func (m *Traffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Traffic.Merge(m, src)
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Size() int {
+func (m *Traffic) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponse_PeerInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DebugPeerResponse_PeerInfo proto.InternalMessageInfo
-
-func (m *DebugPeerResponse_PeerInfo) GetMetadata() *v1.MetaData {
-	if m != nil {
-		return m.Metadata
-	}
-	return nil
+func (m *Traffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_Traffic.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetProtocols() []string {
-	if m != nil {
-		return m.Protocols
-	}
-	return nil
-}
+var xxx_messageInfo_Traffic proto.InternalMessageInfo
 
-func (m *DebugPeerResponse_PeerInfo) GetFaultCount() uint64 {
+func (m *Traffic) GetBytesIn() uint64 {
 	if m != nil {
-		return m.FaultCount
+		return m.BytesIn
 	}
 	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetProtocolVersion() string {
+func (m *Traffic) GetBytesOut() uint64 {
 	if m != nil {
-		return m.ProtocolVersion
+		return m.BytesOut
 	}
-	return ""
+	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetAgentVersion() string {
+func (m *Traffic) GetMessagesIn() uint64 {
 	if m != nil {
-		return m.AgentVersion
+		return m.MessagesIn
 	}
-	return ""
+	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
+func (m *Traffic) GetMessagesOut() uint64 {
 	if m != nil {
-		return m.PeerLatency
+		return m.MessagesOut
 	}
 	return 0
 }
 
-type ScoreInfo struct {
-	OverallScore         float32                        `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
-	ProcessedBlocks      uint64                         `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
-	BlockProviderScore   float32                        `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
-	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	GossipScore          float32                        `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
-	BehaviourPenalty     float32                        `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
-	ValidationError      string                         `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type ProtoArrayForkChoiceResponse struct {
+	PruneThreshold       uint64            `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
+	JustifiedEpoch       uint64            `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64            `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	ProtoArrayNodes      []*ProtoArrayNode `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
+	Indices              map[string]uint64 `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ScoreInfo) Reset()         { *m = ScoreInfo{} }
-func (m *ScoreInfo) String() string { return proto.CompactTextString(m) }
-func (*ScoreInfo) ProtoMessage()    {}
-func (*ScoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoiceResponse{} }
+func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
+func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
 }
-func (m *ScoreInfo) XXX_Unmarshal(b []byte) error {
+func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScoreInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProtoArrayForkChoiceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -851,89 +1030,79 @@ func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ScoreInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScoreInfo.Merge(m, src)
+func (m *ProtoArrayForkChoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoArrayForkChoiceResponse.Merge(m, src)
 }
-func (m *ScoreInfo) XXX_Size() int {
+func (m *ProtoArrayForkChoiceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScoreInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScoreInfo.DiscardUnknown(m)
+func (m *ProtoArrayForkChoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoArrayForkChoiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScoreInfo proto.InternalMessageInfo
+var xxx_messageInfo_ProtoArrayForkChoiceResponse proto.InternalMessageInfo
 
-func (m *ScoreInfo) GetOverallScore() float32 {
+func (m *ProtoArrayForkChoiceResponse) GetPruneThreshold() uint64 {
 	if m != nil {
-		return m.OverallScore
+		return m.PruneThreshold
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetProcessedBlocks() uint64 {
+func (m *ProtoArrayForkChoiceResponse) GetJustifiedEpoch() uint64 {
 	if m != nil {
-		return m.ProcessedBlocks
+		return m.JustifiedEpoch
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetBlockProviderScore() float32 {
+func (m *ProtoArrayForkChoiceResponse) GetFinalizedEpoch() uint64 {
 	if m != nil {
-		return m.BlockProviderScore
+		return m.FinalizedEpoch
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetTopicScores() map[string]*TopicScoreSnapshot {
+func (m *ProtoArrayForkChoiceResponse) GetProtoArrayNodes() []*ProtoArrayNode {
 	if m != nil {
-		return m.TopicScores
+		return m.ProtoArrayNodes
 	}
 	return nil
 }
 
-func (m *ScoreInfo) GetGossipScore() float32 {
+func (m *ProtoArrayForkChoiceResponse) GetIndices() map[string]uint64 {
 	if m != nil {
-		return m.GossipScore
+		return m.Indices
 	}
-	return 0
+	return nil
 }
 
-func (m *ScoreInfo) GetBehaviourPenalty() float32 {
-	if m != nil {
-		return m.BehaviourPenalty
-	}
-	return 0
-}
-
-func (m *ScoreInfo) GetValidationError() string {
-	if m != nil {
-		return m.ValidationError
-	}
-	return ""
-}
-
-type TopicScoreSnapshot struct {
-	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
-	FirstMessageDeliveries   float32  `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
-	MeshMessageDeliveries    float32  `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
-	InvalidMessageDeliveries float32  `protobuf:"fixed32,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+type ProtoArrayNode struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Parent               uint64   `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	JustifiedEpoch       uint64   `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Weight               uint64   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	BestChild            uint64   `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	BestDescendant       uint64   `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
-func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
-func (*TopicScoreSnapshot) ProtoMessage()    {}
-func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
+func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
+func (*ProtoArrayNode) ProtoMessage()    {}
+func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
 }
-func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
+func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicScoreSnapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProtoArrayNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -943,979 +1112,1180 @@ func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TopicScoreSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicScoreSnapshot.Merge(m, src)
+func (m *ProtoArrayNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoArrayNode.Merge(m, src)
 }
-func (m *TopicScoreSnapshot) XXX_Size() int {
+func (m *ProtoArrayNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicScoreSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicScoreSnapshot.DiscardUnknown(m)
+func (m *ProtoArrayNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoArrayNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicScoreSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_ProtoArrayNode proto.InternalMessageInfo
 
-func (m *TopicScoreSnapshot) GetTimeInMesh() uint64 {
+func (m *ProtoArrayNode) GetSlot() uint64 {
 	if m != nil {
-		return m.TimeInMesh
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *TopicScoreSnapshot) GetFirstMessageDeliveries() float32 {
+func (m *ProtoArrayNode) GetRoot() []byte {
 	if m != nil {
-		return m.FirstMessageDeliveries
+		return m.Root
 	}
-	return 0
+	return nil
 }
 
-func (m *TopicScoreSnapshot) GetMeshMessageDeliveries() float32 {
+func (m *ProtoArrayNode) GetParent() uint64 {
 	if m != nil {
-		return m.MeshMessageDeliveries
+		return m.Parent
 	}
 	return 0
 }
 
-func (m *TopicScoreSnapshot) GetInvalidMessageDeliveries() float32 {
+func (m *ProtoArrayNode) GetJustifiedEpoch() uint64 {
 	if m != nil {
-		return m.InvalidMessageDeliveries
+		return m.JustifiedEpoch
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
-	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
-	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
-	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
-	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
-	proto.RegisterType((*ScoreInfo)(nil), "ethereum.beacon.rpc.v1.ScoreInfo")
-	proto.RegisterMapType((map[string]*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry")
-	proto.RegisterType((*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.TopicScoreSnapshot")
+func (m *ProtoArrayNode) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
-
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0x36, 0x29, 0x51, 0xd2, 0x14, 0x19, 0x8a, 0x6e, 0xcb, 0x12, 0x43, 0xdb, 0x7a, 0x8c, 0x1d,
-	0x3f, 0x63, 0x32, 0x62, 0x82, 0xc0, 0x30, 0x0c, 0xc4, 0x7a, 0x59, 0x16, 0x20, 0xdb, 0xca, 0xd0,
-	0xce, 0x21, 0x46, 0x30, 0x18, 0xcd, 0x14, 0xc9, 0xb6, 0x46, 0xd3, 0xe3, 0xee, 0x26, 0x13, 0x39,
-	0x37, 0x23, 0x48, 0x8e, 0x39, 0x04, 0xc8, 0x39, 0x3f, 0x27, 0xc0, 0x5e, 0x16, 0xd8, 0x3f, 0xb0,
-	0x30, 0x16, 0xfb, 0x23, 0x7c, 0x5a, 0x74, 0xf7, 0xcc, 0x90, 0x34, 0x49, 0x5b, 0xbb, 0xd8, 0x5b,
-	0xf7, 0x57, 0x5f, 0x3d, 0xa6, 0xaa, 0xba, 0xbb, 0x06, 0xd6, 0x62, 0xce, 0x24, 0x6b, 0x1c, 0xa3,
-	0xe7, 0xb3, 0xa8, 0xc1, 0x63, 0xbf, 0xd1, 0xdf, 0x6c, 0x04, 0x78, 0xdc, 0xeb, 0xd4, 0xb5, 0x84,
-	0x2c, 0xa3, 0xec, 0x22, 0xc7, 0xde, 0x69, 0xdd, 0x70, 0xea, 0x3c, 0xf6, 0xeb, 0xfd, 0xcd, 0xda,
-	0x0a, 0xca, 0x6e, 0xa3, 0xbf, 0xe9, 0x85, 0x71, 0xd7, 0xdb, 0x6c, 0x44, 0x2c, 0x40, 0xa3, 0x50,
-	0xb3, 0x47, 0x2c, 0xc6, 0xcd, 0x58, 0x59, 0x3c, 0x45, 0x21, 0xbc, 0x0e, 0x8a, 0x84, 0x73, 0xb5,
-	0xc3, 0x58, 0x27, 0xc4, 0x86, 0x17, 0xd3, 0x86, 0x17, 0x45, 0x4c, 0x7a, 0x92, 0xb2, 0x28, 0x95,
-	0x5e, 0x49, 0xa4, 0x7a, 0x77, 0xdc, 0x6b, 0x37, 0xf0, 0x34, 0x96, 0x67, 0x46, 0x68, 0x3f, 0x84,
-	0xa5, 0x83, 0xc8, 0x0f, 0x7b, 0x82, 0xb2, 0xa8, 0x15, 0x32, 0xe9, 0xe0, 0xdb, 0x1e, 0x0a, 0x49,
-	0xca, 0x90, 0xa7, 0x41, 0x35, 0xb7, 0x9e, 0xbb, 0x3d, 0xeb, 0xe4, 0x69, 0x40, 0x08, 0xcc, 0x8a,
-	0x90, 0xc9, 0x6a, 0x5e, 0x23, 0x7a, 0x6d, 0xdf, 0x83, 0xcb, 0x9f, 0xe8, 0x8a, 0x98, 0x45, 0x02,
-	0x27, 0x92, 0x5f, 0x03, 0xd9, 0xd6, 0xdf, 0xd0, 0x92, 0x9e, 0xc4, 0xd4, 0xcd, 0x52, 0xc2, 0xd4,
-	0x8e, 0x9e, 0x5e, 0x30, 0x5c, 0xb2, 0x06, 0x70, 0x1c, 0x32, 0xff, 0xc4, 0xe5, 0x2c, 0xb1, 0x52,
-	0x7a, 0x7a, 0xc1, 0xb1, 0x34, 0xe6, 0x30, 0x26, 0xb7, 0xcb, 0x50, 0x7a, 0xdb, 0x43, 0x7e, 0xe6,
-	0xb6, 0x69, 0x28, 0x91, 0xdb, 0xf7, 0xa1, 0xb4, 0xad, 0x85, 0x89, 0xd9, 0x6b, 0x23, 0x06, 0x94,
-	0xf1, 0xd2, 0x90, 0xba, 0x7d, 0x0b, 0x8a, 0xad, 0xd6, 0x9f, 0xb3, 0x70, 0xab, 0x30, 0x8f, 0x91,
-	0xcf, 0x02, 0x0c, 0x12, 0x6a, 0xba, 0xb5, 0xff, 0x95, 0x83, 0x4b, 0x87, 0xac, 0xd3, 0xa1, 0x51,
-	0xe7, 0x10, 0xfb, 0x18, 0xa6, 0xf6, 0xf7, 0xa1, 0x10, 0xaa, 0xbd, 0xe6, 0x97, 0x9b, 0x9b, 0xf5,
-	0xc9, 0x55, 0xad, 0x4f, 0xd0, 0xad, 0x9b, 0x8d, 0xd1, 0xb7, 0x6f, 0x41, 0x41, 0xef, 0xc9, 0x02,
-	0xcc, 0x1e, 0x3c, 0x7f, 0xf2, 0xa2, 0x72, 0x81, 0x58, 0x50, 0xd8, 0xdd, 0xdb, 0x7e, 0xb5, 0x5f,
-	0xc9, 0xa9, 0xe5, 0x4b, 0x67, 0x6b, 0x67, 0xaf, 0x92, 0xb7, 0xff, 0x39, 0x03, 0x57, 0x8f, 0x54,
-	0xc5, 0xb6, 0x38, 0xf7, 0xce, 0x9e, 0x30, 0x7e, 0xb2, 0xd3, 0x65, 0xd4, 0xc7, 0xec, 0x23, 0x6e,
-	0xc1, 0x62, 0xcc, 0x7b, 0x11, 0xba, 0xb2, 0xcb, 0x51, 0x74, 0x59, 0x98, 0x56, 0xaf, 0xac, 0xe1,
-	0x97, 0x29, 0xaa, 0x88, 0x6f, 0x7a, 0x42, 0xd2, 0x36, 0xc5, 0xc0, 0xc5, 0x98, 0xf9, 0xdd, 0xa4,
-	0x4e, 0xe5, 0x0c, 0xde, 0x53, 0xa8, 0x22, 0xb6, 0x69, 0xe4, 0x85, 0xf4, 0x5d, 0x46, 0x9c, 0x31,
-	0xc4, 0x0c, 0x36, 0x44, 0x07, 0x2e, 0xea, 0x66, 0x72, 0x3d, 0x15, 0x9b, 0xab, 0x9a, 0x57, 0x54,
-	0x67, 0xd7, 0x67, 0x6e, 0x17, 0x9b, 0x37, 0xa7, 0x65, 0x66, 0xf0, 0x2d, 0xcf, 0x59, 0x80, 0xce,
-	0x62, 0x3c, 0xb2, 0x17, 0xe4, 0x35, 0xcc, 0xd3, 0x28, 0xa0, 0x3e, 0x8a, 0x6a, 0x41, 0x5b, 0xda,
-	0xfa, 0xb2, 0xa5, 0xf1, 0xac, 0xd4, 0x0f, 0x8c, 0x8d, 0xbd, 0x48, 0xf2, 0x33, 0x27, 0xb5, 0x58,
-	0x7b, 0x08, 0xa5, 0x61, 0x01, 0xa9, 0xc0, 0xcc, 0x09, 0x9e, 0xe9, 0x7c, 0x59, 0x8e, 0x5a, 0x92,
-	0x25, 0x28, 0xf4, 0xbd, 0xb0, 0x87, 0x49, 0x6a, 0xcc, 0xe6, 0x61, 0xfe, 0x41, 0xce, 0x7e, 0x9f,
-	0x87, 0xf2, 0x68, 0xf0, 0x59, 0xbb, 0xe7, 0x06, 0xed, 0xae, 0xb0, 0x41, 0xf3, 0x3a, 0x7a, 0x4d,
-	0x96, 0x61, 0x2e, 0xf6, 0x38, 0x46, 0x32, 0xc9, 0x63, 0xb2, 0x9b, 0x54, 0x91, 0xd9, 0xf3, 0x56,
-	0xa4, 0x30, 0xb1, 0x22, 0xcb, 0x30, 0xf7, 0x57, 0xa4, 0x9d, 0xae, 0xac, 0xce, 0x19, 0x4f, 0x66,
-	0xa7, 0xcf, 0x05, 0x0a, 0xe9, 0xfa, 0x5d, 0x1a, 0x06, 0xd5, 0x79, 0x2d, 0xb3, 0x14, 0xb2, 0xa3,
-	0x00, 0x65, 0x5f, 0x8b, 0x03, 0x14, 0x3e, 0x46, 0x81, 0x17, 0xc9, 0xea, 0x82, 0xb1, 0xaf, 0xe0,
-	0xdd, 0x0c, 0xb5, 0xff, 0x02, 0x64, 0x57, 0x5d, 0x6a, 0x47, 0x88, 0x3c, 0xcd, 0xb5, 0x20, 0xfb,
-	0x60, 0xf1, 0x74, 0x53, 0xcd, 0xe9, 0xaa, 0xdd, 0x99, 0x56, 0xb5, 0x31, 0x75, 0x67, 0xa0, 0x6b,
-	0x7f, 0x2c, 0xc0, 0xc5, 0x31, 0x02, 0x69, 0xc0, 0xa5, 0x90, 0x0a, 0x89, 0x11, 0x8d, 0x3a, 0xae,
-	0x17, 0x04, 0x1c, 0x45, 0xea, 0xc8, 0x72, 0x48, 0x26, 0xda, 0x4a, 0x25, 0x64, 0x1b, 0xac, 0x80,
-	0x72, 0xf4, 0xd5, 0x65, 0xa8, 0x0b, 0x51, 0x6e, 0xde, 0x18, 0xc4, 0x83, 0xb2, 0x5b, 0x4f, 0x2f,
-	0xdc, 0xba, 0x72, 0xb4, 0x9b, 0x72, 0x9d, 0x81, 0x1a, 0xf9, 0x23, 0x54, 0x7c, 0x16, 0x45, 0x66,
-	0xe7, 0x0a, 0x75, 0x77, 0xe9, 0xea, 0x95, 0x87, 0x5b, 0x7b, 0xc4, 0xd4, 0x4e, 0x46, 0x37, 0x37,
-	0xdd, 0xa2, 0x3f, 0x0a, 0x90, 0x15, 0x98, 0x8f, 0x11, 0xb9, 0x4b, 0x03, 0x5d, 0x66, 0xcb, 0x99,
-	0x53, 0xdb, 0x83, 0x40, 0xb5, 0x21, 0x46, 0x5c, 0x97, 0xd4, 0x72, 0xd4, 0x92, 0xbc, 0x00, 0xcb,
-	0x50, 0xa3, 0x36, 0xd3, 0xa5, 0x2c, 0x36, 0x9b, 0xe7, 0xce, 0xa8, 0xfe, 0xa8, 0x83, 0xa8, 0xcd,
-	0x9c, 0x85, 0x38, 0x59, 0x91, 0x3f, 0x40, 0x51, 0x1b, 0x54, 0x1f, 0xd2, 0x13, 0xba, 0x03, 0x8a,
-	0xcd, 0xd5, 0x31, 0x93, 0x71, 0x33, 0x56, 0x26, 0x5b, 0x9a, 0xe5, 0x80, 0x52, 0x31, 0x6b, 0xb2,
-	0x01, 0xa5, 0xd0, 0x13, 0xd2, 0xed, 0xc5, 0x81, 0x27, 0x31, 0x48, 0xfa, 0xa3, 0xa8, 0xb0, 0x57,
-	0x06, 0x22, 0x8f, 0x01, 0x84, 0xcf, 0x38, 0x9a, 0xa8, 0x2d, 0xed, 0x62, 0x63, 0x5a, 0xd4, 0x2d,
-	0xc5, 0xd4, 0x41, 0x5a, 0x22, 0x5d, 0xd6, 0x3e, 0xe6, 0x60, 0x21, 0x0d, 0x9e, 0x3c, 0x82, 0x85,
-	0x53, 0x94, 0x5e, 0xe0, 0x49, 0x4f, 0x9f, 0xb0, 0x62, 0x73, 0x7d, 0x5a, 0xbc, 0xcf, 0x50, 0x7a,
-	0xbb, 0x9e, 0xf4, 0x9c, 0x4c, 0x83, 0x5c, 0x05, 0x4b, 0x5f, 0x2d, 0x3e, 0x0b, 0x45, 0x35, 0xaf,
-	0x5b, 0x65, 0x00, 0x90, 0x35, 0x28, 0xb6, 0xbd, 0x5e, 0x28, 0x5d, 0x9f, 0xf5, 0xb2, 0x63, 0x09,
-	0x1a, 0xda, 0x51, 0x08, 0xb9, 0x03, 0x95, 0x94, 0xed, 0xf6, 0x91, 0xab, 0x97, 0x2e, 0x29, 0xda,
-	0x62, 0x8a, 0xff, 0xc9, 0xc0, 0xe4, 0x3a, 0xfc, 0xc2, 0xeb, 0x60, 0x24, 0x33, 0x9e, 0xa9, 0x63,
-	0x49, 0x83, 0x29, 0x69, 0x03, 0x4a, 0x3a, 0xff, 0xa1, 0x27, 0x31, 0xf2, 0xcf, 0x92, 0xe3, 0xa9,
-	0x6b, 0x72, 0x68, 0x20, 0xfb, 0xab, 0x19, 0xb0, 0xb2, 0xac, 0x28, 0xab, 0xac, 0x8f, 0xdc, 0x0b,
-	0x43, 0x57, 0xe7, 0x47, 0xa7, 0x20, 0xef, 0x94, 0x12, 0x50, 0x13, 0x93, 0x28, 0x7d, 0xd5, 0xf5,
-	0x81, 0xab, 0x9f, 0x39, 0x91, 0x5c, 0x5c, 0x8b, 0x19, 0xae, 0xdf, 0x47, 0x41, 0x7e, 0x03, 0x4b,
-	0xe6, 0x65, 0x8c, 0x39, 0xeb, 0xd3, 0x40, 0xb5, 0x82, 0x36, 0x3b, 0xa3, 0xcd, 0x12, 0x2d, 0x3b,
-	0x4a, 0x44, 0xc6, 0xf8, 0x2b, 0x28, 0x49, 0x16, 0x53, 0xdf, 0x10, 0xd3, 0x8b, 0xbd, 0xf9, 0xc5,
-	0x82, 0xd6, 0x5f, 0x2a, 0x2d, 0xbd, 0x4d, 0xee, 0xdf, 0xa2, 0x1c, 0x20, 0x2a, 0x13, 0x1d, 0x26,
-	0x04, 0x8d, 0x93, 0x00, 0x0a, 0x3a, 0x80, 0xa2, 0xc1, 0x8c, 0xe7, 0x7b, 0x70, 0xf1, 0x18, 0xbb,
-	0x5e, 0x9f, 0xb2, 0x1e, 0x77, 0x63, 0x8c, 0xbc, 0x50, 0x9a, 0x8c, 0xe5, 0x9d, 0x4a, 0x26, 0x38,
-	0x32, 0xb8, 0xca, 0x41, 0xdf, 0x0b, 0x69, 0xa0, 0x47, 0x1f, 0x17, 0x39, 0x67, 0x5c, 0xb7, 0xb7,
-	0xe5, 0x2c, 0x0e, 0xf0, 0x3d, 0x05, 0xd7, 0xde, 0x40, 0xe5, 0xd3, 0xd8, 0x26, 0x3c, 0x01, 0x8f,
-	0x87, 0x9f, 0x80, 0x62, 0xf3, 0xee, 0xb4, 0x0f, 0x1e, 0x98, 0x6a, 0x45, 0x5e, 0x2c, 0xba, 0x4c,
-	0x0e, 0x3f, 0x17, 0xdf, 0xe7, 0x80, 0x8c, 0x33, 0xc8, 0x3a, 0x94, 0x24, 0x3d, 0x55, 0x47, 0xc4,
-	0x3d, 0x45, 0xd1, 0x4d, 0x9e, 0x0e, 0x50, 0xd8, 0x41, 0xf4, 0x0c, 0x45, 0x97, 0x3c, 0x80, 0x6a,
-	0x9b, 0x72, 0x21, 0xdd, 0x64, 0xd6, 0x73, 0x03, 0x0c, 0x69, 0x1f, 0x39, 0x45, 0x53, 0xdb, 0xbc,
-	0xb3, 0xac, 0xe5, 0xcf, 0x8c, 0x78, 0x37, 0x93, 0x92, 0xdf, 0xc3, 0x8a, 0xb2, 0x39, 0x49, 0xd1,
-	0x54, 0xf9, 0xb2, 0x12, 0x8f, 0xeb, 0x3d, 0x82, 0x1a, 0x8d, 0x74, 0xae, 0x26, 0xa9, 0xce, 0x6a,
-	0xd5, 0x6a, 0xc2, 0x18, 0xd3, 0x6e, 0xfe, 0x6f, 0x1e, 0x0a, 0xfa, 0x0a, 0x22, 0xff, 0xc8, 0x41,
-	0x79, 0x1f, 0xe5, 0xd0, 0xb4, 0x47, 0xa6, 0x26, 0x6f, 0x7c, 0x24, 0xac, 0x5d, 0x9f, 0xda, 0x59,
-	0x83, 0x91, 0xcd, 0xde, 0x78, 0xff, 0xcd, 0x77, 0xff, 0xc9, 0x5f, 0x21, 0xbf, 0x6c, 0x8c, 0xcc,
-	0xcd, 0x7a, 0xd2, 0x6e, 0xe8, 0x5b, 0x9a, 0xfc, 0x0d, 0x16, 0x54, 0x14, 0xaa, 0xa1, 0xc9, 0x8d,
-	0xa9, 0xfe, 0x87, 0xa6, 0xc6, 0x9f, 0xc1, 0xb3, 0x3e, 0x3e, 0xe4, 0xef, 0xb0, 0xd8, 0x42, 0x39,
-	0x3c, 0xfb, 0x91, 0x7b, 0x3f, 0x62, 0x42, 0xac, 0x2d, 0xd7, 0xcd, 0xc4, 0x5e, 0x4f, 0x27, 0xf6,
-	0xfa, 0x9e, 0x9a, 0xd8, 0xed, 0xeb, 0xda, 0xf5, 0x35, 0xfb, 0xca, 0x24, 0xd7, 0xa1, 0x31, 0x44,
-	0xfe, 0x9d, 0x83, 0x95, 0x7d, 0x94, 0x93, 0xa6, 0x22, 0x32, 0xc5, 0x70, 0xed, 0x77, 0x3f, 0x65,
-	0xb6, 0xb2, 0x6f, 0xea, 0x70, 0xd6, 0xc9, 0xea, 0xa4, 0x70, 0xda, 0x8c, 0x9f, 0xf8, 0xc6, 0x2b,
-	0x07, 0xeb, 0x90, 0x0a, 0xa9, 0x2e, 0x74, 0x31, 0x35, 0x84, 0xbb, 0xe7, 0x7e, 0xd6, 0xc4, 0xe7,
-	0x4b, 0x10, 0x6b, 0x37, 0xef, 0x60, 0x5e, 0x25, 0x01, 0x91, 0x13, 0xfb, 0x33, 0x4f, 0x7e, 0x9a,
-	0xf1, 0xf3, 0x8f, 0x29, 0xf6, 0xba, 0x76, 0x5e, 0x23, 0xd5, 0x69, 0xce, 0xc9, 0x7f, 0x73, 0x50,
-	0xd9, 0x47, 0x39, 0xf2, 0x6b, 0x44, 0x7e, 0x3d, 0xcd, 0xc3, 0xa4, 0xbf, 0xaf, 0xda, 0xfd, 0x73,
-	0xb2, 0x93, 0x98, 0x7e, 0xa5, 0x63, 0x5a, 0x23, 0xd7, 0x26, 0xc5, 0x44, 0x53, 0x95, 0xed, 0xd2,
-	0xff, 0x3f, 0xac, 0xe6, 0xbe, 0xfe, 0xb0, 0x9a, 0xfb, 0xf6, 0xc3, 0x6a, 0xee, 0x78, 0x4e, 0x57,
-	0xe0, 0xb7, 0x3f, 0x04, 0x00, 0x00, 0xff, 0xff, 0x48, 0xd6, 0xf7, 0x7c, 0xb3, 0x0e, 0x00, 0x00,
+func (m *ProtoArrayNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
-	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
-	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+func (m *ProtoArrayNode) GetBestChild() uint64 {
+	if m != nil {
+		return m.BestChild
+	}
+	return 0
 }
 
-type debugClient struct {
-	cc *grpc.ClientConn
+func (m *ProtoArrayNode) GetBestDescendant() uint64 {
+	if m != nil {
+		return m.BestDescendant
+	}
+	return 0
 }
 
-func NewDebugClient(cc *grpc.ClientConn) DebugClient {
-	return &debugClient{cc}
+type DebugPeerResponses struct {
+	Responses            []*DebugPeerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
+func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponses) ProtoMessage()    {}
+func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
 }
-
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DebugPeerResponses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponses.Merge(m, src)
 }
-
-func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
-	out := new(DebugPeerResponses)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DebugPeerResponses) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
-	out := new(DebugPeerResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DebugPeerResponses) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponses.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
-	out := new(InclusionSlotResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DebugPeerResponses proto.InternalMessageInfo
+
+func (m *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
+	if m != nil {
+		return m.Responses
 	}
-	return out, nil
+	return nil
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
-	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
-	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+type DebugPeerResponse struct {
+	ListeningAddresses   []string                    `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
+	Direction            v1alpha1.PeerDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
+	ConnectionState      v1alpha1.ConnectionState    `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
+	PeerId               string                      `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Enr                  string                      `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
+	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
+	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
+	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	ScoreInfo            *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
+	Traffic              *PeerTraffic                `protobuf:"bytes,10,opt,name=traffic,proto3" json:"traffic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
+func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
+func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponse) ProtoMessage()    {}
+func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
 }
-
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
-}
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
-}
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+func (m *DebugPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponse.Merge(m, src)
 }
-func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (m *DebugPeerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+func (m *DebugPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponse.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
-}
+var xxx_messageInfo_DebugPeerResponse proto.InternalMessageInfo
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+func (m *DebugPeerResponse) GetListeningAddresses() []string {
+	if m != nil {
+		return m.ListeningAddresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+func (m *DebugPeerResponse) GetDirection() v1alpha1.PeerDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return interceptor(ctx, in, info, handler)
+	return v1alpha1.PeerDirection_UNKNOWN
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+func (m *DebugPeerResponse) GetConnectionState() v1alpha1.ConnectionState {
+	if m != nil {
+		return m.ConnectionState
 	}
-	return interceptor(ctx, in, info, handler)
+	return v1alpha1.ConnectionState_DISCONNECTED
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+func (m *DebugPeerResponse) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	return ""
+}
+
+func (m *DebugPeerResponse) GetEnr() string {
+	if m != nil {
+		return m.Enr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DebugPeerResponse) GetPeerInfo() *DebugPeerResponse_PeerInfo {
+	if m != nil {
+		return m.PeerInfo
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).ListPeers(ctx, in)
+	return nil
+}
+
+func (m *DebugPeerResponse) GetPeerStatus() *v1.Status {
+	if m != nil {
+		return m.PeerStatus
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
+	return nil
+}
+
+func (m *DebugPeerResponse) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
+	if m != nil {
+		return m.ScoreInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.PeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DebugPeerResponse) GetTraffic() *PeerTraffic {
+	if m != nil {
+		return m.Traffic
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetPeer(ctx, in)
+	return nil
+}
+
+type DebugPeerResponse_PeerInfo struct {
+	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	FaultCount           uint64       `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
+	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_PeerInfo{} }
+func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
+func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18, 0}
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponse_PeerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponse_PeerInfo.Merge(m, src)
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponse_PeerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DebugPeerResponse_PeerInfo proto.InternalMessageInfo
+
+func (m *DebugPeerResponse_PeerInfo) GetMetadata() *v1.MetaData {
+	if m != nil {
+		return m.Metadata
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	return nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetProtocols() []string {
+	if m != nil {
+		return m.Protocols
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InclusionSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DebugPeerResponse_PeerInfo) GetFaultCount() uint64 {
+	if m != nil {
+		return m.FaultCount
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetInclusionSlot(ctx, in)
+	return 0
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
+	return ""
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetAgentVersion() string {
+	if m != nil {
+		return m.AgentVersion
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+	return ""
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
+	if m != nil {
+		return m.PeerLatency
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Debug_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.Debug",
-	HandlerType: (*DebugServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconState",
-			Handler:    _Debug_GetBeaconState_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _Debug_GetBlock_Handler,
-		},
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _Debug_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "GetProtoArrayForkChoice",
-			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _Debug_ListPeers_Handler,
-		},
-		{
-			MethodName: "GetPeer",
-			Handler:    _Debug_GetPeer_Handler,
-		},
-		{
-			MethodName: "GetInclusionSlot",
-			Handler:    _Debug_GetInclusionSlot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/debug.proto",
+type ScoreInfo struct {
+	OverallScore         float32                        `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	ProcessedBlocks      uint64                         `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	BlockProviderScore   float32                        `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
+	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GossipScore          float32                        `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
+	BehaviourPenalty     float32                        `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	ValidationError      string                         `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScoreInfo) Reset()         { *m = ScoreInfo{} }
+func (m *ScoreInfo) String() string { return proto.CompactTextString(m) }
+func (*ScoreInfo) ProtoMessage()    {}
+func (*ScoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+func (m *ScoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreInfo.Merge(m, src)
+}
+func (m *ScoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreInfo.DiscardUnknown(m)
 }
 
-func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_ScoreInfo proto.InternalMessageInfo
+
+func (m *ScoreInfo) GetOverallScore() float32 {
+	if m != nil {
+		return m.OverallScore
 	}
-	if m.Id != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ScoreInfo) GetProcessedBlocks() uint64 {
+	if m != nil {
+		return m.ProcessedBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScoreInfo) GetBlockProviderScore() float32 {
+	if m != nil {
+		return m.BlockProviderScore
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScoreInfo) GetTopicScores() map[string]*TopicScoreSnapshot {
+	if m != nil {
+		return m.TopicScores
+	}
+	return nil
 }
 
-func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ScoreInfo) GetGossipScore() float32 {
+	if m != nil {
+		return m.GossipScore
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *ScoreInfo) GetBehaviourPenalty() float32 {
+	if m != nil {
+		return m.BehaviourPenalty
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScoreInfo) GetValidationError() string {
+	if m != nil {
+		return m.ValidationError
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TopicScoreSnapshot struct {
+	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
+	FirstMessageDeliveries   float32  `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
+	MeshMessageDeliveries    float32  `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
+	InvalidMessageDeliveries float32  `protobuf:"fixed32,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryFilter != nil {
-		{
-			size := m.QueryFilter.Size()
-			i -= size
-			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
+func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TopicScoreSnapshot) ProtoMessage()    {}
+func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
+}
+func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicScoreSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopicScoreSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicScoreSnapshot.Merge(m, src)
 }
-
-func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *TopicScoreSnapshot) XXX_Size() int {
+	return m.Size()
 }
-func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopicScoreSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicScoreSnapshot.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BlockRoot != nil {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_TopicScoreSnapshot proto.InternalMessageInfo
+
+func (m *TopicScoreSnapshot) GetTimeInMesh() uint64 {
+	if m != nil {
+		return m.TimeInMesh
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *TopicScoreSnapshot) GetFirstMessageDeliveries() float32 {
+	if m != nil {
+		return m.FirstMessageDeliveries
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopicScoreSnapshot) GetMeshMessageDeliveries() float32 {
+	if m != nil {
+		return m.MeshMessageDeliveries
+	}
+	return 0
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
+func (m *TopicScoreSnapshot) GetInvalidMessageDeliveries() float32 {
+	if m != nil {
+		return m.InvalidMessageDeliveries
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.PeerRule_Kind", PeerRule_Kind_name, PeerRule_Kind_value)
+	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
+	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*BackfillStatusResponse)(nil), "ethereum.beacon.rpc.v1.BackfillStatusResponse")
+	proto.RegisterType((*ReorgsResponse)(nil), "ethereum.beacon.rpc.v1.ReorgsResponse")
+	proto.RegisterType((*Reorg)(nil), "ethereum.beacon.rpc.v1.Reorg")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*PeerRule)(nil), "ethereum.beacon.rpc.v1.PeerRule")
+	proto.RegisterType((*PeerRulesResponse)(nil), "ethereum.beacon.rpc.v1.PeerRulesResponse")
+	proto.RegisterType((*PeerTrafficRequest)(nil), "ethereum.beacon.rpc.v1.PeerTrafficRequest")
+	proto.RegisterType((*PeerTrafficResponse)(nil), "ethereum.beacon.rpc.v1.PeerTrafficResponse")
+	proto.RegisterType((*PeerTraffic)(nil), "ethereum.beacon.rpc.v1.PeerTraffic")
+	proto.RegisterMapType((map[string]*Traffic)(nil), "ethereum.beacon.rpc.v1.PeerTraffic.ProtocolsEntry")
+	proto.RegisterMapType((map[string]*Traffic)(nil), "ethereum.beacon.rpc.v1.PeerTraffic.TopicsEntry")
+	proto.RegisterType((*Traffic)(nil), "ethereum.beacon.rpc.v1.Traffic")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
+	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
+	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*ScoreInfo)(nil), "ethereum.beacon.rpc.v1.ScoreInfo")
+	proto.RegisterMapType((map[string]*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry")
+	proto.RegisterType((*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.TopicScoreSnapshot")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 2183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6f, 0x1b, 0xc7,
+	0x11, 0xf7, 0x49, 0x24, 0x45, 0x0e, 0x69, 0x8a, 0xde, 0x38, 0x32, 0x4d, 0xdb, 0xfa, 0x38, 0x27,
+	0xfe, 0x6c, 0xc8, 0x9a, 0x6d, 0x8a, 0x24, 0x48, 0xd1, 0x48, 0x96, 0x22, 0x0b, 0xf5, 0x87, 0x7a,
+	0x92, 0xfb, 0x90, 0x20, 0x38, 0x9c, 0xee, 0x96, 0xe4, 0x45, 0xc7, 0xdd, 0xcb, 0xed, 0x92, 0xae,
+	0x9c, 0xa7, 0x06, 0xfd, 0x78, 0x2c, 0xd0, 0xa2, 0xfd, 0x5b, 0xda, 0xff, 0xa0, 0x40, 0x5f, 0x0a,
+	0xf4, 0x1f, 0x28, 0x8c, 0xa2, 0x45, 0xff, 0x85, 0x3e, 0x15, 0x3b, 0x7b, 0x7b, 0x24, 0x23, 0x9e,
+	0xa5, 0x16, 0x7e, 0xbb, 0x99, 0xf9, 0xcd, 0xc7, 0xcd, 0xcc, 0xee, 0xce, 0x2e, 0xac, 0xc5, 0x09,
+	0x97, 0xbc, 0x73, 0x44, 0x3d, 0x9f, 0xb3, 0x4e, 0x12, 0xfb, 0x9d, 0xf1, 0x83, 0x4e, 0x40, 0x8f,
+	0x46, 0xfd, 0x36, 0x4a, 0xc8, 0x0a, 0x95, 0x03, 0x9a, 0xd0, 0xd1, 0xb0, 0xad, 0x31, 0xed, 0x24,
+	0xf6, 0xdb, 0xe3, 0x07, 0xad, 0x2b, 0x54, 0x0e, 0x3a, 0xe3, 0x07, 0x5e, 0x14, 0x0f, 0xbc, 0x07,
+	0x1d, 0xc6, 0x03, 0xaa, 0x15, 0x5a, 0xf6, 0x8c, 0xc5, 0xb8, 0x1b, 0x2b, 0x8b, 0x43, 0x2a, 0x84,
+	0xd7, 0xa7, 0x22, 0xc5, 0x5c, 0xef, 0x73, 0xde, 0x8f, 0x68, 0xc7, 0x8b, 0xc3, 0x8e, 0xc7, 0x18,
+	0x97, 0x9e, 0x0c, 0x39, 0x33, 0xd2, 0x6b, 0xa9, 0x14, 0xa9, 0xa3, 0x51, 0xaf, 0x43, 0x87, 0xb1,
+	0x3c, 0xd1, 0x42, 0xfb, 0x23, 0xb8, 0xbc, 0xc7, 0xfc, 0x68, 0x24, 0x42, 0xce, 0x0e, 0x22, 0x2e,
+	0x1d, 0xfa, 0xd5, 0x88, 0x0a, 0x49, 0xea, 0xb0, 0x10, 0x06, 0x4d, 0x6b, 0xdd, 0xba, 0x53, 0x70,
+	0x16, 0xc2, 0x80, 0x10, 0x28, 0x88, 0x88, 0xcb, 0xe6, 0x02, 0x72, 0xf0, 0xdb, 0xbe, 0x0f, 0x6f,
+	0x7f, 0x4b, 0x57, 0xc4, 0x9c, 0x09, 0x3a, 0x17, 0xfc, 0x47, 0x0b, 0x56, 0xb6, 0x3c, 0xff, 0xb8,
+	0x17, 0x46, 0xd1, 0x81, 0xf4, 0xe4, 0x48, 0x64, 0xf0, 0x35, 0xa8, 0xf2, 0x24, 0xec, 0x87, 0xcc,
+	0x45, 0x2d, 0xed, 0x14, 0x34, 0x4b, 0xd9, 0x9d, 0x02, 0x24, 0x3c, 0x35, 0x5b, 0x33, 0x00, 0x87,
+	0x6b, 0x40, 0xc4, 0x5f, 0x50, 0x21, 0xb5, 0x85, 0x45, 0x6d, 0x41, 0xb3, 0x8c, 0x85, 0x14, 0x80,
+	0x16, 0x0a, 0xda, 0x82, 0x66, 0xa1, 0x85, 0x16, 0x94, 0x7d, 0x3e, 0x8c, 0x23, 0x2a, 0x69, 0xb3,
+	0xb8, 0x6e, 0xdd, 0x29, 0x3b, 0x19, 0x6d, 0xef, 0x42, 0xdd, 0xa1, 0x3c, 0xe9, 0x4f, 0x22, 0x7e,
+	0x1f, 0x4a, 0x09, 0x72, 0x9a, 0xd6, 0xfa, 0xe2, 0x9d, 0x6a, 0xf7, 0x46, 0x7b, 0x7e, 0x59, 0xdb,
+	0xa8, 0xe7, 0xa4, 0x60, 0xfb, 0xdf, 0x16, 0x14, 0x91, 0x43, 0xae, 0x42, 0x99, 0x47, 0xc1, 0xf4,
+	0xff, 0x2e, 0xf1, 0x28, 0xc0, 0x50, 0xaf, 0x42, 0x99, 0xd1, 0x17, 0xee, 0x54, 0x02, 0x97, 0x18,
+	0x7d, 0x81, 0xa2, 0xcb, 0x50, 0x0c, 0x68, 0x2c, 0x07, 0xe9, 0x0f, 0x6a, 0x42, 0x85, 0x1e, 0x84,
+	0x42, 0x7a, 0xcc, 0xa7, 0xf8, 0x63, 0x05, 0x27, 0xa3, 0xc9, 0x77, 0xe1, 0xb2, 0xcf, 0x87, 0x43,
+	0xce, 0x5c, 0x45, 0x0a, 0xc9, 0x13, 0x9d, 0x80, 0x22, 0x26, 0x80, 0x68, 0xd9, 0x66, 0x2a, 0xc2,
+	0x44, 0xd8, 0x70, 0x51, 0x45, 0x36, 0xa0, 0x5e, 0xa0, 0xa1, 0x25, 0x84, 0x56, 0x79, 0x14, 0x3c,
+	0xa2, 0x5e, 0x60, 0x30, 0x2a, 0xc4, 0x09, 0x66, 0x49, 0x63, 0x18, 0x7d, 0x61, 0x30, 0xf6, 0xe7,
+	0x40, 0xb6, 0x30, 0x15, 0xaa, 0xd8, 0xd4, 0xb4, 0xd5, 0x65, 0x28, 0x4c, 0xfe, 0xf9, 0xd1, 0x05,
+	0xdd, 0x1b, 0x64, 0x0d, 0xe0, 0x28, 0xe2, 0xfe, 0xf1, 0x54, 0x79, 0x1f, 0x5d, 0x70, 0x2a, 0xc8,
+	0x53, 0xc6, 0xb6, 0xea, 0x50, 0xfb, 0x6a, 0x44, 0x93, 0x13, 0xb7, 0x17, 0x46, 0x92, 0x26, 0xf6,
+	0x7b, 0x50, 0xdb, 0x42, 0x61, 0x6a, 0xf6, 0xc6, 0x8c, 0x01, 0x0b, 0xa3, 0x99, 0xa8, 0xdb, 0xb7,
+	0xa1, 0x7a, 0x70, 0xf0, 0x59, 0x56, 0xbd, 0x26, 0x2c, 0x51, 0xe6, 0xf3, 0x80, 0x06, 0x29, 0xd4,
+	0x90, 0xf6, 0xaf, 0x2d, 0x78, 0xeb, 0x31, 0xef, 0xf7, 0x43, 0xd6, 0x7f, 0x4c, 0xc7, 0x34, 0x32,
+	0xf6, 0x77, 0xa1, 0x18, 0x29, 0x1a, 0xf1, 0xf5, 0xee, 0x83, 0xbc, 0x72, 0xcf, 0xd1, 0x6d, 0x6b,
+	0x42, 0xeb, 0xdb, 0xb7, 0xa1, 0x88, 0x34, 0x29, 0x43, 0x61, 0xef, 0xe9, 0xa7, 0xcf, 0x1a, 0x17,
+	0x48, 0x05, 0x8a, 0xdb, 0x3b, 0x5b, 0xcf, 0x77, 0x1b, 0x96, 0xfa, 0x3c, 0x74, 0x36, 0x1f, 0xee,
+	0x34, 0x16, 0xec, 0x97, 0x50, 0xde, 0xa7, 0x34, 0x71, 0x46, 0x11, 0x25, 0x1f, 0x42, 0xe1, 0x38,
+	0x64, 0x41, 0xea, 0xfc, 0xdd, 0x3c, 0xe7, 0x06, 0xdf, 0xfe, 0x71, 0xc8, 0x02, 0x07, 0x55, 0xd4,
+	0x4a, 0x8c, 0x29, 0x4d, 0x30, 0xa7, 0x15, 0x07, 0xbf, 0xed, 0x35, 0x28, 0x28, 0x04, 0xa9, 0xc2,
+	0xd2, 0xa1, 0xf3, 0xfc, 0xe0, 0x70, 0x67, 0xbb, 0x71, 0x81, 0x00, 0x94, 0xb6, 0x36, 0x9f, 0x3e,
+	0xdd, 0xd9, 0x6e, 0x58, 0xf6, 0x0e, 0x5c, 0x32, 0xb6, 0xc4, 0x74, 0xd2, 0x64, 0x32, 0x12, 0x12,
+	0x93, 0xb6, 0x78, 0xa7, 0xe2, 0x18, 0x92, 0xac, 0x40, 0xe9, 0xc8, 0x63, 0x8c, 0x06, 0xcd, 0x05,
+	0x14, 0xa4, 0x94, 0x7d, 0x0f, 0x88, 0x32, 0x73, 0x98, 0x78, 0xbd, 0x5e, 0xe8, 0x4f, 0x3a, 0xa0,
+	0x18, 0x85, 0xc3, 0xd0, 0xb4, 0xbd, 0x26, 0xec, 0x7d, 0x78, 0x6b, 0x06, 0x9b, 0x3a, 0xfd, 0x10,
+	0x8a, 0x2a, 0x64, 0xb3, 0xcc, 0x6e, 0xbe, 0xee, 0xd7, 0x8d, 0xae, 0xd6, 0xb0, 0xff, 0xb5, 0x08,
+	0xd5, 0x29, 0x36, 0xb9, 0x02, 0x4b, 0x4a, 0xe0, 0xa6, 0xbb, 0x5a, 0xc5, 0x29, 0x29, 0x72, 0x2f,
+	0x20, 0xef, 0x43, 0x51, 0x72, 0xe9, 0x45, 0x98, 0xa3, 0x6a, 0x77, 0x2d, 0xcf, 0x47, 0x66, 0x1f,
+	0xd1, 0xca, 0x5e, 0xe2, 0x49, 0xea, 0x86, 0x0c, 0x57, 0xa3, 0xe5, 0x94, 0x14, 0xb9, 0xc7, 0xd4,
+	0xfa, 0x45, 0x01, 0x1f, 0xe9, 0x7d, 0xc6, 0x72, 0x10, 0xf8, 0x6c, 0xa4, 0xda, 0xa8, 0x24, 0x79,
+	0x1c, 0xfa, 0xa2, 0x59, 0xc4, 0xff, 0xe9, 0x9c, 0xe3, 0x7f, 0xda, 0x87, 0xa8, 0xb1, 0xc3, 0x64,
+	0x72, 0xe2, 0xa4, 0xea, 0x64, 0x1f, 0x2a, 0xb8, 0x7d, 0xfb, 0x3c, 0x12, 0xcd, 0x12, 0xda, 0xea,
+	0x9e, 0xc7, 0xd6, 0xbe, 0x51, 0xd2, 0xe6, 0x26, 0x46, 0x5a, 0x9f, 0x41, 0x75, 0xca, 0x11, 0x69,
+	0xc0, 0xe2, 0x31, 0x3d, 0x49, 0x33, 0xa5, 0x3e, 0x55, 0x9a, 0xc6, 0x5e, 0x34, 0xa2, 0xe7, 0x4e,
+	0x13, 0xa2, 0x3f, 0x5a, 0xf8, 0xc0, 0x6a, 0x7d, 0x01, 0xf5, 0x59, 0xc7, 0x6f, 0xd4, 0xbc, 0xfd,
+	0x4b, 0x0b, 0x96, 0x4c, 0x95, 0xaf, 0x42, 0xf9, 0xe8, 0x44, 0x52, 0xa1, 0xca, 0x92, 0xee, 0xab,
+	0x48, 0xef, 0x31, 0x72, 0x0d, 0x2a, 0x5a, 0xa4, 0x0a, 0xa3, 0x37, 0x56, 0x8d, 0x55, 0x95, 0x59,
+	0x83, 0xaa, 0x39, 0x53, 0x4d, 0x45, 0x0b, 0x0e, 0x18, 0xd6, 0x1e, 0x23, 0x1b, 0x50, 0xcb, 0x00,
+	0xa6, 0xb2, 0x05, 0x27, 0x53, 0x7a, 0x36, 0x92, 0xf6, 0xaf, 0x16, 0xe1, 0x3a, 0xfe, 0xe7, 0x66,
+	0x92, 0x78, 0x27, 0x9f, 0xf2, 0xe4, 0xf8, 0xe1, 0x80, 0x87, 0x3e, 0xcd, 0xba, 0xf9, 0x36, 0x2c,
+	0xc7, 0xc9, 0x88, 0x51, 0x57, 0x0e, 0x12, 0x2a, 0x06, 0x3c, 0x32, 0x07, 0x6c, 0x1d, 0xd9, 0x87,
+	0x86, 0xab, 0x80, 0x5f, 0x8e, 0x84, 0x0c, 0x7b, 0x21, 0x0d, 0x5c, 0x1a, 0x73, 0x7f, 0x90, 0x06,
+	0x5c, 0xcf, 0xd8, 0x3b, 0x8a, 0xab, 0x80, 0xbd, 0x90, 0x79, 0x51, 0xf8, 0x32, 0x03, 0xea, 0xd0,
+	0xeb, 0x19, 0x5b, 0x03, 0x1d, 0xb8, 0x84, 0xb5, 0x76, 0x3d, 0x15, 0x9b, 0xab, 0xe6, 0x0b, 0xd1,
+	0x2c, 0x60, 0xe3, 0xdc, 0xca, 0x6d, 0x9c, 0xec, 0x5f, 0x9e, 0xf2, 0x80, 0x3a, 0xcb, 0xf1, 0x0c,
+	0x2d, 0xc8, 0xe7, 0xb0, 0x14, 0xb2, 0x20, 0xf4, 0xa9, 0x69, 0xe7, 0xcd, 0xb3, 0x2d, 0x9d, 0xce,
+	0x4a, 0x7b, 0x4f, 0xdb, 0xd0, 0x1d, 0x69, 0x2c, 0xb6, 0x3e, 0x82, 0xda, 0xb4, 0x60, 0x4e, 0xc7,
+	0x5c, 0x9e, 0xee, 0x98, 0xc2, 0x74, 0x43, 0x7c, 0xb3, 0x00, 0xf5, 0x89, 0x4b, 0x15, 0x6c, 0x36,
+	0x91, 0x58, 0x93, 0x89, 0x44, 0xf1, 0xa6, 0xc6, 0x09, 0xfc, 0x56, 0x7b, 0x59, 0xec, 0x25, 0x94,
+	0x99, 0x19, 0x22, 0xa5, 0xe6, 0x55, 0xa4, 0x70, 0xde, 0x8a, 0x14, 0xe7, 0x56, 0x64, 0x05, 0x4a,
+	0x2f, 0x68, 0xd8, 0x1f, 0xe8, 0x03, 0xb6, 0xe0, 0xa4, 0x14, 0x1e, 0x65, 0x6a, 0x4e, 0xf1, 0x07,
+	0x61, 0x14, 0xe0, 0xc1, 0x5a, 0x70, 0x2a, 0x8a, 0xf3, 0x50, 0x31, 0x94, 0x7d, 0x14, 0x07, 0x54,
+	0xf8, 0x94, 0x05, 0x1e, 0x93, 0xcd, 0xb2, 0xb6, 0xaf, 0xd8, 0xdb, 0x19, 0xd7, 0xfe, 0x02, 0xc8,
+	0xb6, 0x9a, 0x3b, 0x71, 0x27, 0x4f, 0x73, 0x2d, 0xc8, 0x2e, 0x54, 0x12, 0x43, 0xa4, 0x9b, 0xea,
+	0xdd, 0xbc, 0xaa, 0x9d, 0x52, 0x77, 0x26, 0xba, 0xf6, 0x9f, 0x4a, 0x70, 0xe9, 0x14, 0x80, 0x74,
+	0xe0, 0xad, 0x28, 0x14, 0x92, 0xb2, 0x90, 0xf5, 0x5d, 0x2f, 0x08, 0x12, 0x2a, 0x8c, 0xa3, 0x8a,
+	0x43, 0x32, 0xd1, 0xa6, 0x91, 0x90, 0x2d, 0xa8, 0x04, 0x61, 0x42, 0x7d, 0x35, 0xaf, 0x62, 0x21,
+	0xea, 0xdd, 0x77, 0x26, 0xf1, 0x50, 0x39, 0x68, 0x9b, 0x99, 0x18, 0xf7, 0xb1, 0x6d, 0x83, 0x75,
+	0x26, 0x6a, 0xe4, 0x27, 0xd0, 0xf0, 0x39, 0x63, 0x9a, 0x72, 0x85, 0xf4, 0x24, 0xc5, 0xea, 0xd5,
+	0xbb, 0xb7, 0x72, 0x4c, 0x3d, 0xcc, 0xe0, 0x7a, 0x38, 0x59, 0xf6, 0x67, 0x19, 0xd3, 0x87, 0x45,
+	0x61, 0xe6, 0xb0, 0x68, 0xc0, 0x22, 0x65, 0x09, 0x96, 0xb4, 0xe2, 0xa8, 0x4f, 0xf2, 0x0c, 0x2a,
+	0x1a, 0xca, 0x7a, 0x1c, 0x4b, 0xf9, 0x9a, 0xad, 0xf8, 0x54, 0xc2, 0xf0, 0xa7, 0xf6, 0x58, 0x8f,
+	0x3b, 0xe5, 0x38, 0xfd, 0x22, 0x3f, 0x82, 0x2a, 0x1a, 0x14, 0x38, 0x24, 0x63, 0x07, 0x54, 0xbb,
+	0xab, 0xa7, 0x4c, 0xc6, 0xdd, 0x58, 0x99, 0x4c, 0x47, 0x69, 0x50, 0x2a, 0xfa, 0x5b, 0x6d, 0x55,
+	0x91, 0x27, 0xa4, 0x3b, 0x8a, 0x03, 0x4f, 0x1d, 0xd7, 0xba, 0x3f, 0xaa, 0x8a, 0xf7, 0x5c, 0xb3,
+	0xc8, 0x27, 0x00, 0xc2, 0xe7, 0x09, 0xd5, 0x51, 0x57, 0xd0, 0xc5, 0x46, 0x5e, 0xd4, 0x07, 0x0a,
+	0x89, 0x41, 0x56, 0x84, 0xf9, 0x24, 0x3f, 0x54, 0xe3, 0x00, 0xee, 0xb9, 0x4d, 0x58, 0xb7, 0xce,
+	0x7b, 0x36, 0x1b, 0x9d, 0xd6, 0x7f, 0x2c, 0x3d, 0xdf, 0xa0, 0xad, 0x8f, 0xa1, 0x3c, 0xa4, 0xd2,
+	0x0b, 0x3c, 0xe9, 0xe1, 0x02, 0xad, 0x76, 0xd7, 0xf3, 0x7e, 0xf7, 0x09, 0x95, 0xde, 0xb6, 0x27,
+	0x3d, 0x27, 0xd3, 0x20, 0xd7, 0xa7, 0xcf, 0x42, 0x3d, 0x81, 0x4c, 0x18, 0x6a, 0x63, 0xef, 0x79,
+	0xa3, 0x48, 0xba, 0x3e, 0x1f, 0x65, 0xab, 0x1a, 0x90, 0xf5, 0x50, 0x71, 0xc8, 0x5d, 0x68, 0x18,
+	0xb4, 0x3b, 0xa6, 0x89, 0xba, 0xcb, 0xa4, 0x35, 0x5f, 0x36, 0xfc, 0x9f, 0x6a, 0x36, 0xb9, 0x09,
+	0x17, 0xbd, 0x3e, 0x65, 0x32, 0xc3, 0xe9, 0x36, 0xa8, 0x21, 0xd3, 0x80, 0x36, 0xa0, 0x86, 0xe5,
+	0x8b, 0x3c, 0x49, 0x99, 0x7f, 0x92, 0xae, 0x6e, 0x2c, 0xe9, 0x63, 0xcd, 0xb2, 0xff, 0xb2, 0x08,
+	0x95, 0x2c, 0xa9, 0xca, 0x2a, 0x1f, 0xd3, 0xc4, 0x8b, 0x22, 0x17, 0xd3, 0x8b, 0x29, 0x58, 0x70,
+	0x6a, 0x29, 0x13, 0x81, 0x69, 0x94, 0xbe, 0x5a, 0x34, 0x81, 0x8b, 0x83, 0xad, 0x48, 0xf7, 0xbd,
+	0xe5, 0x8c, 0x8f, 0x13, 0xb1, 0x50, 0x23, 0xbf, 0x9e, 0x85, 0xe3, 0x84, 0x8f, 0xc3, 0x40, 0x75,
+	0x12, 0x9a, 0x5d, 0x44, 0xb3, 0x04, 0x65, 0xfb, 0xa9, 0x48, 0x1b, 0x7f, 0x0e, 0x35, 0x9c, 0x2b,
+	0x34, 0xd0, 0x9c, 0x0b, 0xdd, 0x33, 0xfb, 0x41, 0x8f, 0x26, 0x48, 0xa6, 0xdb, 0x77, 0x55, 0x4e,
+	0x38, 0x2a, 0x13, 0x7d, 0x2e, 0x44, 0x18, 0xa7, 0x01, 0x14, 0x31, 0x80, 0xaa, 0xe6, 0x69, 0xcf,
+	0xf7, 0xe1, 0xd2, 0x11, 0x1d, 0x78, 0xe3, 0x90, 0x8f, 0x12, 0x37, 0xa6, 0xcc, 0x8b, 0xa4, 0xce,
+	0xd8, 0x82, 0xd3, 0xc8, 0x04, 0xfb, 0x9a, 0xaf, 0x72, 0x30, 0xf6, 0xa2, 0x30, 0xc0, 0xcb, 0xad,
+	0x4b, 0x93, 0x84, 0x27, 0xb8, 0x3a, 0x2a, 0xce, 0xf2, 0x84, 0xbf, 0xa3, 0xd8, 0xad, 0x2f, 0xa1,
+	0xf1, 0xed, 0xd8, 0xe6, 0x9c, 0x20, 0x9f, 0xcc, 0xce, 0x1c, 0xf7, 0x72, 0x67, 0x8e, 0xcc, 0xd4,
+	0x01, 0xf3, 0x62, 0x31, 0xe0, 0x72, 0xfa, 0xb4, 0xf9, 0xa7, 0x05, 0xe4, 0x34, 0x82, 0xac, 0x43,
+	0x4d, 0x86, 0x43, 0xb5, 0xc2, 0xdc, 0x21, 0x15, 0x03, 0x73, 0xab, 0x55, 0xbc, 0x3d, 0xf6, 0x84,
+	0x8a, 0x01, 0xf9, 0x00, 0x9a, 0xbd, 0x30, 0x11, 0xd2, 0x4d, 0x87, 0x08, 0x37, 0xa0, 0x51, 0x38,
+	0xa6, 0x49, 0x48, 0x75, 0x6d, 0x17, 0x9c, 0x15, 0x94, 0x3f, 0xd1, 0xe2, 0xed, 0x4c, 0x4a, 0x7e,
+	0x00, 0x57, 0x94, 0xcd, 0x79, 0x8a, 0xba, 0xca, 0x6f, 0x2b, 0xf1, 0x69, 0xbd, 0x8f, 0xa1, 0x15,
+	0x32, 0xcc, 0xd5, 0x3c, 0xd5, 0x02, 0xaa, 0x36, 0x53, 0xc4, 0x29, 0xed, 0xee, 0x6f, 0x2f, 0x42,
+	0x11, 0x77, 0x30, 0xf2, 0x0b, 0x0b, 0xea, 0xbb, 0x54, 0x4e, 0xdd, 0xef, 0x48, 0x6e, 0xf2, 0x4e,
+	0x5f, 0x02, 0x5b, 0xb9, 0x5b, 0xc5, 0xd4, 0x25, 0xcd, 0xde, 0xf8, 0xe6, 0x6f, 0xff, 0xf8, 0xdd,
+	0xc2, 0x35, 0x72, 0xb5, 0x33, 0xf3, 0x32, 0x82, 0x6f, 0x29, 0x1d, 0xdc, 0xe4, 0xc9, 0xcf, 0xa0,
+	0xac, 0xa2, 0x50, 0x0d, 0x4d, 0xde, 0xc9, 0xf5, 0x3f, 0x75, 0x4f, 0x7c, 0x03, 0x9e, 0x71, 0xf9,
+	0x90, 0xaf, 0x61, 0xf9, 0x80, 0xca, 0xe9, 0xdb, 0x1e, 0xb9, 0xff, 0x3f, 0xdc, 0x09, 0x5b, 0x2b,
+	0x6d, 0xfd, 0x26, 0xd3, 0x36, 0x6f, 0x32, 0xed, 0x1d, 0xf5, 0x26, 0x63, 0xdf, 0x44, 0xd7, 0x37,
+	0xec, 0x6b, 0xf3, 0x5c, 0x47, 0xda, 0x10, 0xf9, 0x8d, 0x05, 0x57, 0x76, 0xa9, 0x9c, 0x37, 0x54,
+	0x91, 0x1c, 0xc3, 0xad, 0xef, 0xff, 0x3f, 0xa3, 0x99, 0x7d, 0x0b, 0xc3, 0x59, 0x27, 0xab, 0xf3,
+	0xc2, 0xe9, 0xf1, 0xe4, 0xd8, 0xd7, 0x5e, 0x13, 0xa8, 0x3c, 0x0e, 0x85, 0x54, 0x1b, 0xba, 0xc8,
+	0x0d, 0xe1, 0xde, 0xb9, 0x4f, 0x45, 0xf1, 0xfa, 0x12, 0xe0, 0xfd, 0x8e, 0xbc, 0x84, 0x25, 0x95,
+	0x04, 0x4a, 0x13, 0x62, 0xbf, 0x66, 0x62, 0x30, 0x19, 0x3f, 0xff, 0x94, 0x63, 0xaf, 0xa3, 0xf3,
+	0x16, 0x69, 0xe6, 0x39, 0x27, 0x7f, 0xb0, 0xa0, 0xb1, 0x4b, 0xe5, 0xcc, 0xe3, 0x17, 0xf9, 0x4e,
+	0x9e, 0x87, 0x79, 0xef, 0x6b, 0xad, 0xf7, 0xce, 0x89, 0x4e, 0x63, 0x7a, 0x17, 0x63, 0x5a, 0x23,
+	0x37, 0xe6, 0xc5, 0x14, 0x1a, 0x15, 0xf2, 0x73, 0x0b, 0x2e, 0xa9, 0x25, 0x31, 0xf3, 0xce, 0x96,
+	0x5b, 0x91, 0x76, 0xee, 0x9a, 0x99, 0xfb, 0x4e, 0x67, 0xbf, 0x83, 0x41, 0xac, 0x92, 0xeb, 0x73,
+	0x17, 0x46, 0xaa, 0x43, 0x62, 0x00, 0xd5, 0x0c, 0xfa, 0xc5, 0x2c, 0xd7, 0xf7, 0xad, 0xd7, 0xbe,
+	0x98, 0x4d, 0x7c, 0xda, 0xe8, 0xf3, 0x3a, 0x69, 0xcd, 0xf3, 0xa9, 0x9f, 0xd5, 0x48, 0x0c, 0xd5,
+	0xcd, 0x20, 0xc8, 0x9e, 0x4b, 0xd6, 0xcf, 0x7a, 0x20, 0xc9, 0x5d, 0x7e, 0xb7, 0xd1, 0xd9, 0x86,
+	0xbd, 0x96, 0xdb, 0x76, 0x9d, 0x44, 0x3d, 0x8a, 0x10, 0xa1, 0x5e, 0x04, 0x87, 0x7c, 0x4c, 0xdf,
+	0x9c, 0xd3, 0x7b, 0x67, 0x3a, 0xfd, 0x1a, 0x2e, 0x9a, 0x55, 0xe6, 0x20, 0x23, 0x2f, 0xb7, 0x77,
+	0xcf, 0x8a, 0x65, 0x92, 0xde, 0xd4, 0x39, 0x39, 0xd3, 0xf9, 0xef, 0x2d, 0x58, 0x36, 0xde, 0xcd,
+	0x65, 0xfb, 0xde, 0x79, 0x46, 0xbe, 0xb4, 0xdf, 0xef, 0x9f, 0x0b, 0x9b, 0x46, 0x75, 0x17, 0xa3,
+	0xba, 0x49, 0x36, 0xf2, 0xa3, 0x4a, 0x07, 0xc9, 0xad, 0xda, 0x9f, 0x5f, 0xad, 0x5a, 0x7f, 0x7d,
+	0xb5, 0x6a, 0xfd, 0xfd, 0xd5, 0xaa, 0x75, 0x54, 0xc2, 0x4c, 0x7c, 0xef, 0xbf, 0x03, 0x00, 0x48,
+	0xa2, 0xf8, 0x10, 0x87, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error)
+	ListReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ReorgsResponse, error)
+	AddPeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*types.Empty, error)
+	RemovePeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*types.Empty, error)
+	ListPeerRules(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerRulesResponse, error)
+	ListPeerTraffic(ctx context.Context, in *PeerTrafficRequest, opts ...grpc.CallOption) (*PeerTrafficResponse, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Encoded) > 0 {
-		i -= len(m.Encoded)
-		copy(dAtA[i:], m.Encoded)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
-		i--
-		dAtA[i] = 0xa
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
+	out := new(DebugPeerResponses)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Level != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x8
+func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
+	out := new(DebugPeerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
+	out := new(InclusionSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error) {
+	out := new(BackfillStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		for k := range m.Indices {
-			v := m.Indices[k]
-			baseI := i
-			i = encodeVarintDebug(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x18
+func (c *debugClient) ListReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ReorgsResponse, error) {
+	out := new(ReorgsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *debugClient) AddPeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/AddPeerRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PruneThreshold != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *debugClient) RemovePeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RemovePeerRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) ListPeerRules(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerRulesResponse, error) {
+	out := new(PeerRulesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) ListPeerTraffic(ctx context.Context, in *PeerTrafficRequest, opts ...grpc.CallOption) (*PeerTrafficResponse, error) {
+	out := new(PeerTrafficResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BestDescendant != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
-		i--
-		dAtA[i] = 0x40
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
+	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	GetBackfillStatus(context.Context, *types.Empty) (*BackfillStatusResponse, error)
+	ListReorgs(context.Context, *types.Empty) (*ReorgsResponse, error)
+	AddPeerRule(context.Context, *PeerRule) (*types.Empty, error)
+	RemovePeerRule(context.Context, *PeerRule) (*types.Empty, error)
+	ListPeerRules(context.Context, *types.Empty) (*PeerRulesResponse, error)
+	ListPeerTraffic(context.Context, *PeerTrafficRequest) (*PeerTrafficResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+}
+func (*UnimplementedDebugServer) GetBackfillStatus(ctx context.Context, req *types.Empty) (*BackfillStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
+func (*UnimplementedDebugServer) ListReorgs(ctx context.Context, req *types.Empty) (*ReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedDebugServer) AddPeerRule(ctx context.Context, req *PeerRule) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerRule not implemented")
+}
+func (*UnimplementedDebugServer) RemovePeerRule(ctx context.Context, req *PeerRule) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeerRule not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerRules(ctx context.Context, req *types.Empty) (*PeerRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerRules not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerTraffic(ctx context.Context, req *PeerTrafficRequest) (*PeerTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerTraffic not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BestChild != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
 	}
-	if m.Weight != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
 	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Parent != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
 	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(DebugServer).GetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InclusionSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetInclusionSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Debug_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ScoreInfo != nil {
-		{
-			size, err := m.ScoreInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if interceptor == nil {
+		return srv.(DebugServer).GetBackfillStatus(ctx, in)
 	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x40
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus",
 	}
-	if m.PeerStatus != nil {
-		{
-			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBackfillStatus(ctx, req.(*types.Empty))
 	}
-	if m.PeerInfo != nil {
-		{
-			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Enr) > 0 {
-		i -= len(m.Enr)
-		copy(dAtA[i:], m.Enr)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(DebugServer).ListReorgs(ctx, in)
 	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListReorgs",
 	}
-	if m.ConnectionState != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
-		i--
-		dAtA[i] = 0x18
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListReorgs(ctx, req.(*types.Empty))
 	}
-	if m.Direction != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x10
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_AddPeerRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRule)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ListeningAddresses) > 0 {
-		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ListeningAddresses[iNdEx])
-			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(DebugServer).AddPeerRule(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/AddPeerRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).AddPeerRule(ctx, req.(*PeerRule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
+func _Debug_RemovePeerRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RemovePeerRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RemovePeerRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RemovePeerRule(ctx, req.(*PeerRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerRules(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerTraffic(ctx, req.(*PeerTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Debug_GetBlock_Handler,
+		},
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _Debug_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "GetProtoArrayForkChoice",
+			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Debug_ListPeers_Handler,
+		},
+		{
+			MethodName: "GetPeer",
+			Handler:    _Debug_GetPeer_Handler,
+		},
+		{
+			MethodName: "GetInclusionSlot",
+			Handler:    _Debug_GetInclusionSlot_Handler,
+		},
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Debug_GetBackfillStatus_Handler,
+		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _Debug_ListReorgs_Handler,
+		},
+		{
+			MethodName: "AddPeerRule",
+			Handler:    _Debug_AddPeerRule_Handler,
+		},
+		{
+			MethodName: "RemovePeerRule",
+			Handler:    _Debug_RemovePeerRule_Handler,
+		},
+		{
+			MethodName: "ListPeerRules",
+			Handler:    _Debug_ListPeerRules_Handler,
+		},
+		{
+			MethodName: "ListPeerTraffic",
+			Handler:    _Debug_ListPeerTraffic_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1925,12 +2295,12 @@ func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1939,55 +2309,52 @@ func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerLatency != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.AgentVersion) > 0 {
-		i -= len(m.AgentVersion)
-		copy(dAtA[i:], m.AgentVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x10
 	}
-	if len(m.ProtocolVersion) > 0 {
-		i -= len(m.ProtocolVersion)
-		copy(dAtA[i:], m.ProtocolVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
+	if m.Id != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x8
 	}
-	if m.FaultCount != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Protocols) > 0 {
-		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Protocols[iNdEx])
-			copy(dAtA[i:], m.Protocols[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScoreInfo) Marshal() (dAtA []byte, err error) {
+func (m *BackfillStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1997,12 +2364,12 @@ func (m *ScoreInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScoreInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackfillStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackfillStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2011,72 +2378,85 @@ func (m *ScoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ValidationError) > 0 {
-		i -= len(m.ValidationError)
-		copy(dAtA[i:], m.ValidationError)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ValidationError)))
+	if m.Complete {
 		i--
-		dAtA[i] = 0x3a
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.BehaviourPenalty != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BehaviourPenalty))))
+	if len(m.LowestRoot) > 0 {
+		i -= len(m.LowestRoot)
+		copy(dAtA[i:], m.LowestRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.LowestRoot)))
 		i--
-		dAtA[i] = 0x35
+		dAtA[i] = 0x22
 	}
-	if m.GossipScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GossipScore))))
+	if m.LowestSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LowestSlot))
 		i--
-		dAtA[i] = 0x2d
+		dAtA[i] = 0x18
 	}
-	if len(m.TopicScores) > 0 {
-		for k := range m.TopicScores {
-			v := m.TopicScores[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintDebug(dAtA, i, uint64(size))
+	if len(m.OriginRoot) > 0 {
+		i -= len(m.OriginRoot)
+		copy(dAtA[i:], m.OriginRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OriginRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OriginSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReorgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReorgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
-				i--
-				dAtA[i] = 0x12
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
 		}
 	}
-	if m.BlockProviderScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BlockProviderScore))))
-		i--
-		dAtA[i] = 0x1d
-	}
-	if m.ProcessedBlocks != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ProcessedBlocks))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OverallScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OverallScore))))
-		i--
-		dAtA[i] = 0xd
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *Reorg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2086,12 +2466,12 @@ func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicScoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *Reorg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Reorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2100,407 +2480,2860 @@ func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InvalidMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.InvalidMessageDeliveries))))
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NewHeadRoot)))
 		i--
-		dAtA[i] = 0x25
+		dAtA[i] = 0x3a
 	}
-	if m.MeshMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MeshMessageDeliveries))))
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OldHeadRoot)))
 		i--
-		dAtA[i] = 0x1d
+		dAtA[i] = 0x32
 	}
-	if m.FirstMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FirstMessageDeliveries))))
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CommonAncestorRoot)))
 		i--
-		dAtA[i] = 0x15
+		dAtA[i] = 0x2a
 	}
-	if m.TimeInMesh != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.TimeInMesh))
+	if m.Distance != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Distance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Depth != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldSlot))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDebug(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InclusionSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovDebug(uint64(m.Id))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InclusionSlotResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *BeaconStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
 	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
-	return n
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovDebug(uint64(l))
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SSZResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Encoded)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LoggingLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Level != 0 {
-		n += 1 + sovDebug(uint64(m.Level))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PruneThreshold != 0 {
-		n += 1 + sovDebug(uint64(m.PruneThreshold))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for _, e := range m.ProtoArrayNodes {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
-	if len(m.Indices) > 0 {
-		for k, v := range m.Indices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Level != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtoArrayNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeerRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovDebug(uint64(m.Parent))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	if m.Kind != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Weight != 0 {
-		n += 1 + sovDebug(uint64(m.Weight))
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BestChild != 0 {
-		n += 1 + sovDebug(uint64(m.BestChild))
+	return dAtA[:n], nil
+}
+
+func (m *PeerRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.BestDescendant != 0 {
-		n += 1 + sovDebug(uint64(m.BestDescendant))
+	if len(m.Banned) > 0 {
+		for iNdEx := len(m.Banned) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Banned[iNdEx])
+			copy(dAtA[i:], m.Banned[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Banned[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Trusted) > 0 {
+		for iNdEx := len(m.Trusted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Trusted[iNdEx])
+			copy(dAtA[i:], m.Trusted[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Trusted[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DebugPeerResponses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeerTrafficRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Limit != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DebugPeerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeerTrafficResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerTrafficResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ListeningAddresses) > 0 {
-		for _, s := range m.ListeningAddresses {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Direction != 0 {
-		n += 1 + sovDebug(uint64(m.Direction))
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.ConnectionState != 0 {
-		n += 1 + sovDebug(uint64(m.ConnectionState))
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerTraffic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.Enr)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerInfo != nil {
-		l = m.PeerInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerStatus != nil {
-		l = m.PeerStatus.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.LastUpdated != 0 {
-		n += 1 + sovDebug(uint64(m.LastUpdated))
-	}
-	if m.ScoreInfo != nil {
-		l = m.ScoreInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PeerTraffic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerTraffic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDebug(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Protocols) > 0 {
-		for _, s := range m.Protocols {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
+		for k := range m.Protocols {
+			v := m.Protocols[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDebug(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.FaultCount != 0 {
-		n += 1 + sovDebug(uint64(m.FaultCount))
+	if len(m.Topics) > 0 {
+		for k := range m.Topics {
+			v := m.Topics[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDebug(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ProtocolVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if m.RateOut != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RateOut))))
+		i--
+		dAtA[i] = 0x21
 	}
-	l = len(m.AgentVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if m.RateIn != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RateIn))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.PeerLatency != 0 {
-		n += 1 + sovDebug(uint64(m.PeerLatency))
+	if m.Total != nil {
+		{
+			size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScoreInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Traffic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Traffic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Traffic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OverallScore != 0 {
-		n += 5
-	}
-	if m.ProcessedBlocks != 0 {
-		n += 1 + sovDebug(uint64(m.ProcessedBlocks))
-	}
-	if m.BlockProviderScore != 0 {
-		n += 5
-	}
-	if len(m.TopicScores) > 0 {
-		for k, v := range m.TopicScores {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovDebug(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GossipScore != 0 {
-		n += 5
+	if m.MessagesOut != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MessagesOut))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.BehaviourPenalty != 0 {
-		n += 5
+	if m.MessagesIn != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MessagesIn))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValidationError)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if m.BytesOut != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BytesOut))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.BytesIn != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BytesIn))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicScoreSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TimeInMesh != 0 {
-		n += 1 + sovDebug(uint64(m.TimeInMesh))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FirstMessageDeliveries != 0 {
-		n += 5
+	if len(m.Indices) > 0 {
+		for k := range m.Indices {
+			v := m.Indices[k]
+			baseI := i
+			i = encodeVarintDebug(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.MeshMessageDeliveries != 0 {
-		n += 5
+	if len(m.ProtoArrayNodes) > 0 {
+		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.InvalidMessageDeliveries != 0 {
-		n += 5
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PruneThreshold != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovDebug(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDebug(x uint64) (n int) {
-	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Traffic != nil {
+		{
+			size, err := m.Traffic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ScoreInfo != nil {
+		{
+			size, err := m.ScoreInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeerStatus != nil {
+		{
+			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerInfo != nil {
+		{
+			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Enr) > 0 {
+		i -= len(m.Enr)
+		copy(dAtA[i:], m.Enr)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConnectionState != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ListeningAddresses) > 0 {
+		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ListeningAddresses[iNdEx])
+			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PeerLatency != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AgentVersion) > 0 {
+		i -= len(m.AgentVersion)
+		copy(dAtA[i:], m.AgentVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProtocolVersion) > 0 {
+		i -= len(m.ProtocolVersion)
+		copy(dAtA[i:], m.ProtocolVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FaultCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Protocols[iNdEx])
+			copy(dAtA[i:], m.Protocols[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidationError) > 0 {
+		i -= len(m.ValidationError)
+		copy(dAtA[i:], m.ValidationError)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ValidationError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BehaviourPenalty != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BehaviourPenalty))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.GossipScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GossipScore))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.TopicScores) > 0 {
+		for k := range m.TopicScores {
+			v := m.TopicScores[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDebug(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockProviderScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BlockProviderScore))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.ProcessedBlocks != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProcessedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OverallScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OverallScore))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicScoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.InvalidMessageDeliveries))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.MeshMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MeshMessageDeliveries))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.FirstMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FirstMessageDeliveries))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.TimeInMesh != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TimeInMesh))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InclusionSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDebug(uint64(m.Id))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OriginSlot))
+	}
+	l = len(m.OriginRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LowestSlot != 0 {
+		n += 1 + sovDebug(uint64(m.LowestSlot))
+	}
+	l = len(m.LowestRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReorgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OldSlot))
+	}
+	if m.NewSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NewSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovDebug(uint64(m.Depth))
+	}
+	if m.Distance != 0 {
+		n += 1 + sovDebug(uint64(m.Distance))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoggingLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovDebug(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovDebug(uint64(m.Kind))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trusted) > 0 {
+		for _, s := range m.Trusted {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Banned) > 0 {
+		for _, s := range m.Banned {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerTrafficRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDebug(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerTrafficResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerTraffic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.RateIn != 0 {
+		n += 9
+	}
+	if m.RateOut != 0 {
+		n += 9
+	}
+	if len(m.Topics) > 0 {
+		for k, v := range m.Topics {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDebug(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Protocols) > 0 {
+		for k, v := range m.Protocols {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDebug(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Traffic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesIn != 0 {
+		n += 1 + sovDebug(uint64(m.BytesIn))
+	}
+	if m.BytesOut != 0 {
+		n += 1 + sovDebug(uint64(m.BytesOut))
+	}
+	if m.MessagesIn != 0 {
+		n += 1 + sovDebug(uint64(m.MessagesIn))
+	}
+	if m.MessagesOut != 0 {
+		n += 1 + sovDebug(uint64(m.MessagesOut))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruneThreshold != 0 {
+		n += 1 + sovDebug(uint64(m.PruneThreshold))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for _, e := range m.ProtoArrayNodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for k, v := range m.Indices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovDebug(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovDebug(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovDebug(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ListeningAddresses) > 0 {
+		for _, s := range m.ListeningAddresses {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Direction != 0 {
+		n += 1 + sovDebug(uint64(m.Direction))
+	}
+	if m.ConnectionState != 0 {
+		n += 1 + sovDebug(uint64(m.ConnectionState))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Enr)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerInfo != nil {
+		l = m.PeerInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerStatus != nil {
+		l = m.PeerStatus.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovDebug(uint64(m.LastUpdated))
+	}
+	if m.ScoreInfo != nil {
+		l = m.ScoreInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Traffic != nil {
+		l = m.Traffic.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.FaultCount != 0 {
+		n += 1 + sovDebug(uint64(m.FaultCount))
+	}
+	l = len(m.ProtocolVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerLatency != 0 {
+		n += 1 + sovDebug(uint64(m.PeerLatency))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverallScore != 0 {
+		n += 5
+	}
+	if m.ProcessedBlocks != 0 {
+		n += 1 + sovDebug(uint64(m.ProcessedBlocks))
+	}
+	if m.BlockProviderScore != 0 {
+		n += 5
+	}
+	if len(m.TopicScores) > 0 {
+		for k, v := range m.TopicScores {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDebug(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.GossipScore != 0 {
+		n += 5
+	}
+	if m.BehaviourPenalty != 0 {
+		n += 5
+	}
+	l = len(m.ValidationError)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TopicScoreSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeInMesh != 0 {
+		n += 1 + sovDebug(uint64(m.TimeInMesh))
+	}
+	if m.FirstMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.MeshMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackfillStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSlot", wireType)
+			}
+			m.OriginSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginRoot = append(m.OriginRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginRoot == nil {
+				m.OriginRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestSlot", wireType)
+			}
+			m.LowestSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowestRoot = append(m.LowestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.LowestRoot == nil {
+				m.LowestRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReorgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReorgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReorgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reorgs = append(m.Reorgs, &Reorg{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSlot", wireType)
+			}
+			m.OldSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSlot", wireType)
+			}
+			m.NewSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			m.Distance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Distance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PeerRule_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trusted = append(m.Trusted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Banned = append(m.Banned, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+func (m *PeerTrafficRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2523,36 +5356,17 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerTrafficRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Slot = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2562,7 +5376,7 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2589,7 +5403,7 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+func (m *PeerTrafficResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2612,17 +5426,17 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerTrafficResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerTrafficResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
 			}
-			m.Slot = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2632,11 +5446,26 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerTraffic{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2659,7 +5488,7 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+func (m *PeerTraffic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,17 +5511,17 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerTraffic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerTraffic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2702,17 +5531,29 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &BeaconStateRequest_Slot{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2722,81 +5563,184 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
+			if m.Total == nil {
+				m.Total = &Traffic{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDebug
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateIn", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RateIn = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateOut", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RateOut = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topics == nil {
+				m.Topics = make(map[string]*Traffic)
+			}
+			var mapkey string
+			var mapvalue *Traffic
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Traffic{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Topics[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2806,25 +5750,120 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			if m.Protocols == nil {
+				m.Protocols = make(map[string]*Traffic)
+			}
+			var mapkey string
+			var mapvalue *Traffic
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Traffic{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Protocols[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2848,7 +5887,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+func (m *Traffic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2871,17 +5910,17 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Traffic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Traffic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesIn", wireType)
 			}
-			var byteLen int
+			m.BytesIn = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2891,82 +5930,54 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BytesIn |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encoded == nil {
-				m.Encoded = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesOut", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			m.BytesOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesIn", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MessagesIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesOut", wireType)
 			}
-			m.Level = 0
+			m.MessagesOut = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2976,7 +5987,7 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				m.MessagesOut |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3851,6 +6862,42 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traffic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Traffic == nil {
+				m.Traffic = &PeerTraffic{}
+			}
+			if err := m.Traffic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -0,0 +1,3928 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/validator.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AttesterDutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Data                 []uint64 `protobuf:"varint,2,rep,packed,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttesterDutiesRequest) Reset()         { *m = AttesterDutiesRequest{} }
+func (m *AttesterDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*AttesterDutiesRequest) ProtoMessage()    {}
+func (*AttesterDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{0}
+}
+func (m *AttesterDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterDutiesRequest.Merge(m, src)
+}
+func (m *AttesterDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterDutiesRequest proto.InternalMessageInfo
+
+func (m *AttesterDutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AttesterDutiesRequest) GetData() []uint64 {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AttesterDutiesResponse struct {
+	Data                 []*AttesterDuty `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AttesterDutiesResponse) Reset()         { *m = AttesterDutiesResponse{} }
+func (m *AttesterDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*AttesterDutiesResponse) ProtoMessage()    {}
+func (*AttesterDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{1}
+}
+func (m *AttesterDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterDutiesResponse.Merge(m, src)
+}
+func (m *AttesterDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterDutiesResponse proto.InternalMessageInfo
+
+func (m *AttesterDutiesResponse) GetData() []*AttesterDuty {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AttesterDuty struct {
+	Pubkey                  []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ValidatorIndex          uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	CommitteeIndex          uint64   `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	CommitteeLength         uint64   `protobuf:"varint,4,opt,name=committee_length,json=committeeLength,proto3" json:"committee_length,omitempty"`
+	CommitteesAtSlot        uint64   `protobuf:"varint,5,opt,name=committees_at_slot,json=committeesAtSlot,proto3" json:"committees_at_slot,omitempty"`
+	ValidatorCommitteeIndex uint64   `protobuf:"varint,6,opt,name=validator_committee_index,json=validatorCommitteeIndex,proto3" json:"validator_committee_index,omitempty"`
+	Slot                    uint64   `protobuf:"varint,7,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *AttesterDuty) Reset()         { *m = AttesterDuty{} }
+func (m *AttesterDuty) String() string { return proto.CompactTextString(m) }
+func (*AttesterDuty) ProtoMessage()    {}
+func (*AttesterDuty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{2}
+}
+func (m *AttesterDuty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterDuty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterDuty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterDuty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterDuty.Merge(m, src)
+}
+func (m *AttesterDuty) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterDuty) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterDuty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterDuty proto.InternalMessageInfo
+
+func (m *AttesterDuty) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *AttesterDuty) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *AttesterDuty) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *AttesterDuty) GetCommitteeLength() uint64 {
+	if m != nil {
+		return m.CommitteeLength
+	}
+	return 0
+}
+
+func (m *AttesterDuty) GetCommitteesAtSlot() uint64 {
+	if m != nil {
+		return m.CommitteesAtSlot
+	}
+	return 0
+}
+
+func (m *AttesterDuty) GetValidatorCommitteeIndex() uint64 {
+	if m != nil {
+		return m.ValidatorCommitteeIndex
+	}
+	return 0
+}
+
+func (m *AttesterDuty) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type ProposerDutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposerDutiesRequest) Reset()         { *m = ProposerDutiesRequest{} }
+func (m *ProposerDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerDutiesRequest) ProtoMessage()    {}
+func (*ProposerDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{3}
+}
+func (m *ProposerDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDutiesRequest.Merge(m, src)
+}
+func (m *ProposerDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerDutiesRequest proto.InternalMessageInfo
+
+func (m *ProposerDutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ProposerDutiesResponse struct {
+	Data                 []*ProposerDuty `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ProposerDutiesResponse) Reset()         { *m = ProposerDutiesResponse{} }
+func (m *ProposerDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerDutiesResponse) ProtoMessage()    {}
+func (*ProposerDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{4}
+}
+func (m *ProposerDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDutiesResponse.Merge(m, src)
+}
+func (m *ProposerDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerDutiesResponse proto.InternalMessageInfo
+
+func (m *ProposerDutiesResponse) GetData() []*ProposerDuty {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProposerDuty struct {
+	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposerDuty) Reset()         { *m = ProposerDuty{} }
+func (m *ProposerDuty) String() string { return proto.CompactTextString(m) }
+func (*ProposerDuty) ProtoMessage()    {}
+func (*ProposerDuty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{5}
+}
+func (m *ProposerDuty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDuty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDuty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerDuty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDuty.Merge(m, src)
+}
+func (m *ProposerDuty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDuty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDuty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerDuty proto.InternalMessageInfo
+
+func (m *ProposerDuty) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *ProposerDuty) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ProposerDuty) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type ProduceBlockRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,3,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProduceBlockRequest) Reset()         { *m = ProduceBlockRequest{} }
+func (m *ProduceBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ProduceBlockRequest) ProtoMessage()    {}
+func (*ProduceBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{6}
+}
+func (m *ProduceBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProduceBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProduceBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProduceBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProduceBlockRequest.Merge(m, src)
+}
+func (m *ProduceBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProduceBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProduceBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProduceBlockRequest proto.InternalMessageInfo
+
+func (m *ProduceBlockRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ProduceBlockRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
+}
+
+func (m *ProduceBlockRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+type ProduceBlockResponse struct {
+	Data                 *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ProduceBlockResponse) Reset()         { *m = ProduceBlockResponse{} }
+func (m *ProduceBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ProduceBlockResponse) ProtoMessage()    {}
+func (*ProduceBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{7}
+}
+func (m *ProduceBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProduceBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProduceBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProduceBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProduceBlockResponse.Merge(m, src)
+}
+func (m *ProduceBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProduceBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProduceBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProduceBlockResponse proto.InternalMessageInfo
+
+func (m *ProduceBlockResponse) GetData() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProduceAttestationDataRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProduceAttestationDataRequest) Reset()         { *m = ProduceAttestationDataRequest{} }
+func (m *ProduceAttestationDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ProduceAttestationDataRequest) ProtoMessage()    {}
+func (*ProduceAttestationDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{8}
+}
+func (m *ProduceAttestationDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProduceAttestationDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProduceAttestationDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProduceAttestationDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProduceAttestationDataRequest.Merge(m, src)
+}
+func (m *ProduceAttestationDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProduceAttestationDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProduceAttestationDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProduceAttestationDataRequest proto.InternalMessageInfo
+
+func (m *ProduceAttestationDataRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ProduceAttestationDataRequest) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+type ProduceAttestationDataResponse struct {
+	Data                 *v1alpha1.AttestationData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ProduceAttestationDataResponse) Reset()         { *m = ProduceAttestationDataResponse{} }
+func (m *ProduceAttestationDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ProduceAttestationDataResponse) ProtoMessage()    {}
+func (*ProduceAttestationDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{9}
+}
+func (m *ProduceAttestationDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProduceAttestationDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProduceAttestationDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProduceAttestationDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProduceAttestationDataResponse.Merge(m, src)
+}
+func (m *ProduceAttestationDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProduceAttestationDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProduceAttestationDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProduceAttestationDataResponse proto.InternalMessageInfo
+
+func (m *ProduceAttestationDataResponse) GetData() *v1alpha1.AttestationData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AggregateAttestationRequest struct {
+	AttestationDataRoot  []byte   `protobuf:"bytes,1,opt,name=attestation_data_root,json=attestationDataRoot,proto3" json:"attestation_data_root,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregateAttestationRequest) Reset()         { *m = AggregateAttestationRequest{} }
+func (m *AggregateAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateAttestationRequest) ProtoMessage()    {}
+func (*AggregateAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{10}
+}
+func (m *AggregateAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateAttestationRequest.Merge(m, src)
+}
+func (m *AggregateAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateAttestationRequest proto.InternalMessageInfo
+
+func (m *AggregateAttestationRequest) GetAttestationDataRoot() []byte {
+	if m != nil {
+		return m.AttestationDataRoot
+	}
+	return nil
+}
+
+func (m *AggregateAttestationRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type AggregateAttestationResponse struct {
+	Data                 *v1alpha1.Attestation `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AggregateAttestationResponse) Reset()         { *m = AggregateAttestationResponse{} }
+func (m *AggregateAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregateAttestationResponse) ProtoMessage()    {}
+func (*AggregateAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{11}
+}
+func (m *AggregateAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateAttestationResponse.Merge(m, src)
+}
+func (m *AggregateAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateAttestationResponse proto.InternalMessageInfo
+
+func (m *AggregateAttestationResponse) GetData() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SubmitAggregateAndProofsRequest struct {
+	Data                 []*v1alpha1.SignedAggregateAttestationAndProof `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *SubmitAggregateAndProofsRequest) Reset()         { *m = SubmitAggregateAndProofsRequest{} }
+func (m *SubmitAggregateAndProofsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitAggregateAndProofsRequest) ProtoMessage()    {}
+func (*SubmitAggregateAndProofsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{12}
+}
+func (m *SubmitAggregateAndProofsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitAggregateAndProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitAggregateAndProofsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitAggregateAndProofsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitAggregateAndProofsRequest.Merge(m, src)
+}
+func (m *SubmitAggregateAndProofsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitAggregateAndProofsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitAggregateAndProofsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitAggregateAndProofsRequest proto.InternalMessageInfo
+
+func (m *SubmitAggregateAndProofsRequest) GetData() []*v1alpha1.SignedAggregateAttestationAndProof {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SubmitBeaconCommitteeSubscriptionsRequest struct {
+	Data                 []*BeaconCommitteeSubscription `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) Reset() {
+	*m = SubmitBeaconCommitteeSubscriptionsRequest{}
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SubmitBeaconCommitteeSubscriptionsRequest) ProtoMessage() {}
+func (*SubmitBeaconCommitteeSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{13}
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBeaconCommitteeSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBeaconCommitteeSubscriptionsRequest.Merge(m, src)
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBeaconCommitteeSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitBeaconCommitteeSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) GetData() []*BeaconCommitteeSubscription {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type BeaconCommitteeSubscription struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	CommitteesAtSlot     uint64   `protobuf:"varint,3,opt,name=committees_at_slot,json=committeesAtSlot,proto3" json:"committees_at_slot,omitempty"`
+	Slot                 uint64   `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	IsAggregator         bool     `protobuf:"varint,5,opt,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconCommitteeSubscription) Reset()         { *m = BeaconCommitteeSubscription{} }
+func (m *BeaconCommitteeSubscription) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommitteeSubscription) ProtoMessage()    {}
+func (*BeaconCommitteeSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71635b60de283c0, []int{14}
+}
+func (m *BeaconCommitteeSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconCommitteeSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconCommitteeSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconCommitteeSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommitteeSubscription.Merge(m, src)
+}
+func (m *BeaconCommitteeSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconCommitteeSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommitteeSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommitteeSubscription proto.InternalMessageInfo
+
+func (m *BeaconCommitteeSubscription) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *BeaconCommitteeSubscription) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *BeaconCommitteeSubscription) GetCommitteesAtSlot() uint64 {
+	if m != nil {
+		return m.CommitteesAtSlot
+	}
+	return 0
+}
+
+func (m *BeaconCommitteeSubscription) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconCommitteeSubscription) GetIsAggregator() bool {
+	if m != nil {
+		return m.IsAggregator
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AttesterDutiesRequest)(nil), "ethereum.beacon.rpc.v1.AttesterDutiesRequest")
+	proto.RegisterType((*AttesterDutiesResponse)(nil), "ethereum.beacon.rpc.v1.AttesterDutiesResponse")
+	proto.RegisterType((*AttesterDuty)(nil), "ethereum.beacon.rpc.v1.AttesterDuty")
+	proto.RegisterType((*ProposerDutiesRequest)(nil), "ethereum.beacon.rpc.v1.ProposerDutiesRequest")
+	proto.RegisterType((*ProposerDutiesResponse)(nil), "ethereum.beacon.rpc.v1.ProposerDutiesResponse")
+	proto.RegisterType((*ProposerDuty)(nil), "ethereum.beacon.rpc.v1.ProposerDuty")
+	proto.RegisterType((*ProduceBlockRequest)(nil), "ethereum.beacon.rpc.v1.ProduceBlockRequest")
+	proto.RegisterType((*ProduceBlockResponse)(nil), "ethereum.beacon.rpc.v1.ProduceBlockResponse")
+	proto.RegisterType((*ProduceAttestationDataRequest)(nil), "ethereum.beacon.rpc.v1.ProduceAttestationDataRequest")
+	proto.RegisterType((*ProduceAttestationDataResponse)(nil), "ethereum.beacon.rpc.v1.ProduceAttestationDataResponse")
+	proto.RegisterType((*AggregateAttestationRequest)(nil), "ethereum.beacon.rpc.v1.AggregateAttestationRequest")
+	proto.RegisterType((*AggregateAttestationResponse)(nil), "ethereum.beacon.rpc.v1.AggregateAttestationResponse")
+	proto.RegisterType((*SubmitAggregateAndProofsRequest)(nil), "ethereum.beacon.rpc.v1.SubmitAggregateAndProofsRequest")
+	proto.RegisterType((*SubmitBeaconCommitteeSubscriptionsRequest)(nil), "ethereum.beacon.rpc.v1.SubmitBeaconCommitteeSubscriptionsRequest")
+	proto.RegisterType((*BeaconCommitteeSubscription)(nil), "ethereum.beacon.rpc.v1.BeaconCommitteeSubscription")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/validator.proto", fileDescriptor_f71635b60de283c0)
+}
+
+var fileDescriptor_f71635b60de283c0 = []byte{
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xd6, 0x24, 0xe9, 0x52, 0x5e, 0x0d, 0x85, 0x69, 0x37, 0x0d, 0xde, 0x92, 0xdd, 0xba, 0x55,
+	0xf7, 0x47, 0x5b, 0x9b, 0x6c, 0x7f, 0x00, 0xcb, 0x29, 0x4b, 0x51, 0x85, 0x04, 0x68, 0xe5, 0x95,
+	0x7a, 0xaa, 0x64, 0x4d, 0xec, 0xd9, 0xc4, 0x34, 0xf1, 0x18, 0x7b, 0x12, 0xb1, 0xaa, 0x7a, 0xe1,
+	0x4f, 0x80, 0x3b, 0x12, 0x07, 0x24, 0xee, 0x88, 0x13, 0x77, 0xc4, 0x11, 0x09, 0x89, 0x33, 0x5a,
+	0xf1, 0x87, 0x20, 0xcf, 0x8c, 0x7f, 0xa5, 0x76, 0x36, 0x51, 0x6f, 0xf6, 0x9b, 0xef, 0x7d, 0xef,
+	0x9b, 0x99, 0x37, 0xdf, 0x0c, 0xdc, 0x0c, 0x23, 0xc6, 0x99, 0x35, 0xa0, 0xc4, 0x65, 0x81, 0x15,
+	0x85, 0xae, 0x35, 0xeb, 0x59, 0x33, 0x32, 0xf6, 0x3d, 0xc2, 0x59, 0x64, 0x8a, 0x51, 0xdc, 0xa6,
+	0x7c, 0x44, 0x23, 0x3a, 0x9d, 0x98, 0x12, 0x67, 0x46, 0xa1, 0x6b, 0xce, 0x7a, 0x7a, 0x97, 0xf2,
+	0x91, 0x35, 0xeb, 0x91, 0x71, 0x38, 0x22, 0x3d, 0x8b, 0x70, 0x4e, 0x63, 0x4e, 0xb8, 0xcf, 0x02,
+	0x99, 0xa7, 0x6f, 0x96, 0xc6, 0x65, 0xae, 0x33, 0x18, 0x33, 0xf7, 0xb9, 0x02, 0x5c, 0x1f, 0x32,
+	0x36, 0x1c, 0x53, 0x8b, 0x84, 0xbe, 0x45, 0x82, 0x80, 0xc9, 0xec, 0x58, 0x8d, 0x6e, 0xa8, 0x51,
+	0xf1, 0x37, 0x98, 0x9e, 0x58, 0x74, 0x12, 0xf2, 0x53, 0x39, 0x68, 0xf4, 0x61, 0xbd, 0x2f, 0x0a,
+	0xd2, 0xe8, 0xf1, 0x94, 0xfb, 0x34, 0xb6, 0xe9, 0x37, 0x53, 0x1a, 0x73, 0x7c, 0x15, 0x2e, 0xd0,
+	0x90, 0xb9, 0xa3, 0x0e, 0xda, 0x42, 0x3b, 0x2d, 0x5b, 0xfe, 0x60, 0x0c, 0x2d, 0x8f, 0x70, 0xd2,
+	0x69, 0x6c, 0x35, 0x77, 0x5a, 0xb6, 0xf8, 0x36, 0x6c, 0x68, 0xcf, 0x53, 0xc4, 0x21, 0x0b, 0x62,
+	0x8a, 0x3f, 0x52, 0x68, 0xb4, 0xd5, 0xdc, 0xb9, 0xb4, 0x7f, 0xcb, 0xac, 0x9e, 0xbf, 0x59, 0xc8,
+	0x3e, 0x55, 0x9c, 0x3f, 0x35, 0x40, 0x2b, 0x86, 0x71, 0x1b, 0xd6, 0xc2, 0xe9, 0xe0, 0x39, 0x3d,
+	0x15, 0x7a, 0x34, 0x5b, 0xfd, 0xe1, 0x6d, 0xb8, 0x9c, 0x2d, 0xb3, 0xe3, 0x07, 0x1e, 0xfd, 0xb6,
+	0xd3, 0x10, 0x82, 0xdf, 0xce, 0xc2, 0x9f, 0x27, 0xd1, 0x04, 0xe8, 0xb2, 0xc9, 0xc4, 0xe7, 0x9c,
+	0x52, 0x05, 0x6c, 0x4a, 0x60, 0x16, 0x96, 0xc0, 0x5d, 0x78, 0x27, 0x07, 0x8e, 0x69, 0x30, 0xe4,
+	0xa3, 0x4e, 0x4b, 0x20, 0x73, 0x82, 0x2f, 0x44, 0x18, 0xdf, 0x05, 0x9c, 0x85, 0x62, 0x87, 0x70,
+	0x27, 0x1e, 0x33, 0xde, 0xb9, 0x20, 0xc0, 0x39, 0x49, 0xdc, 0xe7, 0xc7, 0x63, 0xc6, 0xf1, 0x01,
+	0xbc, 0x97, 0x4b, 0x9d, 0xd7, 0xb2, 0x26, 0x92, 0xae, 0x65, 0x80, 0x4f, 0xcb, 0xa2, 0x30, 0xb4,
+	0x04, 0xf7, 0x1b, 0x02, 0x26, 0xbe, 0x8d, 0x7b, 0xb0, 0x7e, 0x14, 0xb1, 0x90, 0xc5, 0x4b, 0x6d,
+	0x5d, 0xb2, 0x4d, 0xf3, 0xf0, 0xd5, 0xb6, 0xa9, 0x90, 0x9d, 0x6e, 0x93, 0x0b, 0x5a, 0x31, 0xfa,
+	0xfa, 0xbb, 0x94, 0xce, 0xb3, 0x59, 0x98, 0xe7, 0xd7, 0x70, 0xe5, 0x28, 0x62, 0xde, 0xd4, 0xa5,
+	0x87, 0x49, 0xcf, 0xa7, 0xb3, 0x4c, 0xa1, 0x28, 0x87, 0xe2, 0x9b, 0xf0, 0x56, 0x44, 0x02, 0x8f,
+	0x30, 0x27, 0xa2, 0x33, 0x4a, 0xc6, 0xa2, 0x8a, 0x66, 0x6b, 0x32, 0x68, 0x8b, 0x18, 0xd6, 0xe1,
+	0xe2, 0x30, 0x22, 0x27, 0x27, 0x3e, 0xf7, 0x45, 0x1d, 0xcd, 0xce, 0xfe, 0x8d, 0xaf, 0xe0, 0x6a,
+	0xb9, 0x96, 0x5a, 0xa2, 0x47, 0xd9, 0x12, 0xa1, 0x9d, 0x4b, 0xfb, 0x46, 0xbe, 0x44, 0x94, 0x8f,
+	0xcc, 0xf4, 0x68, 0x9a, 0x87, 0x62, 0xbd, 0x64, 0xa6, 0x5c, 0xa0, 0x67, 0xf0, 0xbe, 0xe2, 0xeb,
+	0xe7, 0xc7, 0xfa, 0x31, 0xe1, 0x64, 0xd1, 0x2c, 0x2a, 0x5a, 0xb5, 0x51, 0xd5, 0xaa, 0xc6, 0x33,
+	0xe8, 0xd6, 0xb1, 0x2b, 0xdd, 0x07, 0x25, 0xdd, 0xb7, 0x6b, 0x74, 0xcf, 0x67, 0x4b, 0xed, 0x14,
+	0x36, 0xfa, 0xc3, 0x61, 0x44, 0x87, 0x84, 0x17, 0xf9, 0x53, 0xe5, 0xfb, 0xb0, 0x5e, 0xb0, 0x2a,
+	0x27, 0x49, 0x71, 0x22, 0xa6, 0xa6, 0xa2, 0xd9, 0x57, 0xc8, 0x1c, 0x29, 0x63, 0xf9, 0x6c, 0x1b,
+	0x85, 0xed, 0x7d, 0x0a, 0xd7, 0xab, 0xcb, 0xac, 0xb4, 0xf4, 0xc5, 0x4c, 0x29, 0x3f, 0x84, 0xcd,
+	0xe3, 0xe9, 0x60, 0xe2, 0xf3, 0x9c, 0x3d, 0xf0, 0x8e, 0x22, 0xc6, 0x4e, 0xb2, 0x83, 0xf2, 0x65,
+	0xa9, 0xf1, 0x3f, 0xae, 0xa1, 0x3e, 0xf6, 0x87, 0x01, 0xf5, 0xaa, 0x34, 0xa6, 0x84, 0xaa, 0x22,
+	0x87, 0x5d, 0x59, 0x51, 0xf6, 0x41, 0x76, 0x84, 0x8f, 0xa7, 0x83, 0xd8, 0x8d, 0xfc, 0x30, 0x49,
+	0xc9, 0x6a, 0x3f, 0x29, 0xd5, 0xbe, 0x5f, 0x77, 0xe8, 0x16, 0x50, 0xa9, 0xaa, 0xff, 0x20, 0xd8,
+	0x58, 0x80, 0xaa, 0x3a, 0x7b, 0x68, 0x59, 0x87, 0xac, 0x6c, 0xbb, 0x1a, 0xdb, 0x6b, 0xd6, 0xd8,
+	0x5e, 0xba, 0xe7, 0xad, 0xf2, 0x39, 0xf5, 0x63, 0x87, 0xa8, 0x25, 0x65, 0x91, 0xf0, 0xcc, 0x8b,
+	0xb6, 0xe6, 0xc7, 0xfd, 0x2c, 0xb6, 0xff, 0xc7, 0x9b, 0x70, 0x59, 0x4e, 0xec, 0x69, 0x2a, 0x14,
+	0xff, 0x82, 0xe0, 0xdd, 0x27, 0x94, 0x97, 0xef, 0x1b, 0x7c, 0x6f, 0x89, 0x9b, 0x25, 0xf7, 0x47,
+	0xdd, 0x5c, 0x16, 0x2e, 0x3b, 0xd0, 0x78, 0xf0, 0xdd, 0xdf, 0xff, 0xfd, 0xd0, 0x30, 0x0f, 0xd0,
+	0x9e, 0xb1, 0x6b, 0xc9, 0xbb, 0x38, 0xbf, 0xdb, 0x2d, 0x4f, 0x80, 0xd5, 0xb5, 0x4d, 0x23, 0xeb,
+	0x85, 0xb0, 0xdb, 0x97, 0xf8, 0x67, 0x29, 0xb5, 0xec, 0xb9, 0xf5, 0x52, 0x2b, 0xad, 0x5c, 0x37,
+	0x97, 0x85, 0x2b, 0xa9, 0x3d, 0x21, 0xf5, 0x0e, 0xae, 0xd7, 0x19, 0xaa, 0xc4, 0x4c, 0xe7, 0xf7,
+	0x08, 0x34, 0xe5, 0x22, 0xc2, 0xb9, 0xf0, 0x9d, 0x05, 0x35, 0xe7, 0x5d, 0x58, 0xbf, 0xbb, 0x1c,
+	0x58, 0xc9, 0xdb, 0x16, 0xf2, 0x6e, 0xe0, 0xcd, 0x57, 0xe5, 0x89, 0xf7, 0x4c, 0x6c, 0xbd, 0x48,
+	0xfa, 0xe3, 0x25, 0xfe, 0x15, 0x41, 0x5b, 0x31, 0xcc, 0x99, 0x13, 0x7e, 0x78, 0x4e, 0xc5, 0x6a,
+	0xa3, 0xd5, 0x1f, 0xad, 0x9a, 0xa6, 0x24, 0xef, 0x09, 0xc9, 0xb7, 0xb0, 0xf1, 0xaa, 0xe4, 0x79,
+	0xfb, 0xc3, 0xbf, 0x21, 0xb8, 0x96, 0x74, 0x67, 0x85, 0x55, 0xe0, 0xda, 0x13, 0xbe, 0xc0, 0x63,
+	0xf5, 0x07, 0xab, 0x25, 0x29, 0xc9, 0x96, 0x90, 0xbc, 0x8b, 0xb7, 0x2b, 0x24, 0xa7, 0x79, 0x4e,
+	0x41, 0x3c, 0xfe, 0x11, 0x41, 0xa7, 0xce, 0x2b, 0xf1, 0x87, 0x75, 0x1a, 0xce, 0x71, 0x57, 0xbd,
+	0x6d, 0xca, 0x87, 0xa7, 0x99, 0x3e, 0x3c, 0xcd, 0xcf, 0x92, 0x87, 0x67, 0xda, 0xa3, 0xc6, 0xed,
+	0x85, 0xf2, 0x02, 0xcf, 0x09, 0x05, 0xdd, 0x01, 0xda, 0xc3, 0xbf, 0x23, 0xb8, 0x71, 0xae, 0xb5,
+	0xe2, 0xfe, 0x62, 0xa5, 0x4b, 0xb8, 0x72, 0xad, 0xe6, 0x4f, 0x84, 0xe6, 0x87, 0x89, 0x05, 0x7c,
+	0x50, 0xd1, 0xbb, 0xf2, 0x49, 0x9e, 0xbb, 0x67, 0x5c, 0xe4, 0x3e, 0xd4, 0xfe, 0x3c, 0xeb, 0xa2,
+	0xbf, 0xce, 0xba, 0xe8, 0xdf, 0xb3, 0x2e, 0x1a, 0xac, 0x09, 0xea, 0xfb, 0xff, 0x0f, 0x00, 0xf3,
+	0x41, 0xc6, 0x65, 0x33, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconValidatorClient is the client API for BeaconValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconValidatorClient interface {
+	GetAttesterDuties(ctx context.Context, in *AttesterDutiesRequest, opts ...grpc.CallOption) (*AttesterDutiesResponse, error)
+	GetProposerDuties(ctx context.Context, in *ProposerDutiesRequest, opts ...grpc.CallOption) (*ProposerDutiesResponse, error)
+	ProduceBlock(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*ProduceBlockResponse, error)
+	ProduceAttestationData(ctx context.Context, in *ProduceAttestationDataRequest, opts ...grpc.CallOption) (*ProduceAttestationDataResponse, error)
+	GetAggregateAttestation(ctx context.Context, in *AggregateAttestationRequest, opts ...grpc.CallOption) (*AggregateAttestationResponse, error)
+	SubmitAggregateAndProofs(ctx context.Context, in *SubmitAggregateAndProofsRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	SubmitBeaconCommitteeSubscription(ctx context.Context, in *SubmitBeaconCommitteeSubscriptionsRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type beaconValidatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconValidatorClient(cc *grpc.ClientConn) BeaconValidatorClient {
+	return &beaconValidatorClient{cc}
+}
+
+func (c *beaconValidatorClient) GetAttesterDuties(ctx context.Context, in *AttesterDutiesRequest, opts ...grpc.CallOption) (*AttesterDutiesResponse, error) {
+	out := new(AttesterDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/GetAttesterDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) GetProposerDuties(ctx context.Context, in *ProposerDutiesRequest, opts ...grpc.CallOption) (*ProposerDutiesResponse, error) {
+	out := new(ProposerDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/GetProposerDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) ProduceBlock(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*ProduceBlockResponse, error) {
+	out := new(ProduceBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/ProduceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) ProduceAttestationData(ctx context.Context, in *ProduceAttestationDataRequest, opts ...grpc.CallOption) (*ProduceAttestationDataResponse, error) {
+	out := new(ProduceAttestationDataResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/ProduceAttestationData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) GetAggregateAttestation(ctx context.Context, in *AggregateAttestationRequest, opts ...grpc.CallOption) (*AggregateAttestationResponse, error) {
+	out := new(AggregateAttestationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/GetAggregateAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) SubmitAggregateAndProofs(ctx context.Context, in *SubmitAggregateAndProofsRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/SubmitAggregateAndProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconValidatorClient) SubmitBeaconCommitteeSubscription(ctx context.Context, in *SubmitBeaconCommitteeSubscriptionsRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconValidator/SubmitBeaconCommitteeSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconValidatorServer is the server API for BeaconValidator service.
+type BeaconValidatorServer interface {
+	GetAttesterDuties(context.Context, *AttesterDutiesRequest) (*AttesterDutiesResponse, error)
+	GetProposerDuties(context.Context, *ProposerDutiesRequest) (*ProposerDutiesResponse, error)
+	ProduceBlock(context.Context, *ProduceBlockRequest) (*ProduceBlockResponse, error)
+	ProduceAttestationData(context.Context, *ProduceAttestationDataRequest) (*ProduceAttestationDataResponse, error)
+	GetAggregateAttestation(context.Context, *AggregateAttestationRequest) (*AggregateAttestationResponse, error)
+	SubmitAggregateAndProofs(context.Context, *SubmitAggregateAndProofsRequest) (*types.Empty, error)
+	SubmitBeaconCommitteeSubscription(context.Context, *SubmitBeaconCommitteeSubscriptionsRequest) (*types.Empty, error)
+}
+
+// UnimplementedBeaconValidatorServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconValidatorServer struct {
+}
+
+func (*UnimplementedBeaconValidatorServer) GetAttesterDuties(ctx context.Context, req *AttesterDutiesRequest) (*AttesterDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttesterDuties not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) GetProposerDuties(ctx context.Context, req *ProposerDutiesRequest) (*ProposerDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerDuties not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) ProduceBlock(ctx context.Context, req *ProduceBlockRequest) (*ProduceBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProduceBlock not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) ProduceAttestationData(ctx context.Context, req *ProduceAttestationDataRequest) (*ProduceAttestationDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProduceAttestationData not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) GetAggregateAttestation(ctx context.Context, req *AggregateAttestationRequest) (*AggregateAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregateAttestation not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) SubmitAggregateAndProofs(ctx context.Context, req *SubmitAggregateAndProofsRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProofs not implemented")
+}
+func (*UnimplementedBeaconValidatorServer) SubmitBeaconCommitteeSubscription(ctx context.Context, req *SubmitBeaconCommitteeSubscriptionsRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBeaconCommitteeSubscription not implemented")
+}
+
+func RegisterBeaconValidatorServer(s *grpc.Server, srv BeaconValidatorServer) {
+	s.RegisterService(&_BeaconValidator_serviceDesc, srv)
+}
+
+func _BeaconValidator_GetAttesterDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttesterDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).GetAttesterDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/GetAttesterDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).GetAttesterDuties(ctx, req.(*AttesterDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_GetProposerDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).GetProposerDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/GetProposerDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).GetProposerDuties(ctx, req.(*ProposerDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_ProduceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProduceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).ProduceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/ProduceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).ProduceBlock(ctx, req.(*ProduceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_ProduceAttestationData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProduceAttestationDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).ProduceAttestationData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/ProduceAttestationData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).ProduceAttestationData(ctx, req.(*ProduceAttestationDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_GetAggregateAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).GetAggregateAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/GetAggregateAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).GetAggregateAttestation(ctx, req.(*AggregateAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_SubmitAggregateAndProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAggregateAndProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).SubmitAggregateAndProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/SubmitAggregateAndProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).SubmitAggregateAndProofs(ctx, req.(*SubmitAggregateAndProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconValidator_SubmitBeaconCommitteeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBeaconCommitteeSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorServer).SubmitBeaconCommitteeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconValidator/SubmitBeaconCommitteeSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorServer).SubmitBeaconCommitteeSubscription(ctx, req.(*SubmitBeaconCommitteeSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconValidator",
+	HandlerType: (*BeaconValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttesterDuties",
+			Handler:    _BeaconValidator_GetAttesterDuties_Handler,
+		},
+		{
+			MethodName: "GetProposerDuties",
+			Handler:    _BeaconValidator_GetProposerDuties_Handler,
+		},
+		{
+			MethodName: "ProduceBlock",
+			Handler:    _BeaconValidator_ProduceBlock_Handler,
+		},
+		{
+			MethodName: "ProduceAttestationData",
+			Handler:    _BeaconValidator_ProduceAttestationData_Handler,
+		},
+		{
+			MethodName: "GetAggregateAttestation",
+			Handler:    _BeaconValidator_GetAggregateAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAggregateAndProofs",
+			Handler:    _BeaconValidator_SubmitAggregateAndProofs_Handler,
+		},
+		{
+			MethodName: "SubmitBeaconCommitteeSubscription",
+			Handler:    _BeaconValidator_SubmitBeaconCommitteeSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator.proto",
+}
+
+func (m *AttesterDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		dAtA2 := make([]byte, len(m.Data)*10)
+		var j1 int
+		for _, num := range m.Data {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidator(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterDuty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterDuty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValidatorCommitteeIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ValidatorCommitteeIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitteesAtSlot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteesAtSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommitteeLength != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteeLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDuty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDuty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProduceBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProduceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProduceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProduceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProduceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProduceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProduceAttestationDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProduceAttestationDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProduceAttestationDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProduceAttestationDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProduceAttestationDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProduceAttestationDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AttestationDataRoot) > 0 {
+		i -= len(m.AttestationDataRoot)
+		copy(dAtA[i:], m.AttestationDataRoot)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.AttestationDataRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitAggregateAndProofsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitAggregateAndProofsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitAggregateAndProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconCommitteeSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconCommitteeSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconCommitteeSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsAggregator {
+		i--
+		if m.IsAggregator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Slot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitteesAtSlot != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteesAtSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttesterDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidator(uint64(m.Epoch))
+	}
+	if len(m.Data) > 0 {
+		l = 0
+		for _, e := range m.Data {
+			l += sovValidator(uint64(e))
+		}
+		n += 1 + sovValidator(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovValidator(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterDuty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovValidator(uint64(m.ValidatorIndex))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteeIndex))
+	}
+	if m.CommitteeLength != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteeLength))
+	}
+	if m.CommitteesAtSlot != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteesAtSlot))
+	}
+	if m.ValidatorCommitteeIndex != 0 {
+		n += 1 + sovValidator(uint64(m.ValidatorCommitteeIndex))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidator(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovValidator(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDuty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovValidator(uint64(m.ValidatorIndex))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProduceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProduceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProduceAttestationDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteeIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProduceAttestationDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregateAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationDataRoot)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregateAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmitAggregateAndProofsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovValidator(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovValidator(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconCommitteeSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovValidator(uint64(m.ValidatorIndex))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteeIndex))
+	}
+	if m.CommitteesAtSlot != 0 {
+		n += 1 + sovValidator(uint64(m.CommitteesAtSlot))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovValidator(uint64(m.Slot))
+	}
+	if m.IsAggregator {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidator(x uint64) (n int) {
+	return sovValidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttesterDutiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterDutiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Data = append(m.Data, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Data) == 0 {
+					m.Data = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Data = append(m.Data, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &AttesterDuty{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterDuty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterDuty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterDuty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeLength", wireType)
+			}
+			m.CommitteeLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteesAtSlot", wireType)
+			}
+			m.CommitteesAtSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteesAtSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommitteeIndex", wireType)
+			}
+			m.ValidatorCommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerDutiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerDutiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &ProposerDuty{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerDuty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerDuty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerDuty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProduceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProduceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProduceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProduceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProduceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProduceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProduceAttestationDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProduceAttestationDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProduceAttestationDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProduceAttestationDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProduceAttestationDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProduceAttestationDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &v1alpha1.AttestationData{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationDataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationDataRoot = append(m.AttestationDataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationDataRoot == nil {
+				m.AttestationDataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &v1alpha1.Attestation{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitAggregateAndProofsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitAggregateAndProofsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitAggregateAndProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &v1alpha1.SignedAggregateAttestationAndProof{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitBeaconCommitteeSubscriptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitBeaconCommitteeSubscriptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitBeaconCommitteeSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &BeaconCommitteeSubscription{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconCommitteeSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconCommitteeSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconCommitteeSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteesAtSlot", wireType)
+			}
+			m.CommitteesAtSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteesAtSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAggregator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAggregator = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/validator_history.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorPerformanceHistoryRequest struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,4,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorPerformanceHistoryRequest) Reset()         { *m = ValidatorPerformanceHistoryRequest{} }
+func (m *ValidatorPerformanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceHistoryRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{0}
+}
+func (m *ValidatorPerformanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceHistoryRequest.Merge(m, src)
+}
+func (m *ValidatorPerformanceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceHistoryRequest proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceHistoryRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ValidatorPerformanceHistoryRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorPerformanceHistoryResponse struct {
+	Validators           []*ValidatorPerformanceHistory `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	MissingEpochs        []uint64                       `protobuf:"varint,2,rep,packed,name=missing_epochs,json=missingEpochs,proto3" json:"missing_epochs,omitempty"`
+	MissingValidators    [][]byte                       `protobuf:"bytes,3,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ValidatorPerformanceHistoryResponse) Reset()         { *m = ValidatorPerformanceHistoryResponse{} }
+func (m *ValidatorPerformanceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceHistoryResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{1}
+}
+func (m *ValidatorPerformanceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceHistoryResponse.Merge(m, src)
+}
+func (m *ValidatorPerformanceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceHistoryResponse proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceHistoryResponse) GetValidators() []*ValidatorPerformanceHistory {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ValidatorPerformanceHistoryResponse) GetMissingEpochs() []uint64 {
+	if m != nil {
+		return m.MissingEpochs
+	}
+	return nil
+}
+
+func (m *ValidatorPerformanceHistoryResponse) GetMissingValidators() [][]byte {
+	if m != nil {
+		return m.MissingValidators
+	}
+	return nil
+}
+
+type ValidatorPerformanceHistory struct {
+	Index                uint64                       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte                       `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Epochs               []*ValidatorEpochPerformance `protobuf:"bytes,3,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ValidatorPerformanceHistory) Reset()         { *m = ValidatorPerformanceHistory{} }
+func (m *ValidatorPerformanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceHistory) ProtoMessage()    {}
+func (*ValidatorPerformanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{2}
+}
+func (m *ValidatorPerformanceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceHistory.Merge(m, src)
+}
+func (m *ValidatorPerformanceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceHistory proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceHistory) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceHistory) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorPerformanceHistory) GetEpochs() []*ValidatorEpochPerformance {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+type ValidatorEpochPerformance struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CorrectlyVotedSource bool     `protobuf:"varint,2,opt,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget bool     `protobuf:"varint,3,opt,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead   bool     `protobuf:"varint,4,opt,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	BalanceChange        int64    `protobuf:"varint,6,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorEpochPerformance) Reset()         { *m = ValidatorEpochPerformance{} }
+func (m *ValidatorEpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochPerformance) ProtoMessage()    {}
+func (*ValidatorEpochPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{3}
+}
+func (m *ValidatorEpochPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochPerformance.Merge(m, src)
+}
+func (m *ValidatorEpochPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochPerformance proto.InternalMessageInfo
+
+func (m *ValidatorEpochPerformance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorEpochPerformance) GetCorrectlyVotedSource() bool {
+	if m != nil {
+		return m.CorrectlyVotedSource
+	}
+	return false
+}
+
+func (m *ValidatorEpochPerformance) GetCorrectlyVotedTarget() bool {
+	if m != nil {
+		return m.CorrectlyVotedTarget
+	}
+	return false
+}
+
+func (m *ValidatorEpochPerformance) GetCorrectlyVotedHead() bool {
+	if m != nil {
+		return m.CorrectlyVotedHead
+	}
+	return false
+}
+
+func (m *ValidatorEpochPerformance) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *ValidatorEpochPerformance) GetBalanceChange() int64 {
+	if m != nil {
+		return m.BalanceChange
+	}
+	return 0
+}
+
+type ValidatorParticipationHistoryRequest struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorParticipationHistoryRequest) Reset()         { *m = ValidatorParticipationHistoryRequest{} }
+func (m *ValidatorParticipationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationHistoryRequest) ProtoMessage()    {}
+func (*ValidatorParticipationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{4}
+}
+func (m *ValidatorParticipationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationHistoryRequest.Merge(m, src)
+}
+func (m *ValidatorParticipationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationHistoryRequest proto.InternalMessageInfo
+
+func (m *ValidatorParticipationHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorParticipationHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type ValidatorParticipationHistoryResponse struct {
+	Epochs               []*EpochParticipation `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	MissingEpochs        []uint64              `protobuf:"varint,2,rep,packed,name=missing_epochs,json=missingEpochs,proto3" json:"missing_epochs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ValidatorParticipationHistoryResponse) Reset()         { *m = ValidatorParticipationHistoryResponse{} }
+func (m *ValidatorParticipationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationHistoryResponse) ProtoMessage()    {}
+func (*ValidatorParticipationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{5}
+}
+func (m *ValidatorParticipationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationHistoryResponse.Merge(m, src)
+}
+func (m *ValidatorParticipationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationHistoryResponse proto.InternalMessageInfo
+
+func (m *ValidatorParticipationHistoryResponse) GetEpochs() []*EpochParticipation {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *ValidatorParticipationHistoryResponse) GetMissingEpochs() []uint64 {
+	if m != nil {
+		return m.MissingEpochs
+	}
+	return nil
+}
+
+type EpochParticipation struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ActiveGwei           uint64   `protobuf:"varint,2,opt,name=active_gwei,json=activeGwei,proto3" json:"active_gwei,omitempty"`
+	SourceAttestingGwei  uint64   `protobuf:"varint,3,opt,name=source_attesting_gwei,json=sourceAttestingGwei,proto3" json:"source_attesting_gwei,omitempty"`
+	TargetAttestingGwei  uint64   `protobuf:"varint,4,opt,name=target_attesting_gwei,json=targetAttestingGwei,proto3" json:"target_attesting_gwei,omitempty"`
+	HeadAttestingGwei    uint64   `protobuf:"varint,5,opt,name=head_attesting_gwei,json=headAttestingGwei,proto3" json:"head_attesting_gwei,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochParticipation) Reset()         { *m = EpochParticipation{} }
+func (m *EpochParticipation) String() string { return proto.CompactTextString(m) }
+func (*EpochParticipation) ProtoMessage()    {}
+func (*EpochParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d76a8f2e98c1fc6, []int{6}
+}
+func (m *EpochParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochParticipation.Merge(m, src)
+}
+func (m *EpochParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochParticipation proto.InternalMessageInfo
+
+func (m *EpochParticipation) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetActiveGwei() uint64 {
+	if m != nil {
+		return m.ActiveGwei
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetSourceAttestingGwei() uint64 {
+	if m != nil {
+		return m.SourceAttestingGwei
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetTargetAttestingGwei() uint64 {
+	if m != nil {
+		return m.TargetAttestingGwei
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetHeadAttestingGwei() uint64 {
+	if m != nil {
+		return m.HeadAttestingGwei
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorPerformanceHistoryRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryRequest")
+	proto.RegisterType((*ValidatorPerformanceHistoryResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryResponse")
+	proto.RegisterType((*ValidatorPerformanceHistory)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceHistory")
+	proto.RegisterType((*ValidatorEpochPerformance)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochPerformance")
+	proto.RegisterType((*ValidatorParticipationHistoryRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorParticipationHistoryRequest")
+	proto.RegisterType((*ValidatorParticipationHistoryResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorParticipationHistoryResponse")
+	proto.RegisterType((*EpochParticipation)(nil), "ethereum.beacon.rpc.v1.EpochParticipation")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/validator_history.proto", fileDescriptor_8d76a8f2e98c1fc6)
+}
+
+var fileDescriptor_8d76a8f2e98c1fc6 = []byte{
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x95, 0xe3, 0xb4, 0xaf, 0xbd, 0x6d, 0x9f, 0x5e, 0xa7, 0x7d, 0x95, 0x5f, 0xfb, 0x48, 0x23,
+	0x43, 0xa5, 0x88, 0x0f, 0xbb, 0x69, 0x2b, 0x16, 0x7c, 0x2c, 0x28, 0xa0, 0x16, 0xb1, 0x41, 0x2e,
+	0xea, 0xd6, 0x9a, 0xd8, 0x97, 0x64, 0x44, 0xea, 0x31, 0x33, 0x93, 0x94, 0x6c, 0xf9, 0x09, 0xb0,
+	0x66, 0xc9, 0xdf, 0x60, 0xcd, 0x8e, 0x4a, 0x2c, 0xd8, 0xa2, 0xc2, 0x0f, 0x41, 0x9e, 0xb1, 0xd3,
+	0xa4, 0x24, 0x29, 0xaa, 0xd8, 0xc5, 0xf7, 0xdc, 0x73, 0xe7, 0x9e, 0x3b, 0xe7, 0x66, 0xe0, 0x46,
+	0x2a, 0xb8, 0xe2, 0x7e, 0x03, 0x69, 0xc4, 0x13, 0x5f, 0xa4, 0x91, 0xdf, 0xad, 0xfb, 0x5d, 0xda,
+	0x66, 0x31, 0x55, 0x5c, 0x84, 0x2d, 0x26, 0x15, 0x17, 0x3d, 0x4f, 0x67, 0x91, 0x15, 0x54, 0x2d,
+	0x14, 0xd8, 0x39, 0xf2, 0x4c, 0xbe, 0x27, 0xd2, 0xc8, 0xeb, 0xd6, 0x57, 0xff, 0x6f, 0x72, 0xde,
+	0x6c, 0xa3, 0x4f, 0x53, 0xe6, 0xd3, 0x24, 0xe1, 0x8a, 0x2a, 0xc6, 0x13, 0x69, 0x58, 0xee, 0x7b,
+	0x0b, 0xdc, 0xc3, 0xa2, 0xe2, 0x33, 0x14, 0x2f, 0xb8, 0x38, 0xa2, 0x49, 0x84, 0xfb, 0xa6, 0x76,
+	0x80, 0xaf, 0x3a, 0x28, 0x15, 0x59, 0x87, 0x39, 0xa9, 0xa8, 0x50, 0x21, 0xa6, 0x3c, 0x6a, 0x39,
+	0x56, 0xd5, 0xaa, 0x95, 0x03, 0xd0, 0xa1, 0xc7, 0x59, 0x84, 0xac, 0xc1, 0x2c, 0x26, 0x71, 0x0e,
+	0x97, 0x34, 0x3c, 0x83, 0x49, 0x6c, 0x40, 0x07, 0xfe, 0x62, 0x49, 0xcc, 0x22, 0x94, 0x8e, 0x5d,
+	0xb5, 0x6b, 0xe5, 0xa0, 0xf8, 0xcc, 0xea, 0xa6, 0x9d, 0x46, 0x9b, 0x45, 0xe1, 0x4b, 0xec, 0x49,
+	0xa7, 0x5c, 0xb5, 0x6b, 0xf3, 0x01, 0x98, 0xd0, 0x53, 0xec, 0x49, 0xf7, 0xc4, 0x82, 0xab, 0x13,
+	0xfb, 0x93, 0x29, 0x4f, 0x24, 0x92, 0x03, 0x80, 0xfe, 0x60, 0xa4, 0x63, 0x55, 0xed, 0xda, 0xdc,
+	0xd6, 0xb6, 0x37, 0x7a, 0x24, 0xde, 0xa4, 0x82, 0x03, 0x65, 0xc8, 0x06, 0xfc, 0x7d, 0xc4, 0xa4,
+	0x64, 0x49, 0xd3, 0x08, 0x93, 0x4e, 0x49, 0xb7, 0xbf, 0x90, 0x47, 0xb5, 0x3a, 0x49, 0x6e, 0x01,
+	0x29, 0xd2, 0x06, 0x7a, 0xb0, 0xb5, 0x96, 0xc5, 0x1c, 0xe9, 0x1f, 0x29, 0xb3, 0x91, 0xaf, 0x4d,
+	0xe8, 0x80, 0x2c, 0xc3, 0x14, 0x4b, 0x62, 0x7c, 0x9d, 0x4f, 0xd9, 0x7c, 0x90, 0x2b, 0x00, 0x67,
+	0x93, 0xd2, 0x13, 0x9e, 0x0f, 0x66, 0xfb, 0x83, 0x22, 0x4f, 0x60, 0x3a, 0x6f, 0xd1, 0xd6, 0xda,
+	0xeb, 0x17, 0x6a, 0xd7, 0xcd, 0x0f, 0x1c, 0x1f, 0xe4, 0x05, 0xdc, 0x0f, 0x25, 0xf8, 0x6f, 0x6c,
+	0x56, 0xd6, 0xdd, 0xa0, 0x07, 0xcc, 0x07, 0xd9, 0x81, 0x95, 0x88, 0x0b, 0x81, 0x91, 0x6a, 0xf7,
+	0xc2, 0x2e, 0x57, 0x18, 0x87, 0x92, 0x77, 0x44, 0x84, 0xba, 0xd3, 0x99, 0x60, 0xb9, 0x8f, 0x1e,
+	0x66, 0xe0, 0x81, 0xc6, 0x46, 0xb1, 0x14, 0x15, 0x4d, 0x54, 0x8e, 0x3d, 0x8a, 0xf5, 0x5c, 0x63,
+	0x64, 0x13, 0x96, 0xcf, 0xb3, 0x5a, 0x48, 0x63, 0xa7, 0xac, 0x39, 0x64, 0x98, 0xb3, 0x8f, 0x34,
+	0xce, 0x2e, 0x88, 0x25, 0x51, 0xbb, 0x23, 0x19, 0x4f, 0xc2, 0x98, 0x49, 0x95, 0x29, 0x71, 0xa6,
+	0xb4, 0x80, 0xc5, 0x3e, 0xf2, 0x28, 0x07, 0xb2, 0x6b, 0x6f, 0xd0, 0x76, 0xf6, 0x33, 0x8c, 0x5a,
+	0x34, 0x69, 0xa2, 0x33, 0x5d, 0xb5, 0x6a, 0x76, 0xb0, 0x90, 0x47, 0x1f, 0xea, 0xa0, 0x1b, 0xc3,
+	0xb5, 0xb3, 0x6b, 0xa4, 0x42, 0xb1, 0x88, 0xa5, 0x7a, 0xb7, 0xfe, 0xe4, 0xee, 0xb8, 0x6f, 0x2d,
+	0xd8, 0xb8, 0xe0, 0x98, 0x7c, 0x05, 0x76, 0xfb, 0x16, 0x30, 0xf6, 0xbf, 0x3e, 0xce, 0x02, 0xe6,
+	0x4e, 0x07, 0x4b, 0x15, 0x77, 0xff, 0x9b, 0x8e, 0x77, 0xbf, 0x5b, 0x40, 0x7e, 0xad, 0x32, 0xc6,
+	0x1b, 0xeb, 0x30, 0x47, 0x23, 0xc5, 0xba, 0x18, 0x36, 0x8f, 0x91, 0xe5, 0x02, 0xc1, 0x84, 0xf6,
+	0x8e, 0x91, 0x91, 0x2d, 0xf8, 0xd7, 0x98, 0x25, 0xa4, 0x4a, 0xa1, 0x54, 0xd9, 0xe9, 0x3a, 0xd5,
+	0xd6, 0xa9, 0x4b, 0x06, 0x7c, 0x50, 0x60, 0x05, 0xc7, 0x58, 0xe5, 0x3c, 0xa7, 0x6c, 0x38, 0x06,
+	0x1c, 0xe6, 0x78, 0xb0, 0x94, 0x19, 0xe5, 0x3c, 0x23, 0xf7, 0x41, 0x06, 0x0d, 0xe5, 0x6f, 0x7d,
+	0xb4, 0xe1, 0x9f, 0xfe, 0xe8, 0x8b, 0xed, 0xfc, 0x6c, 0x41, 0x65, 0x0f, 0xd5, 0xa4, 0x05, 0xbe,
+	0x73, 0x99, 0xff, 0x1d, 0x63, 0x96, 0xd5, 0xbb, 0x97, 0xe2, 0x1a, 0x07, 0xb8, 0x3b, 0x6f, 0xbe,
+	0xfc, 0x78, 0x57, 0xf2, 0xc8, 0x4d, 0x1f, 0x55, 0xcb, 0xef, 0xd6, 0x69, 0x3b, 0x6d, 0xd1, 0x81,
+	0x17, 0x43, 0xfa, 0xe9, 0x19, 0xd9, 0xcf, 0x9f, 0x0f, 0xf2, 0xd5, 0x82, 0xea, 0x90, 0xa2, 0x11,
+	0x26, 0x23, 0xf7, 0x2e, 0xee, 0x6b, 0xfc, 0x0a, 0xac, 0xde, 0xbf, 0x24, 0x3b, 0xd7, 0x75, 0x5b,
+	0xeb, 0xda, 0x24, 0xde, 0x78, 0x5d, 0x83, 0xf4, 0x42, 0xd9, 0xee, 0xfc, 0xa7, 0xd3, 0x8a, 0x75,
+	0x72, 0x5a, 0xb1, 0xbe, 0x9d, 0x56, 0xac, 0xc6, 0xb4, 0x7e, 0xf1, 0xb6, 0x7f, 0x0e, 0x00, 0x98,
+	0xb0, 0x92, 0xbe, 0x56, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ValidatorHistoryClient is the client API for ValidatorHistory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorHistoryClient interface {
+	GetValidatorPerformanceHistory(ctx context.Context, in *ValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceHistoryResponse, error)
+	GetValidatorParticipationHistory(ctx context.Context, in *ValidatorParticipationHistoryRequest, opts ...grpc.CallOption) (*ValidatorParticipationHistoryResponse, error)
+}
+
+type validatorHistoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorHistoryClient(cc *grpc.ClientConn) ValidatorHistoryClient {
+	return &validatorHistoryClient{cc}
+}
+
+func (c *validatorHistoryClient) GetValidatorPerformanceHistory(ctx context.Context, in *ValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceHistoryResponse, error) {
+	out := new(ValidatorPerformanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorPerformanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorHistoryClient) GetValidatorParticipationHistory(ctx context.Context, in *ValidatorParticipationHistoryRequest, opts ...grpc.CallOption) (*ValidatorParticipationHistoryResponse, error) {
+	out := new(ValidatorParticipationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorParticipationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorHistoryServer is the server API for ValidatorHistory service.
+type ValidatorHistoryServer interface {
+	GetValidatorPerformanceHistory(context.Context, *ValidatorPerformanceHistoryRequest) (*ValidatorPerformanceHistoryResponse, error)
+	GetValidatorParticipationHistory(context.Context, *ValidatorParticipationHistoryRequest) (*ValidatorParticipationHistoryResponse, error)
+}
+
+// UnimplementedValidatorHistoryServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorHistoryServer struct {
+}
+
+func (*UnimplementedValidatorHistoryServer) GetValidatorPerformanceHistory(ctx context.Context, req *ValidatorPerformanceHistoryRequest) (*ValidatorPerformanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceHistory not implemented")
+}
+func (*UnimplementedValidatorHistoryServer) GetValidatorParticipationHistory(ctx context.Context, req *ValidatorParticipationHistoryRequest) (*ValidatorParticipationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipationHistory not implemented")
+}
+
+func RegisterValidatorHistoryServer(s *grpc.Server, srv ValidatorHistoryServer) {
+	s.RegisterService(&_ValidatorHistory_serviceDesc, srv)
+}
+
+func _ValidatorHistory_GetValidatorPerformanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorHistoryServer).GetValidatorPerformanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorPerformanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorHistoryServer).GetValidatorPerformanceHistory(ctx, req.(*ValidatorPerformanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorHistory_GetValidatorParticipationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorParticipationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorHistoryServer).GetValidatorParticipationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorParticipationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorHistoryServer).GetValidatorParticipationHistory(ctx, req.(*ValidatorParticipationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorHistory_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorHistory",
+	HandlerType: (*ValidatorHistoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorPerformanceHistory",
+			Handler:    _ValidatorHistory_GetValidatorPerformanceHistory_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipationHistory",
+			Handler:    _ValidatorHistory_GetValidatorParticipationHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_history.proto",
+}
+
+func (m *ValidatorPerformanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintValidatorHistory(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingValidators) > 0 {
+		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingValidators[iNdEx])
+			copy(dAtA[i:], m.MissingValidators[iNdEx])
+			i = encodeVarintValidatorHistory(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MissingEpochs) > 0 {
+		dAtA4 := make([]byte, len(m.MissingEpochs)*10)
+		var j3 int
+		for _, num := range m.MissingEpochs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BalanceChange != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.BalanceChange))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CorrectlyVotedHead {
+		i--
+		if m.CorrectlyVotedHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CorrectlyVotedTarget {
+		i--
+		if m.CorrectlyVotedTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CorrectlyVotedSource {
+		i--
+		if m.CorrectlyVotedSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingEpochs) > 0 {
+		dAtA6 := make([]byte, len(m.MissingEpochs)*10)
+		var j5 int
+		for _, num := range m.MissingEpochs {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeadAttestingGwei != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.HeadAttestingGwei))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetAttestingGwei != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.TargetAttestingGwei))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceAttestingGwei != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.SourceAttestingGwei))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActiveGwei != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.ActiveGwei))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorPerformanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.EndEpoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovValidatorHistory(uint64(e))
+		}
+		n += 1 + sovValidatorHistory(uint64(l)) + l
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovValidatorHistory(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovValidatorHistory(uint64(l))
+		}
+	}
+	if len(m.MissingEpochs) > 0 {
+		l = 0
+		for _, e := range m.MissingEpochs {
+			l += sovValidatorHistory(uint64(e))
+		}
+		n += 1 + sovValidatorHistory(uint64(l)) + l
+	}
+	if len(m.MissingValidators) > 0 {
+		for _, b := range m.MissingValidators {
+			l = len(b)
+			n += 1 + l + sovValidatorHistory(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorHistory(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovValidatorHistory(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.Epoch))
+	}
+	if m.CorrectlyVotedSource {
+		n += 2
+	}
+	if m.CorrectlyVotedTarget {
+		n += 2
+	}
+	if m.CorrectlyVotedHead {
+		n += 2
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.InclusionDistance))
+	}
+	if m.BalanceChange != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.BalanceChange))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorParticipationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.EndEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorParticipationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovValidatorHistory(uint64(l))
+		}
+	}
+	if len(m.MissingEpochs) > 0 {
+		l = 0
+		for _, e := range m.MissingEpochs {
+			l += sovValidatorHistory(uint64(e))
+		}
+		n += 1 + sovValidatorHistory(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.Epoch))
+	}
+	if m.ActiveGwei != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.ActiveGwei))
+	}
+	if m.SourceAttestingGwei != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.SourceAttestingGwei))
+	}
+	if m.TargetAttestingGwei != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.TargetAttestingGwei))
+	}
+	if m.HeadAttestingGwei != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.HeadAttestingGwei))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorHistory(x uint64) (n int) {
+	return sovValidatorHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorPerformanceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorPerformanceHistory{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingEpochs = append(m.MissingEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingEpochs) == 0 {
+					m.MissingEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingEpochs = append(m.MissingEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingEpochs", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &ValidatorEpochPerformance{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectlyVotedSource = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectlyVotedTarget = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectlyVotedHead = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChange", wireType)
+			}
+			m.BalanceChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceChange |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &EpochParticipation{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingEpochs = append(m.MissingEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorHistory
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingEpochs) == 0 {
+					m.MissingEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingEpochs = append(m.MissingEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingEpochs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveGwei", wireType)
+			}
+			m.ActiveGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAttestingGwei", wireType)
+			}
+			m.SourceAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAttestingGwei", wireType)
+			}
+			m.TargetAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadAttestingGwei", wireType)
+			}
+			m.HeadAttestingGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadAttestingGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorHistory = fmt.Errorf("proto: unexpected end of group")
+)
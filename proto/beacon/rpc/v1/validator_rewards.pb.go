@@ -0,0 +1,1469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/validator_rewards.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewardsRequest) Reset()         { *m = ValidatorRewardsRequest{} }
+func (m *ValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsRequest) ProtoMessage()    {}
+func (*ValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5589cbe05279c3, []int{0}
+}
+func (m *ValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ValidatorRewardsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorRewardsResponse struct {
+	Epoch                uint64                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorEpochRewards `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	MissingValidators    [][]byte                 `protobuf:"bytes,3,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ValidatorRewardsResponse) Reset()         { *m = ValidatorRewardsResponse{} }
+func (m *ValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsResponse) ProtoMessage()    {}
+func (*ValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5589cbe05279c3, []int{1}
+}
+func (m *ValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsResponse.Merge(m, src)
+}
+func (m *ValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *ValidatorRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse) GetRewards() []*ValidatorEpochRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ValidatorRewardsResponse) GetMissingValidators() [][]byte {
+	if m != nil {
+		return m.MissingValidators
+	}
+	return nil
+}
+
+type ValidatorEpochRewards struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,3,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,4,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,5,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,6,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,7,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,8,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,9,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,10,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,11,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	BalanceBefore        uint64   `protobuf:"varint,12,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64   `protobuf:"varint,13,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorEpochRewards) Reset()         { *m = ValidatorEpochRewards{} }
+func (m *ValidatorEpochRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochRewards) ProtoMessage()    {}
+func (*ValidatorEpochRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5589cbe05279c3, []int{2}
+}
+func (m *ValidatorEpochRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochRewards.Merge(m, src)
+}
+func (m *ValidatorEpochRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochRewards proto.InternalMessageInfo
+
+func (m *ValidatorEpochRewards) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorEpochRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetBalanceBefore() uint64 {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetBalanceAfter() uint64 {
+	if m != nil {
+		return m.BalanceAfter
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorRewardsResponse")
+	proto.RegisterType((*ValidatorEpochRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochRewards")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/validator_rewards.proto", fileDescriptor_cb5589cbe05279c3)
+}
+
+var fileDescriptor_cb5589cbe05279c3 = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0xe5, 0x26, 0x6d, 0xe8, 0x8b, 0x1b, 0xe8, 0x50, 0x8a, 0x55, 0x41, 0x1a, 0x82, 0x2a,
+	0x22, 0xa1, 0xd8, 0xa4, 0x70, 0x01, 0x2a, 0x50, 0x17, 0x6c, 0x90, 0x17, 0x6c, 0xa3, 0x89, 0xfd,
+	0x9a, 0x0c, 0xb8, 0x33, 0x66, 0x66, 0x12, 0xc8, 0xb6, 0x57, 0xe0, 0x06, 0xac, 0xb8, 0x01, 0x57,
+	0x60, 0x89, 0xc4, 0x05, 0x50, 0xc4, 0x41, 0x90, 0xe7, 0x8f, 0x03, 0xb4, 0x08, 0x96, 0xf3, 0xcd,
+	0xf7, 0xfd, 0xe6, 0xbd, 0xf1, 0x1b, 0xc3, 0xc3, 0x52, 0x0a, 0x2d, 0x92, 0x09, 0xd2, 0x4c, 0xf0,
+	0x44, 0x96, 0x59, 0xb2, 0x18, 0x25, 0x0b, 0x5a, 0xb0, 0x9c, 0x6a, 0x21, 0xc7, 0x12, 0xdf, 0x51,
+	0x99, 0xab, 0xd8, 0xb8, 0xc8, 0x3e, 0xea, 0x19, 0x4a, 0x9c, 0x9f, 0xc7, 0xd6, 0x1f, 0xcb, 0x32,
+	0x8b, 0x17, 0xa3, 0x83, 0x3b, 0x53, 0x21, 0xa6, 0x05, 0x26, 0xb4, 0x64, 0x09, 0xe5, 0x5c, 0x68,
+	0xaa, 0x99, 0xe0, 0x2e, 0xd5, 0x7f, 0x0d, 0xb7, 0x5f, 0x79, 0x60, 0x6a, 0x79, 0x29, 0xbe, 0x9d,
+	0xa3, 0xd2, 0x64, 0x0f, 0x36, 0xb1, 0x14, 0xd9, 0x2c, 0x0a, 0x7a, 0xc1, 0xa0, 0x99, 0xda, 0x05,
+	0x89, 0xa0, 0xc5, 0x78, 0xce, 0x32, 0x54, 0xd1, 0x46, 0xaf, 0x31, 0x68, 0xa6, 0x7e, 0x49, 0x0e,
+	0xa1, 0x5d, 0xce, 0x27, 0x05, 0xcb, 0xc6, 0x6f, 0x70, 0xa9, 0xa2, 0x46, 0xaf, 0x31, 0x08, 0x53,
+	0xb0, 0xd2, 0x0b, 0x5c, 0xaa, 0xfe, 0xa7, 0x00, 0xa2, 0xcb, 0x87, 0xa9, 0x52, 0x70, 0x85, 0x7f,
+	0x39, 0xed, 0x14, 0x5a, 0xae, 0x4b, 0x73, 0x5a, 0xfb, 0x78, 0x18, 0x5f, 0xdd, 0x66, 0x5c, 0x83,
+	0x9f, 0x57, 0x41, 0x4f, 0xf7, 0x69, 0x32, 0x04, 0x72, 0xce, 0x94, 0x62, 0x7c, 0x3a, 0xae, 0x2f,
+	0xd0, 0xd7, 0xb8, 0xeb, 0x76, 0x6a, 0x84, 0xea, 0x5f, 0x34, 0xe1, 0xd6, 0x95, 0xc4, 0xaa, 0x4e,
+	0xc6, 0x73, 0x7c, 0xef, 0xeb, 0x34, 0x0b, 0x72, 0x17, 0x60, 0xdd, 0x7b, 0xb4, 0xd1, 0x0b, 0x06,
+	0x61, 0xba, 0x5d, 0xb7, 0x4e, 0xee, 0xc3, 0x8e, 0x12, 0x73, 0x99, 0xa1, 0xfb, 0x66, 0x51, 0xc3,
+	0x84, 0x43, 0x2b, 0x5a, 0x34, 0x39, 0x82, 0x8e, 0x33, 0x95, 0xc8, 0x69, 0xa1, 0x97, 0x51, 0xd3,
+	0xb8, 0x5c, 0xf4, 0xa5, 0x15, 0x2b, 0x96, 0xa6, 0x72, 0x8a, 0xda, 0xb3, 0x36, 0x2d, 0xcb, 0x8a,
+	0x6b, 0x96, 0x33, 0x79, 0xd6, 0x96, 0x65, 0x59, 0xd5, 0xb3, 0x0e, 0xa1, 0x3d, 0x43, 0x9a, 0x7b,
+	0x52, 0xcb, 0x78, 0xa0, 0x92, 0x1c, 0xe7, 0x1e, 0x84, 0xc6, 0xe0, 0x29, 0xd7, 0x8c, 0xc3, 0x84,
+	0x3c, 0xe3, 0x09, 0xec, 0x33, 0x9e, 0x15, 0x73, 0xc5, 0x04, 0x1f, 0xe7, 0x58, 0xd0, 0xa5, 0xc7,
+	0x6d, 0x1b, 0xf3, 0x5e, 0xbd, 0xfb, 0xac, 0xda, 0x74, 0xe0, 0x07, 0x70, 0xbd, 0x94, 0xa2, 0x14,
+	0x0a, 0xfd, 0x1c, 0x47, 0x60, 0xec, 0x1d, 0x2f, 0x3b, 0xe3, 0x10, 0x08, 0xe3, 0x34, 0xd3, 0x6c,
+	0xc1, 0xf4, 0xb2, 0xae, 0xa3, 0x6d, 0xbc, 0xbb, 0xeb, 0x1d, 0x5f, 0xcd, 0x11, 0x74, 0x26, 0xb4,
+	0xa0, 0x3c, 0xc3, 0xf1, 0x04, 0xcf, 0x84, 0xc4, 0x28, 0xb4, 0x8d, 0x3b, 0xf5, 0xc4, 0x88, 0xd5,
+	0x25, 0x7a, 0x1b, 0x3d, 0xd3, 0x28, 0xa3, 0x1d, 0x7b, 0x89, 0x4e, 0x7c, 0x5a, 0x69, 0xc7, 0x9f,
+	0x03, 0xb8, 0xf1, 0xe7, 0xbc, 0x92, 0x8f, 0x01, 0xdc, 0x3c, 0x45, 0x7d, 0x49, 0x4f, 0xfe, 0x39,
+	0x98, 0xbf, 0x3f, 0xaf, 0x83, 0x47, 0xff, 0x1f, 0xb0, 0x4f, 0xa4, 0x3f, 0xb8, 0xf8, 0xf6, 0xe3,
+	0xc3, 0x46, 0x9f, 0xf4, 0x12, 0xd4, 0xb3, 0x64, 0x31, 0xa2, 0x45, 0x39, 0xa3, 0xbf, 0xfc, 0x10,
+	0x54, 0xe2, 0xa6, 0xfd, 0x24, 0xfc, 0xb2, 0xea, 0x06, 0x5f, 0x57, 0xdd, 0xe0, 0xfb, 0xaa, 0x1b,
+	0x4c, 0xb6, 0xcc, 0x53, 0x7f, 0xfc, 0x73, 0x00, 0xe2, 0x62, 0x60, 0xa1, 0x4f, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ValidatorRewardsClient is the client API for ValidatorRewards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorRewardsClient interface {
+	GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error)
+}
+
+type validatorRewardsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorRewardsClient(cc *grpc.ClientConn) ValidatorRewardsClient {
+	return &validatorRewardsClient{cc}
+}
+
+func (c *validatorRewardsClient) GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error) {
+	out := new(ValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorRewards/GetValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorRewardsServer is the server API for ValidatorRewards service.
+type ValidatorRewardsServer interface {
+	GetValidatorRewards(context.Context, *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error)
+}
+
+// UnimplementedValidatorRewardsServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorRewardsServer struct {
+}
+
+func (*UnimplementedValidatorRewardsServer) GetValidatorRewards(ctx context.Context, req *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorRewards not implemented")
+}
+
+func RegisterValidatorRewardsServer(s *grpc.Server, srv ValidatorRewardsServer) {
+	s.RegisterService(&_ValidatorRewards_serviceDesc, srv)
+}
+
+func _ValidatorRewards_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorRewardsServer).GetValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorRewards/GetValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorRewardsServer).GetValidatorRewards(ctx, req.(*ValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorRewards_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorRewards",
+	HandlerType: (*ValidatorRewardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _ValidatorRewards_GetValidatorRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_rewards.proto",
+}
+
+func (m *ValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingValidators) > 0 {
+		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingValidators[iNdEx])
+			copy(dAtA[i:], m.MissingValidators[iNdEx])
+			i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BalanceAfter != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.BalanceAfter))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BalanceBefore != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.BalanceBefore))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovValidatorRewards(uint64(e))
+		}
+		n += 1 + sovValidatorRewards(uint64(l)) + l
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovValidatorRewards(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovValidatorRewards(uint64(l))
+		}
+	}
+	if len(m.MissingValidators) > 0 {
+		for _, b := range m.MissingValidators {
+			l = len(b)
+			n += 1 + l + sovValidatorRewards(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewards(uint64(l))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.SourceReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.SourcePenalty))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.TargetReward))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.ProposerReward))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.InactivityPenalty))
+	}
+	if m.BalanceBefore != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.BalanceBefore))
+	}
+	if m.BalanceAfter != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.BalanceAfter))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorRewards(x uint64) (n int) {
+	return sovValidatorRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorRewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorRewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorRewards
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorRewards
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorRewards
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorEpochRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
+			}
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			m.BalanceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
+			}
+			m.BalanceAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorRewards = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/attestation_tracker.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type StreamTrackedAttestationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *StreamTrackedAttestationsRequest) Reset() {
+	*x = StreamTrackedAttestationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTrackedAttestationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTrackedAttestationsRequest) ProtoMessage() {}
+
+func (x *StreamTrackedAttestationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTrackedAttestationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTrackedAttestationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamTrackedAttestationsRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type TrackedAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex    uint64 `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot              uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex    uint64 `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	FirstSeen         int64  `protobuf:"varint,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	Included          bool   `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	InclusionSlot     uint64 `protobuf:"varint,6,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance uint64 `protobuf:"varint,7,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectHead       bool   `protobuf:"varint,8,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget     bool   `protobuf:"varint,9,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	Final             bool   `protobuf:"varint,10,opt,name=final,proto3" json:"final,omitempty"`
+}
+
+func (x *TrackedAttestation) Reset() {
+	*x = TrackedAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackedAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedAttestation) ProtoMessage() {}
+
+func (x *TrackedAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedAttestation.ProtoReflect.Descriptor instead.
+func (*TrackedAttestation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackedAttestation) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+func (x *TrackedAttestation) GetInclusionSlot() uint64 {
+	if x != nil {
+		return x.InclusionSlot
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetInclusionDistance() uint64 {
+	if x != nil {
+		return x.InclusionDistance
+	}
+	return 0
+}
+
+func (x *TrackedAttestation) GetCorrectHead() bool {
+	if x != nil {
+		return x.CorrectHead
+	}
+	return false
+}
+
+func (x *TrackedAttestation) GetCorrectTarget() bool {
+	if x != nil {
+		return x.CorrectTarget
+	}
+	return false
+}
+
+func (x *TrackedAttestation) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+type AttestationEffectivenessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *AttestationEffectivenessRequest) Reset() {
+	*x = AttestationEffectivenessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationEffectivenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationEffectivenessRequest) ProtoMessage() {}
+
+func (x *AttestationEffectivenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationEffectivenessRequest.ProtoReflect.Descriptor instead.
+func (*AttestationEffectivenessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttestationEffectivenessRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type AttestationEffectivenessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*AttestationEffectiveness `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *AttestationEffectivenessResponse) Reset() {
+	*x = AttestationEffectivenessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationEffectivenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationEffectivenessResponse) ProtoMessage() {}
+
+func (x *AttestationEffectivenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationEffectivenessResponse.ProtoReflect.Descriptor instead.
+func (*AttestationEffectivenessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttestationEffectivenessResponse) GetValidators() []*AttestationEffectiveness {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type AttestationEffectiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex           uint64  `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Attestations             uint64  `protobuf:"varint,2,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	Included                 uint64  `protobuf:"varint,3,opt,name=included,proto3" json:"included,omitempty"`
+	CorrectHead              uint64  `protobuf:"varint,4,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget            uint64  `protobuf:"varint,5,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	AverageInclusionDistance float64 `protobuf:"fixed64,6,opt,name=average_inclusion_distance,json=averageInclusionDistance,proto3" json:"average_inclusion_distance,omitempty"`
+	AverageFirstSeenDelay    int64   `protobuf:"varint,7,opt,name=average_first_seen_delay,json=averageFirstSeenDelay,proto3" json:"average_first_seen_delay,omitempty"`
+	Effectiveness            float64 `protobuf:"fixed64,8,opt,name=effectiveness,proto3" json:"effectiveness,omitempty"`
+}
+
+func (x *AttestationEffectiveness) Reset() {
+	*x = AttestationEffectiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationEffectiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationEffectiveness) ProtoMessage() {}
+
+func (x *AttestationEffectiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationEffectiveness.ProtoReflect.Descriptor instead.
+func (*AttestationEffectiveness) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttestationEffectiveness) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetAttestations() uint64 {
+	if x != nil {
+		return x.Attestations
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetIncluded() uint64 {
+	if x != nil {
+		return x.Included
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetCorrectHead() uint64 {
+	if x != nil {
+		return x.CorrectHead
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetCorrectTarget() uint64 {
+	if x != nil {
+		return x.CorrectTarget
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetAverageInclusionDistance() float64 {
+	if x != nil {
+		return x.AverageInclusionDistance
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetAverageFirstSeenDelay() int64 {
+	if x != nil {
+		return x.AverageFirstSeenDelay
+	}
+	return 0
+}
+
+func (x *AttestationEffectiveness) GetEffectiveness() float64 {
+	if x != nil {
+		return x.Effectiveness
+	}
+	return 0
+}
+
+var File_proto_beacon_rpc_v1_attestation_tracker_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x20, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x22, 0x3b, 0x0a, 0x1f, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x74,
+	0x0a, 0x20, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x18, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x48, 0x65, 0x61, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x32, 0xea, 0x02, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x83, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0xcd,
+	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescData = file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_beacon_rpc_v1_attestation_tracker_proto_goTypes = []interface{}{
+	(*StreamTrackedAttestationsRequest)(nil), // 0: ethereum.beacon.rpc.v1.StreamTrackedAttestationsRequest
+	(*TrackedAttestation)(nil),               // 1: ethereum.beacon.rpc.v1.TrackedAttestation
+	(*AttestationEffectivenessRequest)(nil),  // 2: ethereum.beacon.rpc.v1.AttestationEffectivenessRequest
+	(*AttestationEffectivenessResponse)(nil), // 3: ethereum.beacon.rpc.v1.AttestationEffectivenessResponse
+	(*AttestationEffectiveness)(nil),         // 4: ethereum.beacon.rpc.v1.AttestationEffectiveness
+}
+var file_proto_beacon_rpc_v1_attestation_tracker_proto_depIdxs = []int32{
+	4, // 0: ethereum.beacon.rpc.v1.AttestationEffectivenessResponse.validators:type_name -> ethereum.beacon.rpc.v1.AttestationEffectiveness
+	0, // 1: ethereum.beacon.rpc.v1.AttestationTracker.StreamTrackedAttestations:input_type -> ethereum.beacon.rpc.v1.StreamTrackedAttestationsRequest
+	2, // 2: ethereum.beacon.rpc.v1.AttestationTracker.GetAttestationEffectiveness:input_type -> ethereum.beacon.rpc.v1.AttestationEffectivenessRequest
+	1, // 3: ethereum.beacon.rpc.v1.AttestationTracker.StreamTrackedAttestations:output_type -> ethereum.beacon.rpc.v1.TrackedAttestation
+	3, // 4: ethereum.beacon.rpc.v1.AttestationTracker.GetAttestationEffectiveness:output_type -> ethereum.beacon.rpc.v1.AttestationEffectivenessResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_attestation_tracker_proto_init() }
+func file_proto_beacon_rpc_v1_attestation_tracker_proto_init() {
+	if File_proto_beacon_rpc_v1_attestation_tracker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTrackedAttestationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationEffectivenessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationEffectivenessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationEffectiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_attestation_tracker_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_attestation_tracker_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_attestation_tracker_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_attestation_tracker_proto = out.File
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_attestation_tracker_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AttestationTrackerClient is the client API for AttestationTracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttestationTrackerClient interface {
+	StreamTrackedAttestations(ctx context.Context, in *StreamTrackedAttestationsRequest, opts ...grpc.CallOption) (AttestationTracker_StreamTrackedAttestationsClient, error)
+	GetAttestationEffectiveness(ctx context.Context, in *AttestationEffectivenessRequest, opts ...grpc.CallOption) (*AttestationEffectivenessResponse, error)
+}
+
+type attestationTrackerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttestationTrackerClient(cc grpc.ClientConnInterface) AttestationTrackerClient {
+	return &attestationTrackerClient{cc}
+}
+
+func (c *attestationTrackerClient) StreamTrackedAttestations(ctx context.Context, in *StreamTrackedAttestationsRequest, opts ...grpc.CallOption) (AttestationTracker_StreamTrackedAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AttestationTracker_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.AttestationTracker/StreamTrackedAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attestationTrackerStreamTrackedAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AttestationTracker_StreamTrackedAttestationsClient interface {
+	Recv() (*TrackedAttestation, error)
+	grpc.ClientStream
+}
+
+type attestationTrackerStreamTrackedAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *attestationTrackerStreamTrackedAttestationsClient) Recv() (*TrackedAttestation, error) {
+	m := new(TrackedAttestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attestationTrackerClient) GetAttestationEffectiveness(ctx context.Context, in *AttestationEffectivenessRequest, opts ...grpc.CallOption) (*AttestationEffectivenessResponse, error) {
+	out := new(AttestationEffectivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttestationTracker/GetAttestationEffectiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttestationTrackerServer is the server API for AttestationTracker service.
+type AttestationTrackerServer interface {
+	StreamTrackedAttestations(*StreamTrackedAttestationsRequest, AttestationTracker_StreamTrackedAttestationsServer) error
+	GetAttestationEffectiveness(context.Context, *AttestationEffectivenessRequest) (*AttestationEffectivenessResponse, error)
+}
+
+// UnimplementedAttestationTrackerServer can be embedded to have forward compatible implementations.
+type UnimplementedAttestationTrackerServer struct {
+}
+
+func (*UnimplementedAttestationTrackerServer) StreamTrackedAttestations(*StreamTrackedAttestationsRequest, AttestationTracker_StreamTrackedAttestationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTrackedAttestations not implemented")
+}
+func (*UnimplementedAttestationTrackerServer) GetAttestationEffectiveness(context.Context, *AttestationEffectivenessRequest) (*AttestationEffectivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationEffectiveness not implemented")
+}
+
+func RegisterAttestationTrackerServer(s *grpc.Server, srv AttestationTrackerServer) {
+	s.RegisterService(&_AttestationTracker_serviceDesc, srv)
+}
+
+func _AttestationTracker_StreamTrackedAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTrackedAttestationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttestationTrackerServer).StreamTrackedAttestations(m, &attestationTrackerStreamTrackedAttestationsServer{stream})
+}
+
+type AttestationTracker_StreamTrackedAttestationsServer interface {
+	Send(*TrackedAttestation) error
+	grpc.ServerStream
+}
+
+type attestationTrackerStreamTrackedAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *attestationTrackerStreamTrackedAttestationsServer) Send(m *TrackedAttestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AttestationTracker_GetAttestationEffectiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationEffectivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationTrackerServer).GetAttestationEffectiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttestationTracker/GetAttestationEffectiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationTrackerServer).GetAttestationEffectiveness(ctx, req.(*AttestationEffectivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttestationTracker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttestationTracker",
+	HandlerType: (*AttestationTrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttestationEffectiveness",
+			Handler:    _AttestationTracker_GetAttestationEffectiveness_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTrackedAttestations",
+			Handler:       _AttestationTracker_StreamTrackedAttestations_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/attestation_tracker.proto",
+}
@@ -79,7 +79,53 @@ func (x LoggingLevelRequest_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LoggingLevelRequest_Level.Descriptor instead.
 func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{5, 0}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type PeerRule_Kind int32
+
+const (
+	PeerRule_TRUSTED PeerRule_Kind = 0
+	PeerRule_BANNED  PeerRule_Kind = 1
+)
+
+// Enum value maps for PeerRule_Kind.
+var (
+	PeerRule_Kind_name = map[int32]string{
+		0: "TRUSTED",
+		1: "BANNED",
+	}
+	PeerRule_Kind_value = map[string]int32{
+		"TRUSTED": 0,
+		"BANNED":  1,
+	}
+)
+
+func (x PeerRule_Kind) Enum() *PeerRule_Kind {
+	p := new(PeerRule_Kind)
+	*p = x
+	return p
+}
+
+func (x PeerRule_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerRule_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_beacon_rpc_v1_debug_proto_enumTypes[1].Descriptor()
+}
+
+func (PeerRule_Kind) Type() protoreflect.EnumType {
+	return &file_proto_beacon_rpc_v1_debug_proto_enumTypes[1]
+}
+
+func (x PeerRule_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerRule_Kind.Descriptor instead.
+func (PeerRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type InclusionSlotRequest struct {
@@ -184,6 +230,227 @@ func (x *InclusionSlotResponse) GetSlot() uint64 {
 	return 0
 }
 
+type BackfillStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginSlot uint64 `protobuf:"varint,1,opt,name=origin_slot,json=originSlot,proto3" json:"origin_slot,omitempty"`
+	OriginRoot []byte `protobuf:"bytes,2,opt,name=origin_root,json=originRoot,proto3" json:"origin_root,omitempty"`
+	LowestSlot uint64 `protobuf:"varint,3,opt,name=lowest_slot,json=lowestSlot,proto3" json:"lowest_slot,omitempty"`
+	LowestRoot []byte `protobuf:"bytes,4,opt,name=lowest_root,json=lowestRoot,proto3" json:"lowest_root,omitempty"`
+	Complete   bool   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (x *BackfillStatusResponse) Reset() {
+	*x = BackfillStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackfillStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillStatusResponse) ProtoMessage() {}
+
+func (x *BackfillStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillStatusResponse.ProtoReflect.Descriptor instead.
+func (*BackfillStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BackfillStatusResponse) GetOriginSlot() uint64 {
+	if x != nil {
+		return x.OriginSlot
+	}
+	return 0
+}
+
+func (x *BackfillStatusResponse) GetOriginRoot() []byte {
+	if x != nil {
+		return x.OriginRoot
+	}
+	return nil
+}
+
+func (x *BackfillStatusResponse) GetLowestSlot() uint64 {
+	if x != nil {
+		return x.LowestSlot
+	}
+	return 0
+}
+
+func (x *BackfillStatusResponse) GetLowestRoot() []byte {
+	if x != nil {
+		return x.LowestRoot
+	}
+	return nil
+}
+
+func (x *BackfillStatusResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type ReorgsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reorgs []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+}
+
+func (x *ReorgsResponse) Reset() {
+	*x = ReorgsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorgsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorgsResponse) ProtoMessage() {}
+
+func (x *ReorgsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorgsResponse.ProtoReflect.Descriptor instead.
+func (*ReorgsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReorgsResponse) GetReorgs() []*Reorg {
+	if x != nil {
+		return x.Reorgs
+	}
+	return nil
+}
+
+type Reorg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldSlot            uint64 `protobuf:"varint,1,opt,name=old_slot,json=oldSlot,proto3" json:"old_slot,omitempty"`
+	NewSlot            uint64 `protobuf:"varint,2,opt,name=new_slot,json=newSlot,proto3" json:"new_slot,omitempty"`
+	Depth              uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Distance           uint64 `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	CommonAncestorRoot []byte `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	OldHeadRoot        []byte `protobuf:"bytes,6,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadRoot        []byte `protobuf:"bytes,7,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+}
+
+func (x *Reorg) Reset() {
+	*x = Reorg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reorg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reorg) ProtoMessage() {}
+
+func (x *Reorg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reorg.ProtoReflect.Descriptor instead.
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Reorg) GetOldSlot() uint64 {
+	if x != nil {
+		return x.OldSlot
+	}
+	return 0
+}
+
+func (x *Reorg) GetNewSlot() uint64 {
+	if x != nil {
+		return x.NewSlot
+	}
+	return 0
+}
+
+func (x *Reorg) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Reorg) GetDistance() uint64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Reorg) GetCommonAncestorRoot() []byte {
+	if x != nil {
+		return x.CommonAncestorRoot
+	}
+	return nil
+}
+
+func (x *Reorg) GetOldHeadRoot() []byte {
+	if x != nil {
+		return x.OldHeadRoot
+	}
+	return nil
+}
+
+func (x *Reorg) GetNewHeadRoot() []byte {
+	if x != nil {
+		return x.NewHeadRoot
+	}
+	return nil
+}
+
 type BeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -198,7 +465,7 @@ type BeaconStateRequest struct {
 func (x *BeaconStateRequest) Reset() {
 	*x = BeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[2]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -211,7 +478,7 @@ func (x *BeaconStateRequest) String() string {
 func (*BeaconStateRequest) ProtoMessage() {}
 
 func (x *BeaconStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[2]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +491,7 @@ func (x *BeaconStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconStateRequest.ProtoReflect.Descriptor instead.
 func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{2}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
@@ -275,7 +542,7 @@ type BlockRequest struct {
 func (x *BlockRequest) Reset() {
 	*x = BlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[3]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -288,7 +555,7 @@ func (x *BlockRequest) String() string {
 func (*BlockRequest) ProtoMessage() {}
 
 func (x *BlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[3]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +568,7 @@ func (x *BlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{3}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BlockRequest) GetBlockRoot() []byte {
@@ -322,7 +589,7 @@ type SSZResponse struct {
 func (x *SSZResponse) Reset() {
 	*x = SSZResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[4]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +602,7 @@ func (x *SSZResponse) String() string {
 func (*SSZResponse) ProtoMessage() {}
 
 func (x *SSZResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[4]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +615,7 @@ func (x *SSZResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSZResponse.ProtoReflect.Descriptor instead.
 func (*SSZResponse) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{4}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SSZResponse) GetEncoded() []byte {
@@ -369,7 +636,7 @@ type LoggingLevelRequest struct {
 func (x *LoggingLevelRequest) Reset() {
 	*x = LoggingLevelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[5]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -382,7 +649,7 @@ func (x *LoggingLevelRequest) String() string {
 func (*LoggingLevelRequest) ProtoMessage() {}
 
 func (x *LoggingLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[5]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +662,7 @@ func (x *LoggingLevelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingLevelRequest.ProtoReflect.Descriptor instead.
 func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{5}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
@@ -405,6 +672,368 @@ func (x *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
 	return LoggingLevelRequest_INFO
 }
 
+type PeerRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind PeerRule_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.beacon.rpc.v1.PeerRule_Kind" json:"kind,omitempty"`
+	Peer string        `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (x *PeerRule) Reset() {
+	*x = PeerRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerRule) ProtoMessage() {}
+
+func (x *PeerRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerRule.ProtoReflect.Descriptor instead.
+func (*PeerRule) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PeerRule) GetKind() PeerRule_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return PeerRule_TRUSTED
+}
+
+func (x *PeerRule) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type PeerRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trusted []string `protobuf:"bytes,1,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	Banned  []string `protobuf:"bytes,2,rep,name=banned,proto3" json:"banned,omitempty"`
+}
+
+func (x *PeerRulesResponse) Reset() {
+	*x = PeerRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerRulesResponse) ProtoMessage() {}
+
+func (x *PeerRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerRulesResponse.ProtoReflect.Descriptor instead.
+func (*PeerRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PeerRulesResponse) GetTrusted() []string {
+	if x != nil {
+		return x.Trusted
+	}
+	return nil
+}
+
+func (x *PeerRulesResponse) GetBanned() []string {
+	if x != nil {
+		return x.Banned
+	}
+	return nil
+}
+
+type PeerTrafficRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *PeerTrafficRequest) Reset() {
+	*x = PeerTrafficRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerTrafficRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerTrafficRequest) ProtoMessage() {}
+
+func (x *PeerTrafficRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerTrafficRequest.ProtoReflect.Descriptor instead.
+func (*PeerTrafficRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PeerTrafficRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PeerTrafficResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*PeerTraffic `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *PeerTrafficResponse) Reset() {
+	*x = PeerTrafficResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerTrafficResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerTrafficResponse) ProtoMessage() {}
+
+func (x *PeerTrafficResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerTrafficResponse.ProtoReflect.Descriptor instead.
+func (*PeerTrafficResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PeerTrafficResponse) GetPeers() []*PeerTraffic {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type PeerTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId    string              `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Total     *Traffic            `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	RateIn    float64             `protobuf:"fixed64,3,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	RateOut   float64             `protobuf:"fixed64,4,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+	Topics    map[string]*Traffic `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Protocols map[string]*Traffic `protobuf:"bytes,6,rep,name=protocols,proto3" json:"protocols,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PeerTraffic) Reset() {
+	*x = PeerTraffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerTraffic) ProtoMessage() {}
+
+func (x *PeerTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerTraffic.ProtoReflect.Descriptor instead.
+func (*PeerTraffic) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PeerTraffic) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *PeerTraffic) GetTotal() *Traffic {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PeerTraffic) GetRateIn() float64 {
+	if x != nil {
+		return x.RateIn
+	}
+	return 0
+}
+
+func (x *PeerTraffic) GetRateOut() float64 {
+	if x != nil {
+		return x.RateOut
+	}
+	return 0
+}
+
+func (x *PeerTraffic) GetTopics() map[string]*Traffic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *PeerTraffic) GetProtocols() map[string]*Traffic {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+type Traffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesIn     uint64 `protobuf:"varint,1,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut    uint64 `protobuf:"varint,2,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	MessagesIn  uint64 `protobuf:"varint,3,opt,name=messages_in,json=messagesIn,proto3" json:"messages_in,omitempty"`
+	MessagesOut uint64 `protobuf:"varint,4,opt,name=messages_out,json=messagesOut,proto3" json:"messages_out,omitempty"`
+}
+
+func (x *Traffic) Reset() {
+	*x = Traffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Traffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Traffic) ProtoMessage() {}
+
+func (x *Traffic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Traffic.ProtoReflect.Descriptor instead.
+func (*Traffic) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Traffic) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *Traffic) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *Traffic) GetMessagesIn() uint64 {
+	if x != nil {
+		return x.MessagesIn
+	}
+	return 0
+}
+
+func (x *Traffic) GetMessagesOut() uint64 {
+	if x != nil {
+		return x.MessagesOut
+	}
+	return 0
+}
+
 type ProtoArrayForkChoiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -420,7 +1049,7 @@ type ProtoArrayForkChoiceResponse struct {
 func (x *ProtoArrayForkChoiceResponse) Reset() {
 	*x = ProtoArrayForkChoiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[6]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -433,7 +1062,7 @@ func (x *ProtoArrayForkChoiceResponse) String() string {
 func (*ProtoArrayForkChoiceResponse) ProtoMessage() {}
 
 func (x *ProtoArrayForkChoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[6]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +1075,7 @@ func (x *ProtoArrayForkChoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoArrayForkChoiceResponse.ProtoReflect.Descriptor instead.
 func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{6}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ProtoArrayForkChoiceResponse) GetPruneThreshold() uint64 {
@@ -502,7 +1131,7 @@ type ProtoArrayNode struct {
 func (x *ProtoArrayNode) Reset() {
 	*x = ProtoArrayNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[7]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +1144,7 @@ func (x *ProtoArrayNode) String() string {
 func (*ProtoArrayNode) ProtoMessage() {}
 
 func (x *ProtoArrayNode) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[7]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +1157,7 @@ func (x *ProtoArrayNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoArrayNode.ProtoReflect.Descriptor instead.
 func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{7}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ProtoArrayNode) GetSlot() uint64 {
@@ -598,7 +1227,7 @@ type DebugPeerResponses struct {
 func (x *DebugPeerResponses) Reset() {
 	*x = DebugPeerResponses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[8]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +1240,7 @@ func (x *DebugPeerResponses) String() string {
 func (*DebugPeerResponses) ProtoMessage() {}
 
 func (x *DebugPeerResponses) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[8]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +1253,7 @@ func (x *DebugPeerResponses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugPeerResponses.ProtoReflect.Descriptor instead.
 func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{8}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
@@ -648,12 +1277,13 @@ type DebugPeerResponse struct {
 	PeerStatus         *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
 	LastUpdated        uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	ScoreInfo          *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
+	Traffic            *PeerTraffic                `protobuf:"bytes,10,opt,name=traffic,proto3" json:"traffic,omitempty"`
 }
 
 func (x *DebugPeerResponse) Reset() {
 	*x = DebugPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[9]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -666,7 +1296,7 @@ func (x *DebugPeerResponse) String() string {
 func (*DebugPeerResponse) ProtoMessage() {}
 
 func (x *DebugPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[9]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +1309,7 @@ func (x *DebugPeerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugPeerResponse.ProtoReflect.Descriptor instead.
 func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{9}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DebugPeerResponse) GetListeningAddresses() []string {
@@ -745,6 +1375,13 @@ func (x *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
 	return nil
 }
 
+func (x *DebugPeerResponse) GetTraffic() *PeerTraffic {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
 type ScoreInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -762,7 +1399,7 @@ type ScoreInfo struct {
 func (x *ScoreInfo) Reset() {
 	*x = ScoreInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[10]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +1412,7 @@ func (x *ScoreInfo) String() string {
 func (*ScoreInfo) ProtoMessage() {}
 
 func (x *ScoreInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[10]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1425,7 @@ func (x *ScoreInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreInfo.ProtoReflect.Descriptor instead.
 func (*ScoreInfo) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{10}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ScoreInfo) GetOverallScore() float32 {
@@ -854,7 +1491,7 @@ type TopicScoreSnapshot struct {
 func (x *TopicScoreSnapshot) Reset() {
 	*x = TopicScoreSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[11]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -867,7 +1504,7 @@ func (x *TopicScoreSnapshot) String() string {
 func (*TopicScoreSnapshot) ProtoMessage() {}
 
 func (x *TopicScoreSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[11]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1517,7 @@ func (x *TopicScoreSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicScoreSnapshot.ProtoReflect.Descriptor instead.
 func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{11}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TopicScoreSnapshot) GetTimeInMesh() uint64 {
@@ -927,7 +1564,7 @@ type DebugPeerResponse_PeerInfo struct {
 func (x *DebugPeerResponse_PeerInfo) Reset() {
 	*x = DebugPeerResponse_PeerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[13]
+		mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +1577,7 @@ func (x *DebugPeerResponse_PeerInfo) String() string {
 func (*DebugPeerResponse_PeerInfo) ProtoMessage() {}
 
 func (x *DebugPeerResponse_PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[13]
+	mi := &file_proto_beacon_rpc_v1_debug_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1590,7 @@ func (x *DebugPeerResponse_PeerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugPeerResponse_PeerInfo.ProtoReflect.Descriptor instead.
 func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{9, 0}
+	return file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *DebugPeerResponse_PeerInfo) GetMetadata() *v1.MetaData {
@@ -1018,7 +1655,38 @@ var file_proto_beacon_rpc_v1_debug_proto_rawDesc = []byte{
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x2b, 0x0a,
 	0x15, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x5b, 0x0a, 0x12, 0x42, 0x65,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x42,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x6f,
+	0x77, 0x65, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c,
+	0x6f, 0x77, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x6f, 0x72, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x52, 0x06, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x22, 0xe9,
+	0x01, 0x0a, 0x05, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x48, 0x65,
+	0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x5b, 0x0a, 0x12, 0x42, 0x65,
 	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x14, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
 	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
@@ -1038,204 +1706,314 @@ var file_proto_beacon_rpc_v1_debug_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
 	0x22, 0x27, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46,
 	0x4f, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x02, 0x22, 0x86, 0x03, 0x0a, 0x1c, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
-	0x75, 0x6e, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6a, 0x75,
-	0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x52, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x61,
-	0x72, 0x72, 0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41,
-	0x72, 0x72, 0x61, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x41,
-	0x72, 0x72, 0x61, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x07, 0x69, 0x6e, 0x64,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x65, 0x74, 0x68,
+	0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x02, 0x22, 0x7a, 0x0a, 0x08, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07,
+	0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x22, 0x45, 0x0a, 0x11, 0x50, 0x65, 0x65, 0x72, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x12,
+	0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x50, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0xe7, 0x03, 0x0a, 0x0b, 0x50,
+	0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x47,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f,
-	0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x69,
-	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27,
-	0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x44, 0x65, 0x73,
-	0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x47, 0x0a,
-	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xfa, 0x05, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x65, 0x6e, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x72, 0x12,
-	0x4f, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x3f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
-	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x1a, 0x5a, 0x0a, 0x0b, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
 	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xfa, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
-	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x22, 0xcb, 0x03, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
-	0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x86, 0x03, 0x0a,
+	0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x52, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x07,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x61, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x12, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xb9, 0x06, 0x0a, 0x11, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x6e, 0x72, 0x12, 0x4f, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x1a, 0xfa, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xcb, 0x03, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x75, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x6a, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe6, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x18,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c,
+	0x0a, 0x1a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x18, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x32, 0x92, 0x0d, 0x0a,
+	0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x53, 0x5a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x78, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65,
 	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f,
-	0x73, 0x73, 0x69, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
-	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
-	0x6f, 0x75, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x6a, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x74, 0x68,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x5a, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x65,
+	0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xe6, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a,
-	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x18, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x32, 0xa0, 0x07, 0x0a, 0x05, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x5a,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x12, 0x19, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x78, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x5a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x65, 0x74, 0x68,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x6c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x12, 0x8f, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
 	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x12, 0x8f, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41,
-	0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68,
-	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x66, 0x6f, 0x72, 0x6b, 0x63, 0x68,
-	0x6f, 0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x6f, 0x72, 0x6b,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x66, 0x6f, 0x72, 0x6b,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x7a, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65,
 	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
 	0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
-	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x7a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x65, 0x74, 0x68,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f,
-	0x70, 0x65, 0x65, 0x72, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x65,
+	0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2c, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65,
 	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x12, 0x1d, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x6c, 0x12, 0x70, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x72,
+	0x65, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x70, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x7b, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x2a, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
 	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x75, 0x67, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1250,64 +2028,97 @@ func file_proto_beacon_rpc_v1_debug_proto_rawDescGZIP() []byte {
 	return file_proto_beacon_rpc_v1_debug_proto_rawDescData
 }
 
-var file_proto_beacon_rpc_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_beacon_rpc_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_beacon_rpc_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_beacon_rpc_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_proto_beacon_rpc_v1_debug_proto_goTypes = []interface{}{
 	(LoggingLevelRequest_Level)(0),       // 0: ethereum.beacon.rpc.v1.LoggingLevelRequest.Level
-	(*InclusionSlotRequest)(nil),         // 1: ethereum.beacon.rpc.v1.InclusionSlotRequest
-	(*InclusionSlotResponse)(nil),        // 2: ethereum.beacon.rpc.v1.InclusionSlotResponse
-	(*BeaconStateRequest)(nil),           // 3: ethereum.beacon.rpc.v1.BeaconStateRequest
-	(*BlockRequest)(nil),                 // 4: ethereum.beacon.rpc.v1.BlockRequest
-	(*SSZResponse)(nil),                  // 5: ethereum.beacon.rpc.v1.SSZResponse
-	(*LoggingLevelRequest)(nil),          // 6: ethereum.beacon.rpc.v1.LoggingLevelRequest
-	(*ProtoArrayForkChoiceResponse)(nil), // 7: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse
-	(*ProtoArrayNode)(nil),               // 8: ethereum.beacon.rpc.v1.ProtoArrayNode
-	(*DebugPeerResponses)(nil),           // 9: ethereum.beacon.rpc.v1.DebugPeerResponses
-	(*DebugPeerResponse)(nil),            // 10: ethereum.beacon.rpc.v1.DebugPeerResponse
-	(*ScoreInfo)(nil),                    // 11: ethereum.beacon.rpc.v1.ScoreInfo
-	(*TopicScoreSnapshot)(nil),           // 12: ethereum.beacon.rpc.v1.TopicScoreSnapshot
-	nil,                                  // 13: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry
-	(*DebugPeerResponse_PeerInfo)(nil),   // 14: ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo
-	nil,                                  // 15: ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry
-	(v1alpha1.PeerDirection)(0),          // 16: ethereum.eth.v1alpha1.PeerDirection
-	(v1alpha1.ConnectionState)(0),        // 17: ethereum.eth.v1alpha1.ConnectionState
-	(*v1.Status)(nil),                    // 18: ethereum.beacon.p2p.v1.Status
-	(*v1.MetaData)(nil),                  // 19: ethereum.beacon.p2p.v1.MetaData
-	(*empty.Empty)(nil),                  // 20: google.protobuf.Empty
-	(*v1alpha1.PeerRequest)(nil),         // 21: ethereum.eth.v1alpha1.PeerRequest
+	(PeerRule_Kind)(0),                   // 1: ethereum.beacon.rpc.v1.PeerRule.Kind
+	(*InclusionSlotRequest)(nil),         // 2: ethereum.beacon.rpc.v1.InclusionSlotRequest
+	(*InclusionSlotResponse)(nil),        // 3: ethereum.beacon.rpc.v1.InclusionSlotResponse
+	(*BackfillStatusResponse)(nil),       // 4: ethereum.beacon.rpc.v1.BackfillStatusResponse
+	(*ReorgsResponse)(nil),               // 5: ethereum.beacon.rpc.v1.ReorgsResponse
+	(*Reorg)(nil),                        // 6: ethereum.beacon.rpc.v1.Reorg
+	(*BeaconStateRequest)(nil),           // 7: ethereum.beacon.rpc.v1.BeaconStateRequest
+	(*BlockRequest)(nil),                 // 8: ethereum.beacon.rpc.v1.BlockRequest
+	(*SSZResponse)(nil),                  // 9: ethereum.beacon.rpc.v1.SSZResponse
+	(*LoggingLevelRequest)(nil),          // 10: ethereum.beacon.rpc.v1.LoggingLevelRequest
+	(*PeerRule)(nil),                     // 11: ethereum.beacon.rpc.v1.PeerRule
+	(*PeerRulesResponse)(nil),            // 12: ethereum.beacon.rpc.v1.PeerRulesResponse
+	(*PeerTrafficRequest)(nil),           // 13: ethereum.beacon.rpc.v1.PeerTrafficRequest
+	(*PeerTrafficResponse)(nil),          // 14: ethereum.beacon.rpc.v1.PeerTrafficResponse
+	(*PeerTraffic)(nil),                  // 15: ethereum.beacon.rpc.v1.PeerTraffic
+	(*Traffic)(nil),                      // 16: ethereum.beacon.rpc.v1.Traffic
+	(*ProtoArrayForkChoiceResponse)(nil), // 17: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse
+	(*ProtoArrayNode)(nil),               // 18: ethereum.beacon.rpc.v1.ProtoArrayNode
+	(*DebugPeerResponses)(nil),           // 19: ethereum.beacon.rpc.v1.DebugPeerResponses
+	(*DebugPeerResponse)(nil),            // 20: ethereum.beacon.rpc.v1.DebugPeerResponse
+	(*ScoreInfo)(nil),                    // 21: ethereum.beacon.rpc.v1.ScoreInfo
+	(*TopicScoreSnapshot)(nil),           // 22: ethereum.beacon.rpc.v1.TopicScoreSnapshot
+	nil,                                  // 23: ethereum.beacon.rpc.v1.PeerTraffic.TopicsEntry
+	nil,                                  // 24: ethereum.beacon.rpc.v1.PeerTraffic.ProtocolsEntry
+	nil,                                  // 25: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry
+	(*DebugPeerResponse_PeerInfo)(nil),   // 26: ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo
+	nil,                                  // 27: ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry
+	(v1alpha1.PeerDirection)(0),          // 28: ethereum.eth.v1alpha1.PeerDirection
+	(v1alpha1.ConnectionState)(0),        // 29: ethereum.eth.v1alpha1.ConnectionState
+	(*v1.Status)(nil),                    // 30: ethereum.beacon.p2p.v1.Status
+	(*v1.MetaData)(nil),                  // 31: ethereum.beacon.p2p.v1.MetaData
+	(*empty.Empty)(nil),                  // 32: google.protobuf.Empty
+	(*v1alpha1.PeerRequest)(nil),         // 33: ethereum.eth.v1alpha1.PeerRequest
 }
 var file_proto_beacon_rpc_v1_debug_proto_depIdxs = []int32{
-	0,  // 0: ethereum.beacon.rpc.v1.LoggingLevelRequest.level:type_name -> ethereum.beacon.rpc.v1.LoggingLevelRequest.Level
-	8,  // 1: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.proto_array_nodes:type_name -> ethereum.beacon.rpc.v1.ProtoArrayNode
-	13, // 2: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.indices:type_name -> ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry
-	10, // 3: ethereum.beacon.rpc.v1.DebugPeerResponses.responses:type_name -> ethereum.beacon.rpc.v1.DebugPeerResponse
-	16, // 4: ethereum.beacon.rpc.v1.DebugPeerResponse.direction:type_name -> ethereum.eth.v1alpha1.PeerDirection
-	17, // 5: ethereum.beacon.rpc.v1.DebugPeerResponse.connection_state:type_name -> ethereum.eth.v1alpha1.ConnectionState
-	14, // 6: ethereum.beacon.rpc.v1.DebugPeerResponse.peer_info:type_name -> ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo
-	18, // 7: ethereum.beacon.rpc.v1.DebugPeerResponse.peer_status:type_name -> ethereum.beacon.p2p.v1.Status
-	11, // 8: ethereum.beacon.rpc.v1.DebugPeerResponse.score_info:type_name -> ethereum.beacon.rpc.v1.ScoreInfo
-	15, // 9: ethereum.beacon.rpc.v1.ScoreInfo.topic_scores:type_name -> ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry
-	19, // 10: ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo.metadata:type_name -> ethereum.beacon.p2p.v1.MetaData
-	12, // 11: ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry.value:type_name -> ethereum.beacon.rpc.v1.TopicScoreSnapshot
-	3,  // 12: ethereum.beacon.rpc.v1.Debug.GetBeaconState:input_type -> ethereum.beacon.rpc.v1.BeaconStateRequest
-	4,  // 13: ethereum.beacon.rpc.v1.Debug.GetBlock:input_type -> ethereum.beacon.rpc.v1.BlockRequest
-	6,  // 14: ethereum.beacon.rpc.v1.Debug.SetLoggingLevel:input_type -> ethereum.beacon.rpc.v1.LoggingLevelRequest
-	20, // 15: ethereum.beacon.rpc.v1.Debug.GetProtoArrayForkChoice:input_type -> google.protobuf.Empty
-	20, // 16: ethereum.beacon.rpc.v1.Debug.ListPeers:input_type -> google.protobuf.Empty
-	21, // 17: ethereum.beacon.rpc.v1.Debug.GetPeer:input_type -> ethereum.eth.v1alpha1.PeerRequest
-	1,  // 18: ethereum.beacon.rpc.v1.Debug.GetInclusionSlot:input_type -> ethereum.beacon.rpc.v1.InclusionSlotRequest
-	5,  // 19: ethereum.beacon.rpc.v1.Debug.GetBeaconState:output_type -> ethereum.beacon.rpc.v1.SSZResponse
-	5,  // 20: ethereum.beacon.rpc.v1.Debug.GetBlock:output_type -> ethereum.beacon.rpc.v1.SSZResponse
-	20, // 21: ethereum.beacon.rpc.v1.Debug.SetLoggingLevel:output_type -> google.protobuf.Empty
-	7,  // 22: ethereum.beacon.rpc.v1.Debug.GetProtoArrayForkChoice:output_type -> ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse
-	9,  // 23: ethereum.beacon.rpc.v1.Debug.ListPeers:output_type -> ethereum.beacon.rpc.v1.DebugPeerResponses
-	10, // 24: ethereum.beacon.rpc.v1.Debug.GetPeer:output_type -> ethereum.beacon.rpc.v1.DebugPeerResponse
-	2,  // 25: ethereum.beacon.rpc.v1.Debug.GetInclusionSlot:output_type -> ethereum.beacon.rpc.v1.InclusionSlotResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 0: ethereum.beacon.rpc.v1.ReorgsResponse.reorgs:type_name -> ethereum.beacon.rpc.v1.Reorg
+	0,  // 1: ethereum.beacon.rpc.v1.LoggingLevelRequest.level:type_name -> ethereum.beacon.rpc.v1.LoggingLevelRequest.Level
+	1,  // 2: ethereum.beacon.rpc.v1.PeerRule.kind:type_name -> ethereum.beacon.rpc.v1.PeerRule.Kind
+	15, // 3: ethereum.beacon.rpc.v1.PeerTrafficResponse.peers:type_name -> ethereum.beacon.rpc.v1.PeerTraffic
+	16, // 4: ethereum.beacon.rpc.v1.PeerTraffic.total:type_name -> ethereum.beacon.rpc.v1.Traffic
+	23, // 5: ethereum.beacon.rpc.v1.PeerTraffic.topics:type_name -> ethereum.beacon.rpc.v1.PeerTraffic.TopicsEntry
+	24, // 6: ethereum.beacon.rpc.v1.PeerTraffic.protocols:type_name -> ethereum.beacon.rpc.v1.PeerTraffic.ProtocolsEntry
+	18, // 7: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.proto_array_nodes:type_name -> ethereum.beacon.rpc.v1.ProtoArrayNode
+	25, // 8: ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.indices:type_name -> ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry
+	20, // 9: ethereum.beacon.rpc.v1.DebugPeerResponses.responses:type_name -> ethereum.beacon.rpc.v1.DebugPeerResponse
+	28, // 10: ethereum.beacon.rpc.v1.DebugPeerResponse.direction:type_name -> ethereum.eth.v1alpha1.PeerDirection
+	29, // 11: ethereum.beacon.rpc.v1.DebugPeerResponse.connection_state:type_name -> ethereum.eth.v1alpha1.ConnectionState
+	26, // 12: ethereum.beacon.rpc.v1.DebugPeerResponse.peer_info:type_name -> ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo
+	30, // 13: ethereum.beacon.rpc.v1.DebugPeerResponse.peer_status:type_name -> ethereum.beacon.p2p.v1.Status
+	21, // 14: ethereum.beacon.rpc.v1.DebugPeerResponse.score_info:type_name -> ethereum.beacon.rpc.v1.ScoreInfo
+	15, // 15: ethereum.beacon.rpc.v1.DebugPeerResponse.traffic:type_name -> ethereum.beacon.rpc.v1.PeerTraffic
+	27, // 16: ethereum.beacon.rpc.v1.ScoreInfo.topic_scores:type_name -> ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry
+	16, // 17: ethereum.beacon.rpc.v1.PeerTraffic.TopicsEntry.value:type_name -> ethereum.beacon.rpc.v1.Traffic
+	16, // 18: ethereum.beacon.rpc.v1.PeerTraffic.ProtocolsEntry.value:type_name -> ethereum.beacon.rpc.v1.Traffic
+	31, // 19: ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo.metadata:type_name -> ethereum.beacon.p2p.v1.MetaData
+	22, // 20: ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry.value:type_name -> ethereum.beacon.rpc.v1.TopicScoreSnapshot
+	7,  // 21: ethereum.beacon.rpc.v1.Debug.GetBeaconState:input_type -> ethereum.beacon.rpc.v1.BeaconStateRequest
+	8,  // 22: ethereum.beacon.rpc.v1.Debug.GetBlock:input_type -> ethereum.beacon.rpc.v1.BlockRequest
+	10, // 23: ethereum.beacon.rpc.v1.Debug.SetLoggingLevel:input_type -> ethereum.beacon.rpc.v1.LoggingLevelRequest
+	32, // 24: ethereum.beacon.rpc.v1.Debug.GetProtoArrayForkChoice:input_type -> google.protobuf.Empty
+	32, // 25: ethereum.beacon.rpc.v1.Debug.ListPeers:input_type -> google.protobuf.Empty
+	33, // 26: ethereum.beacon.rpc.v1.Debug.GetPeer:input_type -> ethereum.eth.v1alpha1.PeerRequest
+	2,  // 27: ethereum.beacon.rpc.v1.Debug.GetInclusionSlot:input_type -> ethereum.beacon.rpc.v1.InclusionSlotRequest
+	32, // 28: ethereum.beacon.rpc.v1.Debug.GetBackfillStatus:input_type -> google.protobuf.Empty
+	32, // 29: ethereum.beacon.rpc.v1.Debug.ListReorgs:input_type -> google.protobuf.Empty
+	11, // 30: ethereum.beacon.rpc.v1.Debug.AddPeerRule:input_type -> ethereum.beacon.rpc.v1.PeerRule
+	11, // 31: ethereum.beacon.rpc.v1.Debug.RemovePeerRule:input_type -> ethereum.beacon.rpc.v1.PeerRule
+	32, // 32: ethereum.beacon.rpc.v1.Debug.ListPeerRules:input_type -> google.protobuf.Empty
+	13, // 33: ethereum.beacon.rpc.v1.Debug.ListPeerTraffic:input_type -> ethereum.beacon.rpc.v1.PeerTrafficRequest
+	9,  // 34: ethereum.beacon.rpc.v1.Debug.GetBeaconState:output_type -> ethereum.beacon.rpc.v1.SSZResponse
+	9,  // 35: ethereum.beacon.rpc.v1.Debug.GetBlock:output_type -> ethereum.beacon.rpc.v1.SSZResponse
+	32, // 36: ethereum.beacon.rpc.v1.Debug.SetLoggingLevel:output_type -> google.protobuf.Empty
+	17, // 37: ethereum.beacon.rpc.v1.Debug.GetProtoArrayForkChoice:output_type -> ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse
+	19, // 38: ethereum.beacon.rpc.v1.Debug.ListPeers:output_type -> ethereum.beacon.rpc.v1.DebugPeerResponses
+	20, // 39: ethereum.beacon.rpc.v1.Debug.GetPeer:output_type -> ethereum.beacon.rpc.v1.DebugPeerResponse
+	3,  // 40: ethereum.beacon.rpc.v1.Debug.GetInclusionSlot:output_type -> ethereum.beacon.rpc.v1.InclusionSlotResponse
+	4,  // 41: ethereum.beacon.rpc.v1.Debug.GetBackfillStatus:output_type -> ethereum.beacon.rpc.v1.BackfillStatusResponse
+	5,  // 42: ethereum.beacon.rpc.v1.Debug.ListReorgs:output_type -> ethereum.beacon.rpc.v1.ReorgsResponse
+	32, // 43: ethereum.beacon.rpc.v1.Debug.AddPeerRule:output_type -> google.protobuf.Empty
+	32, // 44: ethereum.beacon.rpc.v1.Debug.RemovePeerRule:output_type -> google.protobuf.Empty
+	12, // 45: ethereum.beacon.rpc.v1.Debug.ListPeerRules:output_type -> ethereum.beacon.rpc.v1.PeerRulesResponse
+	14, // 46: ethereum.beacon.rpc.v1.Debug.ListPeerTraffic:output_type -> ethereum.beacon.rpc.v1.PeerTrafficResponse
+	34, // [34:47] is the sub-list for method output_type
+	21, // [21:34] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_beacon_rpc_v1_debug_proto_init() }
@@ -1341,7 +2152,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconStateRequest); i {
+			switch v := v.(*BackfillStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,7 +2164,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRequest); i {
+			switch v := v.(*ReorgsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,7 +2176,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SSZResponse); i {
+			switch v := v.(*Reorg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +2188,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingLevelRequest); i {
+			switch v := v.(*BeaconStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +2200,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoArrayForkChoiceResponse); i {
+			switch v := v.(*BlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +2212,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoArrayNode); i {
+			switch v := v.(*SSZResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,7 +2224,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugPeerResponses); i {
+			switch v := v.(*LoggingLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,7 +2236,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugPeerResponse); i {
+			switch v := v.(*PeerRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,7 +2248,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScoreInfo); i {
+			switch v := v.(*PeerRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +2260,19 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicScoreSnapshot); i {
+			switch v := v.(*PeerTrafficRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerTrafficResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,6 +2284,102 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 		file_proto_beacon_rpc_v1_debug_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerTraffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Traffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoArrayForkChoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoArrayNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPeerResponses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicScoreSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_debug_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugPeerResponse_PeerInfo); i {
 			case 0:
 				return &v.state
@@ -1473,7 +2392,7 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 			}
 		}
 	}
-	file_proto_beacon_rpc_v1_debug_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_proto_beacon_rpc_v1_debug_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*BeaconStateRequest_Slot)(nil),
 		(*BeaconStateRequest_BlockRoot)(nil),
 	}
@@ -1482,8 +2401,8 @@ func file_proto_beacon_rpc_v1_debug_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_beacon_rpc_v1_debug_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1517,6 +2436,12 @@ type DebugClient interface {
 	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
 	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
 	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	GetBackfillStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error)
+	ListReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReorgsResponse, error)
+	AddPeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemovePeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListPeerRules(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerRulesResponse, error)
+	ListPeerTraffic(ctx context.Context, in *PeerTrafficRequest, opts ...grpc.CallOption) (*PeerTrafficResponse, error)
 }
 
 type debugClient struct {
@@ -1590,6 +2515,60 @@ func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotReq
 	return out, nil
 }
 
+func (c *debugClient) GetBackfillStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error) {
+	out := new(BackfillStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReorgsResponse, error) {
+	out := new(ReorgsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) AddPeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/AddPeerRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RemovePeerRule(ctx context.Context, in *PeerRule, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RemovePeerRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeerRules(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerRulesResponse, error) {
+	out := new(PeerRulesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeerTraffic(ctx context.Context, in *PeerTrafficRequest, opts ...grpc.CallOption) (*PeerTrafficResponse, error) {
+	out := new(PeerTrafficResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
@@ -1599,6 +2578,12 @@ type DebugServer interface {
 	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
 	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
 	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	GetBackfillStatus(context.Context, *empty.Empty) (*BackfillStatusResponse, error)
+	ListReorgs(context.Context, *empty.Empty) (*ReorgsResponse, error)
+	AddPeerRule(context.Context, *PeerRule) (*empty.Empty, error)
+	RemovePeerRule(context.Context, *PeerRule) (*empty.Empty, error)
+	ListPeerRules(context.Context, *empty.Empty) (*PeerRulesResponse, error)
+	ListPeerTraffic(context.Context, *PeerTrafficRequest) (*PeerTrafficResponse, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -1626,6 +2611,24 @@ func (*UnimplementedDebugServer) GetPeer(context.Context, *v1alpha1.PeerRequest)
 func (*UnimplementedDebugServer) GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
 }
+func (*UnimplementedDebugServer) GetBackfillStatus(context.Context, *empty.Empty) (*BackfillStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
+func (*UnimplementedDebugServer) ListReorgs(context.Context, *empty.Empty) (*ReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedDebugServer) AddPeerRule(context.Context, *PeerRule) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerRule not implemented")
+}
+func (*UnimplementedDebugServer) RemovePeerRule(context.Context, *PeerRule) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeerRule not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerRules(context.Context, *empty.Empty) (*PeerRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerRules not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerTraffic(context.Context, *PeerTrafficRequest) (*PeerTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerTraffic not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -1757,6 +2760,114 @@ func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBackfillStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListReorgs(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_AddPeerRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).AddPeerRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/AddPeerRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).AddPeerRule(ctx, req.(*PeerRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RemovePeerRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RemovePeerRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RemovePeerRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RemovePeerRule(ctx, req.(*PeerRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerRules(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerTraffic(ctx, req.(*PeerTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -1789,6 +2900,30 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInclusionSlot",
 			Handler:    _Debug_GetInclusionSlot_Handler,
 		},
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Debug_GetBackfillStatus_Handler,
+		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _Debug_ListReorgs_Handler,
+		},
+		{
+			MethodName: "AddPeerRule",
+			Handler:    _Debug_AddPeerRule_Handler,
+		},
+		{
+			MethodName: "RemovePeerRule",
+			Handler:    _Debug_RemovePeerRule_Handler,
+		},
+		{
+			MethodName: "ListPeerRules",
+			Handler:    _Debug_ListPeerRules_Handler,
+		},
+		{
+			MethodName: "ListPeerTraffic",
+			Handler:    _Debug_ListPeerTraffic_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/debug.proto",
@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/events.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamEventsRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type EventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*EventsResponse_Head
+	//	*EventsResponse_Block
+	//	*EventsResponse_Attestation
+	//	*EventsResponse_VoluntaryExit
+	//	*EventsResponse_FinalizedCheckpoint
+	//	*EventsResponse_ChainReorg
+	Event isEventsResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *EventsResponse) Reset() {
+	*x = EventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse) ProtoMessage() {}
+
+func (x *EventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *EventsResponse) GetEvent() isEventsResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetHead() *EventHead {
+	if x, ok := x.GetEvent().(*EventsResponse_Head); ok {
+		return x.Head
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetBlock() *EventBlock {
+	if x, ok := x.GetEvent().(*EventsResponse_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetAttestation() *v1alpha1.Attestation {
+	if x, ok := x.GetEvent().(*EventsResponse_Attestation); ok {
+		return x.Attestation
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetVoluntaryExit() *v1alpha1.SignedVoluntaryExit {
+	if x, ok := x.GetEvent().(*EventsResponse_VoluntaryExit); ok {
+		return x.VoluntaryExit
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetFinalizedCheckpoint() *EventFinalizedCheckpoint {
+	if x, ok := x.GetEvent().(*EventsResponse_FinalizedCheckpoint); ok {
+		return x.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetChainReorg() *EventChainReorg {
+	if x, ok := x.GetEvent().(*EventsResponse_ChainReorg); ok {
+		return x.ChainReorg
+	}
+	return nil
+}
+
+type isEventsResponse_Event interface {
+	isEventsResponse_Event()
+}
+
+type EventsResponse_Head struct {
+	Head *EventHead `protobuf:"bytes,1,opt,name=head,proto3,oneof"`
+}
+
+type EventsResponse_Block struct {
+	Block *EventBlock `protobuf:"bytes,2,opt,name=block,proto3,oneof"`
+}
+
+type EventsResponse_Attestation struct {
+	Attestation *v1alpha1.Attestation `protobuf:"bytes,3,opt,name=attestation,proto3,oneof"`
+}
+
+type EventsResponse_VoluntaryExit struct {
+	VoluntaryExit *v1alpha1.SignedVoluntaryExit `protobuf:"bytes,4,opt,name=voluntary_exit,json=voluntaryExit,proto3,oneof"`
+}
+
+type EventsResponse_FinalizedCheckpoint struct {
+	FinalizedCheckpoint *EventFinalizedCheckpoint `protobuf:"bytes,5,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3,oneof"`
+}
+
+type EventsResponse_ChainReorg struct {
+	ChainReorg *EventChainReorg `protobuf:"bytes,6,opt,name=chain_reorg,json=chainReorg,proto3,oneof"`
+}
+
+func (*EventsResponse_Head) isEventsResponse_Event() {}
+
+func (*EventsResponse_Block) isEventsResponse_Event() {}
+
+func (*EventsResponse_Attestation) isEventsResponse_Event() {}
+
+func (*EventsResponse_VoluntaryExit) isEventsResponse_Event() {}
+
+func (*EventsResponse_FinalizedCheckpoint) isEventsResponse_Event() {}
+
+func (*EventsResponse_ChainReorg) isEventsResponse_Event() {}
+
+type EventHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot            uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Block           []byte `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	State           []byte `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	EpochTransition bool   `protobuf:"varint,4,opt,name=epoch_transition,json=epochTransition,proto3" json:"epoch_transition,omitempty"`
+}
+
+func (x *EventHead) Reset() {
+	*x = EventHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHead) ProtoMessage() {}
+
+func (x *EventHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventHead.ProtoReflect.Descriptor instead.
+func (*EventHead) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventHead) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EventHead) GetBlock() []byte {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *EventHead) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *EventHead) GetEpochTransition() bool {
+	if x != nil {
+		return x.EpochTransition
+	}
+	return false
+}
+
+type EventBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot  uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Block []byte `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *EventBlock) Reset() {
+	*x = EventBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBlock) ProtoMessage() {}
+
+func (x *EventBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBlock.ProtoReflect.Descriptor instead.
+func (*EventBlock) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventBlock) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EventBlock) GetBlock() []byte {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type EventFinalizedCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block []byte `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *EventFinalizedCheckpoint) Reset() {
+	*x = EventFinalizedCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFinalizedCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFinalizedCheckpoint) ProtoMessage() {}
+
+func (x *EventFinalizedCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFinalizedCheckpoint.ProtoReflect.Descriptor instead.
+func (*EventFinalizedCheckpoint) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventFinalizedCheckpoint) GetBlock() []byte {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *EventFinalizedCheckpoint) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *EventFinalizedCheckpoint) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type EventChainReorg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot         uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Depth        uint64 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	OldHeadBlock []byte `protobuf:"bytes,3,opt,name=old_head_block,json=oldHeadBlock,proto3" json:"old_head_block,omitempty"`
+	NewHeadBlock []byte `protobuf:"bytes,4,opt,name=new_head_block,json=newHeadBlock,proto3" json:"new_head_block,omitempty"`
+	OldHeadState []byte `protobuf:"bytes,5,opt,name=old_head_state,json=oldHeadState,proto3" json:"old_head_state,omitempty"`
+	NewHeadState []byte `protobuf:"bytes,6,opt,name=new_head_state,json=newHeadState,proto3" json:"new_head_state,omitempty"`
+	Epoch        uint64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *EventChainReorg) Reset() {
+	*x = EventChainReorg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventChainReorg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventChainReorg) ProtoMessage() {}
+
+func (x *EventChainReorg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventChainReorg.ProtoReflect.Descriptor instead.
+func (*EventChainReorg) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventChainReorg) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EventChainReorg) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *EventChainReorg) GetOldHeadBlock() []byte {
+	if x != nil {
+		return x.OldHeadBlock
+	}
+	return nil
+}
+
+func (x *EventChainReorg) GetNewHeadBlock() []byte {
+	if x != nil {
+		return x.NewHeadBlock
+	}
+	return nil
+}
+
+func (x *EventChainReorg) GetOldHeadState() []byte {
+	if x != nil {
+		return x.OldHeadState
+	}
+	return nil
+}
+
+func (x *EventChainReorg) GetNewHeadState() []byte {
+	if x != nil {
+		return x.NewHeadState
+	}
+	return nil
+}
+
+func (x *EventChainReorg) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+var File_proto_beacon_rpc_v1_events_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x13, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x0e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x76, 0x6f,
+	0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x45, 0x78, 0x69, 0x74, 0x12,
+	0x65, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x6f, 0x72, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x6f, 0x72, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x6f,
+	0x72, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x09, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x5c, 0x0a, 0x18, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x6c, 0x64,
+	0x48, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x32, 0x6f, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x65, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_events_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_events_proto_rawDescData = file_proto_beacon_rpc_v1_events_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_events_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_events_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_events_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_events_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_beacon_rpc_v1_events_proto_goTypes = []interface{}{
+	(*StreamEventsRequest)(nil),          // 0: ethereum.beacon.rpc.v1.StreamEventsRequest
+	(*EventsResponse)(nil),               // 1: ethereum.beacon.rpc.v1.EventsResponse
+	(*EventHead)(nil),                    // 2: ethereum.beacon.rpc.v1.EventHead
+	(*EventBlock)(nil),                   // 3: ethereum.beacon.rpc.v1.EventBlock
+	(*EventFinalizedCheckpoint)(nil),     // 4: ethereum.beacon.rpc.v1.EventFinalizedCheckpoint
+	(*EventChainReorg)(nil),              // 5: ethereum.beacon.rpc.v1.EventChainReorg
+	(*v1alpha1.Attestation)(nil),         // 6: ethereum.eth.v1alpha1.Attestation
+	(*v1alpha1.SignedVoluntaryExit)(nil), // 7: ethereum.eth.v1alpha1.SignedVoluntaryExit
+}
+var file_proto_beacon_rpc_v1_events_proto_depIdxs = []int32{
+	2, // 0: ethereum.beacon.rpc.v1.EventsResponse.head:type_name -> ethereum.beacon.rpc.v1.EventHead
+	3, // 1: ethereum.beacon.rpc.v1.EventsResponse.block:type_name -> ethereum.beacon.rpc.v1.EventBlock
+	6, // 2: ethereum.beacon.rpc.v1.EventsResponse.attestation:type_name -> ethereum.eth.v1alpha1.Attestation
+	7, // 3: ethereum.beacon.rpc.v1.EventsResponse.voluntary_exit:type_name -> ethereum.eth.v1alpha1.SignedVoluntaryExit
+	4, // 4: ethereum.beacon.rpc.v1.EventsResponse.finalized_checkpoint:type_name -> ethereum.beacon.rpc.v1.EventFinalizedCheckpoint
+	5, // 5: ethereum.beacon.rpc.v1.EventsResponse.chain_reorg:type_name -> ethereum.beacon.rpc.v1.EventChainReorg
+	0, // 6: ethereum.beacon.rpc.v1.Events.StreamEvents:input_type -> ethereum.beacon.rpc.v1.StreamEventsRequest
+	1, // 7: ethereum.beacon.rpc.v1.Events.StreamEvents:output_type -> ethereum.beacon.rpc.v1.EventsResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_events_proto_init() }
+func file_proto_beacon_rpc_v1_events_proto_init() {
+	if File_proto_beacon_rpc_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFinalizedCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventChainReorg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_beacon_rpc_v1_events_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*EventsResponse_Head)(nil),
+		(*EventsResponse_Block)(nil),
+		(*EventsResponse_Attestation)(nil),
+		(*EventsResponse_VoluntaryExit)(nil),
+		(*EventsResponse_FinalizedCheckpoint)(nil),
+		(*EventsResponse_ChainReorg)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_events_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_events_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_events_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_events_proto = out.File
+	file_proto_beacon_rpc_v1_events_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_events_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_events_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsClient interface {
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (Events_StreamEventsClient, error)
+}
+
+type eventsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsClient(cc grpc.ClientConnInterface) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (Events_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Events_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Events/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_StreamEventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
+}
+
+type eventsStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsStreamEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventsServer is the server API for Events service.
+type EventsServer interface {
+	StreamEvents(*StreamEventsRequest, Events_StreamEventsServer) error
+}
+
+// UnimplementedEventsServer can be embedded to have forward compatible implementations.
+type UnimplementedEventsServer struct {
+}
+
+func (*UnimplementedEventsServer) StreamEvents(*StreamEventsRequest, Events_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+
+func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
+	s.RegisterService(&_Events_serviceDesc, srv)
+}
+
+func _Events_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).StreamEvents(m, &eventsStreamEventsServer{stream})
+}
+
+type Events_StreamEventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
+}
+
+type eventsStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsStreamEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Events_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Events_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/events.proto",
+}
@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/validator_history.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ValidatorPerformanceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Indices    []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PublicKeys [][]byte `protobuf:"bytes,4,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *ValidatorPerformanceHistoryRequest) Reset() {
+	*x = ValidatorPerformanceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformanceHistoryRequest) ProtoMessage() {}
+
+func (x *ValidatorPerformanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPerformanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorPerformanceHistoryRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorPerformanceHistoryRequest) GetEndEpoch() uint64 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorPerformanceHistoryRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ValidatorPerformanceHistoryRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorPerformanceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators        []*ValidatorPerformanceHistory `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	MissingEpochs     []uint64                       `protobuf:"varint,2,rep,packed,name=missing_epochs,json=missingEpochs,proto3" json:"missing_epochs,omitempty"`
+	MissingValidators [][]byte                       `protobuf:"bytes,3,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+}
+
+func (x *ValidatorPerformanceHistoryResponse) Reset() {
+	*x = ValidatorPerformanceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformanceHistoryResponse) ProtoMessage() {}
+
+func (x *ValidatorPerformanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPerformanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorPerformanceHistoryResponse) GetValidators() []*ValidatorPerformanceHistory {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *ValidatorPerformanceHistoryResponse) GetMissingEpochs() []uint64 {
+	if x != nil {
+		return x.MissingEpochs
+	}
+	return nil
+}
+
+func (x *ValidatorPerformanceHistoryResponse) GetMissingValidators() [][]byte {
+	if x != nil {
+		return x.MissingValidators
+	}
+	return nil
+}
+
+type ValidatorPerformanceHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     uint64                       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey []byte                       `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Epochs    []*ValidatorEpochPerformance `protobuf:"bytes,3,rep,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (x *ValidatorPerformanceHistory) Reset() {
+	*x = ValidatorPerformanceHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformanceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformanceHistory) ProtoMessage() {}
+
+func (x *ValidatorPerformanceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPerformanceHistory.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformanceHistory) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorPerformanceHistory) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorPerformanceHistory) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorPerformanceHistory) GetEpochs() []*ValidatorEpochPerformance {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+type ValidatorEpochPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CorrectlyVotedSource bool   `protobuf:"varint,2,opt,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget bool   `protobuf:"varint,3,opt,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead   bool   `protobuf:"varint,4,opt,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	InclusionDistance    uint64 `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	BalanceChange        int64  `protobuf:"varint,6,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+}
+
+func (x *ValidatorEpochPerformance) Reset() {
+	*x = ValidatorEpochPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorEpochPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEpochPerformance) ProtoMessage() {}
+
+func (x *ValidatorEpochPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorEpochPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorEpochPerformance) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidatorEpochPerformance) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorEpochPerformance) GetCorrectlyVotedSource() bool {
+	if x != nil {
+		return x.CorrectlyVotedSource
+	}
+	return false
+}
+
+func (x *ValidatorEpochPerformance) GetCorrectlyVotedTarget() bool {
+	if x != nil {
+		return x.CorrectlyVotedTarget
+	}
+	return false
+}
+
+func (x *ValidatorEpochPerformance) GetCorrectlyVotedHead() bool {
+	if x != nil {
+		return x.CorrectlyVotedHead
+	}
+	return false
+}
+
+func (x *ValidatorEpochPerformance) GetInclusionDistance() uint64 {
+	if x != nil {
+		return x.InclusionDistance
+	}
+	return 0
+}
+
+func (x *ValidatorEpochPerformance) GetBalanceChange() int64 {
+	if x != nil {
+		return x.BalanceChange
+	}
+	return 0
+}
+
+type ValidatorParticipationHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+}
+
+func (x *ValidatorParticipationHistoryRequest) Reset() {
+	*x = ValidatorParticipationHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorParticipationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorParticipationHistoryRequest) ProtoMessage() {}
+
+func (x *ValidatorParticipationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidatorParticipationHistoryRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationHistoryRequest) GetEndEpoch() uint64 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+type ValidatorParticipationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs        []*EpochParticipation `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	MissingEpochs []uint64              `protobuf:"varint,2,rep,packed,name=missing_epochs,json=missingEpochs,proto3" json:"missing_epochs,omitempty"`
+}
+
+func (x *ValidatorParticipationHistoryResponse) Reset() {
+	*x = ValidatorParticipationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorParticipationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorParticipationHistoryResponse) ProtoMessage() {}
+
+func (x *ValidatorParticipationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorParticipationHistoryResponse) GetEpochs() []*EpochParticipation {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *ValidatorParticipationHistoryResponse) GetMissingEpochs() []uint64 {
+	if x != nil {
+		return x.MissingEpochs
+	}
+	return nil
+}
+
+type EpochParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch               uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ActiveGwei          uint64 `protobuf:"varint,2,opt,name=active_gwei,json=activeGwei,proto3" json:"active_gwei,omitempty"`
+	SourceAttestingGwei uint64 `protobuf:"varint,3,opt,name=source_attesting_gwei,json=sourceAttestingGwei,proto3" json:"source_attesting_gwei,omitempty"`
+	TargetAttestingGwei uint64 `protobuf:"varint,4,opt,name=target_attesting_gwei,json=targetAttestingGwei,proto3" json:"target_attesting_gwei,omitempty"`
+	HeadAttestingGwei   uint64 `protobuf:"varint,5,opt,name=head_attesting_gwei,json=headAttestingGwei,proto3" json:"head_attesting_gwei,omitempty"`
+}
+
+func (x *EpochParticipation) Reset() {
+	*x = EpochParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochParticipation) ProtoMessage() {}
+
+func (x *EpochParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochParticipation.ProtoReflect.Descriptor instead.
+func (*EpochParticipation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EpochParticipation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochParticipation) GetActiveGwei() uint64 {
+	if x != nil {
+		return x.ActiveGwei
+	}
+	return 0
+}
+
+func (x *EpochParticipation) GetSourceAttestingGwei() uint64 {
+	if x != nil {
+		return x.SourceAttestingGwei
+	}
+	return 0
+}
+
+func (x *EpochParticipation) GetTargetAttestingGwei() uint64 {
+	if x != nil {
+		return x.TargetAttestingGwei
+	}
+	return 0
+}
+
+func (x *EpochParticipation) GetHeadAttestingGwei() uint64 {
+	if x != nil {
+		return x.HeadAttestingGwei
+	}
+	return 0
+}
+
+var File_proto_beacon_rpc_v1_validator_history_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_validator_history_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x6e, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x65, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x23, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x06, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x06,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x6c, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x64,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x56,
+	0x6f, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x64,
+	0x0a, 0x24, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x92, 0x01, 0x0a, 0x25, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x67, 0x77, 0x65, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x47, 0x77, 0x65, 0x69, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x77, 0x65, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x77, 0x65, 0x69, 0x12, 0x32, 0x0a, 0x15, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x67, 0x77, 0x65, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x77, 0x65, 0x69, 0x12,
+	0x2e, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x67, 0x77, 0x65, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x65,
+	0x61, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x77, 0x65, 0x69, 0x32,
+	0xbe, 0x03, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0xcf, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0xd7, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x12, 0x2e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_validator_history_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_validator_history_proto_rawDescData = file_proto_beacon_rpc_v1_validator_history_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_validator_history_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_validator_history_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_validator_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_validator_history_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_validator_history_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_validator_history_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_beacon_rpc_v1_validator_history_proto_goTypes = []interface{}{
+	(*ValidatorPerformanceHistoryRequest)(nil),    // 0: ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryRequest
+	(*ValidatorPerformanceHistoryResponse)(nil),   // 1: ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryResponse
+	(*ValidatorPerformanceHistory)(nil),           // 2: ethereum.beacon.rpc.v1.ValidatorPerformanceHistory
+	(*ValidatorEpochPerformance)(nil),             // 3: ethereum.beacon.rpc.v1.ValidatorEpochPerformance
+	(*ValidatorParticipationHistoryRequest)(nil),  // 4: ethereum.beacon.rpc.v1.ValidatorParticipationHistoryRequest
+	(*ValidatorParticipationHistoryResponse)(nil), // 5: ethereum.beacon.rpc.v1.ValidatorParticipationHistoryResponse
+	(*EpochParticipation)(nil),                    // 6: ethereum.beacon.rpc.v1.EpochParticipation
+}
+var file_proto_beacon_rpc_v1_validator_history_proto_depIdxs = []int32{
+	2, // 0: ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryResponse.validators:type_name -> ethereum.beacon.rpc.v1.ValidatorPerformanceHistory
+	3, // 1: ethereum.beacon.rpc.v1.ValidatorPerformanceHistory.epochs:type_name -> ethereum.beacon.rpc.v1.ValidatorEpochPerformance
+	6, // 2: ethereum.beacon.rpc.v1.ValidatorParticipationHistoryResponse.epochs:type_name -> ethereum.beacon.rpc.v1.EpochParticipation
+	0, // 3: ethereum.beacon.rpc.v1.ValidatorHistory.GetValidatorPerformanceHistory:input_type -> ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryRequest
+	4, // 4: ethereum.beacon.rpc.v1.ValidatorHistory.GetValidatorParticipationHistory:input_type -> ethereum.beacon.rpc.v1.ValidatorParticipationHistoryRequest
+	1, // 5: ethereum.beacon.rpc.v1.ValidatorHistory.GetValidatorPerformanceHistory:output_type -> ethereum.beacon.rpc.v1.ValidatorPerformanceHistoryResponse
+	5, // 6: ethereum.beacon.rpc.v1.ValidatorHistory.GetValidatorParticipationHistory:output_type -> ethereum.beacon.rpc.v1.ValidatorParticipationHistoryResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_validator_history_proto_init() }
+func file_proto_beacon_rpc_v1_validator_history_proto_init() {
+	if File_proto_beacon_rpc_v1_validator_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformanceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformanceHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorEpochPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_history_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_validator_history_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_validator_history_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_validator_history_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_validator_history_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_validator_history_proto = out.File
+	file_proto_beacon_rpc_v1_validator_history_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_validator_history_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_validator_history_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ValidatorHistoryClient is the client API for ValidatorHistory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorHistoryClient interface {
+	GetValidatorPerformanceHistory(ctx context.Context, in *ValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceHistoryResponse, error)
+	GetValidatorParticipationHistory(ctx context.Context, in *ValidatorParticipationHistoryRequest, opts ...grpc.CallOption) (*ValidatorParticipationHistoryResponse, error)
+}
+
+type validatorHistoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorHistoryClient(cc grpc.ClientConnInterface) ValidatorHistoryClient {
+	return &validatorHistoryClient{cc}
+}
+
+func (c *validatorHistoryClient) GetValidatorPerformanceHistory(ctx context.Context, in *ValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceHistoryResponse, error) {
+	out := new(ValidatorPerformanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorPerformanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorHistoryClient) GetValidatorParticipationHistory(ctx context.Context, in *ValidatorParticipationHistoryRequest, opts ...grpc.CallOption) (*ValidatorParticipationHistoryResponse, error) {
+	out := new(ValidatorParticipationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorParticipationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorHistoryServer is the server API for ValidatorHistory service.
+type ValidatorHistoryServer interface {
+	GetValidatorPerformanceHistory(context.Context, *ValidatorPerformanceHistoryRequest) (*ValidatorPerformanceHistoryResponse, error)
+	GetValidatorParticipationHistory(context.Context, *ValidatorParticipationHistoryRequest) (*ValidatorParticipationHistoryResponse, error)
+}
+
+// UnimplementedValidatorHistoryServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorHistoryServer struct {
+}
+
+func (*UnimplementedValidatorHistoryServer) GetValidatorPerformanceHistory(context.Context, *ValidatorPerformanceHistoryRequest) (*ValidatorPerformanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceHistory not implemented")
+}
+func (*UnimplementedValidatorHistoryServer) GetValidatorParticipationHistory(context.Context, *ValidatorParticipationHistoryRequest) (*ValidatorParticipationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipationHistory not implemented")
+}
+
+func RegisterValidatorHistoryServer(s *grpc.Server, srv ValidatorHistoryServer) {
+	s.RegisterService(&_ValidatorHistory_serviceDesc, srv)
+}
+
+func _ValidatorHistory_GetValidatorPerformanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorHistoryServer).GetValidatorPerformanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorPerformanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorHistoryServer).GetValidatorPerformanceHistory(ctx, req.(*ValidatorPerformanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorHistory_GetValidatorParticipationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorParticipationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorHistoryServer).GetValidatorParticipationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorHistory/GetValidatorParticipationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorHistoryServer).GetValidatorParticipationHistory(ctx, req.(*ValidatorParticipationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorHistory_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorHistory",
+	HandlerType: (*ValidatorHistoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorPerformanceHistory",
+			Handler:    _ValidatorHistory_GetValidatorPerformanceHistory_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipationHistory",
+			Handler:    _ValidatorHistory_GetValidatorParticipationHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_history.proto",
+}
@@ -0,0 +1,546 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/validator_rewards.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ValidatorRewardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices    []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PublicKeys [][]byte `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *ValidatorRewardsRequest) Reset() {
+	*x = ValidatorRewardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRewardsRequest) ProtoMessage() {}
+
+func (x *ValidatorRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRewardsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorRewardsRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorRewardsRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorRewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch             uint64                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards           []*ValidatorEpochRewards `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	MissingValidators [][]byte                 `protobuf:"bytes,3,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+}
+
+func (x *ValidatorRewardsResponse) Reset() {
+	*x = ValidatorRewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRewardsResponse) ProtoMessage() {}
+
+func (x *ValidatorRewardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRewardsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorRewardsResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorRewardsResponse) GetRewards() []*ValidatorEpochRewards {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *ValidatorRewardsResponse) GetMissingValidators() [][]byte {
+	if x != nil {
+		return x.MissingValidators
+	}
+	return nil
+}
+
+type ValidatorEpochRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index                uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SourceReward         uint64 `protobuf:"varint,3,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64 `protobuf:"varint,4,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64 `protobuf:"varint,5,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64 `protobuf:"varint,6,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64 `protobuf:"varint,7,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64 `protobuf:"varint,8,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64 `protobuf:"varint,9,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64 `protobuf:"varint,10,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    uint64 `protobuf:"varint,11,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	BalanceBefore        uint64 `protobuf:"varint,12,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64 `protobuf:"varint,13,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+}
+
+func (x *ValidatorEpochRewards) Reset() {
+	*x = ValidatorEpochRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorEpochRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEpochRewards) ProtoMessage() {}
+
+func (x *ValidatorEpochRewards) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorEpochRewards.ProtoReflect.Descriptor instead.
+func (*ValidatorEpochRewards) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorEpochRewards) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorEpochRewards) GetSourceReward() uint64 {
+	if x != nil {
+		return x.SourceReward
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetSourcePenalty() uint64 {
+	if x != nil {
+		return x.SourcePenalty
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetTargetReward() uint64 {
+	if x != nil {
+		return x.TargetReward
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetTargetPenalty() uint64 {
+	if x != nil {
+		return x.TargetPenalty
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetHeadReward() uint64 {
+	if x != nil {
+		return x.HeadReward
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetHeadPenalty() uint64 {
+	if x != nil {
+		return x.HeadPenalty
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetInclusionDelayReward() uint64 {
+	if x != nil {
+		return x.InclusionDelayReward
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetProposerReward() uint64 {
+	if x != nil {
+		return x.ProposerReward
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetInactivityPenalty() uint64 {
+	if x != nil {
+		return x.InactivityPenalty
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetBalanceBefore() uint64 {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *ValidatorEpochRewards) GetBalanceAfter() uint64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+var File_proto_beacon_rpc_v1_validator_rewards_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_validator_rewards_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22,
+	0xa8, 0x01, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x82, 0x04, 0x0a, 0x15, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x32,
+	0xb7, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescData = file_proto_beacon_rpc_v1_validator_rewards_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_validator_rewards_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_beacon_rpc_v1_validator_rewards_proto_goTypes = []interface{}{
+	(*ValidatorRewardsRequest)(nil),  // 0: ethereum.beacon.rpc.v1.ValidatorRewardsRequest
+	(*ValidatorRewardsResponse)(nil), // 1: ethereum.beacon.rpc.v1.ValidatorRewardsResponse
+	(*ValidatorEpochRewards)(nil),    // 2: ethereum.beacon.rpc.v1.ValidatorEpochRewards
+}
+var file_proto_beacon_rpc_v1_validator_rewards_proto_depIdxs = []int32{
+	2, // 0: ethereum.beacon.rpc.v1.ValidatorRewardsResponse.rewards:type_name -> ethereum.beacon.rpc.v1.ValidatorEpochRewards
+	0, // 1: ethereum.beacon.rpc.v1.ValidatorRewards.GetValidatorRewards:input_type -> ethereum.beacon.rpc.v1.ValidatorRewardsRequest
+	1, // 2: ethereum.beacon.rpc.v1.ValidatorRewards.GetValidatorRewards:output_type -> ethereum.beacon.rpc.v1.ValidatorRewardsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_validator_rewards_proto_init() }
+func file_proto_beacon_rpc_v1_validator_rewards_proto_init() {
+	if File_proto_beacon_rpc_v1_validator_rewards_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorEpochRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_validator_rewards_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_validator_rewards_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_validator_rewards_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_validator_rewards_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_validator_rewards_proto = out.File
+	file_proto_beacon_rpc_v1_validator_rewards_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_validator_rewards_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_validator_rewards_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ValidatorRewardsClient is the client API for ValidatorRewards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorRewardsClient interface {
+	GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error)
+}
+
+type validatorRewardsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorRewardsClient(cc grpc.ClientConnInterface) ValidatorRewardsClient {
+	return &validatorRewardsClient{cc}
+}
+
+func (c *validatorRewardsClient) GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error) {
+	out := new(ValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorRewards/GetValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorRewardsServer is the server API for ValidatorRewards service.
+type ValidatorRewardsServer interface {
+	GetValidatorRewards(context.Context, *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error)
+}
+
+// UnimplementedValidatorRewardsServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorRewardsServer struct {
+}
+
+func (*UnimplementedValidatorRewardsServer) GetValidatorRewards(context.Context, *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorRewards not implemented")
+}
+
+func RegisterValidatorRewardsServer(s *grpc.Server, srv ValidatorRewardsServer) {
+	s.RegisterService(&_ValidatorRewards_serviceDesc, srv)
+}
+
+func _ValidatorRewards_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorRewardsServer).GetValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorRewards/GetValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorRewardsServer).GetValidatorRewards(ctx, req.(*ValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorRewards_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorRewards",
+	HandlerType: (*ValidatorRewardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _ValidatorRewards_GetValidatorRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_rewards.proto",
+}
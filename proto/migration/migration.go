@@ -50,3 +50,124 @@ func V1ToV1Alpha1Block(alphaBlk *ethpb.SignedBeaconBlock) (*ethpb_alpha.SignedBe
 	}
 	return v1alpha1Block, nil
 }
+
+// V1Alpha1ValidatorToV1 converts a v1alpha1 Validator proto to a v1 proto.
+func V1Alpha1ValidatorToV1(v1Alpha1Validator *ethpb_alpha.Validator) *ethpb.Validator {
+	if v1Alpha1Validator == nil {
+		return &ethpb.Validator{}
+	}
+	return &ethpb.Validator{
+		PublicKey:                  v1Alpha1Validator.PublicKey,
+		WithdrawalCredentials:      v1Alpha1Validator.WithdrawalCredentials,
+		EffectiveBalance:           v1Alpha1Validator.EffectiveBalance,
+		Slashed:                    v1Alpha1Validator.Slashed,
+		ActivationEligibilityEpoch: v1Alpha1Validator.ActivationEligibilityEpoch,
+		ActivationEpoch:            v1Alpha1Validator.ActivationEpoch,
+		ExitEpoch:                  v1Alpha1Validator.ExitEpoch,
+		WithdrawableEpoch:          v1Alpha1Validator.WithdrawableEpoch,
+	}
+}
+
+// V1Alpha1AttestationToV1 converts a v1alpha1 Attestation proto to a v1 proto.
+func V1Alpha1AttestationToV1(v1alpha1Att *ethpb_alpha.Attestation) (*ethpb.Attestation, error) {
+	marshaledAtt, err := v1alpha1Att.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestation")
+	}
+	v1Att := &ethpb.Attestation{}
+	if err := proto.Unmarshal(marshaledAtt, v1Att); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attestation")
+	}
+	return v1Att, nil
+}
+
+// V1AttestationToV1Alpha1 converts a v1 Attestation proto to a v1alpha1 proto.
+func V1AttestationToV1Alpha1(v1Att *ethpb.Attestation) (*ethpb_alpha.Attestation, error) {
+	marshaledAtt, err := v1Att.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestation")
+	}
+	v1alpha1Att := &ethpb_alpha.Attestation{}
+	if err := proto.Unmarshal(marshaledAtt, v1alpha1Att); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attestation")
+	}
+	return v1alpha1Att, nil
+}
+
+// V1Alpha1AttSlashingToV1 converts a v1alpha1 AttesterSlashing proto to a v1 proto.
+func V1Alpha1AttSlashingToV1(v1alpha1Slashing *ethpb_alpha.AttesterSlashing) (*ethpb.AttesterSlashing, error) {
+	marshaledSlashing, err := v1alpha1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	v1Slashing := &ethpb.AttesterSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1AttSlashingToV1Alpha1 converts a v1 AttesterSlashing proto to a v1alpha1 proto.
+func V1AttSlashingToV1Alpha1(v1Slashing *ethpb.AttesterSlashing) (*ethpb_alpha.AttesterSlashing, error) {
+	marshaledSlashing, err := v1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	v1alpha1Slashing := &ethpb_alpha.AttesterSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+	}
+	return v1alpha1Slashing, nil
+}
+
+// V1Alpha1ProposerSlashingToV1 converts a v1alpha1 ProposerSlashing proto to a v1 proto.
+func V1Alpha1ProposerSlashingToV1(v1alpha1Slashing *ethpb_alpha.ProposerSlashing) (*ethpb.ProposerSlashing, error) {
+	marshaledSlashing, err := v1alpha1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	v1Slashing := &ethpb.ProposerSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1ProposerSlashingToV1Alpha1 converts a v1 ProposerSlashing proto to a v1alpha1 proto.
+func V1ProposerSlashingToV1Alpha1(v1Slashing *ethpb.ProposerSlashing) (*ethpb_alpha.ProposerSlashing, error) {
+	marshaledSlashing, err := v1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	v1alpha1Slashing := &ethpb_alpha.ProposerSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+	}
+	return v1alpha1Slashing, nil
+}
+
+// V1Alpha1ExitToV1 converts a v1alpha1 SignedVoluntaryExit proto to a v1 proto.
+func V1Alpha1ExitToV1(v1alpha1Exit *ethpb_alpha.SignedVoluntaryExit) (*ethpb.SignedVoluntaryExit, error) {
+	marshaledExit, err := v1alpha1Exit.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	v1Exit := &ethpb.SignedVoluntaryExit{}
+	if err := proto.Unmarshal(marshaledExit, v1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal voluntary exit")
+	}
+	return v1Exit, nil
+}
+
+// V1ExitToV1Alpha1 converts a v1 SignedVoluntaryExit proto to a v1alpha1 proto.
+func V1ExitToV1Alpha1(v1Exit *ethpb.SignedVoluntaryExit) (*ethpb_alpha.SignedVoluntaryExit, error) {
+	marshaledExit, err := v1Exit.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	v1alpha1Exit := &ethpb_alpha.SignedVoluntaryExit{}
+	if err := proto.Unmarshal(marshaledExit, v1alpha1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal voluntary exit")
+	}
+	return v1alpha1Exit, nil
+}
@@ -0,0 +1,193 @@
+// Package apiutil defines helpers to encode Prysm protobuf messages following the
+// conventions of the standard eth2 HTTP APIs.
+package apiutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// specFieldNames maps the fields of the Prysm protobuf types whose name differs in the
+// standard beacon node API to their standard name, by type name.
+var specFieldNames = map[string]map[string]string{
+	"AttestationData":         {"committee_index": "index"},
+	"Deposit_Data":            {"public_key": "pubkey"},
+	"ProposerSlashing":        {"header_1": "signed_header_1", "header_2": "signed_header_2"},
+	"SignedBeaconBlock":       {"block": "message"},
+	"SignedBeaconBlockHeader": {"header": "message"},
+	"SignedVoluntaryExit":     {"exit": "message"},
+	"Validator":               {"public_key": "pubkey"},
+}
+
+// MarshalSpec encodes a Prysm protobuf message following the encoding of the standard beacon
+// node API: integers are encoded as decimal strings and bytes as 0x prefixed hex strings.
+func MarshalSpec(msg interface{}) ([]byte, error) {
+	return json.Marshal(specValue(reflect.ValueOf(msg)))
+}
+
+// UnmarshalSpec decodes a value encoded following the standard beacon node API into a Prysm
+// protobuf message.
+func UnmarshalSpec(data []byte, msg interface{}) error {
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("can not decode into a non pointer value")
+	}
+	return setSpecValue(decoded, v.Elem())
+}
+
+func specValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return specValue(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := specFieldName(v.Type(), i)
+			if !ok {
+				continue
+			}
+			fields[name] = specValue(v.Field(i))
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		items := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items[i] = specValue(v.Index(i))
+		}
+		return items
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+func setSpecValue(decoded interface{}, v reflect.Value) error {
+	if decoded == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setSpecValue(decoded, v.Elem())
+	case reflect.Struct:
+		fields, ok := decoded.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("expected an object for %s", v.Type().Name())
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := specFieldName(v.Type(), i)
+			if !ok {
+				continue
+			}
+			if err := setSpecValue(fields[name], v.Field(i)); err != nil {
+				return errors.Wrapf(err, "could not decode field %s", name)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := decoded.(string)
+			if !ok {
+				return errors.New("expected a hex string")
+			}
+			b, err := hexutil.Decode(s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(b).Convert(v.Type()))
+			return nil
+		}
+		items, ok := decoded.([]interface{})
+		if !ok {
+			return errors.New("expected an array")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setSpecValue(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint:
+		s, ok := decoded.(string)
+		if !ok {
+			return errors.New("expected a decimal string")
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+		s, ok := decoded.(string)
+		if !ok {
+			return errors.New("expected a decimal string")
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Bool:
+		b, ok := decoded.(bool)
+		if !ok {
+			return errors.New("expected a boolean")
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.String:
+		s, ok := decoded.(string)
+		if !ok {
+			return errors.New("expected a string")
+		}
+		v.SetString(s)
+		return nil
+	default:
+		return errors.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// specFieldName returns the standard API name of the i-th field of a protobuf message type,
+// and false for the fields which are not part of the message.
+func specFieldName(t reflect.Type, i int) (string, bool) {
+	field := t.Field(i)
+	if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+		return "", false
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	if renamed, ok := specFieldNames[t.Name()][name]; ok {
+		return renamed, true
+	}
+	return name, true
+}
@@ -0,0 +1,74 @@
+package apiutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestMarshalSpec_RoundTrip(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 5
+	blk.Block.ProposerIndex = 7
+	att := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+	att.Data.Slot = 4
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+
+	encoded, err := MarshalSpec(blk)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	message, ok := fields["message"].(map[string]interface{})
+	require.Equal(t, true, ok, "Block is not encoded as the message of the signed block")
+	assert.Equal(t, "5", message["slot"])
+	assert.Equal(t, "7", message["proposer_index"])
+	body, ok := message["body"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	attestations, ok := body["attestations"].([]interface{})
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, len(attestations))
+	assert.Equal(t, "0x0d", attestations[0].(map[string]interface{})["aggregation_bits"])
+
+	decoded := &ethpb.SignedBeaconBlock{}
+	require.NoError(t, UnmarshalSpec(encoded, decoded))
+	assert.DeepEqual(t, blk, decoded)
+}
+
+func TestUnmarshalSpec_InvalidValues(t *testing.T) {
+	assert.ErrorContains(t, "expected a decimal string", UnmarshalSpec([]byte(`{"slot":5}`), &ethpb.AttestationData{}))
+	assert.ErrorContains(t, "without 0x prefix", UnmarshalSpec([]byte(`{"beacon_block_root":"abc"}`), &ethpb.AttestationData{}))
+	assert.ErrorContains(t, "non pointer", UnmarshalSpec([]byte(`{}`), ethpb.AttestationData{}))
+}
+
+func TestMarshalSpec_RenamedFields(t *testing.T) {
+	data := &ethpb.AttestationData{
+		Slot:            3,
+		CommitteeIndex:  2,
+		BeaconBlockRoot: make([]byte, 32),
+	}
+	encoded, err := MarshalSpec(data)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	assert.Equal(t, "2", fields["index"])
+	_, ok := fields["committee_index"]
+	assert.Equal(t, false, ok, "Committee index is not encoded with its standard name")
+
+	slashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1}},
+	}
+	encoded, err = MarshalSpec(slashing)
+	require.NoError(t, err)
+	fields = make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	header, ok := fields["signed_header_1"].(map[string]interface{})
+	require.Equal(t, true, ok, "Header is not encoded with its standard name")
+	message, ok := header["message"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "1", message["slot"])
+}
@@ -231,6 +231,24 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// ConvertSourceBackendFlag specifies the storage backend of the database to convert.
+	ConvertSourceBackendFlag = &cli.StringFlag{
+		Name:  "source-backend",
+		Usage: "Storage backend of the database to convert, bolt or badger",
+		Value: "bolt",
+	}
+	// ConvertTargetBackendFlag specifies the storage backend the database is converted to.
+	ConvertTargetBackendFlag = &cli.StringFlag{
+		Name:  "target-backend",
+		Usage: "Storage backend the database is converted to, bolt or badger",
+		Value: "badger",
+	}
+	// RollbackRootFlag specifies the finalized block root the head of the database is rolled back to.
+	RollbackRootFlag = &cli.StringFlag{
+		Name:     "root",
+		Usage:    "Hex encoded root of the finalized block the head of the database is rolled back to",
+		Required: true,
+	}
 )
 
 // LoadFlagsFromConfig sets flags values from config file if ConfigFileFlag is set.
@@ -2,6 +2,7 @@ package grpcutils
 
 import (
 	"context"
+	"net/http"
 	"strings"
 	"time"
 
@@ -79,3 +80,33 @@ func AppendHeaders(parent context.Context, headers []string) context.Context {
 	}
 	return parent
 }
+
+// QueryParamsMetadataKey is the metadata key of the names of the query parameters set on an HTTP
+// request, as passed by the gateway. Proto3 request fields cannot tell a zero value from an unset
+// field, so servers check this metadata to allow filtering on zero values.
+const QueryParamsMetadataKey = "x-query-params"
+
+// QueryParamsMetadata returns the metadata of the query parameters set on the HTTP request, to
+// be passed by the gateway to the gRPC server.
+func QueryParamsMetadata(_ context.Context, r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	for name := range r.URL.Query() {
+		md.Append(QueryParamsMetadataKey, name)
+	}
+	return md
+}
+
+// IsQueryParamSet returns whether the query parameter was set on the request of the context, as
+// passed by the gateway or by gRPC callers in the request metadata.
+func IsQueryParamSet(ctx context.Context, name string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, param := range md.Get(QueryParamsMetadataKey) {
+		if param == name {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package grpcutils
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -54,3 +56,13 @@ func TestAppendHeaders(t *testing.T) {
 		assert.Equal(t, "value=1", md.Get("first")[0])
 	})
 }
+
+func TestQueryParams(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/states/head/committees?index=0&slot=", nil)
+	md := QueryParamsMetadata(context.Background(), r)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	assert.Equal(t, true, IsQueryParamSet(ctx, "index"))
+	assert.Equal(t, true, IsQueryParamSet(ctx, "slot"))
+	assert.Equal(t, false, IsQueryParamSet(ctx, "epoch"))
+	assert.Equal(t, false, IsQueryParamSet(context.Background(), "index"))
+}
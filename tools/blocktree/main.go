@@ -37,7 +37,7 @@ type node struct {
 
 func main() {
 	flag.Parse()
-	db, err := db.NewDB(context.Background(), *datadir)
+	db, err := db.NewDB(context.Background(), *datadir, nil)
 	if err != nil {
 		panic(err)
 	}
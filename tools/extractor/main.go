@@ -22,7 +22,7 @@ func main() {
 	defer resetCfg()
 	flag.Parse()
 	fmt.Println("Starting process...")
-	d, err := db.NewDB(context.Background(), *datadir)
+	d, err := db.NewDB(context.Background(), *datadir, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -20,7 +20,7 @@ func main() {
 
 	fmt.Printf("Reading db at %s and writing ssz output to %s.\n", os.Args[1], os.Args[2])
 
-	d, err := db.NewDB(context.Background(), os.Args[1])
+	d, err := db.NewDB(context.Background(), os.Args[1], nil)
 	if err != nil {
 		panic(err)
 	}
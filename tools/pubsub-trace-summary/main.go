@@ -0,0 +1,294 @@
+/**
+ * Gossipsub trace summary
+ *
+ * Given the gossipsub trace files written by a beacon node running with --pubsub-trace-dir, this
+ * tool summarises per topic how messages propagated to the node and how they were validated:
+ * the latency between the first arrival of a message and its validation result, the delay of
+ * the duplicates received from other peers after the first arrival, the rejection reasons, and
+ * the mesh and gossip control traffic.
+ */
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+)
+
+var (
+	traceDir = flag.String("dir", "", "Directory of the gossipsub trace files. Trace files may also be given as arguments.")
+)
+
+// unknownTopic is the topic of the control messages about messages the node has not seen.
+const unknownTopic = "unknown"
+
+// messageTrace is what is known about a message.
+type messageTrace struct {
+	topic     string
+	firstSeen int64
+}
+
+// topicSummary summarises the trace events of a topic.
+type topicSummary struct {
+	published         int
+	delivered         int
+	rejected          int
+	duplicates        int
+	grafts            int
+	prunes            int
+	ihaveIn           int
+	ihaveOut          int
+	iwantIn           int
+	iwantOut          int
+	rejections        map[string]int
+	validationLatency []time.Duration
+	duplicateDelays   []time.Duration
+}
+
+// summary summarises the trace events of every topic.
+type summary struct {
+	topics   map[string]*topicSummary
+	messages map[string]*messageTrace
+}
+
+func newSummary() *summary {
+	return &summary{
+		topics:   make(map[string]*topicSummary),
+		messages: make(map[string]*messageTrace),
+	}
+}
+
+func main() {
+	flag.Parse()
+	files := flag.Args()
+	if *traceDir != "" {
+		dirFiles, err := filepath.Glob(filepath.Join(*traceDir, "pubsub-trace-*.pb"))
+		if err != nil {
+			panic(err)
+		}
+		// Trace files are named after their creation time.
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+	if len(files) == 0 {
+		fmt.Println("No trace files given, use --dir or pass trace files as arguments")
+		os.Exit(1)
+	}
+
+	s := newSummary()
+	for _, file := range files {
+		if err := s.readFile(file); err != nil {
+			panic(err)
+		}
+	}
+	if err := s.print(os.Stdout); err != nil {
+		panic(err)
+	}
+}
+
+// readFile adds the trace events of the file to the summary.
+func (s *summary) readFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Printf("Could not close %s: %v\n", file, err)
+		}
+	}()
+	return readEvents(bufio.NewReader(f), s.add)
+}
+
+// readEvents reads the length-delimited trace events of the reader.
+func readEvents(r *bufio.Reader, fn func(evt *pubsubpb.TraceEvent)) error {
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not read trace event size")
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			// The last event of a file is truncated when the node was killed while writing it.
+			if err == io.ErrUnexpectedEOF {
+				return nil
+			}
+			return errors.Wrap(err, "could not read trace event")
+		}
+		evt := &pubsubpb.TraceEvent{}
+		if err := evt.Unmarshal(buf); err != nil {
+			return errors.Wrap(err, "could not unmarshal trace event")
+		}
+		fn(evt)
+	}
+}
+
+// add adds the trace event to the summary.
+func (s *summary) add(evt *pubsubpb.TraceEvent) {
+	timestamp := evt.GetTimestamp()
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_PUBLISH_MESSAGE:
+		msg := evt.PublishMessage
+		s.seen(msg.GetMessageID(), msg.GetTopic(), timestamp)
+		s.topic(msg.GetTopic()).published++
+	case pubsubpb.TraceEvent_DELIVER_MESSAGE:
+		msg := evt.DeliverMessage
+		topic := s.topic(s.seen(msg.GetMessageID(), msg.GetTopic(), timestamp))
+		topic.delivered++
+		topic.validationLatency = append(topic.validationLatency, s.sinceFirstSeen(msg.GetMessageID(), timestamp))
+	case pubsubpb.TraceEvent_REJECT_MESSAGE:
+		msg := evt.RejectMessage
+		topic := s.topic(s.seen(msg.GetMessageID(), msg.GetTopic(), timestamp))
+		topic.rejected++
+		topic.rejections[msg.GetReason()]++
+		topic.validationLatency = append(topic.validationLatency, s.sinceFirstSeen(msg.GetMessageID(), timestamp))
+	case pubsubpb.TraceEvent_DUPLICATE_MESSAGE:
+		msg := evt.DuplicateMessage
+		topic := s.topic(s.seen(msg.GetMessageID(), msg.GetTopic(), timestamp))
+		topic.duplicates++
+		topic.duplicateDelays = append(topic.duplicateDelays, s.sinceFirstSeen(msg.GetMessageID(), timestamp))
+	case pubsubpb.TraceEvent_GRAFT:
+		s.topic(evt.Graft.GetTopic()).grafts++
+	case pubsubpb.TraceEvent_PRUNE:
+		s.topic(evt.Prune.GetTopic()).prunes++
+	case pubsubpb.TraceEvent_RECV_RPC:
+		meta := evt.RecvRPC.GetMeta()
+		for _, msg := range meta.GetMessages() {
+			s.seen(msg.GetMessageID(), msg.GetTopic(), timestamp)
+		}
+		for _, ihave := range meta.GetControl().GetIhave() {
+			s.topic(ihave.GetTopic()).ihaveIn += len(ihave.GetMessageIDs())
+		}
+		for _, iwant := range meta.GetControl().GetIwant() {
+			for _, id := range iwant.GetMessageIDs() {
+				s.topic(s.messageTopic(id)).iwantIn++
+			}
+		}
+	case pubsubpb.TraceEvent_SEND_RPC:
+		control := evt.SendRPC.GetMeta().GetControl()
+		for _, ihave := range control.GetIhave() {
+			s.topic(ihave.GetTopic()).ihaveOut += len(ihave.GetMessageIDs())
+		}
+		for _, iwant := range control.GetIwant() {
+			for _, id := range iwant.GetMessageIDs() {
+				s.topic(s.messageTopic(id)).iwantOut++
+			}
+		}
+	}
+}
+
+// seen records that the message was seen at the given time, and returns its topic. Older trace
+// formats do not carry the topic of every message, so the topic known for the message is used
+// when the event has none.
+func (s *summary) seen(id []byte, topic string, timestamp int64) string {
+	msg, ok := s.messages[string(id)]
+	if !ok {
+		msg = &messageTrace{topic: topic, firstSeen: timestamp}
+		s.messages[string(id)] = msg
+	}
+	if msg.topic == "" {
+		msg.topic = topic
+	}
+	if timestamp < msg.firstSeen {
+		msg.firstSeen = timestamp
+	}
+	if msg.topic == "" {
+		return unknownTopic
+	}
+	return msg.topic
+}
+
+// sinceFirstSeen returns the time elapsed between the first time the message was seen and the
+// given time.
+func (s *summary) sinceFirstSeen(id []byte, timestamp int64) time.Duration {
+	msg, ok := s.messages[string(id)]
+	if !ok {
+		return 0
+	}
+	return time.Duration(timestamp - msg.firstSeen)
+}
+
+// messageTopic returns the topic of the message, if it was seen.
+func (s *summary) messageTopic(id []byte) string {
+	if msg, ok := s.messages[string(id)]; ok && msg.topic != "" {
+		return msg.topic
+	}
+	return unknownTopic
+}
+
+func (s *summary) topic(topic string) *topicSummary {
+	if topic == "" {
+		topic = unknownTopic
+	}
+	summary, ok := s.topics[topic]
+	if !ok {
+		summary = &topicSummary{rejections: make(map[string]int)}
+		s.topics[topic] = summary
+	}
+	return summary
+}
+
+// print writes the summary of every topic, then the rejection reasons of every topic.
+func (s *summary) print(out io.Writer) error {
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPUBLISHED\tDELIVERED\tREJECTED\tDUPLICATES\tVALIDATION P50/P90/P99\tDUPLICATE DELAY P50/P90/P99\tGRAFT/PRUNE\tIHAVE IN/OUT\tIWANT IN/OUT")
+	for _, name := range topics {
+		topic := s.topics[name]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%s\t%d/%d\t%d/%d\t%d/%d\n",
+			name, topic.published, topic.delivered, topic.rejected, topic.duplicates,
+			percentiles(topic.validationLatency), percentiles(topic.duplicateDelays),
+			topic.grafts, topic.prunes, topic.ihaveIn, topic.ihaveOut, topic.iwantIn, topic.iwantOut)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "TOPIC\tREJECTION REASON\tCOUNT")
+	for _, name := range topics {
+		topic := s.topics[name]
+		reasons := make([]string, 0, len(topic.rejections))
+		for reason := range topic.rejections {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			return topic.rejections[reasons[i]] > topic.rejections[reasons[j]]
+		})
+		for _, reason := range reasons {
+			fmt.Fprintf(w, "%s\t%s\t%d\n", name, reason, topic.rejections[reason])
+		}
+	}
+	return w.Flush()
+}
+
+// percentiles formats the 50th, 90th and 99th percentiles of the durations.
+func percentiles(durations []time.Duration) string {
+	if len(durations) == 0 {
+		return "-"
+	}
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	percentile := func(p float64) time.Duration {
+		// Nearest-rank percentile.
+		return sorted[int(math.Ceil(p*float64(len(sorted))))-1].Round(time.Microsecond)
+	}
+	return fmt.Sprintf("%s/%s/%s", percentile(0.5), percentile(0.9), percentile(0.99))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSummary(t *testing.T) {
+	topic := "/eth2/abcd1234/beacon_block/ssz_snappy"
+	reason := "validation failed"
+	event := func(typ pubsubpb.TraceEvent_Type, timestamp time.Duration) *pubsubpb.TraceEvent {
+		ts := int64(timestamp)
+		return &pubsubpb.TraceEvent{Type: &typ, Timestamp: &ts}
+	}
+	recv := func(id string, timestamp time.Duration) *pubsubpb.TraceEvent {
+		evt := event(pubsubpb.TraceEvent_RECV_RPC, timestamp)
+		evt.RecvRPC = &pubsubpb.TraceEvent_RecvRPC{Meta: &pubsubpb.TraceEvent_RPCMeta{
+			Messages: []*pubsubpb.TraceEvent_MessageMeta{{MessageID: []byte(id), Topic: &topic}},
+		}}
+		return evt
+	}
+	deliver := event(pubsubpb.TraceEvent_DELIVER_MESSAGE, 30*time.Millisecond)
+	deliver.DeliverMessage = &pubsubpb.TraceEvent_DeliverMessage{MessageID: []byte("a"), Topic: &topic}
+	duplicate := event(pubsubpb.TraceEvent_DUPLICATE_MESSAGE, 110*time.Millisecond)
+	duplicate.DuplicateMessage = &pubsubpb.TraceEvent_DuplicateMessage{MessageID: []byte("a")}
+	reject := event(pubsubpb.TraceEvent_REJECT_MESSAGE, 205*time.Millisecond)
+	reject.RejectMessage = &pubsubpb.TraceEvent_RejectMessage{MessageID: []byte("b"), Topic: &topic, Reason: &reason}
+	iwant := event(pubsubpb.TraceEvent_SEND_RPC, 300*time.Millisecond)
+	iwant.SendRPC = &pubsubpb.TraceEvent_SendRPC{Meta: &pubsubpb.TraceEvent_RPCMeta{
+		Control: &pubsubpb.TraceEvent_ControlMeta{
+			Iwant: []*pubsubpb.TraceEvent_ControlIWantMeta{{MessageIDs: [][]byte{[]byte("a"), []byte("c")}}},
+		},
+	}}
+
+	var buf bytes.Buffer
+	for _, evt := range []*pubsubpb.TraceEvent{
+		recv("a", 10*time.Millisecond), deliver, duplicate, recv("b", 200*time.Millisecond), reject, iwant,
+	} {
+		enc, err := evt.Marshal()
+		require.NoError(t, err)
+		var prefix [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(prefix[:], uint64(len(enc)))
+		buf.Write(prefix[:n])
+		buf.Write(enc)
+	}
+	// A truncated event is ignored.
+	buf.Write([]byte{10, 1})
+
+	s := newSummary()
+	require.NoError(t, readEvents(bufio.NewReader(&buf), s.add))
+	summary := s.topics[topic]
+	require.NotNil(t, summary)
+	assert.Equal(t, 1, summary.delivered)
+	assert.Equal(t, 1, summary.rejected)
+	assert.Equal(t, 1, summary.rejections[reason])
+	assert.Equal(t, 1, summary.duplicates)
+	assert.DeepEqual(t, []time.Duration{20 * time.Millisecond, 5 * time.Millisecond}, summary.validationLatency)
+	assert.DeepEqual(t, []time.Duration{100 * time.Millisecond}, summary.duplicateDelays)
+	assert.Equal(t, 1, summary.iwantOut)
+	assert.Equal(t, 1, s.topics[unknownTopic].iwantOut)
+
+	var out bytes.Buffer
+	require.NoError(t, s.print(&out))
+	assert.Equal(t, true, strings.Contains(out.String(), "5ms/20ms/20ms"))
+	assert.Equal(t, true, strings.Contains(out.String(), reason))
+}
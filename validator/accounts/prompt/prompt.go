@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -145,6 +146,54 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	crt := cliCtx.String(flags.RemoteSignerCertPathFlag.Name)
+	key := cliCtx.String(flags.RemoteSignerKeyPathFlag.Name)
+	ca := cliCtx.String(flags.RemoteSignerCACertPathFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as https://signer.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	crtPath, keyPath, caPath := "", "", ""
+	if crt != "" {
+		crtPath, err = fileutil.ExpandPath(strings.TrimRight(crt, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", crt)
+		}
+	}
+	if key != "" {
+		keyPath, err = fileutil.ExpandPath(strings.TrimRight(key, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", key)
+		}
+	}
+	if ca != "" {
+		caPath, err = fileutil.ExpandPath(strings.TrimRight(ca, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", ca)
+		}
+	}
+
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseURL:        strings.TrimRight(url, "\r\n"),
+		ClientCertPath: crtPath,
+		ClientKeyPath:  keyPath,
+		CACertPath:     caPath,
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
@@ -0,0 +1,59 @@
+package beaconapi
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+)
+
+// GetChainHead returns the head of the beacon node along with its checkpoints.
+func (c *Client) GetChainHead(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	header := &headerData{}
+	if err := c.get(ctx, "/eth/v1/beacon/headers/head", nil, header); err != nil {
+		return nil, err
+	}
+	checkpoints := &finalityCheckpointsData{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", nil, checkpoints); err != nil {
+		return nil, err
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	headSlot := uint64(header.Header.Message.Slot)
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  headSlot / slotsPerEpoch,
+		HeadBlockRoot:              header.Root,
+		FinalizedSlot:              uint64(checkpoints.Finalized.Epoch) * slotsPerEpoch,
+		FinalizedEpoch:             uint64(checkpoints.Finalized.Epoch),
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              uint64(checkpoints.CurrentJustified.Epoch) * slotsPerEpoch,
+		JustifiedEpoch:             uint64(checkpoints.CurrentJustified.Epoch),
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      uint64(checkpoints.PreviousJustified.Epoch) * slotsPerEpoch,
+		PreviousJustifiedEpoch:     uint64(checkpoints.PreviousJustified.Epoch),
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}, nil
+}
+
+// StreamBlocks streams the blocks announced by the event stream of the beacon node. Only
+// verified blocks are announced by the standard API.
+func (c *Client) StreamBlocks(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	return newBlockStream(ctx, c)
+}
+
+// GetValidatorPerformance is not supported by the standard API.
+func (c *Client) GetValidatorPerformance(_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, errNotSupported
+}
+
+// ListIndexedAttestations is not supported by the standard API.
+func (c *Client) ListIndexedAttestations(_ context.Context, _ *ethpb.ListIndexedAttestationsRequest, _ ...grpc.CallOption) (*ethpb.ListIndexedAttestationsResponse, error) {
+	return nil, errNotSupported
+}
+
+// ListBlocks is not supported by the standard API.
+func (c *Client) ListBlocks(_ context.Context, _ *ethpb.ListBlocksRequest, _ ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	return nil, errNotSupported
+}
@@ -0,0 +1,172 @@
+// Package beaconapi implements the beacon node clients of the validator client over the
+// standard beacon node REST API, so that a Prysm validator can perform its duties with any
+// beacon node implementation.
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "beaconapi")
+
+// requestTimeout is the timeout of the requests made to the beacon node, besides streams.
+const requestTimeout = 30 * time.Second
+
+// errNotSupported is returned by the methods of the clients which the standard API can not
+// serve, with the status code of unimplemented gRPC methods.
+var errNotSupported = status.Error(codes.Unimplemented, "not supported by the standard beacon node API")
+
+// Client serves the beacon node validator, node and beacon chain clients of the validator
+// client from the standard REST API of a beacon node.
+type Client struct {
+	endpoint               string
+	httpClient             *http.Client
+	streamClient           *http.Client
+	genesisLock            sync.Mutex
+	genesis                *genesisData
+	subscriptionsLock      sync.Mutex
+	subscriptions          map[subscriptionKey]*attesterDuty
+	pollInterval           time.Duration
+	activationPollInterval time.Duration
+}
+
+// subscriptionKey identifies the committee of an attester duty.
+type subscriptionKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+// apiError is the error body returned by the standard API.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewClient returns a client of the standard REST API of the beacon node at the given
+// endpoint, such as http://127.0.0.1:3500.
+func NewClient(endpoint string) *Client {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	return &Client{
+		endpoint:               strings.TrimSuffix(endpoint, "/"),
+		httpClient:             &http.Client{Timeout: requestTimeout},
+		streamClient:           &http.Client{},
+		subscriptions:          make(map[subscriptionKey]*attesterDuty),
+		pollInterval:           5 * time.Second,
+		activationPollInterval: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+	}
+}
+
+// get fetches the given path and decodes the data field of the response into data.
+func (c *Client) get(ctx context.Context, path string, query url.Values, data interface{}) error {
+	return c.do(ctx, http.MethodGet, path, query, nil, data)
+}
+
+// post sends the given body to the path, and decodes the data field of the response into data
+// when it is not nil.
+func (c *Client) post(ctx context.Context, path string, body []byte, data interface{}) error {
+	return c.do(ctx, http.MethodPost, path, nil, body, data)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body []byte, data interface{}) error {
+	u := c.endpoint + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Transport errors have the status code of unavailable gRPC servers.
+		return status.Errorf(codes.Unavailable, "could not send request to %s: %v", path, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return responseError(path, resp.StatusCode, respBody)
+	}
+	if data == nil {
+		return nil
+	}
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return errors.Wrapf(err, "could not decode response of %s", path)
+	}
+	if err := decodeData(envelope.Data, data); err != nil {
+		return errors.Wrapf(err, "could not decode response of %s", path)
+	}
+	return nil
+}
+
+// decodeData decodes the data of a response, following the standard encoding for protobuf
+// messages.
+func decodeData(raw json.RawMessage, data interface{}) error {
+	if _, ok := data.(proto.Message); ok {
+		return apiutil.UnmarshalSpec(raw, data)
+	}
+	return json.Unmarshal(raw, data)
+}
+
+// statusError is returned for the responses of the beacon node which are not successful.
+type statusError struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("request to %s failed with status %d: %s", e.path, e.code, e.message)
+}
+
+func responseError(path string, code int, body []byte) error {
+	apiErr := &apiError{}
+	message := string(body)
+	if err := json.Unmarshal(body, apiErr); err == nil && apiErr.Message != "" {
+		message = apiErr.Message
+	}
+	return &statusError{path: path, code: code, message: message}
+}
+
+// isNotFound returns true if the error is the not found response of the beacon node.
+func isNotFound(err error) bool {
+	var e *statusError
+	return errors.As(err, &e) && e.code == http.StatusNotFound
+}
@@ -0,0 +1,227 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// newTestClient returns a client of a beacon node served by the given handler.
+func newTestClient(t *testing.T, mux *http.ServeMux) *Client {
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	c := NewClient(srv.URL)
+	c.pollInterval = 10 * time.Millisecond
+	c.activationPollInterval = 10 * time.Millisecond
+	return c
+}
+
+// writeData writes the data in the response envelope of the standard API.
+func writeData(t *testing.T, w http.ResponseWriter, data string) {
+	_, err := fmt.Fprintf(w, `{"data":%s}`, data)
+	require.NoError(t, err)
+}
+
+func TestGetDuties(t *testing.T) {
+	known := bytesutil.PadTo([]byte("known"), 48)
+	unknown := bytesutil.PadTo([]byte("unknown"), 48)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		assert.DeepEqual(t, []string{hexBytes(known).String(), hexBytes(unknown).String()}, r.URL.Query()["id"])
+		writeData(t, w, fmt.Sprintf(`[{"index":"3","balance":"32000000000","status":"active_ongoing",`+
+			`"validator":{"pubkey":"%s","activation_epoch":"0","exit_epoch":"18446744073709551615"}}]`, hexBytes(known)))
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/attester/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `["3"]`, string(body))
+		writeData(t, w, fmt.Sprintf(`[{"pubkey":"%s","validator_index":"3","committee_index":"1","committee_length":"3",`+
+			`"committees_at_slot":"2","validator_committee_index":"1","slot":"40"}]`, hexBytes(known)))
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/attester/2", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(`[{"pubkey":"%s","validator_index":"3","committee_index":"0","committee_length":"2",`+
+			`"committees_at_slot":"2","validator_committee_index":"0","slot":"70"}]`, hexBytes(known)))
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees/1", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[{"index":"0","slot":"40","validators":["1","2"]},{"index":"1","slot":"40","validators":["5","3","9"]}]`)
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees/2", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[{"index":"0","slot":"70","validators":["3","4"]}]`)
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/1", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(`[{"pubkey":"%s","validator_index":"3","slot":"33"},`+
+			`{"pubkey":"%s","validator_index":"8","slot":"34"}]`, hexBytes(known), hexBytes(unknown)))
+	})
+	var subscriptions []*committeeSubscription
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &subscriptions))
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	resp, err := c.GetDuties(ctx, &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{known, unknown}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	assert.DeepEqual(t, &ethpb.DutiesResponse_Duty{
+		Committee:      []uint64{5, 3, 9},
+		CommitteeIndex: 1,
+		AttesterSlot:   40,
+		ProposerSlots:  []uint64{33},
+		PublicKey:      known,
+		Status:         ethpb.ValidatorStatus_ACTIVE,
+		ValidatorIndex: 3,
+	}, resp.CurrentEpochDuties[0])
+	assert.DeepEqual(t, &ethpb.DutiesResponse_Duty{
+		PublicKey: unknown,
+		Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+	}, resp.CurrentEpochDuties[1])
+	assert.DeepEqual(t, resp.CurrentEpochDuties, resp.Duties)
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+	assert.DeepEqual(t, []uint64{3, 4}, resp.NextEpochDuties[0].Committee)
+	assert.Equal(t, uint64(70), resp.NextEpochDuties[0].AttesterSlot)
+	assert.Equal(t, 0, len(resp.NextEpochDuties[0].ProposerSlots))
+
+	// Subscriptions are completed from the attester duties.
+	_, err = c.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []uint64{40, 70, 71},
+		CommitteeIds: []uint64{1, 0, 0},
+		IsAggregator: []bool{true, false, false},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*committeeSubscription{
+		{ValidatorIndex: 3, CommitteeIndex: 1, CommitteesAtSlot: 2, Slot: 40, IsAggregator: true},
+		{ValidatorIndex: 3, CommitteeIndex: 0, CommitteesAtSlot: 2, Slot: 70, IsAggregator: false},
+	}, subscriptions)
+}
+
+func TestDomainData(t *testing.T) {
+	genesisValidatorsRoot := bytesutil.PadTo([]byte("genesis validators root"), 32)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(`{"genesis_time":"100","genesis_validators_root":"%s","genesis_fork_version":"0x00000000"}`,
+			hexBytes(genesisValidatorsRoot)))
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/fork", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `{"previous_version":"0x00000000","current_version":"0x00000001","epoch":"10"}`)
+	})
+	c := newTestClient(t, mux)
+
+	domainType := params.BeaconConfig().DomainBeaconAttester
+	resp, err := c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: 12, Domain: domainType[:]})
+	require.NoError(t, err)
+	fork := &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 1}, Epoch: 10}
+	want, err := helpers.Domain(fork, 12, domainType, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp.SignatureDomain)
+}
+
+func TestAttestation(t *testing.T) {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 9, CommitteeIndex: 2})
+	var submitted []*ethpb.Attestation
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "9", r.URL.Query().Get("slot"))
+		assert.Equal(t, "2", r.URL.Query().Get("committee_index"))
+		encoded, err := apiutil.MarshalSpec(data)
+		require.NoError(t, err)
+		writeData(t, w, string(encoded))
+	})
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, apiutil.UnmarshalSpec(body, &submitted))
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	resp, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 9, CommitteeIndex: 2})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, resp)
+
+	att := testutil.HydrateAttestation(&ethpb.Attestation{Data: resp})
+	attResp, err := c.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	root, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], attResp.AttestationDataRoot)
+	assert.DeepEqual(t, []*ethpb.Attestation{att}, submitted)
+}
+
+func TestWaitForChainStart(t *testing.T) {
+	genesisValidatorsRoot := bytesutil.PadTo([]byte("genesis validators root"), 32)
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"code":404,"message":"Chain genesis info is not yet known"}`))
+			require.NoError(t, err)
+			return
+		}
+		writeData(t, w, fmt.Sprintf(`{"genesis_time":"100","genesis_validators_root":"%s","genesis_fork_version":"0x00000000"}`,
+			hexBytes(genesisValidatorsRoot)))
+	})
+	c := newTestClient(t, mux)
+
+	stream, err := c.WaitForChainStart(context.Background(), nil)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.ChainStartResponse{
+		Started:               true,
+		GenesisTime:           100,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}, resp)
+	assert.Equal(t, 3, calls)
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	distance := "5"
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(`{"head_slot":"100","sync_distance":"%s"}`, distance))
+	})
+	c := newTestClient(t, mux)
+
+	resp, err := c.GetSyncStatus(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Syncing)
+
+	distance = "0"
+	resp, err = c.GetSyncStatus(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Syncing)
+}
+
+func TestRequestError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, err := w.Write([]byte(`{"code":503,"message":"Beacon node is currently syncing"}`))
+		require.NoError(t, err)
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	assert.ErrorContains(t, "failed with status 503: Beacon node is currently syncing", err)
+}
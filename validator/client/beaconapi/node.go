@@ -0,0 +1,77 @@
+package beaconapi
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+var _ ethpb.NodeClient = (*Client)(nil)
+
+// GetSyncStatus returns whether the beacon node is syncing, that is when its head is more than
+// a slot behind the current slot.
+func (c *Client) GetSyncStatus(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	syncing := &syncingData{}
+	if err := c.get(ctx, "/eth/v1/node/syncing", nil, syncing); err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncStatus{
+		Syncing: syncing.SyncDistance > 1,
+	}, nil
+}
+
+// GetGenesis returns the genesis of the chain along with the deposit contract address.
+func (c *Client) GetGenesis(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := c.genesisData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	depositContract := &depositContractData{}
+	if err := c.get(ctx, "/eth/v1/config/deposit_contract", nil, depositContract); err != nil {
+		return nil, err
+	}
+	address, err := hexutil.Decode(depositContract.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract address")
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            &ptypes.Timestamp{Seconds: int64(genesis.GenesisTime)},
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesis.GenesisValidatorsRoot,
+	}, nil
+}
+
+// GetVersion returns the version of the beacon node.
+func (c *Client) GetVersion(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+	version := &versionData{}
+	if err := c.get(ctx, "/eth/v1/node/version", nil, version); err != nil {
+		return nil, err
+	}
+	return &ethpb.Version{
+		Version: version.Version,
+	}, nil
+}
+
+// ListImplementedServices is not supported by the standard API.
+func (c *Client) ListImplementedServices(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ImplementedServices, error) {
+	return nil, errNotSupported
+}
+
+// GetHost is not supported by the standard API.
+func (c *Client) GetHost(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.HostData, error) {
+	return nil, errNotSupported
+}
+
+// GetPeer is not supported by the standard API.
+func (c *Client) GetPeer(_ context.Context, _ *ethpb.PeerRequest, _ ...grpc.CallOption) (*ethpb.Peer, error) {
+	return nil, errNotSupported
+}
+
+// ListPeers is not supported by the standard API.
+func (c *Client) ListPeers(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+	return nil, errNotSupported
+}
@@ -0,0 +1,216 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/metadata"
+)
+
+// clientStream implements the gRPC client stream methods of the polling streams, which only
+// receive messages.
+type clientStream struct {
+	ctx context.Context
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (s *clientStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *clientStream) SendMsg(_ interface{}) error {
+	return errNotSupported
+}
+
+func (s *clientStream) RecvMsg(_ interface{}) error {
+	return errNotSupported
+}
+
+// wait waits for the duration, and returns an error if the context of the stream is canceled
+// first.
+func (s *clientStream) wait(d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// chainStartStream polls the genesis of the beacon node until the chain has started.
+type chainStartStream struct {
+	clientStream
+	client *Client
+	done   bool
+}
+
+// Recv returns the genesis of the chain once the beacon node knows it, and io.EOF afterwards.
+func (s *chainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+	for {
+		genesis, err := s.client.genesisData(s.ctx)
+		if err == nil {
+			s.done = true
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           uint64(genesis.GenesisTime),
+				GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+			}, nil
+		}
+		// The beacon node does not know the genesis before the chain starts.
+		if !isNotFound(err) {
+			return nil, err
+		}
+		if err := s.wait(s.client.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// activationStream polls the statuses of validators.
+type activationStream struct {
+	clientStream
+	client  *Client
+	pubkeys [][]byte
+	polled  bool
+}
+
+// Recv returns the statuses of the validators of the stream, immediately on the first call and
+// after a poll interval on the following ones.
+func (s *activationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.polled {
+		if err := s.wait(s.client.activationPollInterval); err != nil {
+			return nil, err
+		}
+	}
+	s.polled = true
+	validators, err := s.client.validators(s.ctx, s.pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.ValidatorActivationResponse{
+		Statuses: make([]*ethpb.ValidatorActivationResponse_Status, len(s.pubkeys)),
+	}
+	for i, pubkey := range s.pubkeys {
+		status := &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pubkey,
+		}
+		val, ok := validators[bytesutil.ToBytes48(pubkey)]
+		if ok {
+			status.Index = uint64(val.Index)
+		}
+		status.Status = statusResponse(val)
+		resp.Statuses[i] = status
+	}
+	return resp, nil
+}
+
+// blockStream receives the blocks announced by the event stream of the beacon node.
+type blockStream struct {
+	clientStream
+	client *Client
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// newBlockStream subscribes to the block events of the beacon node.
+func newBlockStream(ctx context.Context, c *Client) (*blockStream, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/eth/v1/events?topics=block", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to block events")
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				log.WithError(err).Debug("Could not close response body")
+			}
+		}()
+		body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<16))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read response body")
+		}
+		return nil, responseError("/eth/v1/events", resp.StatusCode, body)
+	}
+	return &blockStream{
+		clientStream: clientStream{ctx: ctx},
+		client:       c,
+		body:         resp.Body,
+		reader:       bufio.NewReader(resp.Body),
+	}, nil
+}
+
+// Recv returns the next block announced by the beacon node.
+func (s *blockStream) Recv() (*ethpb.SignedBeaconBlock, error) {
+	for {
+		data, err := s.nextEvent()
+		if err != nil {
+			return nil, err
+		}
+		ev := &blockEventData{}
+		if err := json.Unmarshal(data, ev); err != nil {
+			return nil, errors.Wrap(err, "could not decode block event")
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := s.client.get(s.ctx, "/eth/v1/beacon/blocks/"+ev.Block.String(), nil, blk); err != nil {
+			if isNotFound(err) {
+				// The block was announced but is no longer known, such as after a reorg.
+				continue
+			}
+			return nil, err
+		}
+		return blk, nil
+	}
+}
+
+// CloseSend closes the event stream.
+func (s *blockStream) CloseSend() error {
+	return s.body.Close()
+}
+
+// nextEvent returns the data of the next event of the stream, following the format of
+// server-sent events.
+func (s *blockStream) nextEvent() ([]byte, error) {
+	var data []string
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				return []byte(strings.Join(data, "\n")), nil
+			}
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+}
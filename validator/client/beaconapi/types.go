@@ -0,0 +1,166 @@
+package beaconapi
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// uint64String is an integer encoded as a decimal string, as done by the standard beacon
+// node API.
+type uint64String uint64
+
+// UnmarshalJSON decodes a decimal string.
+func (n *uint64String) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*n = uint64String(parsed)
+	return nil
+}
+
+// MarshalJSON encodes the integer as a decimal string.
+func (n uint64String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(n), 10))
+}
+
+// hexBytes is a byte slice encoded as a 0x prefixed hex string, as done by the standard
+// beacon node API.
+type hexBytes []byte
+
+// UnmarshalJSON decodes a 0x prefixed hex string.
+func (b *hexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := hexutil.Decode(s)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// MarshalJSON encodes the bytes as a 0x prefixed hex string.
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// String returns the bytes as a 0x prefixed hex string.
+func (b hexBytes) String() string {
+	return hexutil.Encode(b)
+}
+
+type genesisData struct {
+	GenesisTime           uint64String `json:"genesis_time"`
+	GenesisValidatorsRoot hexBytes     `json:"genesis_validators_root"`
+	GenesisForkVersion    hexBytes     `json:"genesis_fork_version"`
+}
+
+type validatorData struct {
+	Index     uint64String `json:"index"`
+	Balance   uint64String `json:"balance"`
+	Status    string       `json:"status"`
+	Validator struct {
+		Pubkey          hexBytes     `json:"pubkey"`
+		ActivationEpoch uint64String `json:"activation_epoch"`
+		ExitEpoch       uint64String `json:"exit_epoch"`
+	} `json:"validator"`
+}
+
+type attesterDuty struct {
+	Pubkey                  hexBytes     `json:"pubkey"`
+	ValidatorIndex          uint64String `json:"validator_index"`
+	CommitteeIndex          uint64String `json:"committee_index"`
+	CommitteeLength         uint64String `json:"committee_length"`
+	CommitteesAtSlot        uint64String `json:"committees_at_slot"`
+	ValidatorCommitteeIndex uint64String `json:"validator_committee_index"`
+	Slot                    uint64String `json:"slot"`
+}
+
+type proposerDuty struct {
+	Pubkey         hexBytes     `json:"pubkey"`
+	ValidatorIndex uint64String `json:"validator_index"`
+	Slot           uint64String `json:"slot"`
+}
+
+type committeeData struct {
+	Index      uint64String   `json:"index"`
+	Slot       uint64String   `json:"slot"`
+	Validators []uint64String `json:"validators"`
+}
+
+type committeeSubscription struct {
+	ValidatorIndex   uint64String `json:"validator_index"`
+	CommitteeIndex   uint64String `json:"committee_index"`
+	CommitteesAtSlot uint64String `json:"committees_at_slot"`
+	Slot             uint64String `json:"slot"`
+	IsAggregator     bool         `json:"is_aggregator"`
+}
+
+type syncingData struct {
+	HeadSlot     uint64String `json:"head_slot"`
+	SyncDistance uint64String `json:"sync_distance"`
+}
+
+type versionData struct {
+	Version string `json:"version"`
+}
+
+type depositContractData struct {
+	ChainID uint64String `json:"chain_id"`
+	Address string       `json:"address"`
+}
+
+type headerData struct {
+	Root   hexBytes `json:"root"`
+	Header struct {
+		Message struct {
+			Slot uint64String `json:"slot"`
+		} `json:"message"`
+	} `json:"header"`
+}
+
+type checkpointData struct {
+	Epoch uint64String `json:"epoch"`
+	Root  hexBytes     `json:"root"`
+}
+
+type finalityCheckpointsData struct {
+	PreviousJustified checkpointData `json:"previous_justified"`
+	CurrentJustified  checkpointData `json:"current_justified"`
+	Finalized         checkpointData `json:"finalized"`
+}
+
+type blockEventData struct {
+	Slot  uint64String `json:"slot"`
+	Block hexBytes     `json:"block"`
+}
+
+// validatorStatus converts a validator status of the standard API to a Prysm status.
+func validatorStatus(status string) ethpb.ValidatorStatus {
+	switch status {
+	case "pending_initialized":
+		return ethpb.ValidatorStatus_DEPOSITED
+	case "pending_queued":
+		return ethpb.ValidatorStatus_PENDING
+	case "active_ongoing":
+		return ethpb.ValidatorStatus_ACTIVE
+	case "active_exiting":
+		return ethpb.ValidatorStatus_EXITING
+	case "active_slashed":
+		return ethpb.ValidatorStatus_SLASHING
+	case "exited_unslashed", "exited_slashed", "withdrawal_possible", "withdrawal_done":
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
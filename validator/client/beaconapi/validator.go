@@ -0,0 +1,485 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+)
+
+var _ ethpb.BeaconNodeValidatorClient = (*Client)(nil)
+
+// GetDuties returns the duties of the requested validators for the requested epoch and the
+// next one. Proposer duties are only returned for the requested epoch.
+func (c *Client) GetDuties(ctx context.Context, req *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	validators, err := c.validators(ctx, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	c.pruneSubscriptions(req.Epoch)
+	currentEpochDuties, err := c.epochDuties(ctx, req.Epoch, req.PublicKeys, validators, true /* withProposals */)
+	if err != nil {
+		return nil, err
+	}
+	nextEpochDuties, err := c.epochDuties(ctx, req.Epoch+1, req.PublicKeys, validators, false /* withProposals */)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.DutiesResponse{
+		Duties:             currentEpochDuties,
+		CurrentEpochDuties: currentEpochDuties,
+		NextEpochDuties:    nextEpochDuties,
+	}, nil
+}
+
+// StreamDuties is not supported by the standard API, duties are polled with GetDuties instead.
+func (c *Client) StreamDuties(_ context.Context, _ *ethpb.DutiesRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return nil, errNotSupported
+}
+
+// DomainData computes the signature domain of the requested epoch from the fork of the head
+// state and the genesis validators root of the beacon node.
+func (c *Client) DomainData(ctx context.Context, req *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesisValidatorsRoot, err := c.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fork, err := c.HeadFork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := helpers.Domain(fork, req.Epoch, bytesutil.ToBytes4(req.Domain), genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute domain")
+	}
+	return &ethpb.DomainResponse{
+		SignatureDomain: domain,
+	}, nil
+}
+
+// HeadFork returns the fork of the head state.
+func (c *Client) HeadFork(ctx context.Context) (*pb.Fork, error) {
+	fork := &pb.Fork{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/fork", nil, fork); err != nil {
+		return nil, err
+	}
+	return fork, nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the chain.
+func (c *Client) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	genesis, err := c.genesisData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return genesis.GenesisValidatorsRoot, nil
+}
+
+// WaitForChainStart returns a stream which receives the genesis of the chain once the beacon
+// node knows it.
+func (c *Client) WaitForChainStart(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &chainStartStream{clientStream: clientStream{ctx: ctx}, client: c}, nil
+}
+
+// WaitForActivation returns a stream which receives the statuses of the requested validators
+// once per slot.
+func (c *Client) WaitForActivation(ctx context.Context, req *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &activationStream{clientStream: clientStream{ctx: ctx}, client: c, pubkeys: req.PublicKeys}, nil
+}
+
+// ValidatorIndex returns the index of the requested validator.
+func (c *Client) ValidatorIndex(ctx context.Context, req *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	validators, err := c.validators(ctx, [][]byte{req.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	val, ok := validators[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, fmt.Errorf("validator %#x is not known to the beacon node", req.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{
+		Index: uint64(val.Index),
+	}, nil
+}
+
+// ValidatorStatus returns the status of the requested validator.
+func (c *Client) ValidatorStatus(ctx context.Context, req *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	validators, err := c.validators(ctx, [][]byte{req.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	return statusResponse(validators[bytesutil.ToBytes48(req.PublicKey)]), nil
+}
+
+// MultipleValidatorStatus returns the statuses of the requested validators. Validators
+// requested by public key are always part of the response, with an unknown status if the
+// beacon node does not know them.
+func (c *Client) MultipleValidatorStatus(ctx context.Context, req *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	validators, err := c.validators(ctx, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.MultipleValidatorStatusResponse{}
+	for _, pubkey := range req.PublicKeys {
+		val := validators[bytesutil.ToBytes48(pubkey)]
+		resp.PublicKeys = append(resp.PublicKeys, pubkey)
+		resp.Statuses = append(resp.Statuses, statusResponse(val))
+		if val != nil {
+			resp.Indices = append(resp.Indices, uint64(val.Index))
+		} else {
+			resp.Indices = append(resp.Indices, 0)
+		}
+	}
+	if len(req.Indices) > 0 {
+		ids := make([]string, len(req.Indices))
+		for i, index := range req.Indices {
+			ids[i] = strconv.FormatInt(index, 10)
+		}
+		var byIndex []*validatorData
+		if err := c.get(ctx, "/eth/v1/beacon/states/head/validators", url.Values{"id": ids}, &byIndex); err != nil {
+			return nil, err
+		}
+		for _, val := range byIndex {
+			resp.PublicKeys = append(resp.PublicKeys, val.Validator.Pubkey)
+			resp.Statuses = append(resp.Statuses, statusResponse(val))
+			resp.Indices = append(resp.Indices, uint64(val.Index))
+		}
+	}
+	return resp, nil
+}
+
+// GetBlock returns an unsigned block produced by the beacon node for the requested slot.
+func (c *Client) GetBlock(ctx context.Context, req *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	query := url.Values{
+		"randao_reveal": {hexBytes(req.RandaoReveal).String()},
+	}
+	if len(req.Graffiti) > 0 {
+		query.Set("graffiti", hexBytes(req.Graffiti).String())
+	}
+	blk := &ethpb.BeaconBlock{}
+	if err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/blocks/%d", req.Slot), query, blk); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+// ProposeBlock submits a signed block to the beacon node for broadcasting.
+func (c *Client) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block")
+	}
+	body, err := apiutil.MarshalSpec(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode block")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, err
+	}
+	return &ethpb.ProposeResponse{
+		BlockRoot: root[:],
+	}, nil
+}
+
+// GetAttestationData returns the attestation data of the requested slot and committee.
+func (c *Client) GetAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	query := url.Values{
+		"slot":            {strconv.FormatUint(req.Slot, 10)},
+		"committee_index": {strconv.FormatUint(req.CommitteeIndex, 10)},
+	}
+	data := &ethpb.AttestationData{}
+	if err := c.get(ctx, "/eth/v1/validator/attestation_data", query, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ProposeAttestation submits a signed attestation to the beacon node for broadcasting.
+func (c *Client) ProposeAttestation(ctx context.Context, att *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	root, err := att.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	body, err := apiutil.MarshalSpec([]*ethpb.Attestation{att})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode attestation")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/attestations", body, nil); err != nil {
+		return nil, err
+	}
+	return &ethpb.AttestResponse{
+		AttestationDataRoot: root[:],
+	}, nil
+}
+
+// SubmitAggregateSelectionProof returns the best aggregate known to the beacon node for the
+// attestation data of the requested slot and committee, along with the selection proof of the
+// aggregator.
+func (c *Client) SubmitAggregateSelectionProof(ctx context.Context, req *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	indexResp, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: req.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: req.Slot, CommitteeIndex: req.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	query := url.Values{
+		"attestation_data_root": {hexBytes(root[:]).String()},
+		"slot":                  {strconv.FormatUint(req.Slot, 10)},
+	}
+	aggregate := &ethpb.Attestation{}
+	if err := c.get(ctx, "/eth/v1/validator/aggregate_attestation", query, aggregate); err != nil {
+		return nil, err
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: indexResp.Index,
+			Aggregate:       aggregate,
+			SelectionProof:  req.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof submits a signed aggregate and proof to the beacon node
+// for broadcasting.
+func (c *Client) SubmitSignedAggregateSelectionProof(ctx context.Context, req *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	agg := req.SignedAggregateAndProof
+	if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil {
+		return nil, errors.New("signed aggregate and proof is nil")
+	}
+	root, err := agg.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	body, err := apiutil.MarshalSpec([]*ethpb.SignedAggregateAttestationAndProof{agg})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode aggregate and proof")
+	}
+	if err := c.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body, nil); err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedAggregateSubmitResponse{
+		AttestationDataRoot: root[:],
+	}, nil
+}
+
+// ProposeExit submits a signed voluntary exit to the beacon node for broadcasting.
+func (c *Client) ProposeExit(ctx context.Context, exit *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	root, err := exit.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash exit")
+	}
+	body, err := apiutil.MarshalSpec(exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode exit")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", body, nil); err != nil {
+		return nil, err
+	}
+	return &ethpb.ProposeExitResponse{
+		ExitRoot: root[:],
+	}, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the subnets of the requested
+// committees. The standard API identifies the validator of every subscription, which is taken
+// from the attester duties previously fetched by GetDuties.
+func (c *Client) SubscribeCommitteeSubnets(ctx context.Context, req *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	if len(req.Slots) != len(req.CommitteeIds) || len(req.Slots) != len(req.IsAggregator) {
+		return nil, errors.New("subscription request fields have different lengths")
+	}
+	subscriptions := make([]*committeeSubscription, 0, len(req.Slots))
+	c.subscriptionsLock.Lock()
+	for i, slot := range req.Slots {
+		duty, ok := c.subscriptions[subscriptionKey{slot: slot, committeeIndex: req.CommitteeIds[i]}]
+		if !ok {
+			log.WithField("slot", slot).WithField("committeeIndex", req.CommitteeIds[i]).Debug(
+				"No attester duty known for committee, not subscribing to its subnet")
+			continue
+		}
+		subscriptions = append(subscriptions, &committeeSubscription{
+			ValidatorIndex:   duty.ValidatorIndex,
+			CommitteeIndex:   duty.CommitteeIndex,
+			CommitteesAtSlot: duty.CommitteesAtSlot,
+			Slot:             duty.Slot,
+			IsAggregator:     req.IsAggregator[i],
+		})
+	}
+	c.subscriptionsLock.Unlock()
+	if len(subscriptions) == 0 {
+		return &ptypes.Empty{}, nil
+	}
+	body, err := json.Marshal(subscriptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode subscriptions")
+	}
+	if err := c.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", body, nil); err != nil {
+		return nil, err
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// epochDuties returns the duties of the requested validators for the epoch, and records their
+// attester duties for the subnet subscriptions.
+func (c *Client) epochDuties(
+	ctx context.Context,
+	epoch uint64,
+	pubkeys [][]byte,
+	validators map[[48]byte]*validatorData,
+	withProposals bool,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	duties := make([]*ethpb.DutiesResponse_Duty, len(pubkeys))
+	var indices []string
+	for i, pubkey := range pubkeys {
+		duties[i] = &ethpb.DutiesResponse_Duty{
+			PublicKey: pubkey,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		}
+		if val, ok := validators[bytesutil.ToBytes48(pubkey)]; ok {
+			duties[i].ValidatorIndex = uint64(val.Index)
+			duties[i].Status = validatorStatus(val.Status)
+			indices = append(indices, strconv.FormatUint(uint64(val.Index), 10))
+		}
+	}
+	if len(indices) == 0 {
+		return duties, nil
+	}
+
+	body, err := json.Marshal(indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode validator indices")
+	}
+	var attesterDuties []*attesterDuty
+	path := fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch)
+	if err := c.post(ctx, path, body, &attesterDuties); err != nil {
+		return nil, err
+	}
+	attesterDutyByIndex := make(map[uint64]*attesterDuty, len(attesterDuties))
+	for _, duty := range attesterDuties {
+		attesterDutyByIndex[uint64(duty.ValidatorIndex)] = duty
+	}
+	committees := make(map[subscriptionKey][]uint64)
+	if len(attesterDuties) > 0 {
+		var epochCommittees []*committeeData
+		path := fmt.Sprintf("/eth/v1/beacon/states/head/committees/%d", epoch)
+		if err := c.get(ctx, path, nil, &epochCommittees); err != nil {
+			return nil, err
+		}
+		for _, committee := range epochCommittees {
+			members := make([]uint64, len(committee.Validators))
+			for i, index := range committee.Validators {
+				members[i] = uint64(index)
+			}
+			committees[subscriptionKey{slot: uint64(committee.Slot), committeeIndex: uint64(committee.Index)}] = members
+		}
+	}
+	proposerSlots := make(map[uint64][]uint64)
+	if withProposals {
+		var proposerDuties []*proposerDuty
+		if err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), nil, &proposerDuties); err != nil {
+			return nil, err
+		}
+		for _, duty := range proposerDuties {
+			index := uint64(duty.ValidatorIndex)
+			proposerSlots[index] = append(proposerSlots[index], uint64(duty.Slot))
+		}
+	}
+
+	c.subscriptionsLock.Lock()
+	defer c.subscriptionsLock.Unlock()
+	for _, duty := range duties {
+		if duty.Status == ethpb.ValidatorStatus_UNKNOWN_STATUS {
+			continue
+		}
+		duty.ProposerSlots = proposerSlots[duty.ValidatorIndex]
+		attesterDuty, ok := attesterDutyByIndex[duty.ValidatorIndex]
+		if !ok {
+			continue
+		}
+		key := subscriptionKey{slot: uint64(attesterDuty.Slot), committeeIndex: uint64(attesterDuty.CommitteeIndex)}
+		duty.AttesterSlot = key.slot
+		duty.CommitteeIndex = key.committeeIndex
+		duty.Committee = committees[key]
+		c.subscriptions[key] = attesterDuty
+	}
+	return duties, nil
+}
+
+// pruneSubscriptions removes the attester duties recorded for the epochs before the given
+// epoch.
+func (c *Client) pruneSubscriptions(epoch uint64) {
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	c.subscriptionsLock.Lock()
+	defer c.subscriptionsLock.Unlock()
+	for key := range c.subscriptions {
+		if key.slot < startSlot {
+			delete(c.subscriptions, key)
+		}
+	}
+}
+
+// validators returns the validators of the head state with the given public keys, by public
+// key. Validators which are not known to the beacon node are not part of the result.
+func (c *Client) validators(ctx context.Context, pubkeys [][]byte) (map[[48]byte]*validatorData, error) {
+	validators := make(map[[48]byte]*validatorData, len(pubkeys))
+	if len(pubkeys) == 0 {
+		return validators, nil
+	}
+	ids := make([]string, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		ids[i] = hexBytes(pubkey).String()
+	}
+	var data []*validatorData
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/validators", url.Values{"id": ids}, &data); err != nil {
+		return nil, err
+	}
+	for _, val := range data {
+		validators[bytesutil.ToBytes48(val.Validator.Pubkey)] = val
+	}
+	return validators, nil
+}
+
+// genesisData returns the genesis of the chain, which is cached once known.
+func (c *Client) genesisData(ctx context.Context) (*genesisData, error) {
+	c.genesisLock.Lock()
+	defer c.genesisLock.Unlock()
+	if c.genesis != nil {
+		return c.genesis, nil
+	}
+	genesis := &genesisData{}
+	if err := c.get(ctx, "/eth/v1/beacon/genesis", nil, genesis); err != nil {
+		return nil, err
+	}
+	c.genesis = genesis
+	return genesis, nil
+}
+
+// statusResponse returns the status of a validator, which is unknown if the validator is nil.
+func statusResponse(val *validatorData) *ethpb.ValidatorStatusResponse {
+	if val == nil {
+		return &ethpb.ValidatorStatusResponse{
+			Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+			ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	return &ethpb.ValidatorStatusResponse{
+		Status:          validatorStatus(val.Status),
+		ActivationEpoch: uint64(val.Validator.ActivationEpoch),
+	}
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckDoppelGanger watches the chain for the configured number of epochs following the
+// current one, before the validator signs anything, for attestations and blocks of its keys
+// signed by another validator client. The keys found active on the chain are not started.
+//
+// The epoch of the start of the check is skipped, as attestations and blocks of that epoch
+// could have been signed by this validator client before it was restarted. Only the keys
+// which were not checked yet are watched, so that the keys added at runtime are checked too.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelGanger")
+	defer span.End()
+
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, msgCouldNotFetchKeys)
+	}
+	uncheckedKeys := v.startDoppelgangerCheck(validatingKeys)
+	if len(uncheckedKeys) == 0 {
+		return nil
+	}
+	if err := v.checkDoppelgangers(ctx, uncheckedKeys); err != nil {
+		v.endDoppelgangerCheck(uncheckedKeys, false)
+		return err
+	}
+	v.endDoppelgangerCheck(uncheckedKeys, true)
+	return nil
+}
+
+// startDoppelgangerCheck returns the keys which are neither checked nor being checked, and
+// marks them as being checked.
+func (v *validator) startDoppelgangerCheck(validatingKeys [][48]byte) [][48]byte {
+	v.slashableKeysLock.Lock()
+	defer v.slashableKeysLock.Unlock()
+	if v.doppelgangerChecked == nil {
+		v.doppelgangerChecked = make(map[[48]byte]bool)
+	}
+	uncheckedKeys := make([][48]byte, 0, len(validatingKeys))
+	for _, pubKey := range validatingKeys {
+		if _, ok := v.doppelgangerChecked[pubKey]; ok {
+			continue
+		}
+		v.doppelgangerChecked[pubKey] = false
+		uncheckedKeys = append(uncheckedKeys, pubKey)
+	}
+	return uncheckedKeys
+}
+
+// endDoppelgangerCheck marks the keys as checked, or as not checked if the check failed, so
+// that they are checked again.
+func (v *validator) endDoppelgangerCheck(keys [][48]byte, checked bool) {
+	v.slashableKeysLock.Lock()
+	defer v.slashableKeysLock.Unlock()
+	for _, pubKey := range keys {
+		if checked {
+			v.doppelgangerChecked[pubKey] = true
+		} else {
+			delete(v.doppelgangerChecked, pubKey)
+		}
+	}
+}
+
+// checkDoppelgangers watches the chain for attestations and blocks of the given keys.
+func (v *validator) checkDoppelgangers(ctx context.Context, validatingKeys [][48]byte) error {
+	statuses, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		return wrapBeaconNodeError(err, "could not get validator statuses")
+	}
+	// Only the validators which are active can attest or propose.
+	activeKeys := make(map[uint64][48]byte)
+	for i, s := range statuses.Statuses {
+		if i >= len(statuses.Indices) || i >= len(statuses.PublicKeys) {
+			break
+		}
+		if s.Status == ethpb.ValidatorStatus_ACTIVE || s.Status == ethpb.ValidatorStatus_EXITING ||
+			s.Status == ethpb.ValidatorStatus_SLASHING {
+			activeKeys[statuses.Indices[i]] = bytesutil.ToBytes48(statuses.PublicKeys[i])
+		}
+	}
+	if len(activeKeys) == 0 {
+		return nil
+	}
+
+	startEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0))
+	lastEpoch := startEpoch + v.doppelgangerEpochs
+	log.WithFields(logrus.Fields{
+		"epochs":     v.doppelgangerEpochs,
+		"validators": len(activeKeys),
+	}).Info("Checking that the validator keys are not active on another validator client before starting them")
+	// The attestations of the last epoch can be included until the end of the following epoch.
+	for epoch := startEpoch + 1; epoch <= lastEpoch+1; epoch++ {
+		// The blocks of an epoch are checked once the epoch is over.
+		nextEpochStart, err := helpers.StartSlot(epoch + 1)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(time.Until(slotutil.SlotStartTime(v.genesisTime, nextEpochStart))):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		detected, err := v.detectDoppelgangers(ctx, activeKeys, epoch, startEpoch)
+		if err != nil {
+			return err
+		}
+		if len(detected) == 0 {
+			continue
+		}
+		v.slashableKeysLock.Lock()
+		if v.doppelgangerKeys == nil {
+			v.doppelgangerKeys = make(map[[48]byte]bool)
+		}
+		for index, pubKey := range detected {
+			log.WithFields(logrus.Fields{
+				"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+				"validatorIndex": index,
+				"epoch":          epoch,
+			}).Error("Doppelganger detected: validator key is active on another validator client, not starting it")
+			v.doppelgangerKeys[pubKey] = true
+			delete(activeKeys, index)
+		}
+		v.slashableKeysLock.Unlock()
+		if len(activeKeys) == 0 {
+			return nil
+		}
+	}
+	log.WithField("validators", len(activeKeys)).Info("No doppelganger detected, starting validator keys")
+	return nil
+}
+
+// detectDoppelgangers returns the validators among the given active keys which signed an
+// attestation or a block included in the blocks of the epoch, for epochs after the start epoch.
+func (v *validator) detectDoppelgangers(
+	ctx context.Context,
+	activeKeys map[uint64][48]byte,
+	epoch uint64,
+	startEpoch uint64,
+) (map[uint64][48]byte, error) {
+	detected := make(map[uint64][48]byte)
+
+	pageToken := ""
+	for {
+		resp, err := v.beaconClient.ListIndexedAttestations(ctx, &ethpb.ListIndexedAttestationsRequest{
+			QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+			PageToken:   pageToken,
+		})
+		if err != nil {
+			return nil, wrapBeaconNodeError(err, "could not list attestations")
+		}
+		for _, att := range resp.IndexedAttestations {
+			if att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch <= startEpoch {
+				continue
+			}
+			for _, index := range att.AttestingIndices {
+				if pubKey, ok := activeKeys[index]; ok {
+					detected[index] = pubKey
+				}
+			}
+		}
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	pageToken = ""
+	for {
+		resp, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+			PageToken:   pageToken,
+		})
+		if err != nil {
+			return nil, wrapBeaconNodeError(err, "could not list blocks")
+		}
+		for _, container := range resp.BlockContainers {
+			if container.Block == nil || container.Block.Block == nil {
+				continue
+			}
+			index := container.Block.Block.ProposerIndex
+			if pubKey, ok := activeKeys[index]; ok {
+				detected[index] = pubKey
+			}
+		}
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return detected, nil
+}
+
+// wrapBeaconNodeError wraps the transport errors of the beacon node as connection issues, so
+// that the check is retried. Other errors, such as a request not supported by the beacon node,
+// are returned as is.
+func wrapBeaconNodeError(err error, message string) error {
+	if status.Code(err) == codes.Unavailable {
+		return errors.Wrap(errConnectionIssue, errors.Wrap(err, message).Error())
+	}
+	return errors.Wrap(err, message)
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckDoppelGanger_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		keyManager:      genMockKeymanger(1),
+		validatorClient: client,
+		beaconClient:    beaconClient,
+	}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_NoActiveKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		beaconClient:       beaconClient,
+		doppelgangerEpochs: 2,
+	}
+	client.EXPECT().MultipleValidatorStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{keys[0][:]},
+		Indices:    []uint64{0},
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_PENDING}},
+	}, nil)
+
+	// No attestation nor block is listed for validators which are not active.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestDetectDoppelgangers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	startEpoch := uint64(10)
+	epoch := startEpoch + 1
+	activeKeys := map[uint64][48]byte{
+		1: {'a'},
+		2: {'b'},
+		3: {'c'},
+		4: {'d'},
+	}
+	v := validator{
+		beaconClient: beaconClient,
+	}
+	beaconClient.EXPECT().ListIndexedAttestations(
+		gomock.Any(),
+		&ethpb.ListIndexedAttestationsRequest{
+			QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+		},
+	).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{
+			{
+				// Attestations of the start epoch are not taken into account.
+				AttestingIndices: []uint64{1},
+				Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: startEpoch}},
+			},
+			{
+				AttestingIndices: []uint64{2, 5},
+				Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: epoch}},
+			},
+		},
+		NextPageToken: "1",
+	}, nil)
+	beaconClient.EXPECT().ListIndexedAttestations(
+		gomock.Any(),
+		&ethpb.ListIndexedAttestationsRequest{
+			QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+			PageToken:   "1",
+		},
+	).Return(&ethpb.ListIndexedAttestationsResponse{}, nil)
+	beaconClient.EXPECT().ListBlocks(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ProposerIndex: 3}}},
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ProposerIndex: 6}}},
+		},
+	}, nil)
+
+	detected, err := v.detectDoppelgangers(context.Background(), activeKeys, epoch, startEpoch)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64][48]byte{2: {'b'}, 3: {'c'}}, detected)
+}
+
+func TestDetectDoppelgangers_ConnectionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		beaconClient: beaconClient,
+	}
+	beaconClient.EXPECT().ListIndexedAttestations(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+
+	_, err := v.detectDoppelgangers(context.Background(), map[uint64][48]byte{1: {'a'}}, 1, 0)
+	assert.Equal(t, true, isConnectionError(err), "Expected a connection error, got %v", err)
+}
+
+func TestDetectDoppelgangers_NotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		beaconClient: beaconClient,
+	}
+	beaconClient.EXPECT().ListIndexedAttestations(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, status.Error(codes.Unimplemented, "not supported"))
+
+	// Requests the beacon node does not support are not retried.
+	_, err := v.detectDoppelgangers(context.Background(), map[uint64][48]byte{1: {'a'}}, 1, 0)
+	require.ErrorContains(t, "not supported", err)
+	assert.Equal(t, false, isConnectionError(err))
+}
+
+func TestCheckDoppelGanger_OnlyUncheckedKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:          km,
+		validatorClient:     client,
+		doppelgangerEpochs:  2,
+		doppelgangerChecked: map[[48]byte]bool{keys[0]: true},
+	}
+	client.EXPECT().MultipleValidatorStatus(
+		gomock.Any(),
+		&ethpb.MultipleValidatorStatusRequest{PublicKeys: [][]byte{keys[1][:]}},
+	).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{keys[1][:]},
+		Indices:    []uint64{1},
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_PENDING}},
+	}, nil)
+
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	assert.Equal(t, true, v.doppelgangerChecked[keys[1]])
+
+	// Keys which are all checked are not checked again.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_FailedCheckIsRetried(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		doppelgangerEpochs: 2,
+	}
+	client.EXPECT().MultipleValidatorStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+
+	err = v.CheckDoppelGanger(context.Background())
+	assert.Equal(t, true, isConnectionError(err), "Expected a connection error, got %v", err)
+	_, ok := v.doppelgangerChecked[keys[0]]
+	assert.Equal(t, false, ok, "Expected the key to be checked again")
+}
+
+func TestUpdateDuties_FiltersDoppelgangerKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	slot := params.BeaconConfig().SlotsPerEpoch
+
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:       km,
+		validatorClient:  client,
+		doppelgangerKeys: map[[48]byte]bool{keys[0]: true},
+	}
+	resp := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil).Times(2)
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
+	assert.LogsContain(t, hook, "Not including public key active on another validator client")
+}
+
+func TestUpdateDuties_FiltersUncheckedKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	slot := params.BeaconConfig().SlotsPerEpoch
+
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:          km,
+		validatorClient:     client,
+		doppelgangerEpochs:  2,
+		doppelgangerChecked: map[[48]byte]bool{keys[0]: true, keys[1]: false},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{keys[0][:]}},
+	).Return(&ethpb.DutiesResponse{}, nil)
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 2, PublicKeys: [][]byte{keys[0][:]}},
+	).Return(&ethpb.DutiesResponse{}, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
+	assert.LogsContain(t, hook, "Not including public key pending doppelganger check")
+}
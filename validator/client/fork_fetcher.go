@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+)
+
+// grpcForkFetcher fetches the fork information sent to a web3signer remote signer from the
+// standard beacon chain service of the beacon node.
+type grpcForkFetcher struct {
+	beaconClient ethpbv1.BeaconChainClient
+}
+
+// HeadFork returns the fork of the head state.
+func (f *grpcForkFetcher) HeadFork(ctx context.Context) (*pb.Fork, error) {
+	resp, err := f.beaconClient.GetStateFork(ctx, &ethpbv1.StateRequest{StateId: []byte("head")})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Fork == nil {
+		return nil, errors.New("beacon node returned no fork")
+	}
+	return &pb.Fork{
+		PreviousVersion: resp.Fork.PreviousVersion,
+		CurrentVersion:  resp.Fork.CurrentVersion,
+		Epoch:           resp.Fork.Epoch,
+	}, nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the chain.
+func (f *grpcForkFetcher) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	resp, err := f.beaconClient.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GenesisValidatorsRoot, nil
+}
+
+// setForkFetcher lets a web3signer keymanager fetch the fork information of its signing
+// requests from the beacon node. Other keymanagers are left as is.
+func setForkFetcher(km keymanager.IKeymanager, fetcher web3signer.ForkFetcher) {
+	if km, ok := km.(*web3signer.Keymanager); ok && fetcher != nil {
+		km.SetForkFetcher(fetcher)
+	}
+}
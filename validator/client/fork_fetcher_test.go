@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockV1BeaconChainClient struct {
+	ethpbv1.BeaconChainClient
+	stateID []byte
+}
+
+func (m *mockV1BeaconChainClient) GetStateFork(_ context.Context, req *ethpbv1.StateRequest, _ ...grpc.CallOption) (*ethpbv1.StateForkResponse, error) {
+	m.stateID = req.StateId
+	return &ethpbv1.StateForkResponse{Fork: &ethpbv1.Fork{
+		PreviousVersion: []byte{0, 0, 0, 0},
+		CurrentVersion:  []byte{0, 0, 0, 1},
+		Epoch:           10,
+	}}, nil
+}
+
+func (m *mockV1BeaconChainClient) GetGenesis(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpbv1.GenesisResponse, error) {
+	return &ethpbv1.GenesisResponse{GenesisValidatorsRoot: []byte{'a'}}, nil
+}
+
+func TestGrpcForkFetcher(t *testing.T) {
+	client := &mockV1BeaconChainClient{}
+	fetcher := &grpcForkFetcher{beaconClient: client}
+
+	fork, err := fetcher.HeadFork(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "head", string(client.stateID))
+	assert.DeepEqual(t, &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 1}, Epoch: 10}, fork)
+
+	root, err := fetcher.GenesisValidatorsRoot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'a'}, root)
+}
@@ -30,6 +30,7 @@ type FakeValidator struct {
 	WaitForSyncCalled                 int
 	WaitForActivationCalled           int
 	CanonicalHeadSlotCalled           int
+	CheckDoppelGangerCalled           int
 	ReceiveBlocksCalled               int
 	RetryTillSuccess                  int
 	ProposeBlockArg1                  uint64
@@ -95,6 +96,12 @@ func (fv *FakeValidator) SlasherReady(_ context.Context) error {
 	return nil
 }
 
+// CheckDoppelGanger for mocking.
+func (fv *FakeValidator) CheckDoppelGanger(_ context.Context) error {
+	fv.CheckDoppelGangerCalled++
+	return nil
+}
+
 // CanonicalHeadSlot for mocking.
 func (fv *FakeValidator) CanonicalHeadSlot(_ context.Context) (uint64, error) {
 	fv.CanonicalHeadSlotCalled++
@@ -27,6 +27,7 @@ type Validator interface {
 	WaitForChainStart(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
 	WaitForActivation(ctx context.Context, accountsChangedChan chan struct{}) error
+	CheckDoppelGanger(ctx context.Context) error
 	SlasherReady(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (uint64, error)
 	NextSlot() <-chan uint64
@@ -52,10 +53,11 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Check that the validator keys are not active on another validator client
+// 4 - Wait for the next slot start
+// 5 - Update assignments
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any
 func run(ctx context.Context, v Validator) {
 	cleanup := v.Done
 	defer cleanup()
@@ -109,6 +111,14 @@ func run(ctx context.Context, v Validator) {
 		if err != nil {
 			log.Fatalf("Could not wait for validator activation: %v", err)
 		}
+		err = v.CheckDoppelGanger(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not check for doppelganger validators: %v", err)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Could not check for doppelganger validators: %v", err)
+		}
 		headSlot, err = v.CanonicalHeadSlot(ctx)
 		if isConnectionError(err) {
 			log.Warnf("Could not get current canonical head slot: %v", err)
@@ -136,6 +146,12 @@ func run(ctx context.Context, v Validator) {
 			span.End()
 			cancel()
 			return // Exit if context is canceled.
+		case <-accountsChangedChan:
+			// Keys added at runtime are only started once checked for doppelgangers.
+			go checkDoppelGanger(ctx, v)
+			span.End()
+			cancel()
+			continue
 		case blocksError := <-connectionErrorChannel:
 			if blocksError != nil {
 				log.WithError(blocksError).Warn("block stream interrupted")
@@ -219,6 +235,27 @@ func run(ctx context.Context, v Validator) {
 	}
 }
 
+// checkDoppelGanger checks the keys added at runtime for doppelgangers, retrying on
+// connection issues with the beacon node.
+func checkDoppelGanger(ctx context.Context, v Validator) {
+	for {
+		err := v.CheckDoppelGanger(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not check for doppelganger validators: %v", err)
+			select {
+			case <-time.After(backOffPeriod):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err != nil {
+			log.WithError(err).Error("Could not check for doppelganger validators")
+		}
+		return
+	}
+}
+
 func isConnectionError(err error) bool {
 	return err != nil && errors.Is(err, errConnectionIssue)
 }
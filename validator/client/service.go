@@ -14,16 +14,19 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client/beaconapi"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -49,7 +52,10 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
+	doppelgangerEpochs    uint64
 	conn                  *grpc.ClientConn
+	restClient            *beaconapi.Client
+	beaconRESTAPIProvider string
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -74,12 +80,14 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	LogDutyCountDown           bool
+	DoppelgangerEpochs         uint64
 	WalletInitializedFeed      *event.Feed
 	GrpcRetriesFlag            uint
 	GrpcRetryDelay             time.Duration
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  iface.Protector
 	Endpoint                   string
+	BeaconRESTAPIProvider      string
 	Validator                  Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
@@ -93,11 +101,15 @@ type Config struct {
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	if cfg.BeaconRESTAPIProvider != "" && cfg.DoppelgangerEpochs > 0 {
+		return nil, errors.New("doppelganger protection is not supported when using the standard REST API of the beacon node")
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconRESTAPIProvider: cfg.BeaconRESTAPIProvider,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -115,40 +127,39 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	streamInterceptor := grpc.WithStreamInterceptor(middleware.ChainStreamClient(
-		grpc_opentracing.StreamClientInterceptor(),
-		grpc_prometheus.StreamClientInterceptor,
-		grpc_retry.StreamClientInterceptor(),
-	))
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
-		streamInterceptor,
-	)
-	if dialOpts == nil {
-		return
-	}
-
-	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
-
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
+	var validatorClient ethpb.BeaconNodeValidatorClient
+	var beaconClient beaconChainClient
+	var nodeClient ethpb.NodeClient
+	var forkFetcher web3signer.ForkFetcher
+	if v.beaconRESTAPIProvider != "" {
+		v.restClient = beaconapi.NewClient(v.beaconRESTAPIProvider)
+		validatorClient, beaconClient, nodeClient = v.restClient, v.restClient, v.restClient
+		forkFetcher = v.restClient
+		log.WithField("endpoint", v.beaconRESTAPIProvider).Info("Using the standard REST API of the beacon node")
+		if v.logValidatorBalances {
+			log.Warn("Validator balances are not logged when using the standard REST API of the beacon node")
+			v.logValidatorBalances = false
+		}
+	} else {
+		conn, ok := v.dialBeaconNode()
+		if !ok {
+			return
+		}
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(conn)
+		beaconClient = ethpb.NewBeaconChainClient(conn)
+		nodeClient = ethpb.NewNodeClient(conn)
+		forkFetcher = &grpcForkFetcher{beaconClient: ethpbv1.NewBeaconChainClient(conn)}
 	}
+	setForkFetcher(v.keyManager, forkFetcher)
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -176,9 +187,9 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		beaconClient:                   beaconClient,
+		node:                           nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -196,6 +207,10 @@ func (v *ValidatorService) Start() {
 		graffitiStruct:                 v.graffitiStruct,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		doppelgangerEpochs:             v.doppelgangerEpochs,
+		doppelgangerKeys:               make(map[[48]byte]bool),
+		doppelgangerChecked:            make(map[[48]byte]bool),
+		forkFetcher:                    forkFetcher,
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
@@ -213,12 +228,44 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.conn == nil && v.restClient == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
 }
 
+// dialBeaconNode dials the gRPC endpoint of the beacon node, and returns false if the
+// connection could not be set up.
+func (v *ValidatorService) dialBeaconNode() (*grpc.ClientConn, bool) {
+	streamInterceptor := grpc.WithStreamInterceptor(middleware.ChainStreamClient(
+		grpc_opentracing.StreamClientInterceptor(),
+		grpc_prometheus.StreamClientInterceptor,
+		grpc_retry.StreamClientInterceptor(),
+	))
+	dialOpts := ConstructDialOptions(
+		v.maxCallRecvMsgSize,
+		v.withCert,
+		v.grpcRetries,
+		v.grpcRetryDelay,
+		streamInterceptor,
+	)
+	if dialOpts == nil {
+		return nil, false
+	}
+
+	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
+
+	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	if err != nil {
+		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		return nil, false
+	}
+	if v.withCert != "" {
+		log.Info("Established secure gRPC connection")
+	}
+	return conn, true
+}
+
 func (v *ValidatorService) recheckKeys(ctx context.Context) {
 	var validatingKeys [][48]byte
 	var err error
@@ -307,8 +354,7 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &ptypes.Empty{})
+	resp, err := v.nodeClient().GetSyncStatus(ctx, &ptypes.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -318,8 +364,15 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &ptypes.Empty{})
+	return v.nodeClient().GetGenesis(ctx, &ptypes.Empty{})
+}
+
+// nodeClient returns the node client of the transport used to reach the beacon node.
+func (v *ValidatorService) nodeClient() ethpb.NodeClient {
+	if v.restClient != nil {
+		return v.restClient
+	}
+	return ethpb.NewNodeClient(v.conn)
 }
 
 // to accounts changes in the keymanager, then updates those keys'
@@ -65,6 +65,17 @@ func TestLifecycle_Insecure(t *testing.T) {
 	require.LogsContain(t, hook, "Stopping service")
 }
 
+func TestNewValidatorService_RESTDoppelgangerUnsupported(t *testing.T) {
+	_, err := NewValidatorService(context.Background(), &Config{
+		BeaconRESTAPIProvider: "http://127.0.0.1:3500",
+		DoppelgangerEpochs:    2,
+	})
+	assert.ErrorContains(t, "doppelganger protection is not supported", err)
+
+	_, err = NewValidatorService(context.Background(), &Config{BeaconRESTAPIProvider: "http://127.0.0.1:3500"})
+	require.NoError(t, err)
+}
+
 func TestStatus_NoConnectionError(t *testing.T) {
 	validatorService := &ValidatorService{}
 	assert.ErrorContains(t, "no connection", validatorService.Status())
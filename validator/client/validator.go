@@ -31,9 +31,11 @@ import (
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 // reconnectPeriod is the frequency that we try to restart our
@@ -59,6 +61,16 @@ const (
 	roleAggregator
 )
 
+// beaconChainClient is the part of the beacon chain API used by the validator, which is served
+// by both the Prysm gRPC API and the standard REST API of the beacon node.
+type beaconChainClient interface {
+	GetChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	StreamBlocks(ctx context.Context, in *ethpb.StreamBlocksRequest, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+	ListIndexedAttestations(ctx context.Context, in *ethpb.ListIndexedAttestationsRequest, opts ...grpc.CallOption) (*ethpb.ListIndexedAttestationsResponse, error)
+	ListBlocks(ctx context.Context, in *ethpb.ListBlocksRequest, opts ...grpc.CallOption) (*ethpb.ListBlocksResponse, error)
+}
+
 type validator struct {
 	logValidatorBalances               bool
 	useWeb                             bool
@@ -73,6 +85,7 @@ type validator struct {
 	blockFeed                          *event.Feed
 	genesisTime                        uint64
 	highestValidSlot                   uint64
+	doppelgangerEpochs                 uint64
 	domainDataCache                    *ristretto.Cache
 	aggregatedSlotCommitteeIDCache     *lru.Cache
 	ticker                             *slotutil.SlotTicker
@@ -82,7 +95,7 @@ type validator struct {
 	attLogs                            map[[32]byte]*attSubmitted
 	node                               ethpb.NodeClient
 	keyManager                         keymanager.IKeymanager
-	beaconClient                       ethpb.BeaconChainClient
+	beaconClient                       beaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
 	protector                          iface.Protector
 	db                                 vdb.Database
@@ -90,6 +103,9 @@ type validator struct {
 	voteStats                          voteStats
 	graffitiStruct                     *graffiti.Graffiti
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	doppelgangerKeys                   map[[48]byte]bool
+	doppelgangerChecked                map[[48]byte]bool
+	forkFetcher                        web3signer.ForkFetcher
 }
 
 // Done cleans up the validator.
@@ -117,6 +133,7 @@ func (v *validator) WaitForWalletInitialization(ctx context.Context) error {
 			if err != nil {
 				return errors.Wrap(err, "could not read keymanager")
 			}
+			setForkFetcher(keyManager, v.forkFetcher)
 			v.keyManager = keyManager
 			return nil
 		case <-ctx.Done():
@@ -378,13 +395,23 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	filteredKeys := make([][48]byte, 0, len(validatingKeys))
 	v.slashableKeysLock.RLock()
 	for _, pubKey := range validatingKeys {
-		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
-			filteredKeys = append(filteredKeys, pubKey)
-		} else {
+		if v.eipImportBlacklistedPublicKeys[pubKey] {
 			log.WithField(
 				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
 			).Warn("Not including slashable public key from slashing protection import " +
 				"in request to update validator duties")
+		} else if v.doppelgangerKeys[pubKey] {
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Warn("Not including public key active on another validator client " +
+				"in request to update validator duties")
+		} else if v.doppelgangerEpochs > 0 && !v.doppelgangerChecked[pubKey] {
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Info("Not including public key pending doppelganger check " +
+				"in request to update validator duties")
+		} else {
+			filteredKeys = append(filteredKeys, pubKey)
 		}
 	}
 	v.slashableKeysLock.RUnlock()
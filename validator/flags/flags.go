@@ -38,6 +38,13 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTAPIProviderFlag defines a beacon node endpoint serving the standard REST API.
+	BeaconRESTAPIProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node standard REST API provider endpoint, such as http://127.0.0.1:3500. When set, " +
+			"the validator performs its duties through the standard beacon node API instead of the Prysm gRPC API, " +
+			"which allows using beacon nodes of other implementations",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -249,30 +256,36 @@ var (
 		Value: false,
 	}
 	// RemoteSignerCertPathFlag defines the path to a client.crt file for a wallet to connect to
-	// a secure signer via TLS and gRPC.
+	// a secure signer via TLS.
 	RemoteSignerCertPathFlag = &cli.StringFlag{
 		Name:  "remote-signer-crt-path",
-		Usage: "/path/to/client.crt for establishing a secure, TLS gRPC connection to a remote signer server",
+		Usage: "/path/to/client.crt for establishing a secure, TLS connection to a remote signer server",
 		Value: "",
 	}
 	// RemoteSignerKeyPathFlag defines the path to a client.key file for a wallet to connect to
-	// a secure signer via TLS and gRPC.
+	// a secure signer via TLS.
 	RemoteSignerKeyPathFlag = &cli.StringFlag{
 		Name:  "remote-signer-key-path",
-		Usage: "/path/to/client.key for establishing a secure, TLS gRPC connection to a remote signer server",
+		Usage: "/path/to/client.key for establishing a secure, TLS connection to a remote signer server",
 		Value: "",
 	}
 	// RemoteSignerCACertPathFlag defines the path to a ca.crt file for a wallet to connect to
-	// a secure signer via TLS and gRPC.
+	// a secure signer via TLS.
 	RemoteSignerCACertPathFlag = &cli.StringFlag{
 		Name:  "remote-signer-ca-crt-path",
-		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
+		Usage: "/path/to/ca.crt for establishing a secure, TLS connection to a remote signer server",
+		Value: "",
+	}
+	// Web3SignerURLFlag defines the URL of a web3signer remote signer for a web3signer keymanager to connect to.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "URL of a remote signer serving the web3signer HTTP API, such as https://signer.example.com:9000",
 		Value: "",
 	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -305,6 +318,14 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// DoppelgangerProtectionEpochsFlag defines the number of epochs the validator watches the chain for
+	// its keys being active on another validator client before starting them.
+	DoppelgangerProtectionEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-protection-epochs",
+		Usage: "Number of epochs to watch the chain at startup for attestations and blocks of the validator keys " +
+			"signed by another validator client. Keys found active are not started. Disabled when set to 0",
+		Value: 0,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
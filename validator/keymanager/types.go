@@ -30,8 +30,8 @@ type Keystore struct {
 	Name    string                 `json:"name"`
 }
 
-// Kind defines an enum for either imported, derived, or remote-signing
-// keystores for Prysm wallets.
+// Kind defines an enum for either imported, derived, remote-signing or
+// web3signer keystores for Prysm wallets.
 type Kind int
 
 const (
@@ -41,6 +41,8 @@ const (
 	Derived
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// Web3Signer keymanager capable of remote-signing data via the HTTP API of web3signer.
+	Web3Signer
 )
 
 // String marshals a keymanager kind to a string value.
@@ -52,6 +54,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case Web3Signer:
+		return "web3signer"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -66,6 +70,8 @@ func ParseKind(k string) (Kind, error) {
 		return Imported, nil
 	case "remote":
 		return Remote, nil
+	case "web3signer":
+		return Web3Signer, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -0,0 +1,37 @@
+/*
+Package web3signer defines a keymanager implementation which connects to a remote signer
+over the HTTP signing API of web3signer. Unlike the remote keymanager, which sends bare
+signing roots to a Prysm gRPC remote signer, signing requests carry the type of the
+signed object, the object itself and the fork information, so that the remote signer can
+compute the signing root on its own and apply its own slashing protection. The fork of the
+head state and the genesis validators root are fetched from the beacon node the validator
+client is connected to.
+
+Signing requests are sent to POST /api/v1/eth2/sign/{public_key} with bodies such as:
+
+ {
+   "type": "ATTESTATION",
+   "fork_info": {
+     "fork": {"previous_version": "0x00000000", "current_version": "0x00000000", "epoch": "0"},
+     "genesis_validators_root": "0x4b36...fe95"
+   },
+   "signingRoot": "0x2a9f...1c3d",
+   "attestation": {"slot": "32", "index": "0", "beacon_block_root": "0x...", "source": {...}, "target": {...}}
+ }
+
+The supported types are BLOCK, ATTESTATION, AGGREGATE_AND_PROOF, AGGREGATION_SLOT,
+RANDAO_REVEAL and VOLUNTARY_EXIT. A request denied by the slashing protection of the remote
+signer is answered with a 412 status, and returned as ErrSigningDenied. The public keys
+available for validating are retrieved from GET /api/v1/eth2/publicKeys.
+
+The web3signer keymanager can be customized via a keymanageropts.json file
+which requires the following schema:
+
+ {
+   "base_url": "https://signer.example.com:9000", // Remote signer URL.
+   "crt_path": "/home/eth2/certs/client.crt",     // Optional client certificate path.
+   "key_path": "/home/eth2/certs/client.key",     // Optional client key path.
+   "ca_crt_path": "/home/eth2/certs/ca.crt"       // Optional certificate authority cert path.
+ }
+*/
+package web3signer
@@ -0,0 +1,341 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a failure from the remote signer when
+	// the signing operation was denied by its slashing protection.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+)
+
+// KeymanagerOpts for a web3signer keymanager.
+type KeymanagerOpts struct {
+	BaseURL        string `json:"base_url"`
+	ClientCertPath string `json:"crt_path,omitempty"`
+	ClientKeyPath  string `json:"key_path,omitempty"`
+	CACertPath     string `json:"ca_crt_path,omitempty"`
+}
+
+// ForkFetcher fetches from the beacon node the fork of the head state and the genesis
+// validators root of the chain, which the remote signer needs to compute signing roots.
+type ForkFetcher interface {
+	HeadFork(ctx context.Context) (*pb.Fork, error)
+	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the remote signer options.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation using remote signing keys via the HTTP API of
+// a web3signer compatible remote signer.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *http.Client
+	baseURL               string
+	forkLock              sync.Mutex
+	forkFetcher           ForkFetcher
+	genesisValidatorsRoot []byte
+	fork                  *pb.Fork
+	forkEpoch             uint64
+}
+
+// NewKeymanager instantiates a new web3signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("keymanager configuration is missing")
+	}
+	if cfg.Opts.BaseURL == "" {
+		return nil, errors.New("remote signer URL is required")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Opts.CACertPath != "" || cfg.Opts.ClientCertPath != "" {
+		tlsCfg := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+		if cfg.Opts.CACertPath != "" {
+			serverCA, err := ioutil.ReadFile(cfg.Opts.CACertPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+			}
+			cp := x509.NewCertPool()
+			if !cp.AppendCertsFromPEM(serverCA) {
+				return nil, errors.New("failed to add server's CA certificate to pool")
+			}
+			tlsCfg.RootCAs = cp
+		}
+		if cfg.Opts.ClientCertPath != "" {
+			if cfg.Opts.ClientKeyPath == "" {
+				return nil, errors.New("client key is required with a client certificate")
+			}
+			clientPair, err := tls.LoadX509KeyPair(cfg.Opts.ClientCertPath, cfg.Opts.ClientKeyPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+			}
+			tlsCfg.Certificates = []tls.Certificate{clientPair}
+		}
+		transport.TLSClientConfig = tlsCfg
+	}
+
+	return &Keymanager{
+		opts: cfg.Opts,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		baseURL: strings.TrimRight(cfg.Opts.BaseURL, "/"),
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a web3signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	values := []struct {
+		name  string
+		value string
+	}{
+		{"Remote signer URL", opts.BaseURL},
+		{"Client cert path", opts.ClientCertPath},
+		{"Client key path", opts.ClientKeyPath},
+		{"CA cert path", opts.CACertPath},
+	}
+	for _, v := range values {
+		if _, err := b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta(v.name), v.value)); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the web3signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// SetForkFetcher sets the fetcher of the fork information sent to the remote signer, once the
+// validator client is connected to the beacon node.
+func (km *Keymanager) SetForkFetcher(fetcher ForkFetcher) {
+	km.forkLock.Lock()
+	defer km.forkLock.Unlock()
+	km.forkFetcher = fetcher
+	km.genesisValidatorsRoot = nil
+	km.fork = nil
+}
+
+// forkInfo returns the fork information of a signing request for an object of the epoch. The
+// genesis validators root is fetched once, and the fork of the head state once per epoch.
+func (km *Keymanager) forkInfo(ctx context.Context, epoch uint64) (*forkInfo, error) {
+	km.forkLock.Lock()
+	defer km.forkLock.Unlock()
+	if km.forkFetcher == nil {
+		return nil, errors.New("fork information is not available before connecting to the beacon node")
+	}
+	if km.genesisValidatorsRoot == nil {
+		root, err := km.forkFetcher.GenesisValidatorsRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch genesis validators root")
+		}
+		km.genesisValidatorsRoot = root
+	}
+	if km.fork == nil || km.forkEpoch != epoch {
+		fork, err := km.forkFetcher.HeadFork(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch fork")
+		}
+		km.fork, km.forkEpoch = fork, epoch
+	}
+	return newForkInfo(km.fork, km.genesisValidatorsRoot)
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys available in the remote signer.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, km.baseURL+publicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	body, _, err := km.do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	var encoded []string
+	if err := json.Unmarshal(body, &encoded); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys")
+	}
+	pubKeys := make([][48]byte, len(encoded))
+	for i, k := range encoded {
+		pubKey, err := hexutil.Decode(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", k)
+		}
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("public key %s has length %d, expected 48", k, len(pubKey))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// FetchAllValidatingPublicKeys fetches the list of all public keys, including disabled ones.
+func (km *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return km.FetchValidatingPublicKeys(ctx)
+}
+
+// Sign signs a message for a validator key via an HTTP request carrying the object to sign,
+// so the remote signer can apply its own slashing protection.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signReq, epoch, err := newSignRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	signReq.ForkInfo, err = km.forkInfo(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := json.Marshal(signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode signing request")
+	}
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, km.baseURL+signPath+hexutil.Encode(req.PublicKey), bytes.NewReader(enc),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	body, contentType, err := km.do(httpReq)
+	if err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) {
+			switch statusErr.code {
+			case http.StatusPreconditionFailed:
+				return nil, errors.Wrap(ErrSigningDenied, statusErr.Error())
+			case http.StatusNotFound:
+				return nil, fmt.Errorf("public key %#x is not available in the remote signer", req.PublicKey)
+			default:
+				return nil, errors.Wrap(ErrSigningFailed, statusErr.Error())
+			}
+		}
+		return nil, err
+	}
+	return decodeSignature(body, contentType)
+}
+
+// SubscribeAccountChanges is currently NOT IMPLEMENTED for the web3signer keymanager.
+// INVOKING THIS FUNCTION HAS NO EFFECT!
+func (km *Keymanager) SubscribeAccountChanges(_ chan [][48]byte) event.Subscription {
+	return event.NewSubscription(func(i <-chan struct{}) error {
+		return nil
+	})
+}
+
+// statusError is returned when the remote signer answers a request with an unsuccessful status.
+type statusError struct {
+	code int
+	msg  string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("remote signer returned status %d: %s", e.code, e.msg)
+}
+
+// do sends the request to the remote signer and returns the body and content type of a
+// successful response.
+func (km *Keymanager) do(req *http.Request) ([]byte, string, error) {
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "could not read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", &statusError{code: resp.StatusCode, msg: strings.TrimSpace(string(body))}
+	}
+	return body, resp.Header.Get("Content-Type"), nil
+}
+
+// decodeSignature decodes a signature returned by the remote signer, either as a JSON object
+// or as a plain hex string depending on the content type of the response.
+func decodeSignature(body []byte, contentType string) (bls.Signature, error) {
+	encoded := strings.TrimSpace(string(body))
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == "application/json" {
+		resp := &signResponse{}
+		if err := json.Unmarshal(body, resp); err != nil {
+			return nil, errors.Wrap(err, "could not decode signing response")
+		}
+		encoded = resp.Signature
+	}
+	sig, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return bls.SignatureFromBytes(sig)
+}
@@ -0,0 +1,231 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var genesisValidatorsRoot = "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+
+type mockForkFetcher struct {
+	forkCalls int
+}
+
+func (m *mockForkFetcher) HeadFork(_ context.Context) (*pb.Fork, error) {
+	m.forkCalls++
+	return &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 1}, Epoch: 3}, nil
+}
+
+func (m *mockForkFetcher) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
+	return hexutil.Decode(genesisValidatorsRoot)
+}
+
+func setupKeymanager(t *testing.T, handler http.HandlerFunc) *Keymanager {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{
+			BaseURL: srv.URL,
+		},
+	})
+	require.NoError(t, err)
+	km.SetForkFetcher(&mockForkFetcher{})
+	return km
+}
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{}})
+	assert.ErrorContains(t, "remote signer URL is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseURL:        "http://localhost:9000",
+		ClientCertPath: "/path/to/client.crt",
+	}})
+	assert.ErrorContains(t, "client key is required", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, publicKeysPath, r.URL.Path)
+		_, err := fmt.Fprintf(w, `["%#x"]`, key.PublicKey().Marshal())
+		require.NoError(t, err)
+	})
+
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, key.PublicKey().Marshal(), keys[0][:])
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	root := bytes.Repeat([]byte{1}, 32)
+	sig := key.Sign(root)
+
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{
+		Slot:           40,
+		CommitteeIndex: 3,
+		Target:         &ethpb.Checkpoint{Epoch: 5, Root: make([]byte, 32)},
+	})
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, signPath+hexutil.Encode(key.PublicKey().Marshal()), r.URL.Path)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, attestationType, req["type"])
+		assert.Equal(t, hexutil.Encode(root), req["signingRoot"])
+		info, ok := req["fork_info"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		assert.Equal(t, genesisValidatorsRoot, info["genesis_validators_root"])
+		fork, ok := info["fork"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		assert.Equal(t, "3", fork["epoch"])
+		assert.Equal(t, "0x00000001", fork["current_version"])
+		att, ok := req["attestation"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		assert.Equal(t, "40", att["slot"])
+		assert.Equal(t, "3", att["index"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err = fmt.Fprintf(w, `{"signature":"%#x"}`, sig.Marshal())
+		require.NoError(t, err)
+	})
+
+	got, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   key.PublicKey().Marshal(),
+		SigningRoot: root,
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), got.Marshal())
+}
+
+func TestKeymanager_Sign_PlainTextSignature(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := key.Sign([]byte("randao"))
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &signRequest{}
+		require.NoError(t, json.Unmarshal(body, req))
+		assert.Equal(t, randaoRevealType, req.Type)
+		require.NotNil(t, req.RandaoReveal)
+		assert.Equal(t, "7", req.RandaoReveal.Epoch)
+
+		w.Header().Set("Content-Type", "text/plain")
+		_, err = fmt.Fprintf(w, "%#x", sig.Marshal())
+		require.NoError(t, err)
+	})
+
+	got, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey: key.PublicKey().Marshal(),
+		Object:    &validatorpb.SignRequest_Epoch{Epoch: 7},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), got.Marshal())
+}
+
+func TestKeymanager_Sign_ForkFetchedOncePerEpoch(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := key.Sign([]byte("randao"))
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		_, err := fmt.Fprintf(w, "%#x", sig.Marshal())
+		require.NoError(t, err)
+	})
+	fetcher := &mockForkFetcher{}
+	km.SetForkFetcher(fetcher)
+
+	for _, epoch := range []uint64{4, 4, 5} {
+		_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+			PublicKey: key.PublicKey().Marshal(),
+			Object:    &validatorpb.SignRequest_Epoch{Epoch: epoch},
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, fetcher.forkCalls)
+}
+
+func TestKeymanager_Sign_NoForkFetcher(t *testing.T) {
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{BaseURL: "http://localhost:9000"},
+	})
+	require.NoError(t, err)
+
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey: make([]byte, 48),
+		Object:    &validatorpb.SignRequest_Slot{Slot: 1},
+	})
+	assert.ErrorContains(t, "before connecting to the beacon node", err)
+}
+
+func TestKeymanager_Sign_Denied(t *testing.T) {
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte("Signing operation failed due to slashing protection rules"))
+		require.NoError(t, err)
+	})
+
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey: make([]byte, 48),
+		Object:    &validatorpb.SignRequest_Slot{Slot: 1},
+	})
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+}
+
+func TestKeymanager_Sign_UnsupportedObject(t *testing.T) {
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be sent")
+	})
+
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   make([]byte, 48),
+		SigningRoot: make([]byte, 32),
+	})
+	assert.ErrorContains(t, "unsupported object to sign", err)
+}
+
+func TestNewSignRequest_Block(t *testing.T) {
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 65
+	blk.Body.ProposerSlashings = []*ethpb.ProposerSlashing{{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}}
+
+	req, epoch, err := newSignRequest(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_Block{Block: blk},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, blockType, req.Type)
+	assert.Equal(t, uint64(2), epoch)
+	var encoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(req.Block, &encoded))
+	assert.Equal(t, "65", encoded["slot"])
+	body, ok := encoded["body"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	slashings, ok := body["proposer_slashings"].([]interface{})
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, len(slashings))
+	_, ok = slashings[0].(map[string]interface{})["signed_header_1"]
+	assert.Equal(t, true, ok, "Proposer slashing is not encoded with the standard field names")
+}
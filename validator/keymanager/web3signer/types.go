@@ -0,0 +1,116 @@
+package web3signer
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/apiutil"
+)
+
+// Types of the signing requests of the remote signer.
+const (
+	blockType             = "BLOCK"
+	attestationType       = "ATTESTATION"
+	aggregateAndProofType = "AGGREGATE_AND_PROOF"
+	aggregationSlotType   = "AGGREGATION_SLOT"
+	randaoRevealType      = "RANDAO_REVEAL"
+	voluntaryExitType     = "VOLUNTARY_EXIT"
+)
+
+// signRequest is the body of a signing request, which carries the object to sign along
+// with the fork information needed by the remote signer to compute the signing root.
+type signRequest struct {
+	Type              string           `json:"type"`
+	ForkInfo          *forkInfo        `json:"fork_info"`
+	SigningRoot       hexutil.Bytes    `json:"signingRoot,omitempty"`
+	Block             json.RawMessage  `json:"block,omitempty"`
+	Attestation       json.RawMessage  `json:"attestation,omitempty"`
+	AggregateAndProof json.RawMessage  `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlot `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoReveal    `json:"randao_reveal,omitempty"`
+	VoluntaryExit     json.RawMessage  `json:"voluntary_exit,omitempty"`
+}
+
+type forkInfo struct {
+	Fork                  json.RawMessage `json:"fork"`
+	GenesisValidatorsRoot hexutil.Bytes   `json:"genesis_validators_root"`
+}
+
+type aggregationSlot struct {
+	Slot string `json:"slot"`
+}
+
+type randaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// newSignRequest converts a sign request of the validator client to a typed signing request
+// of the remote signer, and returns the epoch of the object to sign. The fork information of
+// the request is left to the caller.
+func newSignRequest(req *validatorpb.SignRequest) (*signRequest, uint64, error) {
+	signReq := &signRequest{
+		SigningRoot: req.SigningRoot,
+	}
+	var epoch uint64
+	var err error
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		signReq.Type = blockType
+		epoch = helpers.SlotToEpoch(obj.Block.Slot)
+		signReq.Block, err = apiutil.MarshalSpec(obj.Block)
+	case *validatorpb.SignRequest_AttestationData:
+		signReq.Type = attestationType
+		if obj.AttestationData.Target == nil {
+			return nil, 0, errors.New("attestation data has no target")
+		}
+		epoch = obj.AttestationData.Target.Epoch
+		signReq.Attestation, err = apiutil.MarshalSpec(obj.AttestationData)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		signReq.Type = aggregateAndProofType
+		agg := obj.AggregateAttestationAndProof
+		if agg.Aggregate == nil || agg.Aggregate.Data == nil {
+			return nil, 0, errors.New("aggregate has no attestation data")
+		}
+		epoch = helpers.SlotToEpoch(agg.Aggregate.Data.Slot)
+		signReq.AggregateAndProof, err = apiutil.MarshalSpec(agg)
+	case *validatorpb.SignRequest_Slot:
+		signReq.Type = aggregationSlotType
+		epoch = helpers.SlotToEpoch(obj.Slot)
+		signReq.AggregationSlot = &aggregationSlot{Slot: strconv.FormatUint(obj.Slot, 10)}
+	case *validatorpb.SignRequest_Epoch:
+		signReq.Type = randaoRevealType
+		epoch = obj.Epoch
+		signReq.RandaoReveal = &randaoReveal{Epoch: strconv.FormatUint(obj.Epoch, 10)}
+	case *validatorpb.SignRequest_Exit:
+		signReq.Type = voluntaryExitType
+		epoch = obj.Exit.Epoch
+		signReq.VoluntaryExit, err = apiutil.MarshalSpec(obj.Exit)
+	default:
+		return nil, 0, errors.Errorf("unsupported object to sign: %T", req.Object)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not encode %s", signReq.Type)
+	}
+	return signReq, epoch, nil
+}
+
+// newForkInfo encodes the fork information of a signing request.
+func newForkInfo(fork *pb.Fork, genesisValidatorsRoot []byte) (*forkInfo, error) {
+	encodedFork, err := apiutil.MarshalSpec(fork)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode fork")
+	}
+	return &forkInfo{
+		Fork:                  encodedFork,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}, nil
+}
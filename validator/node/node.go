@@ -398,6 +398,7 @@ func (c *ValidatorClient) registerValidatorService(
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconRESTAPIProvider:      c.cliCtx.String(flags.BeaconRESTAPIProviderFlag.Name),
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
@@ -414,6 +415,7 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		DoppelgangerEpochs:         c.cliCtx.Uint64(flags.DoppelgangerProtectionEpochsFlag.Name),
 	})
 
 	if err != nil {